@@ -7,6 +7,7 @@
 package permissionv1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -21,6 +22,58 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MissingAttrMode int32
+
+const (
+	MissingAttrMode_MISSING_ATTR_MODE_UNKNOWN       MissingAttrMode = 0 // 未配置，按 MISSING_ATTR_MODE_FALSE 处理
+	MissingAttrMode_MISSING_ATTR_MODE_FALSE         MissingAttrMode = 1 // 比较结果为 false，NOT 取反后为 true
+	MissingAttrMode_MISSING_ATTR_MODE_INDETERMINATE MissingAttrMode = 2 // 无法判定，参考 XACML Indeterminate 向上传播，由合并算法决定结果
+	MissingAttrMode_MISSING_ATTR_MODE_DEFAULT       MissingAttrMode = 3 // 使用属性定义上的默认值，没有默认值时无法判定
+)
+
+// Enum value maps for MissingAttrMode.
+var (
+	MissingAttrMode_name = map[int32]string{
+		0: "MISSING_ATTR_MODE_UNKNOWN",
+		1: "MISSING_ATTR_MODE_FALSE",
+		2: "MISSING_ATTR_MODE_INDETERMINATE",
+		3: "MISSING_ATTR_MODE_DEFAULT",
+	}
+	MissingAttrMode_value = map[string]int32{
+		"MISSING_ATTR_MODE_UNKNOWN":       0,
+		"MISSING_ATTR_MODE_FALSE":         1,
+		"MISSING_ATTR_MODE_INDETERMINATE": 2,
+		"MISSING_ATTR_MODE_DEFAULT":       3,
+	}
+)
+
+func (x MissingAttrMode) Enum() *MissingAttrMode {
+	p := new(MissingAttrMode)
+	*p = x
+	return p
+}
+
+func (x MissingAttrMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MissingAttrMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_permission_v1_abac_proto_enumTypes[0].Descriptor()
+}
+
+func (MissingAttrMode) Type() protoreflect.EnumType {
+	return &file_permission_v1_abac_proto_enumTypes[0]
+}
+
+func (x MissingAttrMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MissingAttrMode.Descriptor instead.
+func (MissingAttrMode) EnumDescriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{0}
+}
+
 type PolicyStatus int32
 
 const (
@@ -54,11 +107,11 @@ func (x PolicyStatus) String() string {
 }
 
 func (PolicyStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_permission_v1_abac_proto_enumTypes[0].Descriptor()
+	return file_permission_v1_abac_proto_enumTypes[1].Descriptor()
 }
 
 func (PolicyStatus) Type() protoreflect.EnumType {
-	return &file_permission_v1_abac_proto_enumTypes[0]
+	return &file_permission_v1_abac_proto_enumTypes[1]
 }
 
 func (x PolicyStatus) Number() protoreflect.EnumNumber {
@@ -67,7 +120,7 @@ func (x PolicyStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use PolicyStatus.Descriptor instead.
 func (PolicyStatus) EnumDescriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{0}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{1}
 }
 
 type Effect int32
@@ -103,11 +156,11 @@ func (x Effect) String() string {
 }
 
 func (Effect) Descriptor() protoreflect.EnumDescriptor {
-	return file_permission_v1_abac_proto_enumTypes[1].Descriptor()
+	return file_permission_v1_abac_proto_enumTypes[2].Descriptor()
 }
 
 func (Effect) Type() protoreflect.EnumType {
-	return &file_permission_v1_abac_proto_enumTypes[1]
+	return &file_permission_v1_abac_proto_enumTypes[2]
 }
 
 func (x Effect) Number() protoreflect.EnumNumber {
@@ -116,7 +169,7 @@ func (x Effect) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Effect.Descriptor instead.
 func (Effect) EnumDescriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{1}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{2}
 }
 
 type RuleOperator int32
@@ -134,6 +187,8 @@ const (
 	RuleOperator_RULE_OPERATOR_IN               RuleOperator = 9
 	RuleOperator_RULE_OPERATOR_NOT_IN           RuleOperator = 10
 	RuleOperator_RULE_OPERATOR_NOT              RuleOperator = 11
+	RuleOperator_RULE_OPERATOR_IN_CIDR          RuleOperator = 12 // IP 属于网段列表中的任意一个，value 为网段数组，例如 ["10.0.0.0/8","fd00::/8"]
+	RuleOperator_RULE_OPERATOR_NOT_IN_CIDR      RuleOperator = 13
 )
 
 // Enum value maps for RuleOperator.
@@ -151,6 +206,8 @@ var (
 		9:  "RULE_OPERATOR_IN",
 		10: "RULE_OPERATOR_NOT_IN",
 		11: "RULE_OPERATOR_NOT",
+		12: "RULE_OPERATOR_IN_CIDR",
+		13: "RULE_OPERATOR_NOT_IN_CIDR",
 	}
 	RuleOperator_value = map[string]int32{
 		"RULE_OPERATOR_UNKNOWN":          0,
@@ -165,6 +222,8 @@ var (
 		"RULE_OPERATOR_IN":               9,
 		"RULE_OPERATOR_NOT_IN":           10,
 		"RULE_OPERATOR_NOT":              11,
+		"RULE_OPERATOR_IN_CIDR":          12,
+		"RULE_OPERATOR_NOT_IN_CIDR":      13,
 	}
 )
 
@@ -179,11 +238,11 @@ func (x RuleOperator) String() string {
 }
 
 func (RuleOperator) Descriptor() protoreflect.EnumDescriptor {
-	return file_permission_v1_abac_proto_enumTypes[2].Descriptor()
+	return file_permission_v1_abac_proto_enumTypes[3].Descriptor()
 }
 
 func (RuleOperator) Type() protoreflect.EnumType {
-	return &file_permission_v1_abac_proto_enumTypes[2]
+	return &file_permission_v1_abac_proto_enumTypes[3]
 }
 
 func (x RuleOperator) Number() protoreflect.EnumNumber {
@@ -192,7 +251,7 @@ func (x RuleOperator) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use RuleOperator.Descriptor instead.
 func (RuleOperator) EnumDescriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{2}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{3}
 }
 
 type DataType int32
@@ -204,6 +263,8 @@ const (
 	DataType_DATA_TYPE_BOOLEAN  DataType = 3
 	DataType_DATA_TYPE_FLOAT    DataType = 4
 	DataType_DATA_TYPE_DATETIME DataType = 5
+	DataType_DATA_TYPE_IP       DataType = 6 // IPv4 或者 IPv6 地址
+	DataType_DATA_TYPE_ARRAY    DataType = 7 // 字符串数组，JSON 格式
 )
 
 // Enum value maps for DataType.
@@ -215,6 +276,8 @@ var (
 		3: "DATA_TYPE_BOOLEAN",
 		4: "DATA_TYPE_FLOAT",
 		5: "DATA_TYPE_DATETIME",
+		6: "DATA_TYPE_IP",
+		7: "DATA_TYPE_ARRAY",
 	}
 	DataType_value = map[string]int32{
 		"DATA_TYPE_UNKNOWN":  0,
@@ -223,6 +286,8 @@ var (
 		"DATA_TYPE_BOOLEAN":  3,
 		"DATA_TYPE_FLOAT":    4,
 		"DATA_TYPE_DATETIME": 5,
+		"DATA_TYPE_IP":       6,
+		"DATA_TYPE_ARRAY":    7,
 	}
 )
 
@@ -237,11 +302,11 @@ func (x DataType) String() string {
 }
 
 func (DataType) Descriptor() protoreflect.EnumDescriptor {
-	return file_permission_v1_abac_proto_enumTypes[3].Descriptor()
+	return file_permission_v1_abac_proto_enumTypes[4].Descriptor()
 }
 
 func (DataType) Type() protoreflect.EnumType {
-	return &file_permission_v1_abac_proto_enumTypes[3]
+	return &file_permission_v1_abac_proto_enumTypes[4]
 }
 
 func (x DataType) Number() protoreflect.EnumNumber {
@@ -250,7 +315,7 @@ func (x DataType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DataType.Descriptor instead.
 func (DataType) EnumDescriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{3}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{4}
 }
 
 type EntityType int32
@@ -289,11 +354,11 @@ func (x EntityType) String() string {
 }
 
 func (EntityType) Descriptor() protoreflect.EnumDescriptor {
-	return file_permission_v1_abac_proto_enumTypes[4].Descriptor()
+	return file_permission_v1_abac_proto_enumTypes[5].Descriptor()
 }
 
 func (EntityType) Type() protoreflect.EnumType {
-	return &file_permission_v1_abac_proto_enumTypes[4]
+	return &file_permission_v1_abac_proto_enumTypes[5]
 }
 
 func (x EntityType) Number() protoreflect.EnumNumber {
@@ -302,7 +367,59 @@ func (x EntityType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EntityType.Descriptor instead.
 func (EntityType) EnumDescriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{4}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{5}
+}
+
+// AttributeValueFormat 批量导入、导出的格式，每一行是一个实体的一个属性值
+type AttributeValueFormat int32
+
+const (
+	AttributeValueFormat_ATTRIBUTE_VALUE_FORMAT_UNKNOWN AttributeValueFormat = 0
+	// 第一行为表头 entity_id,attribute,value，可选的 resource_type 列用于区分不同资源类型的同名资源属性，导出资源属性值时包含该列
+	AttributeValueFormat_ATTRIBUTE_VALUE_FORMAT_CSV AttributeValueFormat = 1
+	// 每行一个 JSON 对象，例如 {"entity_id":1,"attribute":"dept","value":"rd"}，资源属性可以有 resource_type 字段
+	AttributeValueFormat_ATTRIBUTE_VALUE_FORMAT_NDJSON AttributeValueFormat = 2
+)
+
+// Enum value maps for AttributeValueFormat.
+var (
+	AttributeValueFormat_name = map[int32]string{
+		0: "ATTRIBUTE_VALUE_FORMAT_UNKNOWN",
+		1: "ATTRIBUTE_VALUE_FORMAT_CSV",
+		2: "ATTRIBUTE_VALUE_FORMAT_NDJSON",
+	}
+	AttributeValueFormat_value = map[string]int32{
+		"ATTRIBUTE_VALUE_FORMAT_UNKNOWN": 0,
+		"ATTRIBUTE_VALUE_FORMAT_CSV":     1,
+		"ATTRIBUTE_VALUE_FORMAT_NDJSON":  2,
+	}
+)
+
+func (x AttributeValueFormat) Enum() *AttributeValueFormat {
+	p := new(AttributeValueFormat)
+	*p = x
+	return p
+}
+
+func (x AttributeValueFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttributeValueFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_permission_v1_abac_proto_enumTypes[6].Descriptor()
+}
+
+func (AttributeValueFormat) Type() protoreflect.EnumType {
+	return &file_permission_v1_abac_proto_enumTypes[6]
+}
+
+func (x AttributeValueFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttributeValueFormat.Descriptor instead.
+func (AttributeValueFormat) EnumDescriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{6}
 }
 
 type Policy struct {
@@ -316,6 +433,9 @@ type Policy struct {
 	Ctime         int64                  `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`
 	Utime         int64                  `protobuf:"varint,8,opt,name=utime,proto3" json:"utime,omitempty"`
 	BizId         int64                  `protobuf:"varint,9,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Priority      int32                  `protobuf:"varint,10,opt,name=priority,proto3" json:"priority,omitempty"`                                                             // 优先级，数值越大越优先，first_applicable 合并算法按它排序
+	Version       int64                  `protobuf:"varint,11,opt,name=version,proto3" json:"version,omitempty"`                                                               // 生效的版本号，0 表示还没有发布
+	MissingAttr   MissingAttrMode        `protobuf:"varint,12,opt,name=missing_attr,json=missingAttr,proto3,enum=permission.v1.MissingAttrMode" json:"missing_attr,omitempty"` // 规则引用的属性没有值时的处理方式
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -413,6 +533,27 @@ func (x *Policy) GetBizId() int64 {
 	return 0
 }
 
+func (x *Policy) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *Policy) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *Policy) GetMissingAttr() MissingAttrMode {
+	if x != nil {
+		return x.MissingAttr
+	}
+	return MissingAttrMode_MISSING_ATTR_MODE_UNKNOWN
+}
+
 type PolicyRule struct {
 	state               protoimpl.MessageState `protogen:"open.v1"`
 	Id                  int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -423,8 +564,12 @@ type PolicyRule struct {
 	Operator            RuleOperator           `protobuf:"varint,6,opt,name=operator,proto3,enum=permission.v1.RuleOperator" json:"operator,omitempty"`
 	Ctime               int64                  `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`
 	Utime               int64                  `protobuf:"varint,8,opt,name=utime,proto3" json:"utime,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// 右侧引用的属性，设置后和该属性的值比较，value 不生效，例如 resource.owner_id = subject.id
+	ValueAttributeDefinition *AttributeDefinition `protobuf:"bytes,9,opt,name=value_attribute_definition,json=valueAttributeDefinition,proto3" json:"value_attribute_definition,omitempty"`
+	// 自定义运算符，例如 MATCHES，operator 为 RULE_OPERATOR_UNKNOWN 时生效，运算符需要先在 evaluator.Selector 中注册
+	CustomOperator string `protobuf:"bytes,10,opt,name=custom_operator,json=customOperator,proto3" json:"custom_operator,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *PolicyRule) Reset() {
@@ -513,6 +658,20 @@ func (x *PolicyRule) GetUtime() int64 {
 	return 0
 }
 
+func (x *PolicyRule) GetValueAttributeDefinition() *AttributeDefinition {
+	if x != nil {
+		return x.ValueAttributeDefinition
+	}
+	return nil
+}
+
+func (x *PolicyRule) GetCustomOperator() string {
+	if x != nil {
+		return x.CustomOperator
+	}
+	return ""
+}
+
 type AttributeDefinition struct {
 	state          protoimpl.MessageState `protogen:"open.v1"`
 	Id             int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -523,8 +682,16 @@ type AttributeDefinition struct {
 	ValidationRule string                 `protobuf:"bytes,6,opt,name=validation_rule,json=validationRule,proto3" json:"validation_rule,omitempty"`
 	Ctime          int64                  `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`
 	Utime          int64                  `protobuf:"varint,8,opt,name=utime,proto3" json:"utime,omitempty"`
-	unknownFields  protoimpl.UnknownFields
-	sizeCache      protoimpl.SizeCache
+	// 自定义数据类型，data_type 为 DATA_TYPE_UNKNOWN 时生效，数据类型需要先在 evaluator.Selector 中注册
+	CustomDataType string `protobuf:"bytes,9,opt,name=custom_data_type,json=customDataType,proto3" json:"custom_data_type,omitempty"`
+	// 属性缺失时使用的默认值，为空表示没有默认值，只在策略的 missing_attr 为 MISSING_ATTR_MODE_DEFAULT 时生效
+	DefaultValue string `protobuf:"bytes,10,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// 按数据类型生效的结构化约束，和 validation_rule 同时生效
+	Constraints *AttributeConstraints `protobuf:"bytes,11,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// 只对资源属性生效，不为空时属性只属于该资源类型，不同资源类型可以定义同名的属性；为空表示所有资源类型共用
+	ResourceType  string `protobuf:"bytes,12,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AttributeDefinition) Reset() {
@@ -613,6 +780,127 @@ func (x *AttributeDefinition) GetUtime() int64 {
 	return 0
 }
 
+func (x *AttributeDefinition) GetCustomDataType() string {
+	if x != nil {
+		return x.CustomDataType
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *AttributeDefinition) GetConstraints() *AttributeConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *AttributeDefinition) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+// AttributeConstraints 属性值的结构化约束，未设置的字段不限制，array 的 enum_values、min、max 等约束作用于每个元素
+type AttributeConstraints struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Min           *float64               `protobuf:"fixed64,1,opt,name=min,proto3,oneof" json:"min,omitempty"`                                                         // number、float 的最小值，包含
+	Max           *float64               `protobuf:"fixed64,2,opt,name=max,proto3,oneof" json:"max,omitempty"`                                                         // number、float 的最大值，包含
+	EnumValues    []string               `protobuf:"bytes,3,rep,name=enum_values,json=enumValues,proto3" json:"enum_values,omitempty"`                                 // 允许的取值
+	ElementType   DataType               `protobuf:"varint,4,opt,name=element_type,json=elementType,proto3,enum=permission.v1.DataType" json:"element_type,omitempty"` // array 元素的数据类型，DATA_TYPE_UNKNOWN 不限制
+	MaxLength     int32                  `protobuf:"varint,5,opt,name=max_length,json=maxLength,proto3" json:"max_length,omitempty"`                                   // array 的最大长度，0 不限制
+	NotBefore     int64                  `protobuf:"varint,6,opt,name=not_before,json=notBefore,proto3" json:"not_before,omitempty"`                                   // datetime 的下界，毫秒时间戳，包含，0 不限制
+	NotAfter      int64                  `protobuf:"varint,7,opt,name=not_after,json=notAfter,proto3" json:"not_after,omitempty"`                                      // datetime 的上界，毫秒时间戳，包含，0 不限制
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeConstraints) Reset() {
+	*x = AttributeConstraints{}
+	mi := &file_permission_v1_abac_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeConstraints) ProtoMessage() {}
+
+func (x *AttributeConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeConstraints.ProtoReflect.Descriptor instead.
+func (*AttributeConstraints) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AttributeConstraints) GetMin() float64 {
+	if x != nil && x.Min != nil {
+		return *x.Min
+	}
+	return 0
+}
+
+func (x *AttributeConstraints) GetMax() float64 {
+	if x != nil && x.Max != nil {
+		return *x.Max
+	}
+	return 0
+}
+
+func (x *AttributeConstraints) GetEnumValues() []string {
+	if x != nil {
+		return x.EnumValues
+	}
+	return nil
+}
+
+func (x *AttributeConstraints) GetElementType() DataType {
+	if x != nil {
+		return x.ElementType
+	}
+	return DataType_DATA_TYPE_UNKNOWN
+}
+
+func (x *AttributeConstraints) GetMaxLength() int32 {
+	if x != nil {
+		return x.MaxLength
+	}
+	return 0
+}
+
+func (x *AttributeConstraints) GetNotBefore() int64 {
+	if x != nil {
+		return x.NotBefore
+	}
+	return 0
+}
+
+func (x *AttributeConstraints) GetNotAfter() int64 {
+	if x != nil {
+		return x.NotAfter
+	}
+	return 0
+}
+
 // Attribute related messages
 type SubjectAttributeValue struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -627,7 +915,7 @@ type SubjectAttributeValue struct {
 
 func (x *SubjectAttributeValue) Reset() {
 	*x = SubjectAttributeValue{}
-	mi := &file_permission_v1_abac_proto_msgTypes[3]
+	mi := &file_permission_v1_abac_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -639,7 +927,7 @@ func (x *SubjectAttributeValue) String() string {
 func (*SubjectAttributeValue) ProtoMessage() {}
 
 func (x *SubjectAttributeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[3]
+	mi := &file_permission_v1_abac_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -652,7 +940,7 @@ func (x *SubjectAttributeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubjectAttributeValue.ProtoReflect.Descriptor instead.
 func (*SubjectAttributeValue) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{3}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *SubjectAttributeValue) GetId() int64 {
@@ -703,7 +991,7 @@ type ResourceAttributeValue struct {
 
 func (x *ResourceAttributeValue) Reset() {
 	*x = ResourceAttributeValue{}
-	mi := &file_permission_v1_abac_proto_msgTypes[4]
+	mi := &file_permission_v1_abac_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -715,7 +1003,7 @@ func (x *ResourceAttributeValue) String() string {
 func (*ResourceAttributeValue) ProtoMessage() {}
 
 func (x *ResourceAttributeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[4]
+	mi := &file_permission_v1_abac_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -728,7 +1016,7 @@ func (x *ResourceAttributeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceAttributeValue.ProtoReflect.Descriptor instead.
 func (*ResourceAttributeValue) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{4}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ResourceAttributeValue) GetId() int64 {
@@ -779,7 +1067,7 @@ type EnvironmentAttributeValue struct {
 
 func (x *EnvironmentAttributeValue) Reset() {
 	*x = EnvironmentAttributeValue{}
-	mi := &file_permission_v1_abac_proto_msgTypes[5]
+	mi := &file_permission_v1_abac_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -791,7 +1079,7 @@ func (x *EnvironmentAttributeValue) String() string {
 func (*EnvironmentAttributeValue) ProtoMessage() {}
 
 func (x *EnvironmentAttributeValue) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[5]
+	mi := &file_permission_v1_abac_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -804,7 +1092,7 @@ func (x *EnvironmentAttributeValue) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentAttributeValue.ProtoReflect.Descriptor instead.
 func (*EnvironmentAttributeValue) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{5}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *EnvironmentAttributeValue) GetId() int64 {
@@ -852,7 +1140,7 @@ type SubjectObject struct {
 
 func (x *SubjectObject) Reset() {
 	*x = SubjectObject{}
-	mi := &file_permission_v1_abac_proto_msgTypes[6]
+	mi := &file_permission_v1_abac_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -864,7 +1152,7 @@ func (x *SubjectObject) String() string {
 func (*SubjectObject) ProtoMessage() {}
 
 func (x *SubjectObject) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[6]
+	mi := &file_permission_v1_abac_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -877,7 +1165,7 @@ func (x *SubjectObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubjectObject.ProtoReflect.Descriptor instead.
 func (*SubjectObject) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{6}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SubjectObject) GetId() int64 {
@@ -904,7 +1192,7 @@ type ResourceObject struct {
 
 func (x *ResourceObject) Reset() {
 	*x = ResourceObject{}
-	mi := &file_permission_v1_abac_proto_msgTypes[7]
+	mi := &file_permission_v1_abac_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -916,7 +1204,7 @@ func (x *ResourceObject) String() string {
 func (*ResourceObject) ProtoMessage() {}
 
 func (x *ResourceObject) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[7]
+	mi := &file_permission_v1_abac_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +1217,7 @@ func (x *ResourceObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ResourceObject.ProtoReflect.Descriptor instead.
 func (*ResourceObject) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{7}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ResourceObject) GetId() int64 {
@@ -955,7 +1243,7 @@ type EnvironmentObject struct {
 
 func (x *EnvironmentObject) Reset() {
 	*x = EnvironmentObject{}
-	mi := &file_permission_v1_abac_proto_msgTypes[8]
+	mi := &file_permission_v1_abac_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -967,7 +1255,7 @@ func (x *EnvironmentObject) String() string {
 func (*EnvironmentObject) ProtoMessage() {}
 
 func (x *EnvironmentObject) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[8]
+	mi := &file_permission_v1_abac_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -980,7 +1268,7 @@ func (x *EnvironmentObject) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use EnvironmentObject.ProtoReflect.Descriptor instead.
 func (*EnvironmentObject) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{8}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *EnvironmentObject) GetAttributeValues() []*EnvironmentAttributeValue {
@@ -1001,7 +1289,7 @@ type BizDefinition struct {
 
 func (x *BizDefinition) Reset() {
 	*x = BizDefinition{}
-	mi := &file_permission_v1_abac_proto_msgTypes[9]
+	mi := &file_permission_v1_abac_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1013,7 +1301,7 @@ func (x *BizDefinition) String() string {
 func (*BizDefinition) ProtoMessage() {}
 
 func (x *BizDefinition) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[9]
+	mi := &file_permission_v1_abac_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1026,7 +1314,7 @@ func (x *BizDefinition) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BizDefinition.ProtoReflect.Descriptor instead.
 func (*BizDefinition) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{9}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *BizDefinition) GetSubjectAttrs() []*AttributeDefinition {
@@ -1059,7 +1347,7 @@ type PolicyServiceSaveRequest struct {
 
 func (x *PolicyServiceSaveRequest) Reset() {
 	*x = PolicyServiceSaveRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[10]
+	mi := &file_permission_v1_abac_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1071,7 +1359,7 @@ func (x *PolicyServiceSaveRequest) String() string {
 func (*PolicyServiceSaveRequest) ProtoMessage() {}
 
 func (x *PolicyServiceSaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[10]
+	mi := &file_permission_v1_abac_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1084,7 +1372,7 @@ func (x *PolicyServiceSaveRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceSaveRequest.ProtoReflect.Descriptor instead.
 func (*PolicyServiceSaveRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{10}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *PolicyServiceSaveRequest) GetPolicy() *Policy {
@@ -1103,7 +1391,7 @@ type PolicyServiceSaveResponse struct {
 
 func (x *PolicyServiceSaveResponse) Reset() {
 	*x = PolicyServiceSaveResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[11]
+	mi := &file_permission_v1_abac_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1115,7 +1403,7 @@ func (x *PolicyServiceSaveResponse) String() string {
 func (*PolicyServiceSaveResponse) ProtoMessage() {}
 
 func (x *PolicyServiceSaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[11]
+	mi := &file_permission_v1_abac_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1128,7 +1416,7 @@ func (x *PolicyServiceSaveResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceSaveResponse.ProtoReflect.Descriptor instead.
 func (*PolicyServiceSaveResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{11}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *PolicyServiceSaveResponse) GetId() int64 {
@@ -1147,7 +1435,7 @@ type PolicyServiceDeleteRequest struct {
 
 func (x *PolicyServiceDeleteRequest) Reset() {
 	*x = PolicyServiceDeleteRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[12]
+	mi := &file_permission_v1_abac_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1159,7 +1447,7 @@ func (x *PolicyServiceDeleteRequest) String() string {
 func (*PolicyServiceDeleteRequest) ProtoMessage() {}
 
 func (x *PolicyServiceDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[12]
+	mi := &file_permission_v1_abac_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1172,7 +1460,7 @@ func (x *PolicyServiceDeleteRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceDeleteRequest.ProtoReflect.Descriptor instead.
 func (*PolicyServiceDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{12}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *PolicyServiceDeleteRequest) GetId() int64 {
@@ -1190,7 +1478,7 @@ type PolicyServiceDeleteResponse struct {
 
 func (x *PolicyServiceDeleteResponse) Reset() {
 	*x = PolicyServiceDeleteResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[13]
+	mi := &file_permission_v1_abac_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1202,7 +1490,7 @@ func (x *PolicyServiceDeleteResponse) String() string {
 func (*PolicyServiceDeleteResponse) ProtoMessage() {}
 
 func (x *PolicyServiceDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[13]
+	mi := &file_permission_v1_abac_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1215,7 +1503,7 @@ func (x *PolicyServiceDeleteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceDeleteResponse.ProtoReflect.Descriptor instead.
 func (*PolicyServiceDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{13}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{14}
 }
 
 type PolicyServiceFirstRequest struct {
@@ -1227,7 +1515,7 @@ type PolicyServiceFirstRequest struct {
 
 func (x *PolicyServiceFirstRequest) Reset() {
 	*x = PolicyServiceFirstRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[14]
+	mi := &file_permission_v1_abac_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1239,7 +1527,7 @@ func (x *PolicyServiceFirstRequest) String() string {
 func (*PolicyServiceFirstRequest) ProtoMessage() {}
 
 func (x *PolicyServiceFirstRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[14]
+	mi := &file_permission_v1_abac_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1252,7 +1540,7 @@ func (x *PolicyServiceFirstRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceFirstRequest.ProtoReflect.Descriptor instead.
 func (*PolicyServiceFirstRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{14}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *PolicyServiceFirstRequest) GetId() int64 {
@@ -1271,7 +1559,7 @@ type PolicyServiceFirstResponse struct {
 
 func (x *PolicyServiceFirstResponse) Reset() {
 	*x = PolicyServiceFirstResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[15]
+	mi := &file_permission_v1_abac_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1283,7 +1571,7 @@ func (x *PolicyServiceFirstResponse) String() string {
 func (*PolicyServiceFirstResponse) ProtoMessage() {}
 
 func (x *PolicyServiceFirstResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[15]
+	mi := &file_permission_v1_abac_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,7 +1584,7 @@ func (x *PolicyServiceFirstResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceFirstResponse.ProtoReflect.Descriptor instead.
 func (*PolicyServiceFirstResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{15}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *PolicyServiceFirstResponse) GetPolicy() *Policy {
@@ -1316,7 +1604,7 @@ type PolicyServiceSaveRuleRequest struct {
 
 func (x *PolicyServiceSaveRuleRequest) Reset() {
 	*x = PolicyServiceSaveRuleRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[16]
+	mi := &file_permission_v1_abac_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1328,7 +1616,7 @@ func (x *PolicyServiceSaveRuleRequest) String() string {
 func (*PolicyServiceSaveRuleRequest) ProtoMessage() {}
 
 func (x *PolicyServiceSaveRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[16]
+	mi := &file_permission_v1_abac_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1341,7 +1629,7 @@ func (x *PolicyServiceSaveRuleRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceSaveRuleRequest.ProtoReflect.Descriptor instead.
 func (*PolicyServiceSaveRuleRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{16}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *PolicyServiceSaveRuleRequest) GetPolicyId() int64 {
@@ -1367,7 +1655,7 @@ type PolicyServiceSaveRuleResponse struct {
 
 func (x *PolicyServiceSaveRuleResponse) Reset() {
 	*x = PolicyServiceSaveRuleResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[17]
+	mi := &file_permission_v1_abac_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1379,7 +1667,7 @@ func (x *PolicyServiceSaveRuleResponse) String() string {
 func (*PolicyServiceSaveRuleResponse) ProtoMessage() {}
 
 func (x *PolicyServiceSaveRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[17]
+	mi := &file_permission_v1_abac_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1392,7 +1680,7 @@ func (x *PolicyServiceSaveRuleResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PolicyServiceSaveRuleResponse.ProtoReflect.Descriptor instead.
 func (*PolicyServiceSaveRuleResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{17}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *PolicyServiceSaveRuleResponse) GetId() int64 {
@@ -1402,28 +1690,29 @@ func (x *PolicyServiceSaveRuleResponse) GetId() int64 {
 	return 0
 }
 
-type PolicyServiceDeleteRuleRequest struct {
+type PolicyServiceSaveExpressionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Expression    string                 `protobuf:"bytes,2,opt,name=expression,proto3" json:"expression,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceDeleteRuleRequest) Reset() {
-	*x = PolicyServiceDeleteRuleRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[18]
+func (x *PolicyServiceSaveExpressionRequest) Reset() {
+	*x = PolicyServiceSaveExpressionRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceDeleteRuleRequest) String() string {
+func (x *PolicyServiceSaveExpressionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceDeleteRuleRequest) ProtoMessage() {}
+func (*PolicyServiceSaveExpressionRequest) ProtoMessage() {}
 
-func (x *PolicyServiceDeleteRuleRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[18]
+func (x *PolicyServiceSaveExpressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1434,39 +1723,47 @@ func (x *PolicyServiceDeleteRuleRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceDeleteRuleRequest.ProtoReflect.Descriptor instead.
-func (*PolicyServiceDeleteRuleRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use PolicyServiceSaveExpressionRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSaveExpressionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *PolicyServiceDeleteRuleRequest) GetRuleId() int64 {
+func (x *PolicyServiceSaveExpressionRequest) GetPolicyId() int64 {
 	if x != nil {
-		return x.RuleId
+		return x.PolicyId
 	}
 	return 0
 }
 
-type PolicyServiceDeleteRuleResponse struct {
+func (x *PolicyServiceSaveExpressionRequest) GetExpression() string {
+	if x != nil {
+		return x.Expression
+	}
+	return ""
+}
+
+type PolicyServiceSaveExpressionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"` // 根规则ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceDeleteRuleResponse) Reset() {
-	*x = PolicyServiceDeleteRuleResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[19]
+func (x *PolicyServiceSaveExpressionResponse) Reset() {
+	*x = PolicyServiceSaveExpressionResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceDeleteRuleResponse) String() string {
+func (x *PolicyServiceSaveExpressionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceDeleteRuleResponse) ProtoMessage() {}
+func (*PolicyServiceSaveExpressionResponse) ProtoMessage() {}
 
-func (x *PolicyServiceDeleteRuleResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[19]
+func (x *PolicyServiceSaveExpressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1477,33 +1774,40 @@ func (x *PolicyServiceDeleteRuleResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceDeleteRuleResponse.ProtoReflect.Descriptor instead.
-func (*PolicyServiceDeleteRuleResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use PolicyServiceSaveExpressionResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSaveExpressionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{20}
 }
 
-type PolicyServiceFindPoliciesByPermissionIDsRequest struct {
+func (x *PolicyServiceSaveExpressionResponse) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type PolicyServiceGetExpressionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PermissionIds []int64                `protobuf:"varint,1,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) Reset() {
-	*x = PolicyServiceFindPoliciesByPermissionIDsRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[20]
+func (x *PolicyServiceGetExpressionRequest) Reset() {
+	*x = PolicyServiceGetExpressionRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) String() string {
+func (x *PolicyServiceGetExpressionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceFindPoliciesByPermissionIDsRequest) ProtoMessage() {}
+func (*PolicyServiceGetExpressionRequest) ProtoMessage() {}
 
-func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[20]
+func (x *PolicyServiceGetExpressionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1514,40 +1818,40 @@ func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) ProtoReflect() protore
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceFindPoliciesByPermissionIDsRequest.ProtoReflect.Descriptor instead.
-func (*PolicyServiceFindPoliciesByPermissionIDsRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use PolicyServiceGetExpressionRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceGetExpressionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) GetPermissionIds() []int64 {
+func (x *PolicyServiceGetExpressionRequest) GetPolicyId() int64 {
 	if x != nil {
-		return x.PermissionIds
+		return x.PolicyId
 	}
-	return nil
+	return 0
 }
 
-type PolicyServiceFindPoliciesByPermissionIDsResponse struct {
+type PolicyServiceGetExpressionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Policies      []*Policy              `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	Expression    string                 `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) Reset() {
-	*x = PolicyServiceFindPoliciesByPermissionIDsResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[21]
+func (x *PolicyServiceGetExpressionResponse) Reset() {
+	*x = PolicyServiceGetExpressionResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) String() string {
+func (x *PolicyServiceGetExpressionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceFindPoliciesByPermissionIDsResponse) ProtoMessage() {}
+func (*PolicyServiceGetExpressionResponse) ProtoMessage() {}
 
-func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[21]
+func (x *PolicyServiceGetExpressionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1558,42 +1862,45 @@ func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) ProtoReflect() protor
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceFindPoliciesByPermissionIDsResponse.ProtoReflect.Descriptor instead.
-func (*PolicyServiceFindPoliciesByPermissionIDsResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use PolicyServiceGetExpressionResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceGetExpressionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) GetPolicies() []*Policy {
+func (x *PolicyServiceGetExpressionResponse) GetExpression() string {
 	if x != nil {
-		return x.Policies
+		return x.Expression
 	}
-	return nil
+	return ""
 }
 
-type PolicyServiceSavePermissionPolicyRequest struct {
+type PolicyVersion struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
-	PermissionId  int64                  `protobuf:"varint,2,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
-	Effect        Effect                 `protobuf:"varint,3,opt,name=effect,proto3,enum=permission.v1.Effect" json:"effect,omitempty"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	SourceVersion int64                  `protobuf:"varint,2,opt,name=source_version,json=sourceVersion,proto3" json:"source_version,omitempty"` // 回滚时复制的版本号
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	Published     bool                   `protobuf:"varint,4,opt,name=published,proto3" json:"published,omitempty"` // 是否为当前生效的版本
+	Policy        *Policy                `protobuf:"bytes,5,opt,name=policy,proto3" json:"policy,omitempty"`        // 版本的快照，ListVersions 中不包含规则
+	Ctime         int64                  `protobuf:"varint,6,opt,name=ctime,proto3" json:"ctime,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceSavePermissionPolicyRequest) Reset() {
-	*x = PolicyServiceSavePermissionPolicyRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[22]
+func (x *PolicyVersion) Reset() {
+	*x = PolicyVersion{}
+	mi := &file_permission_v1_abac_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceSavePermissionPolicyRequest) String() string {
+func (x *PolicyVersion) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceSavePermissionPolicyRequest) ProtoMessage() {}
+func (*PolicyVersion) ProtoMessage() {}
 
-func (x *PolicyServiceSavePermissionPolicyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[22]
+func (x *PolicyVersion) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1604,53 +1911,77 @@ func (x *PolicyServiceSavePermissionPolicyRequest) ProtoReflect() protoreflect.M
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceSavePermissionPolicyRequest.ProtoReflect.Descriptor instead.
-func (*PolicyServiceSavePermissionPolicyRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use PolicyVersion.ProtoReflect.Descriptor instead.
+func (*PolicyVersion) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *PolicyServiceSavePermissionPolicyRequest) GetPolicyId() int64 {
+func (x *PolicyVersion) GetVersion() int64 {
 	if x != nil {
-		return x.PolicyId
+		return x.Version
 	}
 	return 0
 }
 
-func (x *PolicyServiceSavePermissionPolicyRequest) GetPermissionId() int64 {
+func (x *PolicyVersion) GetSourceVersion() int64 {
 	if x != nil {
-		return x.PermissionId
+		return x.SourceVersion
 	}
 	return 0
 }
 
-func (x *PolicyServiceSavePermissionPolicyRequest) GetEffect() Effect {
+func (x *PolicyVersion) GetComment() string {
 	if x != nil {
-		return x.Effect
+		return x.Comment
 	}
-	return Effect_EFFECT_UNKNOWN
+	return ""
 }
 
-type PolicyServiceSavePermissionPolicyResponse struct {
+func (x *PolicyVersion) GetPublished() bool {
+	if x != nil {
+		return x.Published
+	}
+	return false
+}
+
+func (x *PolicyVersion) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *PolicyVersion) GetCtime() int64 {
+	if x != nil {
+		return x.Ctime
+	}
+	return 0
+}
+
+type PolicyFieldChange struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceSavePermissionPolicyResponse) Reset() {
-	*x = PolicyServiceSavePermissionPolicyResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[23]
+func (x *PolicyFieldChange) Reset() {
+	*x = PolicyFieldChange{}
+	mi := &file_permission_v1_abac_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceSavePermissionPolicyResponse) String() string {
+func (x *PolicyFieldChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceSavePermissionPolicyResponse) ProtoMessage() {}
+func (*PolicyFieldChange) ProtoMessage() {}
 
-func (x *PolicyServiceSavePermissionPolicyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[23]
+func (x *PolicyFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1661,34 +1992,55 @@ func (x *PolicyServiceSavePermissionPolicyResponse) ProtoReflect() protoreflect.
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceSavePermissionPolicyResponse.ProtoReflect.Descriptor instead.
-func (*PolicyServiceSavePermissionPolicyResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use PolicyFieldChange.ProtoReflect.Descriptor instead.
+func (*PolicyFieldChange) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{24}
 }
 
-type PolicyServiceFindPoliciesRequest struct {
+func (x *PolicyFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *PolicyFieldChange) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *PolicyFieldChange) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+type PolicyServicePublishRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
-	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Comment       string                 `protobuf:"bytes,2,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceFindPoliciesRequest) Reset() {
-	*x = PolicyServiceFindPoliciesRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[24]
+func (x *PolicyServicePublishRequest) Reset() {
+	*x = PolicyServicePublishRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceFindPoliciesRequest) String() string {
+func (x *PolicyServicePublishRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceFindPoliciesRequest) ProtoMessage() {}
+func (*PolicyServicePublishRequest) ProtoMessage() {}
 
-func (x *PolicyServiceFindPoliciesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[24]
+func (x *PolicyServicePublishRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1699,48 +2051,47 @@ func (x *PolicyServiceFindPoliciesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceFindPoliciesRequest.ProtoReflect.Descriptor instead.
-func (*PolicyServiceFindPoliciesRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PolicyServicePublishRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServicePublishRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *PolicyServiceFindPoliciesRequest) GetOffset() int32 {
+func (x *PolicyServicePublishRequest) GetPolicyId() int64 {
 	if x != nil {
-		return x.Offset
+		return x.PolicyId
 	}
 	return 0
 }
 
-func (x *PolicyServiceFindPoliciesRequest) GetLimit() int32 {
+func (x *PolicyServicePublishRequest) GetComment() string {
 	if x != nil {
-		return x.Limit
+		return x.Comment
 	}
-	return 0
+	return ""
 }
 
-type PolicyServiceFindPoliciesResponse struct {
+type PolicyServicePublishResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
-	Policies      []*Policy              `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PolicyServiceFindPoliciesResponse) Reset() {
-	*x = PolicyServiceFindPoliciesResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[25]
+func (x *PolicyServicePublishResponse) Reset() {
+	*x = PolicyServicePublishResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PolicyServiceFindPoliciesResponse) String() string {
+func (x *PolicyServicePublishResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PolicyServiceFindPoliciesResponse) ProtoMessage() {}
+func (*PolicyServicePublishResponse) ProtoMessage() {}
 
-func (x *PolicyServiceFindPoliciesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[25]
+func (x *PolicyServicePublishResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,48 +2102,42 @@ func (x *PolicyServiceFindPoliciesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PolicyServiceFindPoliciesResponse.ProtoReflect.Descriptor instead.
-func (*PolicyServiceFindPoliciesResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use PolicyServicePublishResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServicePublishResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *PolicyServiceFindPoliciesResponse) GetTotal() int64 {
+func (x *PolicyServicePublishResponse) GetVersion() int64 {
 	if x != nil {
-		return x.Total
+		return x.Version
 	}
 	return 0
 }
 
-func (x *PolicyServiceFindPoliciesResponse) GetPolicies() []*Policy {
-	if x != nil {
-		return x.Policies
-	}
-	return nil
-}
-
-type AttributeValueServiceSaveSubjectValueRequest struct {
+type PolicyServiceRollbackRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	SubjectId     int64                  `protobuf:"varint,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
-	Value         *SubjectAttributeValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"` // 回滚到的版本
+	Comment       string                 `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttributeValueServiceSaveSubjectValueRequest) Reset() {
-	*x = AttributeValueServiceSaveSubjectValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[26]
+func (x *PolicyServiceRollbackRequest) Reset() {
+	*x = PolicyServiceRollbackRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeValueServiceSaveSubjectValueRequest) String() string {
+func (x *PolicyServiceRollbackRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeValueServiceSaveSubjectValueRequest) ProtoMessage() {}
+func (*PolicyServiceRollbackRequest) ProtoMessage() {}
 
-func (x *AttributeValueServiceSaveSubjectValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[26]
+func (x *PolicyServiceRollbackRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1803,35 +2148,1150 @@ func (x *AttributeValueServiceSaveSubjectValueRequest) ProtoReflect() protorefle
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeValueServiceSaveSubjectValueRequest.ProtoReflect.Descriptor instead.
-func (*AttributeValueServiceSaveSubjectValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use PolicyServiceRollbackRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceRollbackRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *AttributeValueServiceSaveSubjectValueRequest) GetSubjectId() int64 {
+func (x *PolicyServiceRollbackRequest) GetPolicyId() int64 {
 	if x != nil {
-		return x.SubjectId
+		return x.PolicyId
 	}
 	return 0
 }
 
-func (x *AttributeValueServiceSaveSubjectValueRequest) GetValue() *SubjectAttributeValue {
+func (x *PolicyServiceRollbackRequest) GetVersion() int64 {
 	if x != nil {
-		return x.Value
+		return x.Version
 	}
-	return nil
+	return 0
 }
 
-type AttributeValueServiceSaveSubjectValueResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *PolicyServiceRollbackRequest) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type PolicyServiceRollbackResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       int64                  `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"` // 新发布的版本
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceRollbackResponse) Reset() {
+	*x = PolicyServiceRollbackResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceRollbackResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceRollbackResponse) ProtoMessage() {}
+
+func (x *PolicyServiceRollbackResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceRollbackResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceRollbackResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PolicyServiceRollbackResponse) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PolicyServiceListVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceListVersionsRequest) Reset() {
+	*x = PolicyServiceListVersionsRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceListVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceListVersionsRequest) ProtoMessage() {}
+
+func (x *PolicyServiceListVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceListVersionsRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceListVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *PolicyServiceListVersionsRequest) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+type PolicyServiceListVersionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Versions      []*PolicyVersion       `protobuf:"bytes,1,rep,name=versions,proto3" json:"versions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceListVersionsResponse) Reset() {
+	*x = PolicyServiceListVersionsResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceListVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceListVersionsResponse) ProtoMessage() {}
+
+func (x *PolicyServiceListVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceListVersionsResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceListVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *PolicyServiceListVersionsResponse) GetVersions() []*PolicyVersion {
+	if x != nil {
+		return x.Versions
+	}
+	return nil
+}
+
+type PolicyServiceGetVersionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	Version       int64                  `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceGetVersionRequest) Reset() {
+	*x = PolicyServiceGetVersionRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceGetVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceGetVersionRequest) ProtoMessage() {}
+
+func (x *PolicyServiceGetVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceGetVersionRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceGetVersionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *PolicyServiceGetVersionRequest) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyServiceGetVersionRequest) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type PolicyServiceGetVersionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Version       *PolicyVersion         `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceGetVersionResponse) Reset() {
+	*x = PolicyServiceGetVersionResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceGetVersionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceGetVersionResponse) ProtoMessage() {}
+
+func (x *PolicyServiceGetVersionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceGetVersionResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceGetVersionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *PolicyServiceGetVersionResponse) GetVersion() *PolicyVersion {
+	if x != nil {
+		return x.Version
+	}
+	return nil
+}
+
+type PolicyServiceDiffVersionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	From          int64                  `protobuf:"varint,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            int64                  `protobuf:"varint,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceDiffVersionsRequest) Reset() {
+	*x = PolicyServiceDiffVersionsRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceDiffVersionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceDiffVersionsRequest) ProtoMessage() {}
+
+func (x *PolicyServiceDiffVersionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceDiffVersionsRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceDiffVersionsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *PolicyServiceDiffVersionsRequest) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyServiceDiffVersionsRequest) GetFrom() int64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *PolicyServiceDiffVersionsRequest) GetTo() int64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+type PolicyServiceDiffVersionsResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	FromExpression string                 `protobuf:"bytes,1,opt,name=from_expression,json=fromExpression,proto3" json:"from_expression,omitempty"`
+	ToExpression   string                 `protobuf:"bytes,2,opt,name=to_expression,json=toExpression,proto3" json:"to_expression,omitempty"`
+	AddedRules     []string               `protobuf:"bytes,3,rep,name=added_rules,json=addedRules,proto3" json:"added_rules,omitempty"`       // to 中有而 from 中没有的顶层规则
+	RemovedRules   []string               `protobuf:"bytes,4,rep,name=removed_rules,json=removedRules,proto3" json:"removed_rules,omitempty"` // from 中有而 to 中没有的顶层规则
+	Changes        []*PolicyFieldChange   `protobuf:"bytes,5,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *PolicyServiceDiffVersionsResponse) Reset() {
+	*x = PolicyServiceDiffVersionsResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceDiffVersionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceDiffVersionsResponse) ProtoMessage() {}
+
+func (x *PolicyServiceDiffVersionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceDiffVersionsResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceDiffVersionsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *PolicyServiceDiffVersionsResponse) GetFromExpression() string {
+	if x != nil {
+		return x.FromExpression
+	}
+	return ""
+}
+
+func (x *PolicyServiceDiffVersionsResponse) GetToExpression() string {
+	if x != nil {
+		return x.ToExpression
+	}
+	return ""
+}
+
+func (x *PolicyServiceDiffVersionsResponse) GetAddedRules() []string {
+	if x != nil {
+		return x.AddedRules
+	}
+	return nil
+}
+
+func (x *PolicyServiceDiffVersionsResponse) GetRemovedRules() []string {
+	if x != nil {
+		return x.RemovedRules
+	}
+	return nil
+}
+
+func (x *PolicyServiceDiffVersionsResponse) GetChanges() []*PolicyFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type PolicyServiceDeleteRuleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RuleId        int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceDeleteRuleRequest) Reset() {
+	*x = PolicyServiceDeleteRuleRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceDeleteRuleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceDeleteRuleRequest) ProtoMessage() {}
+
+func (x *PolicyServiceDeleteRuleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceDeleteRuleRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceDeleteRuleRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *PolicyServiceDeleteRuleRequest) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+type PolicyServiceDeleteRuleResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceDeleteRuleResponse) Reset() {
+	*x = PolicyServiceDeleteRuleResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceDeleteRuleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceDeleteRuleResponse) ProtoMessage() {}
+
+func (x *PolicyServiceDeleteRuleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceDeleteRuleResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceDeleteRuleResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{36}
+}
+
+type PolicyServiceFindPoliciesByPermissionIDsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PermissionIds []int64                `protobuf:"varint,1,rep,packed,name=permission_ids,json=permissionIds,proto3" json:"permission_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) Reset() {
+	*x = PolicyServiceFindPoliciesByPermissionIDsRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceFindPoliciesByPermissionIDsRequest) ProtoMessage() {}
+
+func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceFindPoliciesByPermissionIDsRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceFindPoliciesByPermissionIDsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PolicyServiceFindPoliciesByPermissionIDsRequest) GetPermissionIds() []int64 {
+	if x != nil {
+		return x.PermissionIds
+	}
+	return nil
+}
+
+type PolicyServiceFindPoliciesByPermissionIDsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Policies      []*Policy              `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) Reset() {
+	*x = PolicyServiceFindPoliciesByPermissionIDsResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceFindPoliciesByPermissionIDsResponse) ProtoMessage() {}
+
+func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceFindPoliciesByPermissionIDsResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceFindPoliciesByPermissionIDsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PolicyServiceFindPoliciesByPermissionIDsResponse) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type PolicyServiceSavePermissionPolicyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	PermissionId  int64                  `protobuf:"varint,2,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	Effect        Effect                 `protobuf:"varint,3,opt,name=effect,proto3,enum=permission.v1.Effect" json:"effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) Reset() {
+	*x = PolicyServiceSavePermissionPolicyRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceSavePermissionPolicyRequest) ProtoMessage() {}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceSavePermissionPolicyRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSavePermissionPolicyRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) GetPermissionId() int64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+func (x *PolicyServiceSavePermissionPolicyRequest) GetEffect() Effect {
+	if x != nil {
+		return x.Effect
+	}
+	return Effect_EFFECT_UNKNOWN
+}
+
+type PolicyServiceSavePermissionPolicyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceSavePermissionPolicyResponse) Reset() {
+	*x = PolicyServiceSavePermissionPolicyResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceSavePermissionPolicyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceSavePermissionPolicyResponse) ProtoMessage() {}
+
+func (x *PolicyServiceSavePermissionPolicyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceSavePermissionPolicyResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSavePermissionPolicyResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{40}
+}
+
+type PolicyServiceFindPoliciesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Offset        int32                  `protobuf:"varint,1,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceFindPoliciesRequest) Reset() {
+	*x = PolicyServiceFindPoliciesRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceFindPoliciesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceFindPoliciesRequest) ProtoMessage() {}
+
+func (x *PolicyServiceFindPoliciesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceFindPoliciesRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceFindPoliciesRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *PolicyServiceFindPoliciesRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *PolicyServiceFindPoliciesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type PolicyServiceFindPoliciesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Policies      []*Policy              `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceFindPoliciesResponse) Reset() {
+	*x = PolicyServiceFindPoliciesResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceFindPoliciesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceFindPoliciesResponse) ProtoMessage() {}
+
+func (x *PolicyServiceFindPoliciesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceFindPoliciesResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceFindPoliciesResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PolicyServiceFindPoliciesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *PolicyServiceFindPoliciesResponse) GetPolicies() []*Policy {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type PolicyPermissionBinding struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PermissionId  int64                  `protobuf:"varint,1,opt,name=permission_id,json=permissionId,proto3" json:"permission_id,omitempty"`
+	Effect        Effect                 `protobuf:"varint,2,opt,name=effect,proto3,enum=permission.v1.Effect" json:"effect,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyPermissionBinding) Reset() {
+	*x = PolicyPermissionBinding{}
+	mi := &file_permission_v1_abac_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyPermissionBinding) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyPermissionBinding) ProtoMessage() {}
+
+func (x *PolicyPermissionBinding) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyPermissionBinding.ProtoReflect.Descriptor instead.
+func (*PolicyPermissionBinding) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PolicyPermissionBinding) GetPermissionId() int64 {
+	if x != nil {
+		return x.PermissionId
+	}
+	return 0
+}
+
+func (x *PolicyPermissionBinding) GetEffect() Effect {
+	if x != nil {
+		return x.Effect
+	}
+	return Effect_EFFECT_UNKNOWN
+}
+
+type SimulationSample struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Uid                   int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	ResourceType          string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceKey           string                 `protobuf:"bytes,3,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	Actions               []string               `protobuf:"bytes,4,rep,name=actions,proto3" json:"actions,omitempty"`
+	SubjectAttributes     map[string]string      `protobuf:"bytes,5,rep,name=subject_attributes,json=subjectAttributes,proto3" json:"subject_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ResourceAttributes    map[string]string      `protobuf:"bytes,6,rep,name=resource_attributes,json=resourceAttributes,proto3" json:"resource_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EnvironmentAttributes map[string]string      `protobuf:"bytes,7,rep,name=environment_attributes,json=environmentAttributes,proto3" json:"environment_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SimulationSample) Reset() {
+	*x = SimulationSample{}
+	mi := &file_permission_v1_abac_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationSample) ProtoMessage() {}
+
+func (x *SimulationSample) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationSample.ProtoReflect.Descriptor instead.
+func (*SimulationSample) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SimulationSample) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *SimulationSample) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *SimulationSample) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+func (x *SimulationSample) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *SimulationSample) GetSubjectAttributes() map[string]string {
+	if x != nil {
+		return x.SubjectAttributes
+	}
+	return nil
+}
+
+func (x *SimulationSample) GetResourceAttributes() map[string]string {
+	if x != nil {
+		return x.ResourceAttributes
+	}
+	return nil
+}
+
+func (x *SimulationSample) GetEnvironmentAttributes() map[string]string {
+	if x != nil {
+		return x.EnvironmentAttributes
+	}
+	return nil
+}
+
+type SimulationResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Before        bool                   `protobuf:"varint,1,opt,name=before,proto3" json:"before,omitempty"` // 只使用当前生效策略的结果
+	After         bool                   `protobuf:"varint,2,opt,name=after,proto3" json:"after,omitempty"`   // 加入候选策略后的结果
+	Changed       bool                   `protobuf:"varint,3,opt,name=changed,proto3" json:"changed,omitempty"`
+	Applicable    bool                   `protobuf:"varint,4,opt,name=applicable,proto3" json:"applicable,omitempty"` // 候选策略是否绑定了样本涉及的权限
+	Matched       bool                   `protobuf:"varint,5,opt,name=matched,proto3" json:"matched,omitempty"`       // 候选策略的规则是否满足
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimulationResult) Reset() {
+	*x = SimulationResult{}
+	mi := &file_permission_v1_abac_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimulationResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimulationResult) ProtoMessage() {}
+
+func (x *SimulationResult) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimulationResult.ProtoReflect.Descriptor instead.
+func (*SimulationResult) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SimulationResult) GetBefore() bool {
+	if x != nil {
+		return x.Before
+	}
+	return false
+}
+
+func (x *SimulationResult) GetAfter() bool {
+	if x != nil {
+		return x.After
+	}
+	return false
+}
+
+func (x *SimulationResult) GetChanged() bool {
+	if x != nil {
+		return x.Changed
+	}
+	return false
+}
+
+func (x *SimulationResult) GetApplicable() bool {
+	if x != nil {
+		return x.Applicable
+	}
+	return false
+}
+
+func (x *SimulationResult) GetMatched() bool {
+	if x != nil {
+		return x.Matched
+	}
+	return false
+}
+
+type PolicyServiceSimulateRequest struct {
+	state         protoimpl.MessageState     `protogen:"open.v1"`
+	Policy        *Policy                    `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`           // 候选策略以及规则树，id 不为 0 时替换已有的同 id 策略
+	Permissions   []*PolicyPermissionBinding `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"` // 候选策略绑定的权限
+	Samples       []*SimulationSample        `protobuf:"bytes,3,rep,name=samples,proto3" json:"samples,omitempty"`         // 最多 100 个样本
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceSimulateRequest) Reset() {
+	*x = PolicyServiceSimulateRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceSimulateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceSimulateRequest) ProtoMessage() {}
+
+func (x *PolicyServiceSimulateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceSimulateRequest.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSimulateRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *PolicyServiceSimulateRequest) GetPolicy() *Policy {
+	if x != nil {
+		return x.Policy
+	}
+	return nil
+}
+
+func (x *PolicyServiceSimulateRequest) GetPermissions() []*PolicyPermissionBinding {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *PolicyServiceSimulateRequest) GetSamples() []*SimulationSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type PolicyServiceSimulateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*SimulationResult    `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // 与请求中 samples 顺序一一对应
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyServiceSimulateResponse) Reset() {
+	*x = PolicyServiceSimulateResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyServiceSimulateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyServiceSimulateResponse) ProtoMessage() {}
+
+func (x *PolicyServiceSimulateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyServiceSimulateResponse.ProtoReflect.Descriptor instead.
+func (*PolicyServiceSimulateResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *PolicyServiceSimulateResponse) GetResults() []*SimulationResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type AttributeValueServiceSaveSubjectValueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SubjectId     int64                  `protobuf:"varint,1,opt,name=subject_id,json=subjectId,proto3" json:"subject_id,omitempty"`
+	Value         *SubjectAttributeValue `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeValueServiceSaveSubjectValueRequest) Reset() {
+	*x = AttributeValueServiceSaveSubjectValueRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeValueServiceSaveSubjectValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValueServiceSaveSubjectValueRequest) ProtoMessage() {}
+
+func (x *AttributeValueServiceSaveSubjectValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValueServiceSaveSubjectValueRequest.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceSaveSubjectValueRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *AttributeValueServiceSaveSubjectValueRequest) GetSubjectId() int64 {
+	if x != nil {
+		return x.SubjectId
+	}
+	return 0
+}
+
+func (x *AttributeValueServiceSaveSubjectValueRequest) GetValue() *SubjectAttributeValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type AttributeValueServiceSaveSubjectValueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AttributeValueServiceSaveSubjectValueResponse) Reset() {
 	*x = AttributeValueServiceSaveSubjectValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[27]
+	mi := &file_permission_v1_abac_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1843,7 +3303,7 @@ func (x *AttributeValueServiceSaveSubjectValueResponse) String() string {
 func (*AttributeValueServiceSaveSubjectValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveSubjectValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[27]
+	mi := &file_permission_v1_abac_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1856,7 +3316,7 @@ func (x *AttributeValueServiceSaveSubjectValueResponse) ProtoReflect() protorefl
 
 // Deprecated: Use AttributeValueServiceSaveSubjectValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveSubjectValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{27}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *AttributeValueServiceSaveSubjectValueResponse) GetId() int64 {
@@ -1875,7 +3335,7 @@ type AttributeValueServiceDeleteSubjectValueRequest struct {
 
 func (x *AttributeValueServiceDeleteSubjectValueRequest) Reset() {
 	*x = AttributeValueServiceDeleteSubjectValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[28]
+	mi := &file_permission_v1_abac_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1887,7 +3347,7 @@ func (x *AttributeValueServiceDeleteSubjectValueRequest) String() string {
 func (*AttributeValueServiceDeleteSubjectValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceDeleteSubjectValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[28]
+	mi := &file_permission_v1_abac_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1900,7 +3360,7 @@ func (x *AttributeValueServiceDeleteSubjectValueRequest) ProtoReflect() protoref
 
 // Deprecated: Use AttributeValueServiceDeleteSubjectValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceDeleteSubjectValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{28}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *AttributeValueServiceDeleteSubjectValueRequest) GetId() int64 {
@@ -1918,7 +3378,7 @@ type AttributeValueServiceDeleteSubjectValueResponse struct {
 
 func (x *AttributeValueServiceDeleteSubjectValueResponse) Reset() {
 	*x = AttributeValueServiceDeleteSubjectValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[29]
+	mi := &file_permission_v1_abac_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1930,7 +3390,7 @@ func (x *AttributeValueServiceDeleteSubjectValueResponse) String() string {
 func (*AttributeValueServiceDeleteSubjectValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceDeleteSubjectValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[29]
+	mi := &file_permission_v1_abac_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1943,7 +3403,7 @@ func (x *AttributeValueServiceDeleteSubjectValueResponse) ProtoReflect() protore
 
 // Deprecated: Use AttributeValueServiceDeleteSubjectValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceDeleteSubjectValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{29}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{51}
 }
 
 type AttributeValueServiceFindSubjectValueRequest struct {
@@ -1955,7 +3415,7 @@ type AttributeValueServiceFindSubjectValueRequest struct {
 
 func (x *AttributeValueServiceFindSubjectValueRequest) Reset() {
 	*x = AttributeValueServiceFindSubjectValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[30]
+	mi := &file_permission_v1_abac_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1967,7 +3427,7 @@ func (x *AttributeValueServiceFindSubjectValueRequest) String() string {
 func (*AttributeValueServiceFindSubjectValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindSubjectValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[30]
+	mi := &file_permission_v1_abac_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1980,7 +3440,7 @@ func (x *AttributeValueServiceFindSubjectValueRequest) ProtoReflect() protorefle
 
 // Deprecated: Use AttributeValueServiceFindSubjectValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindSubjectValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{30}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *AttributeValueServiceFindSubjectValueRequest) GetSubjectId() int64 {
@@ -1999,7 +3459,7 @@ type AttributeValueServiceFindSubjectValueResponse struct {
 
 func (x *AttributeValueServiceFindSubjectValueResponse) Reset() {
 	*x = AttributeValueServiceFindSubjectValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[31]
+	mi := &file_permission_v1_abac_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2011,7 +3471,7 @@ func (x *AttributeValueServiceFindSubjectValueResponse) String() string {
 func (*AttributeValueServiceFindSubjectValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindSubjectValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[31]
+	mi := &file_permission_v1_abac_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2024,7 +3484,7 @@ func (x *AttributeValueServiceFindSubjectValueResponse) ProtoReflect() protorefl
 
 // Deprecated: Use AttributeValueServiceFindSubjectValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindSubjectValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{31}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *AttributeValueServiceFindSubjectValueResponse) GetSubject() *SubjectObject {
@@ -2043,7 +3503,7 @@ type AttributeValueServiceFindSubjectValueWithDefinitionRequest struct {
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionRequest) Reset() {
 	*x = AttributeValueServiceFindSubjectValueWithDefinitionRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[32]
+	mi := &file_permission_v1_abac_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2055,7 +3515,7 @@ func (x *AttributeValueServiceFindSubjectValueWithDefinitionRequest) String() st
 func (*AttributeValueServiceFindSubjectValueWithDefinitionRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[32]
+	mi := &file_permission_v1_abac_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2068,7 +3528,7 @@ func (x *AttributeValueServiceFindSubjectValueWithDefinitionRequest) ProtoReflec
 
 // Deprecated: Use AttributeValueServiceFindSubjectValueWithDefinitionRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindSubjectValueWithDefinitionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{32}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionRequest) GetSubjectId() int64 {
@@ -2087,7 +3547,7 @@ type AttributeValueServiceFindSubjectValueWithDefinitionResponse struct {
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionResponse) Reset() {
 	*x = AttributeValueServiceFindSubjectValueWithDefinitionResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[33]
+	mi := &file_permission_v1_abac_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2099,7 +3559,7 @@ func (x *AttributeValueServiceFindSubjectValueWithDefinitionResponse) String() s
 func (*AttributeValueServiceFindSubjectValueWithDefinitionResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[33]
+	mi := &file_permission_v1_abac_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2112,7 +3572,7 @@ func (x *AttributeValueServiceFindSubjectValueWithDefinitionResponse) ProtoRefle
 
 // Deprecated: Use AttributeValueServiceFindSubjectValueWithDefinitionResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindSubjectValueWithDefinitionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{33}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *AttributeValueServiceFindSubjectValueWithDefinitionResponse) GetSubject() *SubjectObject {
@@ -2132,7 +3592,7 @@ type AttributeValueServiceSaveResourceValueRequest struct {
 
 func (x *AttributeValueServiceSaveResourceValueRequest) Reset() {
 	*x = AttributeValueServiceSaveResourceValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[34]
+	mi := &file_permission_v1_abac_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2144,7 +3604,7 @@ func (x *AttributeValueServiceSaveResourceValueRequest) String() string {
 func (*AttributeValueServiceSaveResourceValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveResourceValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[34]
+	mi := &file_permission_v1_abac_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2157,7 +3617,7 @@ func (x *AttributeValueServiceSaveResourceValueRequest) ProtoReflect() protorefl
 
 // Deprecated: Use AttributeValueServiceSaveResourceValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveResourceValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{34}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *AttributeValueServiceSaveResourceValueRequest) GetResourceId() int64 {
@@ -2183,7 +3643,7 @@ type AttributeValueServiceSaveResourceValueResponse struct {
 
 func (x *AttributeValueServiceSaveResourceValueResponse) Reset() {
 	*x = AttributeValueServiceSaveResourceValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[35]
+	mi := &file_permission_v1_abac_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2195,7 +3655,7 @@ func (x *AttributeValueServiceSaveResourceValueResponse) String() string {
 func (*AttributeValueServiceSaveResourceValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveResourceValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[35]
+	mi := &file_permission_v1_abac_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2208,7 +3668,7 @@ func (x *AttributeValueServiceSaveResourceValueResponse) ProtoReflect() protoref
 
 // Deprecated: Use AttributeValueServiceSaveResourceValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveResourceValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{35}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *AttributeValueServiceSaveResourceValueResponse) GetId() int64 {
@@ -2227,7 +3687,7 @@ type AttributeValueServiceDeleteResourceValueRequest struct {
 
 func (x *AttributeValueServiceDeleteResourceValueRequest) Reset() {
 	*x = AttributeValueServiceDeleteResourceValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[36]
+	mi := &file_permission_v1_abac_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2239,7 +3699,7 @@ func (x *AttributeValueServiceDeleteResourceValueRequest) String() string {
 func (*AttributeValueServiceDeleteResourceValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceDeleteResourceValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[36]
+	mi := &file_permission_v1_abac_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2252,7 +3712,7 @@ func (x *AttributeValueServiceDeleteResourceValueRequest) ProtoReflect() protore
 
 // Deprecated: Use AttributeValueServiceDeleteResourceValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceDeleteResourceValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{36}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *AttributeValueServiceDeleteResourceValueRequest) GetId() int64 {
@@ -2270,7 +3730,7 @@ type AttributeValueServiceDeleteResourceValueResponse struct {
 
 func (x *AttributeValueServiceDeleteResourceValueResponse) Reset() {
 	*x = AttributeValueServiceDeleteResourceValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[37]
+	mi := &file_permission_v1_abac_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2282,7 +3742,7 @@ func (x *AttributeValueServiceDeleteResourceValueResponse) String() string {
 func (*AttributeValueServiceDeleteResourceValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceDeleteResourceValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[37]
+	mi := &file_permission_v1_abac_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2295,7 +3755,7 @@ func (x *AttributeValueServiceDeleteResourceValueResponse) ProtoReflect() protor
 
 // Deprecated: Use AttributeValueServiceDeleteResourceValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceDeleteResourceValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{37}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{59}
 }
 
 type AttributeValueServiceFindResourceValueRequest struct {
@@ -2307,7 +3767,7 @@ type AttributeValueServiceFindResourceValueRequest struct {
 
 func (x *AttributeValueServiceFindResourceValueRequest) Reset() {
 	*x = AttributeValueServiceFindResourceValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[38]
+	mi := &file_permission_v1_abac_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2319,7 +3779,7 @@ func (x *AttributeValueServiceFindResourceValueRequest) String() string {
 func (*AttributeValueServiceFindResourceValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindResourceValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[38]
+	mi := &file_permission_v1_abac_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2332,7 +3792,7 @@ func (x *AttributeValueServiceFindResourceValueRequest) ProtoReflect() protorefl
 
 // Deprecated: Use AttributeValueServiceFindResourceValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindResourceValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{38}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *AttributeValueServiceFindResourceValueRequest) GetResourceId() int64 {
@@ -2351,7 +3811,7 @@ type AttributeValueServiceFindResourceValueResponse struct {
 
 func (x *AttributeValueServiceFindResourceValueResponse) Reset() {
 	*x = AttributeValueServiceFindResourceValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[39]
+	mi := &file_permission_v1_abac_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2363,7 +3823,7 @@ func (x *AttributeValueServiceFindResourceValueResponse) String() string {
 func (*AttributeValueServiceFindResourceValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindResourceValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[39]
+	mi := &file_permission_v1_abac_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2376,7 +3836,7 @@ func (x *AttributeValueServiceFindResourceValueResponse) ProtoReflect() protoref
 
 // Deprecated: Use AttributeValueServiceFindResourceValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindResourceValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{39}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *AttributeValueServiceFindResourceValueResponse) GetResource() *ResourceObject {
@@ -2395,7 +3855,7 @@ type AttributeValueServiceFindResourceValueWithDefinitionRequest struct {
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionRequest) Reset() {
 	*x = AttributeValueServiceFindResourceValueWithDefinitionRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[40]
+	mi := &file_permission_v1_abac_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2407,7 +3867,7 @@ func (x *AttributeValueServiceFindResourceValueWithDefinitionRequest) String() s
 func (*AttributeValueServiceFindResourceValueWithDefinitionRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[40]
+	mi := &file_permission_v1_abac_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2420,7 +3880,7 @@ func (x *AttributeValueServiceFindResourceValueWithDefinitionRequest) ProtoRefle
 
 // Deprecated: Use AttributeValueServiceFindResourceValueWithDefinitionRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindResourceValueWithDefinitionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{40}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionRequest) GetResourceId() int64 {
@@ -2439,7 +3899,7 @@ type AttributeValueServiceFindResourceValueWithDefinitionResponse struct {
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionResponse) Reset() {
 	*x = AttributeValueServiceFindResourceValueWithDefinitionResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[41]
+	mi := &file_permission_v1_abac_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2451,7 +3911,7 @@ func (x *AttributeValueServiceFindResourceValueWithDefinitionResponse) String()
 func (*AttributeValueServiceFindResourceValueWithDefinitionResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[41]
+	mi := &file_permission_v1_abac_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2464,7 +3924,7 @@ func (x *AttributeValueServiceFindResourceValueWithDefinitionResponse) ProtoRefl
 
 // Deprecated: Use AttributeValueServiceFindResourceValueWithDefinitionResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceFindResourceValueWithDefinitionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{41}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *AttributeValueServiceFindResourceValueWithDefinitionResponse) GetResource() *ResourceObject {
@@ -2483,7 +3943,7 @@ type AttributeValueServiceSaveEnvironmentValueRequest struct {
 
 func (x *AttributeValueServiceSaveEnvironmentValueRequest) Reset() {
 	*x = AttributeValueServiceSaveEnvironmentValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[42]
+	mi := &file_permission_v1_abac_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2495,7 +3955,7 @@ func (x *AttributeValueServiceSaveEnvironmentValueRequest) String() string {
 func (*AttributeValueServiceSaveEnvironmentValueRequest) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveEnvironmentValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[42]
+	mi := &file_permission_v1_abac_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2508,7 +3968,7 @@ func (x *AttributeValueServiceSaveEnvironmentValueRequest) ProtoReflect() protor
 
 // Deprecated: Use AttributeValueServiceSaveEnvironmentValueRequest.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveEnvironmentValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{42}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *AttributeValueServiceSaveEnvironmentValueRequest) GetValue() *EnvironmentAttributeValue {
@@ -2527,7 +3987,7 @@ type AttributeValueServiceSaveEnvironmentValueResponse struct {
 
 func (x *AttributeValueServiceSaveEnvironmentValueResponse) Reset() {
 	*x = AttributeValueServiceSaveEnvironmentValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[43]
+	mi := &file_permission_v1_abac_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2539,7 +3999,7 @@ func (x *AttributeValueServiceSaveEnvironmentValueResponse) String() string {
 func (*AttributeValueServiceSaveEnvironmentValueResponse) ProtoMessage() {}
 
 func (x *AttributeValueServiceSaveEnvironmentValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[43]
+	mi := &file_permission_v1_abac_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2552,7 +4012,7 @@ func (x *AttributeValueServiceSaveEnvironmentValueResponse) ProtoReflect() proto
 
 // Deprecated: Use AttributeValueServiceSaveEnvironmentValueResponse.ProtoReflect.Descriptor instead.
 func (*AttributeValueServiceSaveEnvironmentValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{43}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *AttributeValueServiceSaveEnvironmentValueResponse) GetId() int64 {
@@ -2571,19 +4031,215 @@ type AttributeValueServiceDeleteEnvironmentValueRequest struct {
 
 func (x *AttributeValueServiceDeleteEnvironmentValueRequest) Reset() {
 	*x = AttributeValueServiceDeleteEnvironmentValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[44]
+	mi := &file_permission_v1_abac_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeValueServiceDeleteEnvironmentValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValueServiceDeleteEnvironmentValueRequest) ProtoMessage() {}
+
+func (x *AttributeValueServiceDeleteEnvironmentValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValueServiceDeleteEnvironmentValueRequest.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceDeleteEnvironmentValueRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *AttributeValueServiceDeleteEnvironmentValueRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type AttributeValueServiceDeleteEnvironmentValueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeValueServiceDeleteEnvironmentValueResponse) Reset() {
+	*x = AttributeValueServiceDeleteEnvironmentValueResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeValueServiceDeleteEnvironmentValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValueServiceDeleteEnvironmentValueResponse) ProtoMessage() {}
+
+func (x *AttributeValueServiceDeleteEnvironmentValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValueServiceDeleteEnvironmentValueResponse.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceDeleteEnvironmentValueResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{67}
+}
+
+type AttributeValueServiceFindEnvironmentValueRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeValueServiceFindEnvironmentValueRequest) Reset() {
+	*x = AttributeValueServiceFindEnvironmentValueRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeValueServiceFindEnvironmentValueRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValueServiceFindEnvironmentValueRequest) ProtoMessage() {}
+
+func (x *AttributeValueServiceFindEnvironmentValueRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValueServiceFindEnvironmentValueRequest.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceFindEnvironmentValueRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{68}
+}
+
+type AttributeValueServiceFindEnvironmentValueResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Environment   *EnvironmentObject     `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeValueServiceFindEnvironmentValueResponse) Reset() {
+	*x = AttributeValueServiceFindEnvironmentValueResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeValueServiceFindEnvironmentValueResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValueServiceFindEnvironmentValueResponse) ProtoMessage() {}
+
+func (x *AttributeValueServiceFindEnvironmentValueResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValueServiceFindEnvironmentValueResponse.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceFindEnvironmentValueResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AttributeValueServiceFindEnvironmentValueResponse) GetEnvironment() *EnvironmentObject {
+	if x != nil {
+		return x.Environment
+	}
+	return nil
+}
+
+type AttributeValueServiceFindEnvironmentValueWithDefinitionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) Reset() {
+	*x = AttributeValueServiceFindEnvironmentValueWithDefinitionRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) ProtoMessage() {}
+
+func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeValueServiceFindEnvironmentValueWithDefinitionRequest.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{70}
+}
+
+type AttributeValueServiceFindEnvironmentValueWithDefinitionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Environment   *EnvironmentObject     `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) Reset() {
+	*x = AttributeValueServiceFindEnvironmentValueWithDefinitionResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[71]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeValueServiceDeleteEnvironmentValueRequest) String() string {
+func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeValueServiceDeleteEnvironmentValueRequest) ProtoMessage() {}
+func (*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) ProtoMessage() {}
 
-func (x *AttributeValueServiceDeleteEnvironmentValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[44]
+func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2594,39 +4250,43 @@ func (x *AttributeValueServiceDeleteEnvironmentValueRequest) ProtoReflect() prot
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeValueServiceDeleteEnvironmentValueRequest.ProtoReflect.Descriptor instead.
-func (*AttributeValueServiceDeleteEnvironmentValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *AttributeValueServiceDeleteEnvironmentValueRequest) GetId() int64 {
+func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) GetEnvironment() *EnvironmentObject {
 	if x != nil {
-		return x.Id
+		return x.Environment
 	}
-	return 0
+	return nil
 }
 
-type AttributeValueServiceDeleteEnvironmentValueResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type AttributeValueServiceImportValuesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// entity_type 和 format 只读取第一条消息中的
+	EntityType    EntityType           `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=permission.v1.EntityType" json:"entity_type,omitempty"` // 只支持主体和资源
+	Format        AttributeValueFormat `protobuf:"varint,2,opt,name=format,proto3,enum=permission.v1.AttributeValueFormat" json:"format,omitempty"`
+	Data          []byte               `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"` // 数据块，可以在任意位置切分
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttributeValueServiceDeleteEnvironmentValueResponse) Reset() {
-	*x = AttributeValueServiceDeleteEnvironmentValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[45]
+func (x *AttributeValueServiceImportValuesRequest) Reset() {
+	*x = AttributeValueServiceImportValuesRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeValueServiceDeleteEnvironmentValueResponse) String() string {
+func (x *AttributeValueServiceImportValuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeValueServiceDeleteEnvironmentValueResponse) ProtoMessage() {}
+func (*AttributeValueServiceImportValuesRequest) ProtoMessage() {}
 
-func (x *AttributeValueServiceDeleteEnvironmentValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[45]
+func (x *AttributeValueServiceImportValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2637,32 +4297,57 @@ func (x *AttributeValueServiceDeleteEnvironmentValueResponse) ProtoReflect() pro
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeValueServiceDeleteEnvironmentValueResponse.ProtoReflect.Descriptor instead.
-func (*AttributeValueServiceDeleteEnvironmentValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use AttributeValueServiceImportValuesRequest.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceImportValuesRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{72}
 }
 
-type AttributeValueServiceFindEnvironmentValueRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *AttributeValueServiceImportValuesRequest) GetEntityType() EntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return EntityType_ENTITY_TYPE_UNKNOWN
+}
+
+func (x *AttributeValueServiceImportValuesRequest) GetFormat() AttributeValueFormat {
+	if x != nil {
+		return x.Format
+	}
+	return AttributeValueFormat_ATTRIBUTE_VALUE_FORMAT_UNKNOWN
+}
+
+func (x *AttributeValueServiceImportValuesRequest) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type AttributeValueServiceImportValuesResponse struct {
+	state         protoimpl.MessageState       `protogen:"open.v1"`
+	Total         int64                        `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"` // 数据行数，不包括 CSV 表头以及空行
+	Succeeded     int64                        `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`
+	Failed        int64                        `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`
+	Errors        []*AttributeValueImportError `protobuf:"bytes,4,rep,name=errors,proto3" json:"errors,omitempty"` // 按行号升序，最多 1000 条
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttributeValueServiceFindEnvironmentValueRequest) Reset() {
-	*x = AttributeValueServiceFindEnvironmentValueRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[46]
+func (x *AttributeValueServiceImportValuesResponse) Reset() {
+	*x = AttributeValueServiceImportValuesResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeValueServiceFindEnvironmentValueRequest) String() string {
+func (x *AttributeValueServiceImportValuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeValueServiceFindEnvironmentValueRequest) ProtoMessage() {}
+func (*AttributeValueServiceImportValuesResponse) ProtoMessage() {}
 
-func (x *AttributeValueServiceFindEnvironmentValueRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[46]
+func (x *AttributeValueServiceImportValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2673,33 +4358,62 @@ func (x *AttributeValueServiceFindEnvironmentValueRequest) ProtoReflect() protor
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeValueServiceFindEnvironmentValueRequest.ProtoReflect.Descriptor instead.
-func (*AttributeValueServiceFindEnvironmentValueRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use AttributeValueServiceImportValuesResponse.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceImportValuesResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{73}
 }
 
-type AttributeValueServiceFindEnvironmentValueResponse struct {
+func (x *AttributeValueServiceImportValuesResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AttributeValueServiceImportValuesResponse) GetSucceeded() int64 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *AttributeValueServiceImportValuesResponse) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *AttributeValueServiceImportValuesResponse) GetErrors() []*AttributeValueImportError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type AttributeValueImportError struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Environment   *EnvironmentObject     `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	Line          int64                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"` // 行号，从 1 开始，CSV 包括表头
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttributeValueServiceFindEnvironmentValueResponse) Reset() {
-	*x = AttributeValueServiceFindEnvironmentValueResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[47]
+func (x *AttributeValueImportError) Reset() {
+	*x = AttributeValueImportError{}
+	mi := &file_permission_v1_abac_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeValueServiceFindEnvironmentValueResponse) String() string {
+func (x *AttributeValueImportError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeValueServiceFindEnvironmentValueResponse) ProtoMessage() {}
+func (*AttributeValueImportError) ProtoMessage() {}
 
-func (x *AttributeValueServiceFindEnvironmentValueResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[47]
+func (x *AttributeValueImportError) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2710,39 +4424,48 @@ func (x *AttributeValueServiceFindEnvironmentValueResponse) ProtoReflect() proto
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeValueServiceFindEnvironmentValueResponse.ProtoReflect.Descriptor instead.
-func (*AttributeValueServiceFindEnvironmentValueResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use AttributeValueImportError.ProtoReflect.Descriptor instead.
+func (*AttributeValueImportError) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *AttributeValueServiceFindEnvironmentValueResponse) GetEnvironment() *EnvironmentObject {
+func (x *AttributeValueImportError) GetLine() int64 {
 	if x != nil {
-		return x.Environment
+		return x.Line
 	}
-	return nil
+	return 0
 }
 
-type AttributeValueServiceFindEnvironmentValueWithDefinitionRequest struct {
+func (x *AttributeValueImportError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type AttributeValueServiceExportValuesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	EntityType    EntityType             `protobuf:"varint,1,opt,name=entity_type,json=entityType,proto3,enum=permission.v1.EntityType" json:"entity_type,omitempty"` // 只支持主体和资源
+	Format        AttributeValueFormat   `protobuf:"varint,2,opt,name=format,proto3,enum=permission.v1.AttributeValueFormat" json:"format,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) Reset() {
-	*x = AttributeValueServiceFindEnvironmentValueWithDefinitionRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[48]
+func (x *AttributeValueServiceExportValuesRequest) Reset() {
+	*x = AttributeValueServiceExportValuesRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) String() string {
+func (x *AttributeValueServiceExportValuesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) ProtoMessage() {}
+func (*AttributeValueServiceExportValuesRequest) ProtoMessage() {}
 
-func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[48]
+func (x *AttributeValueServiceExportValuesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2753,33 +4476,47 @@ func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) ProtoRe
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeValueServiceFindEnvironmentValueWithDefinitionRequest.ProtoReflect.Descriptor instead.
-func (*AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use AttributeValueServiceExportValuesRequest.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceExportValuesRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{75}
 }
 
-type AttributeValueServiceFindEnvironmentValueWithDefinitionResponse struct {
+func (x *AttributeValueServiceExportValuesRequest) GetEntityType() EntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return EntityType_ENTITY_TYPE_UNKNOWN
+}
+
+func (x *AttributeValueServiceExportValuesRequest) GetFormat() AttributeValueFormat {
+	if x != nil {
+		return x.Format
+	}
+	return AttributeValueFormat_ATTRIBUTE_VALUE_FORMAT_UNKNOWN
+}
+
+type AttributeValueServiceExportValuesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Environment   *EnvironmentObject     `protobuf:"bytes,1,opt,name=environment,proto3" json:"environment,omitempty"`
+	Data          []byte                 `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) Reset() {
-	*x = AttributeValueServiceFindEnvironmentValueWithDefinitionResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[49]
+func (x *AttributeValueServiceExportValuesResponse) Reset() {
+	*x = AttributeValueServiceExportValuesResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) String() string {
+func (x *AttributeValueServiceExportValuesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) ProtoMessage() {}
+func (*AttributeValueServiceExportValuesResponse) ProtoMessage() {}
 
-func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[49]
+func (x *AttributeValueServiceExportValuesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2790,14 +4527,14 @@ func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) ProtoR
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.ProtoReflect.Descriptor instead.
-func (*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use AttributeValueServiceExportValuesResponse.ProtoReflect.Descriptor instead.
+func (*AttributeValueServiceExportValuesResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) GetEnvironment() *EnvironmentObject {
+func (x *AttributeValueServiceExportValuesResponse) GetData() []byte {
 	if x != nil {
-		return x.Environment
+		return x.Data
 	}
 	return nil
 }
@@ -2811,7 +4548,7 @@ type AttributeDefinitionServiceSaveRequest struct {
 
 func (x *AttributeDefinitionServiceSaveRequest) Reset() {
 	*x = AttributeDefinitionServiceSaveRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[50]
+	mi := &file_permission_v1_abac_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2823,7 +4560,7 @@ func (x *AttributeDefinitionServiceSaveRequest) String() string {
 func (*AttributeDefinitionServiceSaveRequest) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceSaveRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[50]
+	mi := &file_permission_v1_abac_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2836,7 +4573,7 @@ func (x *AttributeDefinitionServiceSaveRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use AttributeDefinitionServiceSaveRequest.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceSaveRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{50}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *AttributeDefinitionServiceSaveRequest) GetDefinition() *AttributeDefinition {
@@ -2855,7 +4592,7 @@ type AttributeDefinitionServiceSaveResponse struct {
 
 func (x *AttributeDefinitionServiceSaveResponse) Reset() {
 	*x = AttributeDefinitionServiceSaveResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[51]
+	mi := &file_permission_v1_abac_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2867,7 +4604,7 @@ func (x *AttributeDefinitionServiceSaveResponse) String() string {
 func (*AttributeDefinitionServiceSaveResponse) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceSaveResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[51]
+	mi := &file_permission_v1_abac_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2880,7 +4617,7 @@ func (x *AttributeDefinitionServiceSaveResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AttributeDefinitionServiceSaveResponse.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceSaveResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{51}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *AttributeDefinitionServiceSaveResponse) GetId() int64 {
@@ -2899,7 +4636,7 @@ type AttributeDefinitionServiceFirstRequest struct {
 
 func (x *AttributeDefinitionServiceFirstRequest) Reset() {
 	*x = AttributeDefinitionServiceFirstRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[52]
+	mi := &file_permission_v1_abac_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2911,7 +4648,7 @@ func (x *AttributeDefinitionServiceFirstRequest) String() string {
 func (*AttributeDefinitionServiceFirstRequest) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceFirstRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[52]
+	mi := &file_permission_v1_abac_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2924,7 +4661,7 @@ func (x *AttributeDefinitionServiceFirstRequest) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AttributeDefinitionServiceFirstRequest.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceFirstRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{52}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *AttributeDefinitionServiceFirstRequest) GetId() int64 {
@@ -2943,7 +4680,7 @@ type AttributeDefinitionServiceFirstResponse struct {
 
 func (x *AttributeDefinitionServiceFirstResponse) Reset() {
 	*x = AttributeDefinitionServiceFirstResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[53]
+	mi := &file_permission_v1_abac_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2955,7 +4692,7 @@ func (x *AttributeDefinitionServiceFirstResponse) String() string {
 func (*AttributeDefinitionServiceFirstResponse) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceFirstResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[53]
+	mi := &file_permission_v1_abac_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2968,7 +4705,7 @@ func (x *AttributeDefinitionServiceFirstResponse) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use AttributeDefinitionServiceFirstResponse.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceFirstResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{53}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *AttributeDefinitionServiceFirstResponse) GetDefinition() *AttributeDefinition {
@@ -2987,7 +4724,7 @@ type AttributeDefinitionServiceDeleteRequest struct {
 
 func (x *AttributeDefinitionServiceDeleteRequest) Reset() {
 	*x = AttributeDefinitionServiceDeleteRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[54]
+	mi := &file_permission_v1_abac_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2999,7 +4736,7 @@ func (x *AttributeDefinitionServiceDeleteRequest) String() string {
 func (*AttributeDefinitionServiceDeleteRequest) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceDeleteRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[54]
+	mi := &file_permission_v1_abac_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3012,7 +4749,7 @@ func (x *AttributeDefinitionServiceDeleteRequest) ProtoReflect() protoreflect.Me
 
 // Deprecated: Use AttributeDefinitionServiceDeleteRequest.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceDeleteRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{54}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *AttributeDefinitionServiceDeleteRequest) GetId() int64 {
@@ -3030,7 +4767,7 @@ type AttributeDefinitionServiceDeleteResponse struct {
 
 func (x *AttributeDefinitionServiceDeleteResponse) Reset() {
 	*x = AttributeDefinitionServiceDeleteResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[55]
+	mi := &file_permission_v1_abac_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3042,7 +4779,7 @@ func (x *AttributeDefinitionServiceDeleteResponse) String() string {
 func (*AttributeDefinitionServiceDeleteResponse) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceDeleteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[55]
+	mi := &file_permission_v1_abac_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3055,7 +4792,7 @@ func (x *AttributeDefinitionServiceDeleteResponse) ProtoReflect() protoreflect.M
 
 // Deprecated: Use AttributeDefinitionServiceDeleteResponse.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceDeleteResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{55}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{82}
 }
 
 type AttributeDefinitionServiceFindRequest struct {
@@ -3066,7 +4803,7 @@ type AttributeDefinitionServiceFindRequest struct {
 
 func (x *AttributeDefinitionServiceFindRequest) Reset() {
 	*x = AttributeDefinitionServiceFindRequest{}
-	mi := &file_permission_v1_abac_proto_msgTypes[56]
+	mi := &file_permission_v1_abac_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3078,7 +4815,7 @@ func (x *AttributeDefinitionServiceFindRequest) String() string {
 func (*AttributeDefinitionServiceFindRequest) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceFindRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[56]
+	mi := &file_permission_v1_abac_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3091,7 +4828,7 @@ func (x *AttributeDefinitionServiceFindRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use AttributeDefinitionServiceFindRequest.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceFindRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{56}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{83}
 }
 
 type AttributeDefinitionServiceFindResponse struct {
@@ -3103,7 +4840,7 @@ type AttributeDefinitionServiceFindResponse struct {
 
 func (x *AttributeDefinitionServiceFindResponse) Reset() {
 	*x = AttributeDefinitionServiceFindResponse{}
-	mi := &file_permission_v1_abac_proto_msgTypes[57]
+	mi := &file_permission_v1_abac_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3115,7 +4852,7 @@ func (x *AttributeDefinitionServiceFindResponse) String() string {
 func (*AttributeDefinitionServiceFindResponse) ProtoMessage() {}
 
 func (x *AttributeDefinitionServiceFindResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_abac_proto_msgTypes[57]
+	mi := &file_permission_v1_abac_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3128,7 +4865,7 @@ func (x *AttributeDefinitionServiceFindResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AttributeDefinitionServiceFindResponse.ProtoReflect.Descriptor instead.
 func (*AttributeDefinitionServiceFindResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_abac_proto_rawDescGZIP(), []int{57}
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *AttributeDefinitionServiceFindResponse) GetBizDefinition() *BizDefinition {
@@ -3138,11 +4875,149 @@ func (x *AttributeDefinitionServiceFindResponse) GetBizDefinition() *BizDefiniti
 	return nil
 }
 
+type AttributeProviderServiceGetAttributeRequest struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	BizId      int64                  `protobuf:"varint,1,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	EntityType EntityType             `protobuf:"varint,2,opt,name=entity_type,json=entityType,proto3,enum=permission.v1.EntityType" json:"entity_type,omitempty"`
+	// 属性名
+	Attribute     string `protobuf:"bytes,3,opt,name=attribute,proto3" json:"attribute,omitempty"`
+	Uid           int64  `protobuf:"varint,4,opt,name=uid,proto3" json:"uid,omitempty"`
+	ResourceType  string `protobuf:"bytes,5,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceKey   string `protobuf:"bytes,6,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeProviderServiceGetAttributeRequest) Reset() {
+	*x = AttributeProviderServiceGetAttributeRequest{}
+	mi := &file_permission_v1_abac_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeProviderServiceGetAttributeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeProviderServiceGetAttributeRequest) ProtoMessage() {}
+
+func (x *AttributeProviderServiceGetAttributeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeProviderServiceGetAttributeRequest.ProtoReflect.Descriptor instead.
+func (*AttributeProviderServiceGetAttributeRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *AttributeProviderServiceGetAttributeRequest) GetBizId() int64 {
+	if x != nil {
+		return x.BizId
+	}
+	return 0
+}
+
+func (x *AttributeProviderServiceGetAttributeRequest) GetEntityType() EntityType {
+	if x != nil {
+		return x.EntityType
+	}
+	return EntityType_ENTITY_TYPE_UNKNOWN
+}
+
+func (x *AttributeProviderServiceGetAttributeRequest) GetAttribute() string {
+	if x != nil {
+		return x.Attribute
+	}
+	return ""
+}
+
+func (x *AttributeProviderServiceGetAttributeRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *AttributeProviderServiceGetAttributeRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *AttributeProviderServiceGetAttributeRequest) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+type AttributeProviderServiceGetAttributeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 没有该属性时为 false
+	Found         bool   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttributeProviderServiceGetAttributeResponse) Reset() {
+	*x = AttributeProviderServiceGetAttributeResponse{}
+	mi := &file_permission_v1_abac_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttributeProviderServiceGetAttributeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttributeProviderServiceGetAttributeResponse) ProtoMessage() {}
+
+func (x *AttributeProviderServiceGetAttributeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_abac_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttributeProviderServiceGetAttributeResponse.ProtoReflect.Descriptor instead.
+func (*AttributeProviderServiceGetAttributeResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_abac_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *AttributeProviderServiceGetAttributeResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *AttributeProviderServiceGetAttributeResponse) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 var File_permission_v1_abac_proto protoreflect.FileDescriptor
 
 const file_permission_v1_abac_proto_rawDesc = "" +
 	"\n" +
-	"\x18permission/v1/abac.proto\x12\rpermission.v1\"\xa6\x02\n" +
+	"\x18permission/v1/abac.proto\x12\rpermission.v1\x1a\x17validate/validate.proto\"\x9f\x03\n" +
 	"\x06Policy\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -3152,7 +5027,11 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\x05rules\x18\x06 \x03(\v2\x19.permission.v1.PolicyRuleR\x05rules\x12\x14\n" +
 	"\x05ctime\x18\a \x01(\x03R\x05ctime\x12\x14\n" +
 	"\x05utime\x18\b \x01(\x03R\x05utime\x12\x15\n" +
-	"\x06biz_id\x18\t \x01(\x03R\x05bizId\"\xe0\x02\n" +
+	"\x06biz_id\x18\t \x01(\x03R\x05bizId\x12\x1a\n" +
+	"\bpriority\x18\n" +
+	" \x01(\x05R\bpriority\x12\x18\n" +
+	"\aversion\x18\v \x01(\x03R\aversion\x12A\n" +
+	"\fmissing_attr\x18\f \x01(\x0e2\x1e.permission.v1.MissingAttrModeR\vmissingAttr\"\xeb\x03\n" +
 	"\n" +
 	"PolicyRule\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12U\n" +
@@ -3163,7 +5042,10 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"right_rule\x18\x05 \x01(\v2\x19.permission.v1.PolicyRuleR\trightRule\x127\n" +
 	"\boperator\x18\x06 \x01(\x0e2\x1b.permission.v1.RuleOperatorR\boperator\x12\x14\n" +
 	"\x05ctime\x18\a \x01(\x03R\x05ctime\x12\x14\n" +
-	"\x05utime\x18\b \x01(\x03R\x05utime\"\xa2\x02\n" +
+	"\x05utime\x18\b \x01(\x03R\x05utime\x12`\n" +
+	"\x1avalue_attribute_definition\x18\t \x01(\v2\".permission.v1.AttributeDefinitionR\x18valueAttributeDefinition\x12'\n" +
+	"\x0fcustom_operator\x18\n" +
+	" \x01(\tR\x0ecustomOperator\"\xdd\x03\n" +
 	"\x13AttributeDefinition\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -3173,7 +5055,25 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"entityType\x12'\n" +
 	"\x0fvalidation_rule\x18\x06 \x01(\tR\x0evalidationRule\x12\x14\n" +
 	"\x05ctime\x18\a \x01(\x03R\x05ctime\x12\x14\n" +
-	"\x05utime\x18\b \x01(\x03R\x05utime\"\xad\x01\n" +
+	"\x05utime\x18\b \x01(\x03R\x05utime\x12(\n" +
+	"\x10custom_data_type\x18\t \x01(\tR\x0ecustomDataType\x12#\n" +
+	"\rdefault_value\x18\n" +
+	" \x01(\tR\fdefaultValue\x12E\n" +
+	"\vconstraints\x18\v \x01(\v2#.permission.v1.AttributeConstraintsR\vconstraints\x12#\n" +
+	"\rresource_type\x18\f \x01(\tR\fresourceType\"\x8c\x02\n" +
+	"\x14AttributeConstraints\x12\x15\n" +
+	"\x03min\x18\x01 \x01(\x01H\x00R\x03min\x88\x01\x01\x12\x15\n" +
+	"\x03max\x18\x02 \x01(\x01H\x01R\x03max\x88\x01\x01\x12\x1f\n" +
+	"\venum_values\x18\x03 \x03(\tR\n" +
+	"enumValues\x12:\n" +
+	"\felement_type\x18\x04 \x01(\x0e2\x17.permission.v1.DataTypeR\velementType\x12\x1d\n" +
+	"\n" +
+	"max_length\x18\x05 \x01(\x05R\tmaxLength\x12\x1d\n" +
+	"\n" +
+	"not_before\x18\x06 \x01(\x03R\tnotBefore\x12\x1b\n" +
+	"\tnot_after\x18\a \x01(\x03R\bnotAfterB\x06\n" +
+	"\x04_minB\x06\n" +
+	"\x04_max\"\xad\x01\n" +
 	"\x15SubjectAttributeValue\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12B\n" +
 	"\n" +
@@ -3225,7 +5125,62 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12-\n" +
 	"\x04rule\x18\x02 \x01(\v2\x19.permission.v1.PolicyRuleR\x04rule\"/\n" +
 	"\x1dPolicyServiceSaveRuleResponse\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"9\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"a\n" +
+	"\"PolicyServiceSaveExpressionRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x02 \x01(\tR\n" +
+	"expression\">\n" +
+	"#PolicyServiceSaveExpressionResponse\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\"@\n" +
+	"!PolicyServiceGetExpressionRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\"D\n" +
+	"\"PolicyServiceGetExpressionResponse\x12\x1e\n" +
+	"\n" +
+	"expression\x18\x01 \x01(\tR\n" +
+	"expression\"\xcd\x01\n" +
+	"\rPolicyVersion\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\x12%\n" +
+	"\x0esource_version\x18\x02 \x01(\x03R\rsourceVersion\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\x12\x1c\n" +
+	"\tpublished\x18\x04 \x01(\bR\tpublished\x12-\n" +
+	"\x06policy\x18\x05 \x01(\v2\x15.permission.v1.PolicyR\x06policy\x12\x14\n" +
+	"\x05ctime\x18\x06 \x01(\x03R\x05ctime\"M\n" +
+	"\x11PolicyFieldChange\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\tR\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\tR\x02to\"T\n" +
+	"\x1bPolicyServicePublishRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12\x18\n" +
+	"\acomment\x18\x02 \x01(\tR\acomment\"8\n" +
+	"\x1cPolicyServicePublishResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"o\n" +
+	"\x1cPolicyServiceRollbackRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\x12\x18\n" +
+	"\acomment\x18\x03 \x01(\tR\acomment\"9\n" +
+	"\x1dPolicyServiceRollbackResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\x03R\aversion\"?\n" +
+	" PolicyServiceListVersionsRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\"]\n" +
+	"!PolicyServiceListVersionsResponse\x128\n" +
+	"\bversions\x18\x01 \x03(\v2\x1c.permission.v1.PolicyVersionR\bversions\"W\n" +
+	"\x1ePolicyServiceGetVersionRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12\x18\n" +
+	"\aversion\x18\x02 \x01(\x03R\aversion\"Y\n" +
+	"\x1fPolicyServiceGetVersionResponse\x126\n" +
+	"\aversion\x18\x01 \x01(\v2\x1c.permission.v1.PolicyVersionR\aversion\"c\n" +
+	" PolicyServiceDiffVersionsRequest\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12\x12\n" +
+	"\x04from\x18\x02 \x01(\x03R\x04from\x12\x0e\n" +
+	"\x02to\x18\x03 \x01(\x03R\x02to\"\xf3\x01\n" +
+	"!PolicyServiceDiffVersionsResponse\x12'\n" +
+	"\x0ffrom_expression\x18\x01 \x01(\tR\x0efromExpression\x12#\n" +
+	"\rto_expression\x18\x02 \x01(\tR\ftoExpression\x12\x1f\n" +
+	"\vadded_rules\x18\x03 \x03(\tR\n" +
+	"addedRules\x12#\n" +
+	"\rremoved_rules\x18\x04 \x03(\tR\fremovedRules\x12:\n" +
+	"\achanges\x18\x05 \x03(\v2 .permission.v1.PolicyFieldChangeR\achanges\"9\n" +
 	"\x1ePolicyServiceDeleteRuleRequest\x12\x17\n" +
 	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\"!\n" +
 	"\x1fPolicyServiceDeleteRuleResponse\"X\n" +
@@ -3243,7 +5198,41 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\"l\n" +
 	"!PolicyServiceFindPoliciesResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x03R\x05total\x121\n" +
-	"\bpolicies\x18\x02 \x03(\v2\x15.permission.v1.PolicyR\bpolicies\"\x89\x01\n" +
+	"\bpolicies\x18\x02 \x03(\v2\x15.permission.v1.PolicyR\bpolicies\"m\n" +
+	"\x17PolicyPermissionBinding\x12#\n" +
+	"\rpermission_id\x18\x01 \x01(\x03R\fpermissionId\x12-\n" +
+	"\x06effect\x18\x02 \x01(\x0e2\x15.permission.v1.EffectR\x06effect\"\xa1\x05\n" +
+	"\x10SimulationSample\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12!\n" +
+	"\fresource_key\x18\x03 \x01(\tR\vresourceKey\x12\x18\n" +
+	"\aactions\x18\x04 \x03(\tR\aactions\x12e\n" +
+	"\x12subject_attributes\x18\x05 \x03(\v26.permission.v1.SimulationSample.SubjectAttributesEntryR\x11subjectAttributes\x12h\n" +
+	"\x13resource_attributes\x18\x06 \x03(\v27.permission.v1.SimulationSample.ResourceAttributesEntryR\x12resourceAttributes\x12q\n" +
+	"\x16environment_attributes\x18\a \x03(\v2:.permission.v1.SimulationSample.EnvironmentAttributesEntryR\x15environmentAttributes\x1aD\n" +
+	"\x16SubjectAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aE\n" +
+	"\x17ResourceAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aH\n" +
+	"\x1aEnvironmentAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x94\x01\n" +
+	"\x10SimulationResult\x12\x16\n" +
+	"\x06before\x18\x01 \x01(\bR\x06before\x12\x14\n" +
+	"\x05after\x18\x02 \x01(\bR\x05after\x12\x18\n" +
+	"\achanged\x18\x03 \x01(\bR\achanged\x12\x1e\n" +
+	"\n" +
+	"applicable\x18\x04 \x01(\bR\n" +
+	"applicable\x12\x18\n" +
+	"\amatched\x18\x05 \x01(\bR\amatched\"\xdc\x01\n" +
+	"\x1cPolicyServiceSimulateRequest\x12-\n" +
+	"\x06policy\x18\x01 \x01(\v2\x15.permission.v1.PolicyR\x06policy\x12H\n" +
+	"\vpermissions\x18\x02 \x03(\v2&.permission.v1.PolicyPermissionBindingR\vpermissions\x12C\n" +
+	"\asamples\x18\x03 \x03(\v2\x1f.permission.v1.SimulationSampleB\b\xfaB\x05\x92\x01\x02\x10dR\asamples\"Z\n" +
+	"\x1dPolicyServiceSimulateResponse\x129\n" +
+	"\aresults\x18\x01 \x03(\v2\x1f.permission.v1.SimulationResultR\aresults\"\x89\x01\n" +
 	",AttributeValueServiceSaveSubjectValueRequest\x12\x1d\n" +
 	"\n" +
 	"subject_id\x18\x01 \x01(\x03R\tsubjectId\x12:\n" +
@@ -3294,7 +5283,26 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\venvironment\x18\x01 \x01(\v2 .permission.v1.EnvironmentObjectR\venvironment\"@\n" +
 	">AttributeValueServiceFindEnvironmentValueWithDefinitionRequest\"\x85\x01\n" +
 	"?AttributeValueServiceFindEnvironmentValueWithDefinitionResponse\x12B\n" +
-	"\venvironment\x18\x01 \x01(\v2 .permission.v1.EnvironmentObjectR\venvironment\"k\n" +
+	"\venvironment\x18\x01 \x01(\v2 .permission.v1.EnvironmentObjectR\venvironment\"\xb7\x01\n" +
+	"(AttributeValueServiceImportValuesRequest\x12:\n" +
+	"\ventity_type\x18\x01 \x01(\x0e2\x19.permission.v1.EntityTypeR\n" +
+	"entityType\x12;\n" +
+	"\x06format\x18\x02 \x01(\x0e2#.permission.v1.AttributeValueFormatR\x06format\x12\x12\n" +
+	"\x04data\x18\x03 \x01(\fR\x04data\"\xb9\x01\n" +
+	")AttributeValueServiceImportValuesResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x03R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x03R\x06failed\x12@\n" +
+	"\x06errors\x18\x04 \x03(\v2(.permission.v1.AttributeValueImportErrorR\x06errors\"I\n" +
+	"\x19AttributeValueImportError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x03R\x04line\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa3\x01\n" +
+	"(AttributeValueServiceExportValuesRequest\x12:\n" +
+	"\ventity_type\x18\x01 \x01(\x0e2\x19.permission.v1.EntityTypeR\n" +
+	"entityType\x12;\n" +
+	"\x06format\x18\x02 \x01(\x0e2#.permission.v1.AttributeValueFormatR\x06format\"?\n" +
+	")AttributeValueServiceExportValuesResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"k\n" +
 	"%AttributeDefinitionServiceSaveRequest\x12B\n" +
 	"\n" +
 	"definition\x18\x01 \x01(\v2\".permission.v1.AttributeDefinitionR\n" +
@@ -3312,7 +5320,23 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"(AttributeDefinitionServiceDeleteResponse\"'\n" +
 	"%AttributeDefinitionServiceFindRequest\"m\n" +
 	"&AttributeDefinitionServiceFindResponse\x12C\n" +
-	"\x0ebiz_definition\x18\x01 \x01(\v2\x1c.permission.v1.BizDefinitionR\rbizDefinition*_\n" +
+	"\x0ebiz_definition\x18\x01 \x01(\v2\x1c.permission.v1.BizDefinitionR\rbizDefinition\"\xf8\x01\n" +
+	"+AttributeProviderServiceGetAttributeRequest\x12\x15\n" +
+	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12:\n" +
+	"\ventity_type\x18\x02 \x01(\x0e2\x19.permission.v1.EntityTypeR\n" +
+	"entityType\x12\x1c\n" +
+	"\tattribute\x18\x03 \x01(\tR\tattribute\x12\x10\n" +
+	"\x03uid\x18\x04 \x01(\x03R\x03uid\x12#\n" +
+	"\rresource_type\x18\x05 \x01(\tR\fresourceType\x12!\n" +
+	"\fresource_key\x18\x06 \x01(\tR\vresourceKey\"Z\n" +
+	",AttributeProviderServiceGetAttributeResponse\x12\x14\n" +
+	"\x05found\x18\x01 \x01(\bR\x05found\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value*\x91\x01\n" +
+	"\x0fMissingAttrMode\x12\x1d\n" +
+	"\x19MISSING_ATTR_MODE_UNKNOWN\x10\x00\x12\x1b\n" +
+	"\x17MISSING_ATTR_MODE_FALSE\x10\x01\x12#\n" +
+	"\x1fMISSING_ATTR_MODE_INDETERMINATE\x10\x02\x12\x1d\n" +
+	"\x19MISSING_ATTR_MODE_DEFAULT\x10\x03*_\n" +
 	"\fPolicyStatus\x12\x19\n" +
 	"\x15POLICY_STATUS_UNKNOWN\x10\x00\x12\x18\n" +
 	"\x14POLICY_STATUS_ACTIVE\x10\x01\x12\x1a\n" +
@@ -3320,7 +5344,7 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\x06Effect\x12\x12\n" +
 	"\x0eEFFECT_UNKNOWN\x10\x00\x12\x10\n" +
 	"\fEFFECT_ALLOW\x10\x01\x12\x0f\n" +
-	"\vEFFECT_DENY\x10\x02*\xcd\x02\n" +
+	"\vEFFECT_DENY\x10\x02*\x87\x03\n" +
 	"\fRuleOperator\x12\x19\n" +
 	"\x15RULE_OPERATOR_UNKNOWN\x10\x00\x12\x18\n" +
 	"\x14RULE_OPERATOR_EQUALS\x10\x01\x12\x1c\n" +
@@ -3334,20 +5358,28 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\x10RULE_OPERATOR_IN\x10\t\x12\x18\n" +
 	"\x14RULE_OPERATOR_NOT_IN\x10\n" +
 	"\x12\x15\n" +
-	"\x11RULE_OPERATOR_NOT\x10\v*\x91\x01\n" +
+	"\x11RULE_OPERATOR_NOT\x10\v\x12\x19\n" +
+	"\x15RULE_OPERATOR_IN_CIDR\x10\f\x12\x1d\n" +
+	"\x19RULE_OPERATOR_NOT_IN_CIDR\x10\r*\xb8\x01\n" +
 	"\bDataType\x12\x15\n" +
 	"\x11DATA_TYPE_UNKNOWN\x10\x00\x12\x14\n" +
 	"\x10DATA_TYPE_STRING\x10\x01\x12\x14\n" +
 	"\x10DATA_TYPE_NUMBER\x10\x02\x12\x15\n" +
 	"\x11DATA_TYPE_BOOLEAN\x10\x03\x12\x13\n" +
 	"\x0fDATA_TYPE_FLOAT\x10\x04\x12\x16\n" +
-	"\x12DATA_TYPE_DATETIME\x10\x05*u\n" +
+	"\x12DATA_TYPE_DATETIME\x10\x05\x12\x10\n" +
+	"\fDATA_TYPE_IP\x10\x06\x12\x13\n" +
+	"\x0fDATA_TYPE_ARRAY\x10\a*u\n" +
 	"\n" +
 	"EntityType\x12\x17\n" +
 	"\x13ENTITY_TYPE_UNKNOWN\x10\x00\x12\x17\n" +
 	"\x13ENTITY_TYPE_SUBJECT\x10\x01\x12\x18\n" +
 	"\x14ENTITY_TYPE_RESOURCE\x10\x02\x12\x1b\n" +
-	"\x17ENTITY_TYPE_ENVIRONMENT\x10\x032\x8a\x06\n" +
+	"\x17ENTITY_TYPE_ENVIRONMENT\x10\x03*}\n" +
+	"\x14AttributeValueFormat\x12\"\n" +
+	"\x1eATTRIBUTE_VALUE_FORMAT_UNKNOWN\x10\x00\x12\x1e\n" +
+	"\x1aATTRIBUTE_VALUE_FORMAT_CSV\x10\x01\x12!\n" +
+	"\x1dATTRIBUTE_VALUE_FORMAT_NDJSON\x10\x022\x8e\r\n" +
 	"\rPolicyService\x12[\n" +
 	"\x04Save\x12'.permission.v1.PolicyServiceSaveRequest\x1a(.permission.v1.PolicyServiceSaveResponse\"\x00\x12a\n" +
 	"\x06Delete\x12).permission.v1.PolicyServiceDeleteRequest\x1a*.permission.v1.PolicyServiceDeleteResponse\"\x00\x12^\n" +
@@ -3356,7 +5388,16 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\n" +
 	"DeleteRule\x12-.permission.v1.PolicyServiceDeleteRuleRequest\x1a..permission.v1.PolicyServiceDeleteRuleResponse\"\x00\x12\x8b\x01\n" +
 	"\x14SavePermissionPolicy\x127.permission.v1.PolicyServiceSavePermissionPolicyRequest\x1a8.permission.v1.PolicyServiceSavePermissionPolicyResponse\"\x00\x12s\n" +
-	"\fFindPolicies\x12/.permission.v1.PolicyServiceFindPoliciesRequest\x1a0.permission.v1.PolicyServiceFindPoliciesResponse\"\x002\xf6\v\n" +
+	"\fFindPolicies\x12/.permission.v1.PolicyServiceFindPoliciesRequest\x1a0.permission.v1.PolicyServiceFindPoliciesResponse\"\x00\x12g\n" +
+	"\bSimulate\x12+.permission.v1.PolicyServiceSimulateRequest\x1a,.permission.v1.PolicyServiceSimulateResponse\"\x00\x12y\n" +
+	"\x0eSaveExpression\x121.permission.v1.PolicyServiceSaveExpressionRequest\x1a2.permission.v1.PolicyServiceSaveExpressionResponse\"\x00\x12v\n" +
+	"\rGetExpression\x120.permission.v1.PolicyServiceGetExpressionRequest\x1a1.permission.v1.PolicyServiceGetExpressionResponse\"\x00\x12d\n" +
+	"\aPublish\x12*.permission.v1.PolicyServicePublishRequest\x1a+.permission.v1.PolicyServicePublishResponse\"\x00\x12g\n" +
+	"\bRollback\x12+.permission.v1.PolicyServiceRollbackRequest\x1a,.permission.v1.PolicyServiceRollbackResponse\"\x00\x12s\n" +
+	"\fListVersions\x12/.permission.v1.PolicyServiceListVersionsRequest\x1a0.permission.v1.PolicyServiceListVersionsResponse\"\x00\x12m\n" +
+	"\n" +
+	"GetVersion\x12-.permission.v1.PolicyServiceGetVersionRequest\x1a..permission.v1.PolicyServiceGetVersionResponse\"\x00\x12s\n" +
+	"\fDiffVersions\x12/.permission.v1.PolicyServiceDiffVersionsRequest\x1a0.permission.v1.PolicyServiceDiffVersionsResponse\"\x002\x86\x0e\n" +
 	"\x15AttributeValueService\x12\x8f\x01\n" +
 	"\x10SaveSubjectValue\x12;.permission.v1.AttributeValueServiceSaveSubjectValueRequest\x1a<.permission.v1.AttributeValueServiceSaveSubjectValueResponse\"\x00\x12\x95\x01\n" +
 	"\x12DeleteSubjectValue\x12=.permission.v1.AttributeValueServiceDeleteSubjectValueRequest\x1a>.permission.v1.AttributeValueServiceDeleteSubjectValueResponse\"\x00\x12\xb9\x01\n" +
@@ -3366,12 +5407,16 @@ const file_permission_v1_abac_proto_rawDesc = "" +
 	"\x1fFindResourceValueWithDefinition\x12J.permission.v1.AttributeValueServiceFindResourceValueWithDefinitionRequest\x1aK.permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse\"\x00\x12\x9b\x01\n" +
 	"\x14SaveEnvironmentValue\x12?.permission.v1.AttributeValueServiceSaveEnvironmentValueRequest\x1a@.permission.v1.AttributeValueServiceSaveEnvironmentValueResponse\"\x00\x12\xa1\x01\n" +
 	"\x16DeleteEnvironmentValue\x12A.permission.v1.AttributeValueServiceDeleteEnvironmentValueRequest\x1aB.permission.v1.AttributeValueServiceDeleteEnvironmentValueResponse\"\x00\x12\xc5\x01\n" +
-	"\"FindEnvironmentValueWithDefinition\x12M.permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest\x1aN.permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse\"\x002\x81\x04\n" +
+	"\"FindEnvironmentValueWithDefinition\x12M.permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest\x1aN.permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse\"\x00\x12\x85\x01\n" +
+	"\fImportValues\x127.permission.v1.AttributeValueServiceImportValuesRequest\x1a8.permission.v1.AttributeValueServiceImportValuesResponse\"\x00(\x01\x12\x85\x01\n" +
+	"\fExportValues\x127.permission.v1.AttributeValueServiceExportValuesRequest\x1a8.permission.v1.AttributeValueServiceExportValuesResponse\"\x000\x012\x81\x04\n" +
 	"\x1aAttributeDefinitionService\x12u\n" +
 	"\x04Save\x124.permission.v1.AttributeDefinitionServiceSaveRequest\x1a5.permission.v1.AttributeDefinitionServiceSaveResponse\"\x00\x12x\n" +
 	"\x05First\x125.permission.v1.AttributeDefinitionServiceFirstRequest\x1a6.permission.v1.AttributeDefinitionServiceFirstResponse\"\x00\x12{\n" +
 	"\x06Delete\x126.permission.v1.AttributeDefinitionServiceDeleteRequest\x1a7.permission.v1.AttributeDefinitionServiceDeleteResponse\"\x00\x12u\n" +
-	"\x04Find\x124.permission.v1.AttributeDefinitionServiceFindRequest\x1a5.permission.v1.AttributeDefinitionServiceFindResponse\"\x00B\xb7\x01\n" +
+	"\x04Find\x124.permission.v1.AttributeDefinitionServiceFindRequest\x1a5.permission.v1.AttributeDefinitionServiceFindResponse\"\x002\xa6\x01\n" +
+	"\x18AttributeProviderService\x12\x89\x01\n" +
+	"\fGetAttribute\x12:.permission.v1.AttributeProviderServiceGetAttributeRequest\x1a;.permission.v1.AttributeProviderServiceGetAttributeResponse\"\x00B\xb7\x01\n" +
 	"\x11com.permission.v1B\tAbacProtoP\x01ZBgithub.com/permission-dev/api/proto/gen/permission/v1;permissionv1\xa2\x02\x03PXX\xaa\x02\rPermission.V1\xca\x02\rPermission\\V1\xe2\x02\x19Permission\\V1\\GPBMetadata\xea\x02\x0ePermission::V1b\x06proto3"
 
 var (
@@ -3386,155 +5431,233 @@ func file_permission_v1_abac_proto_rawDescGZIP() []byte {
 	return file_permission_v1_abac_proto_rawDescData
 }
 
-var file_permission_v1_abac_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_permission_v1_abac_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
+var file_permission_v1_abac_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
+var file_permission_v1_abac_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_permission_v1_abac_proto_goTypes = []any{
-	(PolicyStatus)(0),                                                       // 0: permission.v1.PolicyStatus
-	(Effect)(0),                                                             // 1: permission.v1.Effect
-	(RuleOperator)(0),                                                       // 2: permission.v1.RuleOperator
-	(DataType)(0),                                                           // 3: permission.v1.DataType
-	(EntityType)(0),                                                         // 4: permission.v1.EntityType
-	(*Policy)(nil),                                                          // 5: permission.v1.Policy
-	(*PolicyRule)(nil),                                                      // 6: permission.v1.PolicyRule
-	(*AttributeDefinition)(nil),                                             // 7: permission.v1.AttributeDefinition
-	(*SubjectAttributeValue)(nil),                                           // 8: permission.v1.SubjectAttributeValue
-	(*ResourceAttributeValue)(nil),                                          // 9: permission.v1.ResourceAttributeValue
-	(*EnvironmentAttributeValue)(nil),                                       // 10: permission.v1.EnvironmentAttributeValue
-	(*SubjectObject)(nil),                                                   // 11: permission.v1.SubjectObject
-	(*ResourceObject)(nil),                                                  // 12: permission.v1.ResourceObject
-	(*EnvironmentObject)(nil),                                               // 13: permission.v1.EnvironmentObject
-	(*BizDefinition)(nil),                                                   // 14: permission.v1.BizDefinition
-	(*PolicyServiceSaveRequest)(nil),                                        // 15: permission.v1.PolicyServiceSaveRequest
-	(*PolicyServiceSaveResponse)(nil),                                       // 16: permission.v1.PolicyServiceSaveResponse
-	(*PolicyServiceDeleteRequest)(nil),                                      // 17: permission.v1.PolicyServiceDeleteRequest
-	(*PolicyServiceDeleteResponse)(nil),                                     // 18: permission.v1.PolicyServiceDeleteResponse
-	(*PolicyServiceFirstRequest)(nil),                                       // 19: permission.v1.PolicyServiceFirstRequest
-	(*PolicyServiceFirstResponse)(nil),                                      // 20: permission.v1.PolicyServiceFirstResponse
-	(*PolicyServiceSaveRuleRequest)(nil),                                    // 21: permission.v1.PolicyServiceSaveRuleRequest
-	(*PolicyServiceSaveRuleResponse)(nil),                                   // 22: permission.v1.PolicyServiceSaveRuleResponse
-	(*PolicyServiceDeleteRuleRequest)(nil),                                  // 23: permission.v1.PolicyServiceDeleteRuleRequest
-	(*PolicyServiceDeleteRuleResponse)(nil),                                 // 24: permission.v1.PolicyServiceDeleteRuleResponse
-	(*PolicyServiceFindPoliciesByPermissionIDsRequest)(nil),                 // 25: permission.v1.PolicyServiceFindPoliciesByPermissionIDsRequest
-	(*PolicyServiceFindPoliciesByPermissionIDsResponse)(nil),                // 26: permission.v1.PolicyServiceFindPoliciesByPermissionIDsResponse
-	(*PolicyServiceSavePermissionPolicyRequest)(nil),                        // 27: permission.v1.PolicyServiceSavePermissionPolicyRequest
-	(*PolicyServiceSavePermissionPolicyResponse)(nil),                       // 28: permission.v1.PolicyServiceSavePermissionPolicyResponse
-	(*PolicyServiceFindPoliciesRequest)(nil),                                // 29: permission.v1.PolicyServiceFindPoliciesRequest
-	(*PolicyServiceFindPoliciesResponse)(nil),                               // 30: permission.v1.PolicyServiceFindPoliciesResponse
-	(*AttributeValueServiceSaveSubjectValueRequest)(nil),                    // 31: permission.v1.AttributeValueServiceSaveSubjectValueRequest
-	(*AttributeValueServiceSaveSubjectValueResponse)(nil),                   // 32: permission.v1.AttributeValueServiceSaveSubjectValueResponse
-	(*AttributeValueServiceDeleteSubjectValueRequest)(nil),                  // 33: permission.v1.AttributeValueServiceDeleteSubjectValueRequest
-	(*AttributeValueServiceDeleteSubjectValueResponse)(nil),                 // 34: permission.v1.AttributeValueServiceDeleteSubjectValueResponse
-	(*AttributeValueServiceFindSubjectValueRequest)(nil),                    // 35: permission.v1.AttributeValueServiceFindSubjectValueRequest
-	(*AttributeValueServiceFindSubjectValueResponse)(nil),                   // 36: permission.v1.AttributeValueServiceFindSubjectValueResponse
-	(*AttributeValueServiceFindSubjectValueWithDefinitionRequest)(nil),      // 37: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionRequest
-	(*AttributeValueServiceFindSubjectValueWithDefinitionResponse)(nil),     // 38: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse
-	(*AttributeValueServiceSaveResourceValueRequest)(nil),                   // 39: permission.v1.AttributeValueServiceSaveResourceValueRequest
-	(*AttributeValueServiceSaveResourceValueResponse)(nil),                  // 40: permission.v1.AttributeValueServiceSaveResourceValueResponse
-	(*AttributeValueServiceDeleteResourceValueRequest)(nil),                 // 41: permission.v1.AttributeValueServiceDeleteResourceValueRequest
-	(*AttributeValueServiceDeleteResourceValueResponse)(nil),                // 42: permission.v1.AttributeValueServiceDeleteResourceValueResponse
-	(*AttributeValueServiceFindResourceValueRequest)(nil),                   // 43: permission.v1.AttributeValueServiceFindResourceValueRequest
-	(*AttributeValueServiceFindResourceValueResponse)(nil),                  // 44: permission.v1.AttributeValueServiceFindResourceValueResponse
-	(*AttributeValueServiceFindResourceValueWithDefinitionRequest)(nil),     // 45: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionRequest
-	(*AttributeValueServiceFindResourceValueWithDefinitionResponse)(nil),    // 46: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse
-	(*AttributeValueServiceSaveEnvironmentValueRequest)(nil),                // 47: permission.v1.AttributeValueServiceSaveEnvironmentValueRequest
-	(*AttributeValueServiceSaveEnvironmentValueResponse)(nil),               // 48: permission.v1.AttributeValueServiceSaveEnvironmentValueResponse
-	(*AttributeValueServiceDeleteEnvironmentValueRequest)(nil),              // 49: permission.v1.AttributeValueServiceDeleteEnvironmentValueRequest
-	(*AttributeValueServiceDeleteEnvironmentValueResponse)(nil),             // 50: permission.v1.AttributeValueServiceDeleteEnvironmentValueResponse
-	(*AttributeValueServiceFindEnvironmentValueRequest)(nil),                // 51: permission.v1.AttributeValueServiceFindEnvironmentValueRequest
-	(*AttributeValueServiceFindEnvironmentValueResponse)(nil),               // 52: permission.v1.AttributeValueServiceFindEnvironmentValueResponse
-	(*AttributeValueServiceFindEnvironmentValueWithDefinitionRequest)(nil),  // 53: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest
-	(*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse)(nil), // 54: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse
-	(*AttributeDefinitionServiceSaveRequest)(nil),                           // 55: permission.v1.AttributeDefinitionServiceSaveRequest
-	(*AttributeDefinitionServiceSaveResponse)(nil),                          // 56: permission.v1.AttributeDefinitionServiceSaveResponse
-	(*AttributeDefinitionServiceFirstRequest)(nil),                          // 57: permission.v1.AttributeDefinitionServiceFirstRequest
-	(*AttributeDefinitionServiceFirstResponse)(nil),                         // 58: permission.v1.AttributeDefinitionServiceFirstResponse
-	(*AttributeDefinitionServiceDeleteRequest)(nil),                         // 59: permission.v1.AttributeDefinitionServiceDeleteRequest
-	(*AttributeDefinitionServiceDeleteResponse)(nil),                        // 60: permission.v1.AttributeDefinitionServiceDeleteResponse
-	(*AttributeDefinitionServiceFindRequest)(nil),                           // 61: permission.v1.AttributeDefinitionServiceFindRequest
-	(*AttributeDefinitionServiceFindResponse)(nil),                          // 62: permission.v1.AttributeDefinitionServiceFindResponse
+	(MissingAttrMode)(0),                                                    // 0: permission.v1.MissingAttrMode
+	(PolicyStatus)(0),                                                       // 1: permission.v1.PolicyStatus
+	(Effect)(0),                                                             // 2: permission.v1.Effect
+	(RuleOperator)(0),                                                       // 3: permission.v1.RuleOperator
+	(DataType)(0),                                                           // 4: permission.v1.DataType
+	(EntityType)(0),                                                         // 5: permission.v1.EntityType
+	(AttributeValueFormat)(0),                                               // 6: permission.v1.AttributeValueFormat
+	(*Policy)(nil),                                                          // 7: permission.v1.Policy
+	(*PolicyRule)(nil),                                                      // 8: permission.v1.PolicyRule
+	(*AttributeDefinition)(nil),                                             // 9: permission.v1.AttributeDefinition
+	(*AttributeConstraints)(nil),                                            // 10: permission.v1.AttributeConstraints
+	(*SubjectAttributeValue)(nil),                                           // 11: permission.v1.SubjectAttributeValue
+	(*ResourceAttributeValue)(nil),                                          // 12: permission.v1.ResourceAttributeValue
+	(*EnvironmentAttributeValue)(nil),                                       // 13: permission.v1.EnvironmentAttributeValue
+	(*SubjectObject)(nil),                                                   // 14: permission.v1.SubjectObject
+	(*ResourceObject)(nil),                                                  // 15: permission.v1.ResourceObject
+	(*EnvironmentObject)(nil),                                               // 16: permission.v1.EnvironmentObject
+	(*BizDefinition)(nil),                                                   // 17: permission.v1.BizDefinition
+	(*PolicyServiceSaveRequest)(nil),                                        // 18: permission.v1.PolicyServiceSaveRequest
+	(*PolicyServiceSaveResponse)(nil),                                       // 19: permission.v1.PolicyServiceSaveResponse
+	(*PolicyServiceDeleteRequest)(nil),                                      // 20: permission.v1.PolicyServiceDeleteRequest
+	(*PolicyServiceDeleteResponse)(nil),                                     // 21: permission.v1.PolicyServiceDeleteResponse
+	(*PolicyServiceFirstRequest)(nil),                                       // 22: permission.v1.PolicyServiceFirstRequest
+	(*PolicyServiceFirstResponse)(nil),                                      // 23: permission.v1.PolicyServiceFirstResponse
+	(*PolicyServiceSaveRuleRequest)(nil),                                    // 24: permission.v1.PolicyServiceSaveRuleRequest
+	(*PolicyServiceSaveRuleResponse)(nil),                                   // 25: permission.v1.PolicyServiceSaveRuleResponse
+	(*PolicyServiceSaveExpressionRequest)(nil),                              // 26: permission.v1.PolicyServiceSaveExpressionRequest
+	(*PolicyServiceSaveExpressionResponse)(nil),                             // 27: permission.v1.PolicyServiceSaveExpressionResponse
+	(*PolicyServiceGetExpressionRequest)(nil),                               // 28: permission.v1.PolicyServiceGetExpressionRequest
+	(*PolicyServiceGetExpressionResponse)(nil),                              // 29: permission.v1.PolicyServiceGetExpressionResponse
+	(*PolicyVersion)(nil),                                                   // 30: permission.v1.PolicyVersion
+	(*PolicyFieldChange)(nil),                                               // 31: permission.v1.PolicyFieldChange
+	(*PolicyServicePublishRequest)(nil),                                     // 32: permission.v1.PolicyServicePublishRequest
+	(*PolicyServicePublishResponse)(nil),                                    // 33: permission.v1.PolicyServicePublishResponse
+	(*PolicyServiceRollbackRequest)(nil),                                    // 34: permission.v1.PolicyServiceRollbackRequest
+	(*PolicyServiceRollbackResponse)(nil),                                   // 35: permission.v1.PolicyServiceRollbackResponse
+	(*PolicyServiceListVersionsRequest)(nil),                                // 36: permission.v1.PolicyServiceListVersionsRequest
+	(*PolicyServiceListVersionsResponse)(nil),                               // 37: permission.v1.PolicyServiceListVersionsResponse
+	(*PolicyServiceGetVersionRequest)(nil),                                  // 38: permission.v1.PolicyServiceGetVersionRequest
+	(*PolicyServiceGetVersionResponse)(nil),                                 // 39: permission.v1.PolicyServiceGetVersionResponse
+	(*PolicyServiceDiffVersionsRequest)(nil),                                // 40: permission.v1.PolicyServiceDiffVersionsRequest
+	(*PolicyServiceDiffVersionsResponse)(nil),                               // 41: permission.v1.PolicyServiceDiffVersionsResponse
+	(*PolicyServiceDeleteRuleRequest)(nil),                                  // 42: permission.v1.PolicyServiceDeleteRuleRequest
+	(*PolicyServiceDeleteRuleResponse)(nil),                                 // 43: permission.v1.PolicyServiceDeleteRuleResponse
+	(*PolicyServiceFindPoliciesByPermissionIDsRequest)(nil),                 // 44: permission.v1.PolicyServiceFindPoliciesByPermissionIDsRequest
+	(*PolicyServiceFindPoliciesByPermissionIDsResponse)(nil),                // 45: permission.v1.PolicyServiceFindPoliciesByPermissionIDsResponse
+	(*PolicyServiceSavePermissionPolicyRequest)(nil),                        // 46: permission.v1.PolicyServiceSavePermissionPolicyRequest
+	(*PolicyServiceSavePermissionPolicyResponse)(nil),                       // 47: permission.v1.PolicyServiceSavePermissionPolicyResponse
+	(*PolicyServiceFindPoliciesRequest)(nil),                                // 48: permission.v1.PolicyServiceFindPoliciesRequest
+	(*PolicyServiceFindPoliciesResponse)(nil),                               // 49: permission.v1.PolicyServiceFindPoliciesResponse
+	(*PolicyPermissionBinding)(nil),                                         // 50: permission.v1.PolicyPermissionBinding
+	(*SimulationSample)(nil),                                                // 51: permission.v1.SimulationSample
+	(*SimulationResult)(nil),                                                // 52: permission.v1.SimulationResult
+	(*PolicyServiceSimulateRequest)(nil),                                    // 53: permission.v1.PolicyServiceSimulateRequest
+	(*PolicyServiceSimulateResponse)(nil),                                   // 54: permission.v1.PolicyServiceSimulateResponse
+	(*AttributeValueServiceSaveSubjectValueRequest)(nil),                    // 55: permission.v1.AttributeValueServiceSaveSubjectValueRequest
+	(*AttributeValueServiceSaveSubjectValueResponse)(nil),                   // 56: permission.v1.AttributeValueServiceSaveSubjectValueResponse
+	(*AttributeValueServiceDeleteSubjectValueRequest)(nil),                  // 57: permission.v1.AttributeValueServiceDeleteSubjectValueRequest
+	(*AttributeValueServiceDeleteSubjectValueResponse)(nil),                 // 58: permission.v1.AttributeValueServiceDeleteSubjectValueResponse
+	(*AttributeValueServiceFindSubjectValueRequest)(nil),                    // 59: permission.v1.AttributeValueServiceFindSubjectValueRequest
+	(*AttributeValueServiceFindSubjectValueResponse)(nil),                   // 60: permission.v1.AttributeValueServiceFindSubjectValueResponse
+	(*AttributeValueServiceFindSubjectValueWithDefinitionRequest)(nil),      // 61: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionRequest
+	(*AttributeValueServiceFindSubjectValueWithDefinitionResponse)(nil),     // 62: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse
+	(*AttributeValueServiceSaveResourceValueRequest)(nil),                   // 63: permission.v1.AttributeValueServiceSaveResourceValueRequest
+	(*AttributeValueServiceSaveResourceValueResponse)(nil),                  // 64: permission.v1.AttributeValueServiceSaveResourceValueResponse
+	(*AttributeValueServiceDeleteResourceValueRequest)(nil),                 // 65: permission.v1.AttributeValueServiceDeleteResourceValueRequest
+	(*AttributeValueServiceDeleteResourceValueResponse)(nil),                // 66: permission.v1.AttributeValueServiceDeleteResourceValueResponse
+	(*AttributeValueServiceFindResourceValueRequest)(nil),                   // 67: permission.v1.AttributeValueServiceFindResourceValueRequest
+	(*AttributeValueServiceFindResourceValueResponse)(nil),                  // 68: permission.v1.AttributeValueServiceFindResourceValueResponse
+	(*AttributeValueServiceFindResourceValueWithDefinitionRequest)(nil),     // 69: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionRequest
+	(*AttributeValueServiceFindResourceValueWithDefinitionResponse)(nil),    // 70: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse
+	(*AttributeValueServiceSaveEnvironmentValueRequest)(nil),                // 71: permission.v1.AttributeValueServiceSaveEnvironmentValueRequest
+	(*AttributeValueServiceSaveEnvironmentValueResponse)(nil),               // 72: permission.v1.AttributeValueServiceSaveEnvironmentValueResponse
+	(*AttributeValueServiceDeleteEnvironmentValueRequest)(nil),              // 73: permission.v1.AttributeValueServiceDeleteEnvironmentValueRequest
+	(*AttributeValueServiceDeleteEnvironmentValueResponse)(nil),             // 74: permission.v1.AttributeValueServiceDeleteEnvironmentValueResponse
+	(*AttributeValueServiceFindEnvironmentValueRequest)(nil),                // 75: permission.v1.AttributeValueServiceFindEnvironmentValueRequest
+	(*AttributeValueServiceFindEnvironmentValueResponse)(nil),               // 76: permission.v1.AttributeValueServiceFindEnvironmentValueResponse
+	(*AttributeValueServiceFindEnvironmentValueWithDefinitionRequest)(nil),  // 77: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest
+	(*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse)(nil), // 78: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse
+	(*AttributeValueServiceImportValuesRequest)(nil),                        // 79: permission.v1.AttributeValueServiceImportValuesRequest
+	(*AttributeValueServiceImportValuesResponse)(nil),                       // 80: permission.v1.AttributeValueServiceImportValuesResponse
+	(*AttributeValueImportError)(nil),                                       // 81: permission.v1.AttributeValueImportError
+	(*AttributeValueServiceExportValuesRequest)(nil),                        // 82: permission.v1.AttributeValueServiceExportValuesRequest
+	(*AttributeValueServiceExportValuesResponse)(nil),                       // 83: permission.v1.AttributeValueServiceExportValuesResponse
+	(*AttributeDefinitionServiceSaveRequest)(nil),                           // 84: permission.v1.AttributeDefinitionServiceSaveRequest
+	(*AttributeDefinitionServiceSaveResponse)(nil),                          // 85: permission.v1.AttributeDefinitionServiceSaveResponse
+	(*AttributeDefinitionServiceFirstRequest)(nil),                          // 86: permission.v1.AttributeDefinitionServiceFirstRequest
+	(*AttributeDefinitionServiceFirstResponse)(nil),                         // 87: permission.v1.AttributeDefinitionServiceFirstResponse
+	(*AttributeDefinitionServiceDeleteRequest)(nil),                         // 88: permission.v1.AttributeDefinitionServiceDeleteRequest
+	(*AttributeDefinitionServiceDeleteResponse)(nil),                        // 89: permission.v1.AttributeDefinitionServiceDeleteResponse
+	(*AttributeDefinitionServiceFindRequest)(nil),                           // 90: permission.v1.AttributeDefinitionServiceFindRequest
+	(*AttributeDefinitionServiceFindResponse)(nil),                          // 91: permission.v1.AttributeDefinitionServiceFindResponse
+	(*AttributeProviderServiceGetAttributeRequest)(nil),                     // 92: permission.v1.AttributeProviderServiceGetAttributeRequest
+	(*AttributeProviderServiceGetAttributeResponse)(nil),                    // 93: permission.v1.AttributeProviderServiceGetAttributeResponse
+	nil, // 94: permission.v1.SimulationSample.SubjectAttributesEntry
+	nil, // 95: permission.v1.SimulationSample.ResourceAttributesEntry
+	nil, // 96: permission.v1.SimulationSample.EnvironmentAttributesEntry
 }
 var file_permission_v1_abac_proto_depIdxs = []int32{
-	0,  // 0: permission.v1.Policy.status:type_name -> permission.v1.PolicyStatus
-	1,  // 1: permission.v1.Policy.effect:type_name -> permission.v1.Effect
-	6,  // 2: permission.v1.Policy.rules:type_name -> permission.v1.PolicyRule
-	7,  // 3: permission.v1.PolicyRule.attribute_definition:type_name -> permission.v1.AttributeDefinition
-	6,  // 4: permission.v1.PolicyRule.left_rule:type_name -> permission.v1.PolicyRule
-	6,  // 5: permission.v1.PolicyRule.right_rule:type_name -> permission.v1.PolicyRule
-	2,  // 6: permission.v1.PolicyRule.operator:type_name -> permission.v1.RuleOperator
-	3,  // 7: permission.v1.AttributeDefinition.data_type:type_name -> permission.v1.DataType
-	4,  // 8: permission.v1.AttributeDefinition.entity_type:type_name -> permission.v1.EntityType
-	7,  // 9: permission.v1.SubjectAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
-	7,  // 10: permission.v1.ResourceAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
-	7,  // 11: permission.v1.EnvironmentAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
-	8,  // 12: permission.v1.SubjectObject.attribute_values:type_name -> permission.v1.SubjectAttributeValue
-	9,  // 13: permission.v1.ResourceObject.attribute_values:type_name -> permission.v1.ResourceAttributeValue
-	10, // 14: permission.v1.EnvironmentObject.attribute_values:type_name -> permission.v1.EnvironmentAttributeValue
-	7,  // 15: permission.v1.BizDefinition.subject_attrs:type_name -> permission.v1.AttributeDefinition
-	7,  // 16: permission.v1.BizDefinition.resource_attrs:type_name -> permission.v1.AttributeDefinition
-	7,  // 17: permission.v1.BizDefinition.environment_attrs:type_name -> permission.v1.AttributeDefinition
-	5,  // 18: permission.v1.PolicyServiceSaveRequest.policy:type_name -> permission.v1.Policy
-	5,  // 19: permission.v1.PolicyServiceFirstResponse.policy:type_name -> permission.v1.Policy
-	6,  // 20: permission.v1.PolicyServiceSaveRuleRequest.rule:type_name -> permission.v1.PolicyRule
-	5,  // 21: permission.v1.PolicyServiceFindPoliciesByPermissionIDsResponse.policies:type_name -> permission.v1.Policy
-	1,  // 22: permission.v1.PolicyServiceSavePermissionPolicyRequest.effect:type_name -> permission.v1.Effect
-	5,  // 23: permission.v1.PolicyServiceFindPoliciesResponse.policies:type_name -> permission.v1.Policy
-	8,  // 24: permission.v1.AttributeValueServiceSaveSubjectValueRequest.value:type_name -> permission.v1.SubjectAttributeValue
-	11, // 25: permission.v1.AttributeValueServiceFindSubjectValueResponse.subject:type_name -> permission.v1.SubjectObject
-	11, // 26: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse.subject:type_name -> permission.v1.SubjectObject
-	9,  // 27: permission.v1.AttributeValueServiceSaveResourceValueRequest.value:type_name -> permission.v1.ResourceAttributeValue
-	12, // 28: permission.v1.AttributeValueServiceFindResourceValueResponse.resource:type_name -> permission.v1.ResourceObject
-	12, // 29: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse.resource:type_name -> permission.v1.ResourceObject
-	10, // 30: permission.v1.AttributeValueServiceSaveEnvironmentValueRequest.value:type_name -> permission.v1.EnvironmentAttributeValue
-	13, // 31: permission.v1.AttributeValueServiceFindEnvironmentValueResponse.environment:type_name -> permission.v1.EnvironmentObject
-	13, // 32: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.environment:type_name -> permission.v1.EnvironmentObject
-	7,  // 33: permission.v1.AttributeDefinitionServiceSaveRequest.definition:type_name -> permission.v1.AttributeDefinition
-	7,  // 34: permission.v1.AttributeDefinitionServiceFirstResponse.definition:type_name -> permission.v1.AttributeDefinition
-	14, // 35: permission.v1.AttributeDefinitionServiceFindResponse.biz_definition:type_name -> permission.v1.BizDefinition
-	15, // 36: permission.v1.PolicyService.Save:input_type -> permission.v1.PolicyServiceSaveRequest
-	17, // 37: permission.v1.PolicyService.Delete:input_type -> permission.v1.PolicyServiceDeleteRequest
-	19, // 38: permission.v1.PolicyService.First:input_type -> permission.v1.PolicyServiceFirstRequest
-	21, // 39: permission.v1.PolicyService.SaveRule:input_type -> permission.v1.PolicyServiceSaveRuleRequest
-	23, // 40: permission.v1.PolicyService.DeleteRule:input_type -> permission.v1.PolicyServiceDeleteRuleRequest
-	27, // 41: permission.v1.PolicyService.SavePermissionPolicy:input_type -> permission.v1.PolicyServiceSavePermissionPolicyRequest
-	29, // 42: permission.v1.PolicyService.FindPolicies:input_type -> permission.v1.PolicyServiceFindPoliciesRequest
-	31, // 43: permission.v1.AttributeValueService.SaveSubjectValue:input_type -> permission.v1.AttributeValueServiceSaveSubjectValueRequest
-	33, // 44: permission.v1.AttributeValueService.DeleteSubjectValue:input_type -> permission.v1.AttributeValueServiceDeleteSubjectValueRequest
-	37, // 45: permission.v1.AttributeValueService.FindSubjectValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionRequest
-	39, // 46: permission.v1.AttributeValueService.SaveResourceValue:input_type -> permission.v1.AttributeValueServiceSaveResourceValueRequest
-	41, // 47: permission.v1.AttributeValueService.DeleteResourceValue:input_type -> permission.v1.AttributeValueServiceDeleteResourceValueRequest
-	45, // 48: permission.v1.AttributeValueService.FindResourceValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindResourceValueWithDefinitionRequest
-	47, // 49: permission.v1.AttributeValueService.SaveEnvironmentValue:input_type -> permission.v1.AttributeValueServiceSaveEnvironmentValueRequest
-	49, // 50: permission.v1.AttributeValueService.DeleteEnvironmentValue:input_type -> permission.v1.AttributeValueServiceDeleteEnvironmentValueRequest
-	53, // 51: permission.v1.AttributeValueService.FindEnvironmentValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest
-	55, // 52: permission.v1.AttributeDefinitionService.Save:input_type -> permission.v1.AttributeDefinitionServiceSaveRequest
-	57, // 53: permission.v1.AttributeDefinitionService.First:input_type -> permission.v1.AttributeDefinitionServiceFirstRequest
-	59, // 54: permission.v1.AttributeDefinitionService.Delete:input_type -> permission.v1.AttributeDefinitionServiceDeleteRequest
-	61, // 55: permission.v1.AttributeDefinitionService.Find:input_type -> permission.v1.AttributeDefinitionServiceFindRequest
-	16, // 56: permission.v1.PolicyService.Save:output_type -> permission.v1.PolicyServiceSaveResponse
-	18, // 57: permission.v1.PolicyService.Delete:output_type -> permission.v1.PolicyServiceDeleteResponse
-	20, // 58: permission.v1.PolicyService.First:output_type -> permission.v1.PolicyServiceFirstResponse
-	22, // 59: permission.v1.PolicyService.SaveRule:output_type -> permission.v1.PolicyServiceSaveRuleResponse
-	24, // 60: permission.v1.PolicyService.DeleteRule:output_type -> permission.v1.PolicyServiceDeleteRuleResponse
-	28, // 61: permission.v1.PolicyService.SavePermissionPolicy:output_type -> permission.v1.PolicyServiceSavePermissionPolicyResponse
-	30, // 62: permission.v1.PolicyService.FindPolicies:output_type -> permission.v1.PolicyServiceFindPoliciesResponse
-	32, // 63: permission.v1.AttributeValueService.SaveSubjectValue:output_type -> permission.v1.AttributeValueServiceSaveSubjectValueResponse
-	34, // 64: permission.v1.AttributeValueService.DeleteSubjectValue:output_type -> permission.v1.AttributeValueServiceDeleteSubjectValueResponse
-	38, // 65: permission.v1.AttributeValueService.FindSubjectValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse
-	40, // 66: permission.v1.AttributeValueService.SaveResourceValue:output_type -> permission.v1.AttributeValueServiceSaveResourceValueResponse
-	42, // 67: permission.v1.AttributeValueService.DeleteResourceValue:output_type -> permission.v1.AttributeValueServiceDeleteResourceValueResponse
-	46, // 68: permission.v1.AttributeValueService.FindResourceValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse
-	48, // 69: permission.v1.AttributeValueService.SaveEnvironmentValue:output_type -> permission.v1.AttributeValueServiceSaveEnvironmentValueResponse
-	50, // 70: permission.v1.AttributeValueService.DeleteEnvironmentValue:output_type -> permission.v1.AttributeValueServiceDeleteEnvironmentValueResponse
-	54, // 71: permission.v1.AttributeValueService.FindEnvironmentValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse
-	56, // 72: permission.v1.AttributeDefinitionService.Save:output_type -> permission.v1.AttributeDefinitionServiceSaveResponse
-	58, // 73: permission.v1.AttributeDefinitionService.First:output_type -> permission.v1.AttributeDefinitionServiceFirstResponse
-	60, // 74: permission.v1.AttributeDefinitionService.Delete:output_type -> permission.v1.AttributeDefinitionServiceDeleteResponse
-	62, // 75: permission.v1.AttributeDefinitionService.Find:output_type -> permission.v1.AttributeDefinitionServiceFindResponse
-	56, // [56:76] is the sub-list for method output_type
-	36, // [36:56] is the sub-list for method input_type
-	36, // [36:36] is the sub-list for extension type_name
-	36, // [36:36] is the sub-list for extension extendee
-	0,  // [0:36] is the sub-list for field type_name
+	1,  // 0: permission.v1.Policy.status:type_name -> permission.v1.PolicyStatus
+	2,  // 1: permission.v1.Policy.effect:type_name -> permission.v1.Effect
+	8,  // 2: permission.v1.Policy.rules:type_name -> permission.v1.PolicyRule
+	0,  // 3: permission.v1.Policy.missing_attr:type_name -> permission.v1.MissingAttrMode
+	9,  // 4: permission.v1.PolicyRule.attribute_definition:type_name -> permission.v1.AttributeDefinition
+	8,  // 5: permission.v1.PolicyRule.left_rule:type_name -> permission.v1.PolicyRule
+	8,  // 6: permission.v1.PolicyRule.right_rule:type_name -> permission.v1.PolicyRule
+	3,  // 7: permission.v1.PolicyRule.operator:type_name -> permission.v1.RuleOperator
+	9,  // 8: permission.v1.PolicyRule.value_attribute_definition:type_name -> permission.v1.AttributeDefinition
+	4,  // 9: permission.v1.AttributeDefinition.data_type:type_name -> permission.v1.DataType
+	5,  // 10: permission.v1.AttributeDefinition.entity_type:type_name -> permission.v1.EntityType
+	10, // 11: permission.v1.AttributeDefinition.constraints:type_name -> permission.v1.AttributeConstraints
+	4,  // 12: permission.v1.AttributeConstraints.element_type:type_name -> permission.v1.DataType
+	9,  // 13: permission.v1.SubjectAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
+	9,  // 14: permission.v1.ResourceAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
+	9,  // 15: permission.v1.EnvironmentAttributeValue.definition:type_name -> permission.v1.AttributeDefinition
+	11, // 16: permission.v1.SubjectObject.attribute_values:type_name -> permission.v1.SubjectAttributeValue
+	12, // 17: permission.v1.ResourceObject.attribute_values:type_name -> permission.v1.ResourceAttributeValue
+	13, // 18: permission.v1.EnvironmentObject.attribute_values:type_name -> permission.v1.EnvironmentAttributeValue
+	9,  // 19: permission.v1.BizDefinition.subject_attrs:type_name -> permission.v1.AttributeDefinition
+	9,  // 20: permission.v1.BizDefinition.resource_attrs:type_name -> permission.v1.AttributeDefinition
+	9,  // 21: permission.v1.BizDefinition.environment_attrs:type_name -> permission.v1.AttributeDefinition
+	7,  // 22: permission.v1.PolicyServiceSaveRequest.policy:type_name -> permission.v1.Policy
+	7,  // 23: permission.v1.PolicyServiceFirstResponse.policy:type_name -> permission.v1.Policy
+	8,  // 24: permission.v1.PolicyServiceSaveRuleRequest.rule:type_name -> permission.v1.PolicyRule
+	7,  // 25: permission.v1.PolicyVersion.policy:type_name -> permission.v1.Policy
+	30, // 26: permission.v1.PolicyServiceListVersionsResponse.versions:type_name -> permission.v1.PolicyVersion
+	30, // 27: permission.v1.PolicyServiceGetVersionResponse.version:type_name -> permission.v1.PolicyVersion
+	31, // 28: permission.v1.PolicyServiceDiffVersionsResponse.changes:type_name -> permission.v1.PolicyFieldChange
+	7,  // 29: permission.v1.PolicyServiceFindPoliciesByPermissionIDsResponse.policies:type_name -> permission.v1.Policy
+	2,  // 30: permission.v1.PolicyServiceSavePermissionPolicyRequest.effect:type_name -> permission.v1.Effect
+	7,  // 31: permission.v1.PolicyServiceFindPoliciesResponse.policies:type_name -> permission.v1.Policy
+	2,  // 32: permission.v1.PolicyPermissionBinding.effect:type_name -> permission.v1.Effect
+	94, // 33: permission.v1.SimulationSample.subject_attributes:type_name -> permission.v1.SimulationSample.SubjectAttributesEntry
+	95, // 34: permission.v1.SimulationSample.resource_attributes:type_name -> permission.v1.SimulationSample.ResourceAttributesEntry
+	96, // 35: permission.v1.SimulationSample.environment_attributes:type_name -> permission.v1.SimulationSample.EnvironmentAttributesEntry
+	7,  // 36: permission.v1.PolicyServiceSimulateRequest.policy:type_name -> permission.v1.Policy
+	50, // 37: permission.v1.PolicyServiceSimulateRequest.permissions:type_name -> permission.v1.PolicyPermissionBinding
+	51, // 38: permission.v1.PolicyServiceSimulateRequest.samples:type_name -> permission.v1.SimulationSample
+	52, // 39: permission.v1.PolicyServiceSimulateResponse.results:type_name -> permission.v1.SimulationResult
+	11, // 40: permission.v1.AttributeValueServiceSaveSubjectValueRequest.value:type_name -> permission.v1.SubjectAttributeValue
+	14, // 41: permission.v1.AttributeValueServiceFindSubjectValueResponse.subject:type_name -> permission.v1.SubjectObject
+	14, // 42: permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse.subject:type_name -> permission.v1.SubjectObject
+	12, // 43: permission.v1.AttributeValueServiceSaveResourceValueRequest.value:type_name -> permission.v1.ResourceAttributeValue
+	15, // 44: permission.v1.AttributeValueServiceFindResourceValueResponse.resource:type_name -> permission.v1.ResourceObject
+	15, // 45: permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse.resource:type_name -> permission.v1.ResourceObject
+	13, // 46: permission.v1.AttributeValueServiceSaveEnvironmentValueRequest.value:type_name -> permission.v1.EnvironmentAttributeValue
+	16, // 47: permission.v1.AttributeValueServiceFindEnvironmentValueResponse.environment:type_name -> permission.v1.EnvironmentObject
+	16, // 48: permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.environment:type_name -> permission.v1.EnvironmentObject
+	5,  // 49: permission.v1.AttributeValueServiceImportValuesRequest.entity_type:type_name -> permission.v1.EntityType
+	6,  // 50: permission.v1.AttributeValueServiceImportValuesRequest.format:type_name -> permission.v1.AttributeValueFormat
+	81, // 51: permission.v1.AttributeValueServiceImportValuesResponse.errors:type_name -> permission.v1.AttributeValueImportError
+	5,  // 52: permission.v1.AttributeValueServiceExportValuesRequest.entity_type:type_name -> permission.v1.EntityType
+	6,  // 53: permission.v1.AttributeValueServiceExportValuesRequest.format:type_name -> permission.v1.AttributeValueFormat
+	9,  // 54: permission.v1.AttributeDefinitionServiceSaveRequest.definition:type_name -> permission.v1.AttributeDefinition
+	9,  // 55: permission.v1.AttributeDefinitionServiceFirstResponse.definition:type_name -> permission.v1.AttributeDefinition
+	17, // 56: permission.v1.AttributeDefinitionServiceFindResponse.biz_definition:type_name -> permission.v1.BizDefinition
+	5,  // 57: permission.v1.AttributeProviderServiceGetAttributeRequest.entity_type:type_name -> permission.v1.EntityType
+	18, // 58: permission.v1.PolicyService.Save:input_type -> permission.v1.PolicyServiceSaveRequest
+	20, // 59: permission.v1.PolicyService.Delete:input_type -> permission.v1.PolicyServiceDeleteRequest
+	22, // 60: permission.v1.PolicyService.First:input_type -> permission.v1.PolicyServiceFirstRequest
+	24, // 61: permission.v1.PolicyService.SaveRule:input_type -> permission.v1.PolicyServiceSaveRuleRequest
+	42, // 62: permission.v1.PolicyService.DeleteRule:input_type -> permission.v1.PolicyServiceDeleteRuleRequest
+	46, // 63: permission.v1.PolicyService.SavePermissionPolicy:input_type -> permission.v1.PolicyServiceSavePermissionPolicyRequest
+	48, // 64: permission.v1.PolicyService.FindPolicies:input_type -> permission.v1.PolicyServiceFindPoliciesRequest
+	53, // 65: permission.v1.PolicyService.Simulate:input_type -> permission.v1.PolicyServiceSimulateRequest
+	26, // 66: permission.v1.PolicyService.SaveExpression:input_type -> permission.v1.PolicyServiceSaveExpressionRequest
+	28, // 67: permission.v1.PolicyService.GetExpression:input_type -> permission.v1.PolicyServiceGetExpressionRequest
+	32, // 68: permission.v1.PolicyService.Publish:input_type -> permission.v1.PolicyServicePublishRequest
+	34, // 69: permission.v1.PolicyService.Rollback:input_type -> permission.v1.PolicyServiceRollbackRequest
+	36, // 70: permission.v1.PolicyService.ListVersions:input_type -> permission.v1.PolicyServiceListVersionsRequest
+	38, // 71: permission.v1.PolicyService.GetVersion:input_type -> permission.v1.PolicyServiceGetVersionRequest
+	40, // 72: permission.v1.PolicyService.DiffVersions:input_type -> permission.v1.PolicyServiceDiffVersionsRequest
+	55, // 73: permission.v1.AttributeValueService.SaveSubjectValue:input_type -> permission.v1.AttributeValueServiceSaveSubjectValueRequest
+	57, // 74: permission.v1.AttributeValueService.DeleteSubjectValue:input_type -> permission.v1.AttributeValueServiceDeleteSubjectValueRequest
+	61, // 75: permission.v1.AttributeValueService.FindSubjectValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionRequest
+	63, // 76: permission.v1.AttributeValueService.SaveResourceValue:input_type -> permission.v1.AttributeValueServiceSaveResourceValueRequest
+	65, // 77: permission.v1.AttributeValueService.DeleteResourceValue:input_type -> permission.v1.AttributeValueServiceDeleteResourceValueRequest
+	69, // 78: permission.v1.AttributeValueService.FindResourceValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindResourceValueWithDefinitionRequest
+	71, // 79: permission.v1.AttributeValueService.SaveEnvironmentValue:input_type -> permission.v1.AttributeValueServiceSaveEnvironmentValueRequest
+	73, // 80: permission.v1.AttributeValueService.DeleteEnvironmentValue:input_type -> permission.v1.AttributeValueServiceDeleteEnvironmentValueRequest
+	77, // 81: permission.v1.AttributeValueService.FindEnvironmentValueWithDefinition:input_type -> permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest
+	79, // 82: permission.v1.AttributeValueService.ImportValues:input_type -> permission.v1.AttributeValueServiceImportValuesRequest
+	82, // 83: permission.v1.AttributeValueService.ExportValues:input_type -> permission.v1.AttributeValueServiceExportValuesRequest
+	84, // 84: permission.v1.AttributeDefinitionService.Save:input_type -> permission.v1.AttributeDefinitionServiceSaveRequest
+	86, // 85: permission.v1.AttributeDefinitionService.First:input_type -> permission.v1.AttributeDefinitionServiceFirstRequest
+	88, // 86: permission.v1.AttributeDefinitionService.Delete:input_type -> permission.v1.AttributeDefinitionServiceDeleteRequest
+	90, // 87: permission.v1.AttributeDefinitionService.Find:input_type -> permission.v1.AttributeDefinitionServiceFindRequest
+	92, // 88: permission.v1.AttributeProviderService.GetAttribute:input_type -> permission.v1.AttributeProviderServiceGetAttributeRequest
+	19, // 89: permission.v1.PolicyService.Save:output_type -> permission.v1.PolicyServiceSaveResponse
+	21, // 90: permission.v1.PolicyService.Delete:output_type -> permission.v1.PolicyServiceDeleteResponse
+	23, // 91: permission.v1.PolicyService.First:output_type -> permission.v1.PolicyServiceFirstResponse
+	25, // 92: permission.v1.PolicyService.SaveRule:output_type -> permission.v1.PolicyServiceSaveRuleResponse
+	43, // 93: permission.v1.PolicyService.DeleteRule:output_type -> permission.v1.PolicyServiceDeleteRuleResponse
+	47, // 94: permission.v1.PolicyService.SavePermissionPolicy:output_type -> permission.v1.PolicyServiceSavePermissionPolicyResponse
+	49, // 95: permission.v1.PolicyService.FindPolicies:output_type -> permission.v1.PolicyServiceFindPoliciesResponse
+	54, // 96: permission.v1.PolicyService.Simulate:output_type -> permission.v1.PolicyServiceSimulateResponse
+	27, // 97: permission.v1.PolicyService.SaveExpression:output_type -> permission.v1.PolicyServiceSaveExpressionResponse
+	29, // 98: permission.v1.PolicyService.GetExpression:output_type -> permission.v1.PolicyServiceGetExpressionResponse
+	33, // 99: permission.v1.PolicyService.Publish:output_type -> permission.v1.PolicyServicePublishResponse
+	35, // 100: permission.v1.PolicyService.Rollback:output_type -> permission.v1.PolicyServiceRollbackResponse
+	37, // 101: permission.v1.PolicyService.ListVersions:output_type -> permission.v1.PolicyServiceListVersionsResponse
+	39, // 102: permission.v1.PolicyService.GetVersion:output_type -> permission.v1.PolicyServiceGetVersionResponse
+	41, // 103: permission.v1.PolicyService.DiffVersions:output_type -> permission.v1.PolicyServiceDiffVersionsResponse
+	56, // 104: permission.v1.AttributeValueService.SaveSubjectValue:output_type -> permission.v1.AttributeValueServiceSaveSubjectValueResponse
+	58, // 105: permission.v1.AttributeValueService.DeleteSubjectValue:output_type -> permission.v1.AttributeValueServiceDeleteSubjectValueResponse
+	62, // 106: permission.v1.AttributeValueService.FindSubjectValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindSubjectValueWithDefinitionResponse
+	64, // 107: permission.v1.AttributeValueService.SaveResourceValue:output_type -> permission.v1.AttributeValueServiceSaveResourceValueResponse
+	66, // 108: permission.v1.AttributeValueService.DeleteResourceValue:output_type -> permission.v1.AttributeValueServiceDeleteResourceValueResponse
+	70, // 109: permission.v1.AttributeValueService.FindResourceValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindResourceValueWithDefinitionResponse
+	72, // 110: permission.v1.AttributeValueService.SaveEnvironmentValue:output_type -> permission.v1.AttributeValueServiceSaveEnvironmentValueResponse
+	74, // 111: permission.v1.AttributeValueService.DeleteEnvironmentValue:output_type -> permission.v1.AttributeValueServiceDeleteEnvironmentValueResponse
+	78, // 112: permission.v1.AttributeValueService.FindEnvironmentValueWithDefinition:output_type -> permission.v1.AttributeValueServiceFindEnvironmentValueWithDefinitionResponse
+	80, // 113: permission.v1.AttributeValueService.ImportValues:output_type -> permission.v1.AttributeValueServiceImportValuesResponse
+	83, // 114: permission.v1.AttributeValueService.ExportValues:output_type -> permission.v1.AttributeValueServiceExportValuesResponse
+	85, // 115: permission.v1.AttributeDefinitionService.Save:output_type -> permission.v1.AttributeDefinitionServiceSaveResponse
+	87, // 116: permission.v1.AttributeDefinitionService.First:output_type -> permission.v1.AttributeDefinitionServiceFirstResponse
+	89, // 117: permission.v1.AttributeDefinitionService.Delete:output_type -> permission.v1.AttributeDefinitionServiceDeleteResponse
+	91, // 118: permission.v1.AttributeDefinitionService.Find:output_type -> permission.v1.AttributeDefinitionServiceFindResponse
+	93, // 119: permission.v1.AttributeProviderService.GetAttribute:output_type -> permission.v1.AttributeProviderServiceGetAttributeResponse
+	89, // [89:120] is the sub-list for method output_type
+	58, // [58:89] is the sub-list for method input_type
+	58, // [58:58] is the sub-list for extension type_name
+	58, // [58:58] is the sub-list for extension extendee
+	0,  // [0:58] is the sub-list for field type_name
 }
 
 func init() { file_permission_v1_abac_proto_init() }
@@ -3542,15 +5665,16 @@ func file_permission_v1_abac_proto_init() {
 	if File_permission_v1_abac_proto != nil {
 		return
 	}
+	file_permission_v1_abac_proto_msgTypes[3].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permission_v1_abac_proto_rawDesc), len(file_permission_v1_abac_proto_rawDesc)),
-			NumEnums:      5,
-			NumMessages:   58,
+			NumEnums:      7,
+			NumMessages:   90,
 			NumExtensions: 0,
-			NumServices:   3,
+			NumServices:   4,
 		},
 		GoTypes:           file_permission_v1_abac_proto_goTypes,
 		DependencyIndexes: file_permission_v1_abac_proto_depIdxs,
@@ -106,6 +106,12 @@ func (m *Policy) validate(all bool) error {
 
 	// no validation rules for BizId
 
+	// no validation rules for Priority
+
+	// no validation rules for Version
+
+	// no validation rules for MissingAttr
+
 	if len(errors) > 0 {
 		return PolicyMultiError(errors)
 	}
@@ -302,6 +308,37 @@ func (m *PolicyRule) validate(all bool) error {
 
 	// no validation rules for Utime
 
+	if all {
+		switch v := interface{}(m.GetValueAttributeDefinition()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyRuleValidationError{
+					field:  "ValueAttributeDefinition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyRuleValidationError{
+					field:  "ValueAttributeDefinition",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValueAttributeDefinition()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyRuleValidationError{
+				field:  "ValueAttributeDefinition",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CustomOperator
+
 	if len(errors) > 0 {
 		return PolicyRuleMultiError(errors)
 	}
@@ -417,6 +454,41 @@ func (m *AttributeDefinition) validate(all bool) error {
 
 	// no validation rules for Utime
 
+	// no validation rules for CustomDataType
+
+	// no validation rules for DefaultValue
+
+	if all {
+		switch v := interface{}(m.GetConstraints()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeDefinitionValidationError{
+					field:  "Constraints",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeDefinitionValidationError{
+					field:  "Constraints",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetConstraints()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeDefinitionValidationError{
+				field:  "Constraints",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ResourceType
+
 	if len(errors) > 0 {
 		return AttributeDefinitionMultiError(errors)
 	}
@@ -497,6 +569,124 @@ var _ interface {
 	ErrorName() string
 } = AttributeDefinitionValidationError{}
 
+// Validate checks the field values on AttributeConstraints with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeConstraints) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AttributeConstraints with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeConstraintsMultiError, or nil if none found.
+func (m *AttributeConstraints) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeConstraints) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ElementType
+
+	// no validation rules for MaxLength
+
+	// no validation rules for NotBefore
+
+	// no validation rules for NotAfter
+
+	if m.Min != nil {
+		// no validation rules for Min
+	}
+
+	if m.Max != nil {
+		// no validation rules for Max
+	}
+
+	if len(errors) > 0 {
+		return AttributeConstraintsMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeConstraintsMultiError is an error wrapping multiple validation
+// errors returned by AttributeConstraints.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeConstraintsMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeConstraintsMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeConstraintsMultiError) AllErrors() []error { return m }
+
+// AttributeConstraintsValidationError is the validation error returned by
+// AttributeConstraints.Validate if the designated constraints aren't met.
+type AttributeConstraintsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeConstraintsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeConstraintsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeConstraintsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeConstraintsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeConstraintsValidationError) ErrorName() string {
+	return "AttributeConstraintsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeConstraintsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeConstraints.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeConstraintsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeConstraintsValidationError{}
+
 // Validate checks the field values on SubjectAttributeValue with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -2440,44 +2630,48 @@ var _ interface {
 	ErrorName() string
 } = PolicyServiceSaveRuleResponseValidationError{}
 
-// Validate checks the field values on PolicyServiceDeleteRuleRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PolicyServiceDeleteRuleRequest) Validate() error {
+// Validate checks the field values on PolicyServiceSaveExpressionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceSaveExpressionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PolicyServiceDeleteRuleRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PolicyServiceDeleteRuleRequestMultiError, or nil if none found.
-func (m *PolicyServiceDeleteRuleRequest) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceSaveExpressionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceSaveExpressionRequestMultiError, or nil if none found.
+func (m *PolicyServiceSaveExpressionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PolicyServiceDeleteRuleRequest) validate(all bool) error {
+func (m *PolicyServiceSaveExpressionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RuleId
+	// no validation rules for PolicyId
+
+	// no validation rules for Expression
 
 	if len(errors) > 0 {
-		return PolicyServiceDeleteRuleRequestMultiError(errors)
+		return PolicyServiceSaveExpressionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyServiceDeleteRuleRequestMultiError is an error wrapping multiple
-// validation errors returned by PolicyServiceDeleteRuleRequest.ValidateAll()
-// if the designated constraints aren't met.
-type PolicyServiceDeleteRuleRequestMultiError []error
+// PolicyServiceSaveExpressionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceSaveExpressionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceSaveExpressionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyServiceDeleteRuleRequestMultiError) Error() string {
+func (m PolicyServiceSaveExpressionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2486,12 +2680,12 @@ func (m PolicyServiceDeleteRuleRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyServiceDeleteRuleRequestMultiError) AllErrors() []error { return m }
+func (m PolicyServiceSaveExpressionRequestMultiError) AllErrors() []error { return m }
 
-// PolicyServiceDeleteRuleRequestValidationError is the validation error
-// returned by PolicyServiceDeleteRuleRequest.Validate if the designated
+// PolicyServiceSaveExpressionRequestValidationError is the validation error
+// returned by PolicyServiceSaveExpressionRequest.Validate if the designated
 // constraints aren't met.
-type PolicyServiceDeleteRuleRequestValidationError struct {
+type PolicyServiceSaveExpressionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2499,24 +2693,24 @@ type PolicyServiceDeleteRuleRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyServiceDeleteRuleRequestValidationError) Field() string { return e.field }
+func (e PolicyServiceSaveExpressionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyServiceDeleteRuleRequestValidationError) Reason() string { return e.reason }
+func (e PolicyServiceSaveExpressionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyServiceDeleteRuleRequestValidationError) Cause() error { return e.cause }
+func (e PolicyServiceSaveExpressionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyServiceDeleteRuleRequestValidationError) Key() bool { return e.key }
+func (e PolicyServiceSaveExpressionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyServiceDeleteRuleRequestValidationError) ErrorName() string {
-	return "PolicyServiceDeleteRuleRequestValidationError"
+func (e PolicyServiceSaveExpressionRequestValidationError) ErrorName() string {
+	return "PolicyServiceSaveExpressionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PolicyServiceDeleteRuleRequestValidationError) Error() string {
+func (e PolicyServiceSaveExpressionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2528,14 +2722,14 @@ func (e PolicyServiceDeleteRuleRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicyServiceDeleteRuleRequest.%s: %s%s",
+		"invalid %sPolicyServiceSaveExpressionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyServiceDeleteRuleRequestValidationError{}
+var _ error = PolicyServiceSaveExpressionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2543,44 +2737,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyServiceDeleteRuleRequestValidationError{}
+} = PolicyServiceSaveExpressionRequestValidationError{}
 
-// Validate checks the field values on PolicyServiceDeleteRuleResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *PolicyServiceDeleteRuleResponse) Validate() error {
+// Validate checks the field values on PolicyServiceSaveExpressionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceSaveExpressionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PolicyServiceDeleteRuleResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PolicyServiceDeleteRuleResponseMultiError, or nil if none found.
-func (m *PolicyServiceDeleteRuleResponse) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceSaveExpressionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceSaveExpressionResponseMultiError, or nil if none found.
+func (m *PolicyServiceSaveExpressionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PolicyServiceDeleteRuleResponse) validate(all bool) error {
+func (m *PolicyServiceSaveExpressionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for RuleId
+
 	if len(errors) > 0 {
-		return PolicyServiceDeleteRuleResponseMultiError(errors)
+		return PolicyServiceSaveExpressionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyServiceDeleteRuleResponseMultiError is an error wrapping multiple
-// validation errors returned by PolicyServiceDeleteRuleResponse.ValidateAll()
-// if the designated constraints aren't met.
-type PolicyServiceDeleteRuleResponseMultiError []error
+// PolicyServiceSaveExpressionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceSaveExpressionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceSaveExpressionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyServiceDeleteRuleResponseMultiError) Error() string {
+func (m PolicyServiceSaveExpressionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2589,12 +2787,12 @@ func (m PolicyServiceDeleteRuleResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyServiceDeleteRuleResponseMultiError) AllErrors() []error { return m }
+func (m PolicyServiceSaveExpressionResponseMultiError) AllErrors() []error { return m }
 
-// PolicyServiceDeleteRuleResponseValidationError is the validation error
-// returned by PolicyServiceDeleteRuleResponse.Validate if the designated
+// PolicyServiceSaveExpressionResponseValidationError is the validation error
+// returned by PolicyServiceSaveExpressionResponse.Validate if the designated
 // constraints aren't met.
-type PolicyServiceDeleteRuleResponseValidationError struct {
+type PolicyServiceSaveExpressionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2602,24 +2800,24 @@ type PolicyServiceDeleteRuleResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyServiceDeleteRuleResponseValidationError) Field() string { return e.field }
+func (e PolicyServiceSaveExpressionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyServiceDeleteRuleResponseValidationError) Reason() string { return e.reason }
+func (e PolicyServiceSaveExpressionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyServiceDeleteRuleResponseValidationError) Cause() error { return e.cause }
+func (e PolicyServiceSaveExpressionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyServiceDeleteRuleResponseValidationError) Key() bool { return e.key }
+func (e PolicyServiceSaveExpressionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyServiceDeleteRuleResponseValidationError) ErrorName() string {
-	return "PolicyServiceDeleteRuleResponseValidationError"
+func (e PolicyServiceSaveExpressionResponseValidationError) ErrorName() string {
+	return "PolicyServiceSaveExpressionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PolicyServiceDeleteRuleResponseValidationError) Error() string {
+func (e PolicyServiceSaveExpressionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2631,14 +2829,14 @@ func (e PolicyServiceDeleteRuleResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicyServiceDeleteRuleResponse.%s: %s%s",
+		"invalid %sPolicyServiceSaveExpressionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyServiceDeleteRuleResponseValidationError{}
+var _ error = PolicyServiceSaveExpressionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2646,47 +2844,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyServiceDeleteRuleResponseValidationError{}
+} = PolicyServiceSaveExpressionResponseValidationError{}
 
-// Validate checks the field values on
-// PolicyServiceFindPoliciesByPermissionIDsRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PolicyServiceFindPoliciesByPermissionIDsRequest) Validate() error {
+// Validate checks the field values on PolicyServiceGetExpressionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceGetExpressionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// PolicyServiceFindPoliciesByPermissionIDsRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// PolicyServiceFindPoliciesByPermissionIDsRequestMultiError, or nil if none found.
-func (m *PolicyServiceFindPoliciesByPermissionIDsRequest) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceGetExpressionRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceGetExpressionRequestMultiError, or nil if none found.
+func (m *PolicyServiceGetExpressionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PolicyServiceFindPoliciesByPermissionIDsRequest) validate(all bool) error {
+func (m *PolicyServiceGetExpressionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for PolicyId
+
 	if len(errors) > 0 {
-		return PolicyServiceFindPoliciesByPermissionIDsRequestMultiError(errors)
+		return PolicyServiceGetExpressionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyServiceFindPoliciesByPermissionIDsRequestMultiError is an error
-// wrapping multiple validation errors returned by
-// PolicyServiceFindPoliciesByPermissionIDsRequest.ValidateAll() if the
-// designated constraints aren't met.
-type PolicyServiceFindPoliciesByPermissionIDsRequestMultiError []error
+// PolicyServiceGetExpressionRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceGetExpressionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceGetExpressionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyServiceFindPoliciesByPermissionIDsRequestMultiError) Error() string {
+func (m PolicyServiceGetExpressionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2695,13 +2894,12 @@ func (m PolicyServiceFindPoliciesByPermissionIDsRequestMultiError) Error() strin
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyServiceFindPoliciesByPermissionIDsRequestMultiError) AllErrors() []error { return m }
+func (m PolicyServiceGetExpressionRequestMultiError) AllErrors() []error { return m }
 
-// PolicyServiceFindPoliciesByPermissionIDsRequestValidationError is the
-// validation error returned by
-// PolicyServiceFindPoliciesByPermissionIDsRequest.Validate if the designated
+// PolicyServiceGetExpressionRequestValidationError is the validation error
+// returned by PolicyServiceGetExpressionRequest.Validate if the designated
 // constraints aren't met.
-type PolicyServiceFindPoliciesByPermissionIDsRequestValidationError struct {
+type PolicyServiceGetExpressionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2709,28 +2907,24 @@ type PolicyServiceFindPoliciesByPermissionIDsRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Field() string {
-	return e.field
-}
+func (e PolicyServiceGetExpressionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceGetExpressionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Cause() error { return e.cause }
+func (e PolicyServiceGetExpressionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Key() bool { return e.key }
+func (e PolicyServiceGetExpressionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) ErrorName() string {
-	return "PolicyServiceFindPoliciesByPermissionIDsRequestValidationError"
+func (e PolicyServiceGetExpressionRequestValidationError) ErrorName() string {
+	return "PolicyServiceGetExpressionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Error() string {
+func (e PolicyServiceGetExpressionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2742,14 +2936,14 @@ func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Error()
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicyServiceFindPoliciesByPermissionIDsRequest.%s: %s%s",
+		"invalid %sPolicyServiceGetExpressionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyServiceFindPoliciesByPermissionIDsRequestValidationError{}
+var _ error = PolicyServiceGetExpressionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -2757,81 +2951,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyServiceFindPoliciesByPermissionIDsRequestValidationError{}
+} = PolicyServiceGetExpressionRequestValidationError{}
 
-// Validate checks the field values on
-// PolicyServiceFindPoliciesByPermissionIDsResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PolicyServiceFindPoliciesByPermissionIDsResponse) Validate() error {
+// Validate checks the field values on PolicyServiceGetExpressionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceGetExpressionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// PolicyServiceFindPoliciesByPermissionIDsResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// PolicyServiceFindPoliciesByPermissionIDsResponseMultiError, or nil if none found.
-func (m *PolicyServiceFindPoliciesByPermissionIDsResponse) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceGetExpressionResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceGetExpressionResponseMultiError, or nil if none found.
+func (m *PolicyServiceGetExpressionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PolicyServiceFindPoliciesByPermissionIDsResponse) validate(all bool) error {
+func (m *PolicyServiceGetExpressionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetPolicies() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PolicyServiceFindPoliciesByPermissionIDsResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PolicyServiceFindPoliciesByPermissionIDsResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PolicyServiceFindPoliciesByPermissionIDsResponseValidationError{
-					field:  fmt.Sprintf("Policies[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Expression
 
 	if len(errors) > 0 {
-		return PolicyServiceFindPoliciesByPermissionIDsResponseMultiError(errors)
+		return PolicyServiceGetExpressionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyServiceFindPoliciesByPermissionIDsResponseMultiError is an error
-// wrapping multiple validation errors returned by
-// PolicyServiceFindPoliciesByPermissionIDsResponse.ValidateAll() if the
-// designated constraints aren't met.
-type PolicyServiceFindPoliciesByPermissionIDsResponseMultiError []error
+// PolicyServiceGetExpressionResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceGetExpressionResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceGetExpressionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyServiceFindPoliciesByPermissionIDsResponseMultiError) Error() string {
+func (m PolicyServiceGetExpressionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2840,13 +3001,12 @@ func (m PolicyServiceFindPoliciesByPermissionIDsResponseMultiError) Error() stri
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyServiceFindPoliciesByPermissionIDsResponseMultiError) AllErrors() []error { return m }
+func (m PolicyServiceGetExpressionResponseMultiError) AllErrors() []error { return m }
 
-// PolicyServiceFindPoliciesByPermissionIDsResponseValidationError is the
-// validation error returned by
-// PolicyServiceFindPoliciesByPermissionIDsResponse.Validate if the designated
+// PolicyServiceGetExpressionResponseValidationError is the validation error
+// returned by PolicyServiceGetExpressionResponse.Validate if the designated
 // constraints aren't met.
-type PolicyServiceFindPoliciesByPermissionIDsResponseValidationError struct {
+type PolicyServiceGetExpressionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2854,30 +3014,24 @@ type PolicyServiceFindPoliciesByPermissionIDsResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Field() string {
-	return e.field
-}
+func (e PolicyServiceGetExpressionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceGetExpressionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Cause() error {
-	return e.cause
-}
+func (e PolicyServiceGetExpressionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Key() bool { return e.key }
+func (e PolicyServiceGetExpressionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) ErrorName() string {
-	return "PolicyServiceFindPoliciesByPermissionIDsResponseValidationError"
+func (e PolicyServiceGetExpressionResponseValidationError) ErrorName() string {
+	return "PolicyServiceGetExpressionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Error() string {
+func (e PolicyServiceGetExpressionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -2889,14 +3043,14 @@ func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Error()
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicyServiceFindPoliciesByPermissionIDsResponse.%s: %s%s",
+		"invalid %sPolicyServiceGetExpressionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyServiceFindPoliciesByPermissionIDsResponseValidationError{}
+var _ error = PolicyServiceGetExpressionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -2904,53 +3058,83 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyServiceFindPoliciesByPermissionIDsResponseValidationError{}
+} = PolicyServiceGetExpressionResponseValidationError{}
 
-// Validate checks the field values on PolicyServiceSavePermissionPolicyRequest
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the first error encountered is returned, or nil if
-// there are no violations.
-func (m *PolicyServiceSavePermissionPolicyRequest) Validate() error {
+// Validate checks the field values on PolicyVersion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyVersion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// PolicyServiceSavePermissionPolicyRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// PolicyServiceSavePermissionPolicyRequestMultiError, or nil if none found.
-func (m *PolicyServiceSavePermissionPolicyRequest) ValidateAll() error {
+// ValidateAll checks the field values on PolicyVersion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyVersionMultiError, or
+// nil if none found.
+func (m *PolicyVersion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PolicyServiceSavePermissionPolicyRequest) validate(all bool) error {
+func (m *PolicyVersion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for PolicyId
+	// no validation rules for Version
 
-	// no validation rules for PermissionId
+	// no validation rules for SourceVersion
 
-	// no validation rules for Effect
+	// no validation rules for Comment
+
+	// no validation rules for Published
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyVersionValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyVersionValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyVersionValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Ctime
 
 	if len(errors) > 0 {
-		return PolicyServiceSavePermissionPolicyRequestMultiError(errors)
+		return PolicyVersionMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyServiceSavePermissionPolicyRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// PolicyServiceSavePermissionPolicyRequest.ValidateAll() if the designated
-// constraints aren't met.
-type PolicyServiceSavePermissionPolicyRequestMultiError []error
+// PolicyVersionMultiError is an error wrapping multiple validation errors
+// returned by PolicyVersion.ValidateAll() if the designated constraints
+// aren't met.
+type PolicyVersionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyServiceSavePermissionPolicyRequestMultiError) Error() string {
+func (m PolicyVersionMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -2959,12 +3143,11 @@ func (m PolicyServiceSavePermissionPolicyRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyServiceSavePermissionPolicyRequestMultiError) AllErrors() []error { return m }
+func (m PolicyVersionMultiError) AllErrors() []error { return m }
 
-// PolicyServiceSavePermissionPolicyRequestValidationError is the validation
-// error returned by PolicyServiceSavePermissionPolicyRequest.Validate if the
-// designated constraints aren't met.
-type PolicyServiceSavePermissionPolicyRequestValidationError struct {
+// PolicyVersionValidationError is the validation error returned by
+// PolicyVersion.Validate if the designated constraints aren't met.
+type PolicyVersionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -2972,24 +3155,22 @@ type PolicyServiceSavePermissionPolicyRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyServiceSavePermissionPolicyRequestValidationError) Field() string { return e.field }
+func (e PolicyVersionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyServiceSavePermissionPolicyRequestValidationError) Reason() string { return e.reason }
+func (e PolicyVersionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyServiceSavePermissionPolicyRequestValidationError) Cause() error { return e.cause }
+func (e PolicyVersionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyServiceSavePermissionPolicyRequestValidationError) Key() bool { return e.key }
+func (e PolicyVersionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyServiceSavePermissionPolicyRequestValidationError) ErrorName() string {
-	return "PolicyServiceSavePermissionPolicyRequestValidationError"
-}
+func (e PolicyVersionValidationError) ErrorName() string { return "PolicyVersionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PolicyServiceSavePermissionPolicyRequestValidationError) Error() string {
+func (e PolicyVersionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3001,14 +3182,14 @@ func (e PolicyServiceSavePermissionPolicyRequestValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicyServiceSavePermissionPolicyRequest.%s: %s%s",
+		"invalid %sPolicyVersion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyServiceSavePermissionPolicyRequestValidationError{}
+var _ error = PolicyVersionValidationError{}
 
 var _ interface {
 	Field() string
@@ -3016,47 +3197,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyServiceSavePermissionPolicyRequestValidationError{}
+} = PolicyVersionValidationError{}
 
-// Validate checks the field values on
-// PolicyServiceSavePermissionPolicyResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *PolicyServiceSavePermissionPolicyResponse) Validate() error {
+// Validate checks the field values on PolicyFieldChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PolicyFieldChange) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// PolicyServiceSavePermissionPolicyResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// PolicyServiceSavePermissionPolicyResponseMultiError, or nil if none found.
-func (m *PolicyServiceSavePermissionPolicyResponse) ValidateAll() error {
+// ValidateAll checks the field values on PolicyFieldChange with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyFieldChangeMultiError, or nil if none found.
+func (m *PolicyFieldChange) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PolicyServiceSavePermissionPolicyResponse) validate(all bool) error {
+func (m *PolicyFieldChange) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Field
+
+	// no validation rules for From
+
+	// no validation rules for To
+
 	if len(errors) > 0 {
-		return PolicyServiceSavePermissionPolicyResponseMultiError(errors)
+		return PolicyFieldChangeMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyServiceSavePermissionPolicyResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// PolicyServiceSavePermissionPolicyResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PolicyServiceSavePermissionPolicyResponseMultiError []error
+// PolicyFieldChangeMultiError is an error wrapping multiple validation errors
+// returned by PolicyFieldChange.ValidateAll() if the designated constraints
+// aren't met.
+type PolicyFieldChangeMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyServiceSavePermissionPolicyResponseMultiError) Error() string {
+func (m PolicyFieldChangeMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3065,12 +3249,11 @@ func (m PolicyServiceSavePermissionPolicyResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyServiceSavePermissionPolicyResponseMultiError) AllErrors() []error { return m }
+func (m PolicyFieldChangeMultiError) AllErrors() []error { return m }
 
-// PolicyServiceSavePermissionPolicyResponseValidationError is the validation
-// error returned by PolicyServiceSavePermissionPolicyResponse.Validate if the
-// designated constraints aren't met.
-type PolicyServiceSavePermissionPolicyResponseValidationError struct {
+// PolicyFieldChangeValidationError is the validation error returned by
+// PolicyFieldChange.Validate if the designated constraints aren't met.
+type PolicyFieldChangeValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3078,24 +3261,24 @@ type PolicyServiceSavePermissionPolicyResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyServiceSavePermissionPolicyResponseValidationError) Field() string { return e.field }
+func (e PolicyFieldChangeValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyServiceSavePermissionPolicyResponseValidationError) Reason() string { return e.reason }
+func (e PolicyFieldChangeValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyServiceSavePermissionPolicyResponseValidationError) Cause() error { return e.cause }
+func (e PolicyFieldChangeValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyServiceSavePermissionPolicyResponseValidationError) Key() bool { return e.key }
+func (e PolicyFieldChangeValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyServiceSavePermissionPolicyResponseValidationError) ErrorName() string {
-	return "PolicyServiceSavePermissionPolicyResponseValidationError"
+func (e PolicyFieldChangeValidationError) ErrorName() string {
+	return "PolicyFieldChangeValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PolicyServiceSavePermissionPolicyResponseValidationError) Error() string {
+func (e PolicyFieldChangeValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3107,14 +3290,14 @@ func (e PolicyServiceSavePermissionPolicyResponseValidationError) Error() string
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicyServiceSavePermissionPolicyResponse.%s: %s%s",
+		"invalid %sPolicyFieldChange.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyServiceSavePermissionPolicyResponseValidationError{}
+var _ error = PolicyFieldChangeValidationError{}
 
 var _ interface {
 	Field() string
@@ -3122,50 +3305,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyServiceSavePermissionPolicyResponseValidationError{}
+} = PolicyFieldChangeValidationError{}
 
-// Validate checks the field values on PolicyServiceFindPoliciesRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *PolicyServiceFindPoliciesRequest) Validate() error {
+// Validate checks the field values on PolicyServicePublishRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServicePublishRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PolicyServiceFindPoliciesRequest with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the result is a list of violation errors wrapped in
-// PolicyServiceFindPoliciesRequestMultiError, or nil if none found.
-func (m *PolicyServiceFindPoliciesRequest) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServicePublishRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyServicePublishRequestMultiError, or nil if none found.
+func (m *PolicyServicePublishRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PolicyServiceFindPoliciesRequest) validate(all bool) error {
+func (m *PolicyServicePublishRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Offset
+	// no validation rules for PolicyId
 
-	// no validation rules for Limit
+	// no validation rules for Comment
 
 	if len(errors) > 0 {
-		return PolicyServiceFindPoliciesRequestMultiError(errors)
+		return PolicyServicePublishRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyServiceFindPoliciesRequestMultiError is an error wrapping multiple
-// validation errors returned by
-// PolicyServiceFindPoliciesRequest.ValidateAll() if the designated
-// constraints aren't met.
-type PolicyServiceFindPoliciesRequestMultiError []error
+// PolicyServicePublishRequestMultiError is an error wrapping multiple
+// validation errors returned by PolicyServicePublishRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PolicyServicePublishRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyServiceFindPoliciesRequestMultiError) Error() string {
+func (m PolicyServicePublishRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3174,12 +3355,12 @@ func (m PolicyServiceFindPoliciesRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyServiceFindPoliciesRequestMultiError) AllErrors() []error { return m }
+func (m PolicyServicePublishRequestMultiError) AllErrors() []error { return m }
 
-// PolicyServiceFindPoliciesRequestValidationError is the validation error
-// returned by PolicyServiceFindPoliciesRequest.Validate if the designated
-// constraints aren't met.
-type PolicyServiceFindPoliciesRequestValidationError struct {
+// PolicyServicePublishRequestValidationError is the validation error returned
+// by PolicyServicePublishRequest.Validate if the designated constraints
+// aren't met.
+type PolicyServicePublishRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3187,24 +3368,24 @@ type PolicyServiceFindPoliciesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyServiceFindPoliciesRequestValidationError) Field() string { return e.field }
+func (e PolicyServicePublishRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyServiceFindPoliciesRequestValidationError) Reason() string { return e.reason }
+func (e PolicyServicePublishRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyServiceFindPoliciesRequestValidationError) Cause() error { return e.cause }
+func (e PolicyServicePublishRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyServiceFindPoliciesRequestValidationError) Key() bool { return e.key }
+func (e PolicyServicePublishRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyServiceFindPoliciesRequestValidationError) ErrorName() string {
-	return "PolicyServiceFindPoliciesRequestValidationError"
+func (e PolicyServicePublishRequestValidationError) ErrorName() string {
+	return "PolicyServicePublishRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PolicyServiceFindPoliciesRequestValidationError) Error() string {
+func (e PolicyServicePublishRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3216,14 +3397,14 @@ func (e PolicyServiceFindPoliciesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicyServiceFindPoliciesRequest.%s: %s%s",
+		"invalid %sPolicyServicePublishRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyServiceFindPoliciesRequestValidationError{}
+var _ error = PolicyServicePublishRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3231,82 +3412,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyServiceFindPoliciesRequestValidationError{}
+} = PolicyServicePublishRequestValidationError{}
 
-// Validate checks the field values on PolicyServiceFindPoliciesResponse with
-// the rules defined in the proto definition for this message. If any rules
-// are violated, the first error encountered is returned, or nil if there are
-// no violations.
-func (m *PolicyServiceFindPoliciesResponse) Validate() error {
+// Validate checks the field values on PolicyServicePublishResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServicePublishResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PolicyServiceFindPoliciesResponse
-// with the rules defined in the proto definition for this message. If any
-// rules are violated, the result is a list of violation errors wrapped in
-// PolicyServiceFindPoliciesResponseMultiError, or nil if none found.
-func (m *PolicyServiceFindPoliciesResponse) ValidateAll() error {
-	return m.validate(true)
+// ValidateAll checks the field values on PolicyServicePublishResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyServicePublishResponseMultiError, or nil if none found.
+func (m *PolicyServicePublishResponse) ValidateAll() error {
+	return m.validate(true)
 }
 
-func (m *PolicyServiceFindPoliciesResponse) validate(all bool) error {
+func (m *PolicyServicePublishResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Total
-
-	for idx, item := range m.GetPolicies() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, PolicyServiceFindPoliciesResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, PolicyServiceFindPoliciesResponseValidationError{
-						field:  fmt.Sprintf("Policies[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return PolicyServiceFindPoliciesResponseValidationError{
-					field:  fmt.Sprintf("Policies[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
-			}
-		}
-
-	}
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return PolicyServiceFindPoliciesResponseMultiError(errors)
+		return PolicyServicePublishResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PolicyServiceFindPoliciesResponseMultiError is an error wrapping multiple
-// validation errors returned by
-// PolicyServiceFindPoliciesResponse.ValidateAll() if the designated
-// constraints aren't met.
-type PolicyServiceFindPoliciesResponseMultiError []error
+// PolicyServicePublishResponseMultiError is an error wrapping multiple
+// validation errors returned by PolicyServicePublishResponse.ValidateAll() if
+// the designated constraints aren't met.
+type PolicyServicePublishResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PolicyServiceFindPoliciesResponseMultiError) Error() string {
+func (m PolicyServicePublishResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3315,12 +3460,12 @@ func (m PolicyServiceFindPoliciesResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PolicyServiceFindPoliciesResponseMultiError) AllErrors() []error { return m }
+func (m PolicyServicePublishResponseMultiError) AllErrors() []error { return m }
 
-// PolicyServiceFindPoliciesResponseValidationError is the validation error
-// returned by PolicyServiceFindPoliciesResponse.Validate if the designated
-// constraints aren't met.
-type PolicyServiceFindPoliciesResponseValidationError struct {
+// PolicyServicePublishResponseValidationError is the validation error returned
+// by PolicyServicePublishResponse.Validate if the designated constraints
+// aren't met.
+type PolicyServicePublishResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3328,24 +3473,24 @@ type PolicyServiceFindPoliciesResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PolicyServiceFindPoliciesResponseValidationError) Field() string { return e.field }
+func (e PolicyServicePublishResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PolicyServiceFindPoliciesResponseValidationError) Reason() string { return e.reason }
+func (e PolicyServicePublishResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PolicyServiceFindPoliciesResponseValidationError) Cause() error { return e.cause }
+func (e PolicyServicePublishResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PolicyServiceFindPoliciesResponseValidationError) Key() bool { return e.key }
+func (e PolicyServicePublishResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PolicyServiceFindPoliciesResponseValidationError) ErrorName() string {
-	return "PolicyServiceFindPoliciesResponseValidationError"
+func (e PolicyServicePublishResponseValidationError) ErrorName() string {
+	return "PolicyServicePublishResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e PolicyServiceFindPoliciesResponseValidationError) Error() string {
+func (e PolicyServicePublishResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3357,14 +3502,14 @@ func (e PolicyServiceFindPoliciesResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPolicyServiceFindPoliciesResponse.%s: %s%s",
+		"invalid %sPolicyServicePublishResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PolicyServiceFindPoliciesResponseValidationError{}
+var _ error = PolicyServicePublishResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3372,78 +3517,50 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PolicyServiceFindPoliciesResponseValidationError{}
+} = PolicyServicePublishResponseValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceSaveSubjectValueRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceSaveSubjectValueRequest) Validate() error {
+// Validate checks the field values on PolicyServiceRollbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServiceRollbackRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceSaveSubjectValueRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// AttributeValueServiceSaveSubjectValueRequestMultiError, or nil if none found.
-func (m *AttributeValueServiceSaveSubjectValueRequest) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceRollbackRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyServiceRollbackRequestMultiError, or nil if none found.
+func (m *PolicyServiceRollbackRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceSaveSubjectValueRequest) validate(all bool) error {
+func (m *PolicyServiceRollbackRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SubjectId
+	// no validation rules for PolicyId
 
-	if all {
-		switch v := interface{}(m.GetValue()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeValueServiceSaveSubjectValueRequestValidationError{
-					field:  "Value",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeValueServiceSaveSubjectValueRequestValidationError{
-					field:  "Value",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AttributeValueServiceSaveSubjectValueRequestValidationError{
-				field:  "Value",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Version
+
+	// no validation rules for Comment
 
 	if len(errors) > 0 {
-		return AttributeValueServiceSaveSubjectValueRequestMultiError(errors)
+		return PolicyServiceRollbackRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceSaveSubjectValueRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// AttributeValueServiceSaveSubjectValueRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceSaveSubjectValueRequestMultiError []error
+// PolicyServiceRollbackRequestMultiError is an error wrapping multiple
+// validation errors returned by PolicyServiceRollbackRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PolicyServiceRollbackRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceSaveSubjectValueRequestMultiError) Error() string {
+func (m PolicyServiceRollbackRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3452,13 +3569,12 @@ func (m AttributeValueServiceSaveSubjectValueRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceSaveSubjectValueRequestMultiError) AllErrors() []error { return m }
+func (m PolicyServiceRollbackRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceSaveSubjectValueRequestValidationError is the
-// validation error returned by
-// AttributeValueServiceSaveSubjectValueRequest.Validate if the designated
-// constraints aren't met.
-type AttributeValueServiceSaveSubjectValueRequestValidationError struct {
+// PolicyServiceRollbackRequestValidationError is the validation error returned
+// by PolicyServiceRollbackRequest.Validate if the designated constraints
+// aren't met.
+type PolicyServiceRollbackRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3466,24 +3582,24 @@ type AttributeValueServiceSaveSubjectValueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Field() string { return e.field }
+func (e PolicyServiceRollbackRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Reason() string { return e.reason }
+func (e PolicyServiceRollbackRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Cause() error { return e.cause }
+func (e PolicyServiceRollbackRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Key() bool { return e.key }
+func (e PolicyServiceRollbackRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceSaveSubjectValueRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceSaveSubjectValueRequestValidationError"
+func (e PolicyServiceRollbackRequestValidationError) ErrorName() string {
+	return "PolicyServiceRollbackRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Error() string {
+func (e PolicyServiceRollbackRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3495,14 +3611,14 @@ func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Error() str
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceSaveSubjectValueRequest.%s: %s%s",
+		"invalid %sPolicyServiceRollbackRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceSaveSubjectValueRequestValidationError{}
+var _ error = PolicyServiceRollbackRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3510,49 +3626,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceSaveSubjectValueRequestValidationError{}
+} = PolicyServiceRollbackRequestValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceSaveSubjectValueResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceSaveSubjectValueResponse) Validate() error {
+// Validate checks the field values on PolicyServiceRollbackResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServiceRollbackResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceSaveSubjectValueResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// AttributeValueServiceSaveSubjectValueResponseMultiError, or nil if none found.
-func (m *AttributeValueServiceSaveSubjectValueResponse) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceRollbackResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceRollbackResponseMultiError, or nil if none found.
+func (m *PolicyServiceRollbackResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceSaveSubjectValueResponse) validate(all bool) error {
+func (m *PolicyServiceRollbackResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return AttributeValueServiceSaveSubjectValueResponseMultiError(errors)
+		return PolicyServiceRollbackResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceSaveSubjectValueResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// AttributeValueServiceSaveSubjectValueResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceSaveSubjectValueResponseMultiError []error
+// PolicyServiceRollbackResponseMultiError is an error wrapping multiple
+// validation errors returned by PolicyServiceRollbackResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PolicyServiceRollbackResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceSaveSubjectValueResponseMultiError) Error() string {
+func (m PolicyServiceRollbackResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3561,13 +3674,12 @@ func (m AttributeValueServiceSaveSubjectValueResponseMultiError) Error() string
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceSaveSubjectValueResponseMultiError) AllErrors() []error { return m }
+func (m PolicyServiceRollbackResponseMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceSaveSubjectValueResponseValidationError is the
-// validation error returned by
-// AttributeValueServiceSaveSubjectValueResponse.Validate if the designated
+// PolicyServiceRollbackResponseValidationError is the validation error
+// returned by PolicyServiceRollbackResponse.Validate if the designated
 // constraints aren't met.
-type AttributeValueServiceSaveSubjectValueResponseValidationError struct {
+type PolicyServiceRollbackResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3575,26 +3687,24 @@ type AttributeValueServiceSaveSubjectValueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Field() string { return e.field }
+func (e PolicyServiceRollbackResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceRollbackResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Cause() error { return e.cause }
+func (e PolicyServiceRollbackResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Key() bool { return e.key }
+func (e PolicyServiceRollbackResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceSaveSubjectValueResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceSaveSubjectValueResponseValidationError"
+func (e PolicyServiceRollbackResponseValidationError) ErrorName() string {
+	return "PolicyServiceRollbackResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Error() string {
+func (e PolicyServiceRollbackResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3606,14 +3716,14 @@ func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Error() st
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceSaveSubjectValueResponse.%s: %s%s",
+		"invalid %sPolicyServiceRollbackResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceSaveSubjectValueResponseValidationError{}
+var _ error = PolicyServiceRollbackResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3621,49 +3731,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceSaveSubjectValueResponseValidationError{}
+} = PolicyServiceRollbackResponseValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceDeleteSubjectValueRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceDeleteSubjectValueRequest) Validate() error {
+// Validate checks the field values on PolicyServiceListVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceListVersionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceDeleteSubjectValueRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// AttributeValueServiceDeleteSubjectValueRequestMultiError, or nil if none found.
-func (m *AttributeValueServiceDeleteSubjectValueRequest) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceListVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceListVersionsRequestMultiError, or nil if none found.
+func (m *PolicyServiceListVersionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceDeleteSubjectValueRequest) validate(all bool) error {
+func (m *PolicyServiceListVersionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for PolicyId
 
 	if len(errors) > 0 {
-		return AttributeValueServiceDeleteSubjectValueRequestMultiError(errors)
+		return PolicyServiceListVersionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceDeleteSubjectValueRequestMultiError is an error
-// wrapping multiple validation errors returned by
-// AttributeValueServiceDeleteSubjectValueRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceDeleteSubjectValueRequestMultiError []error
+// PolicyServiceListVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceListVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceListVersionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceDeleteSubjectValueRequestMultiError) Error() string {
+func (m PolicyServiceListVersionsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3672,13 +3781,12 @@ func (m AttributeValueServiceDeleteSubjectValueRequestMultiError) Error() string
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceDeleteSubjectValueRequestMultiError) AllErrors() []error { return m }
+func (m PolicyServiceListVersionsRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceDeleteSubjectValueRequestValidationError is the
-// validation error returned by
-// AttributeValueServiceDeleteSubjectValueRequest.Validate if the designated
+// PolicyServiceListVersionsRequestValidationError is the validation error
+// returned by PolicyServiceListVersionsRequest.Validate if the designated
 // constraints aren't met.
-type AttributeValueServiceDeleteSubjectValueRequestValidationError struct {
+type PolicyServiceListVersionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3686,26 +3794,24 @@ type AttributeValueServiceDeleteSubjectValueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Field() string { return e.field }
+func (e PolicyServiceListVersionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceListVersionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Cause() error { return e.cause }
+func (e PolicyServiceListVersionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Key() bool { return e.key }
+func (e PolicyServiceListVersionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceDeleteSubjectValueRequestValidationError"
+func (e PolicyServiceListVersionsRequestValidationError) ErrorName() string {
+	return "PolicyServiceListVersionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Error() string {
+func (e PolicyServiceListVersionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3717,14 +3823,14 @@ func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Error() s
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceDeleteSubjectValueRequest.%s: %s%s",
+		"invalid %sPolicyServiceListVersionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceDeleteSubjectValueRequestValidationError{}
+var _ error = PolicyServiceListVersionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3732,62 +3838,94 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceDeleteSubjectValueRequestValidationError{}
+} = PolicyServiceListVersionsRequestValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceDeleteSubjectValueResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceDeleteSubjectValueResponse) Validate() error {
+// Validate checks the field values on PolicyServiceListVersionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceListVersionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceDeleteSubjectValueResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// AttributeValueServiceDeleteSubjectValueResponseMultiError, or nil if none found.
-func (m *AttributeValueServiceDeleteSubjectValueResponse) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceListVersionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceListVersionsResponseMultiError, or nil if none found.
+func (m *PolicyServiceListVersionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceDeleteSubjectValueResponse) validate(all bool) error {
+func (m *PolicyServiceListVersionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if len(errors) > 0 {
-		return AttributeValueServiceDeleteSubjectValueResponseMultiError(errors)
-	}
-
-	return nil
-}
-
-// AttributeValueServiceDeleteSubjectValueResponseMultiError is an error
-// wrapping multiple validation errors returned by
-// AttributeValueServiceDeleteSubjectValueResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceDeleteSubjectValueResponseMultiError []error
+	for idx, item := range m.GetVersions() {
+		_, _ = idx, item
 
-// Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceDeleteSubjectValueResponseMultiError) Error() string {
-	msgs := make([]string, 0, len(m))
-	for _, err := range m {
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceListVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceListVersionsResponseValidationError{
+						field:  fmt.Sprintf("Versions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceListVersionsResponseValidationError{
+					field:  fmt.Sprintf("Versions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyServiceListVersionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceListVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceListVersionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceListVersionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceListVersionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
 		msgs = append(msgs, err.Error())
 	}
 	return strings.Join(msgs, "; ")
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceDeleteSubjectValueResponseMultiError) AllErrors() []error { return m }
+func (m PolicyServiceListVersionsResponseMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceDeleteSubjectValueResponseValidationError is the
-// validation error returned by
-// AttributeValueServiceDeleteSubjectValueResponse.Validate if the designated
+// PolicyServiceListVersionsResponseValidationError is the validation error
+// returned by PolicyServiceListVersionsResponse.Validate if the designated
 // constraints aren't met.
-type AttributeValueServiceDeleteSubjectValueResponseValidationError struct {
+type PolicyServiceListVersionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3795,28 +3933,24 @@ type AttributeValueServiceDeleteSubjectValueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Field() string {
-	return e.field
-}
+func (e PolicyServiceListVersionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceListVersionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Cause() error { return e.cause }
+func (e PolicyServiceListVersionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Key() bool { return e.key }
+func (e PolicyServiceListVersionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceDeleteSubjectValueResponseValidationError"
+func (e PolicyServiceListVersionsResponseValidationError) ErrorName() string {
+	return "PolicyServiceListVersionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Error() string {
+func (e PolicyServiceListVersionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3828,14 +3962,14 @@ func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Error()
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceDeleteSubjectValueResponse.%s: %s%s",
+		"invalid %sPolicyServiceListVersionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceDeleteSubjectValueResponseValidationError{}
+var _ error = PolicyServiceListVersionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -3843,49 +3977,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceDeleteSubjectValueResponseValidationError{}
+} = PolicyServiceListVersionsResponseValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceFindSubjectValueRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindSubjectValueRequest) Validate() error {
+// Validate checks the field values on PolicyServiceGetVersionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServiceGetVersionRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceFindSubjectValueRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// AttributeValueServiceFindSubjectValueRequestMultiError, or nil if none found.
-func (m *AttributeValueServiceFindSubjectValueRequest) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceGetVersionRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceGetVersionRequestMultiError, or nil if none found.
+func (m *PolicyServiceGetVersionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindSubjectValueRequest) validate(all bool) error {
+func (m *PolicyServiceGetVersionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SubjectId
+	// no validation rules for PolicyId
+
+	// no validation rules for Version
 
 	if len(errors) > 0 {
-		return AttributeValueServiceFindSubjectValueRequestMultiError(errors)
+		return PolicyServiceGetVersionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindSubjectValueRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// AttributeValueServiceFindSubjectValueRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceFindSubjectValueRequestMultiError []error
+// PolicyServiceGetVersionRequestMultiError is an error wrapping multiple
+// validation errors returned by PolicyServiceGetVersionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PolicyServiceGetVersionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindSubjectValueRequestMultiError) Error() string {
+func (m PolicyServiceGetVersionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3894,13 +4027,12 @@ func (m AttributeValueServiceFindSubjectValueRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindSubjectValueRequestMultiError) AllErrors() []error { return m }
+func (m PolicyServiceGetVersionRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindSubjectValueRequestValidationError is the
-// validation error returned by
-// AttributeValueServiceFindSubjectValueRequest.Validate if the designated
+// PolicyServiceGetVersionRequestValidationError is the validation error
+// returned by PolicyServiceGetVersionRequest.Validate if the designated
 // constraints aren't met.
-type AttributeValueServiceFindSubjectValueRequestValidationError struct {
+type PolicyServiceGetVersionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3908,24 +4040,24 @@ type AttributeValueServiceFindSubjectValueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindSubjectValueRequestValidationError) Field() string { return e.field }
+func (e PolicyServiceGetVersionRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindSubjectValueRequestValidationError) Reason() string { return e.reason }
+func (e PolicyServiceGetVersionRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindSubjectValueRequestValidationError) Cause() error { return e.cause }
+func (e PolicyServiceGetVersionRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindSubjectValueRequestValidationError) Key() bool { return e.key }
+func (e PolicyServiceGetVersionRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindSubjectValueRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceFindSubjectValueRequestValidationError"
+func (e PolicyServiceGetVersionRequestValidationError) ErrorName() string {
+	return "PolicyServiceGetVersionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindSubjectValueRequestValidationError) Error() string {
+func (e PolicyServiceGetVersionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3937,14 +4069,14 @@ func (e AttributeValueServiceFindSubjectValueRequestValidationError) Error() str
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindSubjectValueRequest.%s: %s%s",
+		"invalid %sPolicyServiceGetVersionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindSubjectValueRequestValidationError{}
+var _ error = PolicyServiceGetVersionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -3952,26 +4084,24 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindSubjectValueRequestValidationError{}
+} = PolicyServiceGetVersionRequestValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceFindSubjectValueResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindSubjectValueResponse) Validate() error {
+// Validate checks the field values on PolicyServiceGetVersionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServiceGetVersionResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceFindSubjectValueResponse with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// AttributeValueServiceFindSubjectValueResponseMultiError, or nil if none found.
-func (m *AttributeValueServiceFindSubjectValueResponse) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceGetVersionResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceGetVersionResponseMultiError, or nil if none found.
+func (m *PolicyServiceGetVersionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindSubjectValueResponse) validate(all bool) error {
+func (m *PolicyServiceGetVersionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -3979,28 +4109,28 @@ func (m *AttributeValueServiceFindSubjectValueResponse) validate(all bool) error
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetSubject()).(type) {
+		switch v := interface{}(m.GetVersion()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeValueServiceFindSubjectValueResponseValidationError{
-					field:  "Subject",
+				errors = append(errors, PolicyServiceGetVersionResponseValidationError{
+					field:  "Version",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeValueServiceFindSubjectValueResponseValidationError{
-					field:  "Subject",
+				errors = append(errors, PolicyServiceGetVersionResponseValidationError{
+					field:  "Version",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetVersion()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AttributeValueServiceFindSubjectValueResponseValidationError{
-				field:  "Subject",
+			return PolicyServiceGetVersionResponseValidationError{
+				field:  "Version",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -4008,20 +4138,19 @@ func (m *AttributeValueServiceFindSubjectValueResponse) validate(all bool) error
 	}
 
 	if len(errors) > 0 {
-		return AttributeValueServiceFindSubjectValueResponseMultiError(errors)
+		return PolicyServiceGetVersionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindSubjectValueResponseMultiError is an error wrapping
-// multiple validation errors returned by
-// AttributeValueServiceFindSubjectValueResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceFindSubjectValueResponseMultiError []error
+// PolicyServiceGetVersionResponseMultiError is an error wrapping multiple
+// validation errors returned by PolicyServiceGetVersionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PolicyServiceGetVersionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindSubjectValueResponseMultiError) Error() string {
+func (m PolicyServiceGetVersionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4030,13 +4159,12 @@ func (m AttributeValueServiceFindSubjectValueResponseMultiError) Error() string
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindSubjectValueResponseMultiError) AllErrors() []error { return m }
+func (m PolicyServiceGetVersionResponseMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindSubjectValueResponseValidationError is the
-// validation error returned by
-// AttributeValueServiceFindSubjectValueResponse.Validate if the designated
+// PolicyServiceGetVersionResponseValidationError is the validation error
+// returned by PolicyServiceGetVersionResponse.Validate if the designated
 // constraints aren't met.
-type AttributeValueServiceFindSubjectValueResponseValidationError struct {
+type PolicyServiceGetVersionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4044,26 +4172,24 @@ type AttributeValueServiceFindSubjectValueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindSubjectValueResponseValidationError) Field() string { return e.field }
+func (e PolicyServiceGetVersionResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindSubjectValueResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceGetVersionResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindSubjectValueResponseValidationError) Cause() error { return e.cause }
+func (e PolicyServiceGetVersionResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindSubjectValueResponseValidationError) Key() bool { return e.key }
+func (e PolicyServiceGetVersionResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindSubjectValueResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceFindSubjectValueResponseValidationError"
+func (e PolicyServiceGetVersionResponseValidationError) ErrorName() string {
+	return "PolicyServiceGetVersionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindSubjectValueResponseValidationError) Error() string {
+func (e PolicyServiceGetVersionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4075,14 +4201,14 @@ func (e AttributeValueServiceFindSubjectValueResponseValidationError) Error() st
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindSubjectValueResponse.%s: %s%s",
+		"invalid %sPolicyServiceGetVersionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindSubjectValueResponseValidationError{}
+var _ error = PolicyServiceGetVersionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4090,50 +4216,52 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindSubjectValueResponseValidationError{}
+} = PolicyServiceGetVersionResponseValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceFindSubjectValueWithDefinitionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindSubjectValueWithDefinitionRequest) Validate() error {
+// Validate checks the field values on PolicyServiceDiffVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceDiffVersionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceFindSubjectValueWithDefinitionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError, or
-// nil if none found.
-func (m *AttributeValueServiceFindSubjectValueWithDefinitionRequest) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceDiffVersionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceDiffVersionsRequestMultiError, or nil if none found.
+func (m *PolicyServiceDiffVersionsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindSubjectValueWithDefinitionRequest) validate(all bool) error {
+func (m *PolicyServiceDiffVersionsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for SubjectId
+	// no validation rules for PolicyId
+
+	// no validation rules for From
+
+	// no validation rules for To
 
 	if len(errors) > 0 {
-		return AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError(errors)
+		return PolicyServiceDiffVersionsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError is an
-// error wrapping multiple validation errors returned by
-// AttributeValueServiceFindSubjectValueWithDefinitionRequest.ValidateAll() if
-// the designated constraints aren't met.
-type AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError []error
+// PolicyServiceDiffVersionsRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceDiffVersionsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceDiffVersionsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError) Error() string {
+func (m PolicyServiceDiffVersionsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4142,15 +4270,12 @@ func (m AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError) Er
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError) AllErrors() []error {
-	return m
-}
+func (m PolicyServiceDiffVersionsRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError is
-// the validation error returned by
-// AttributeValueServiceFindSubjectValueWithDefinitionRequest.Validate if the
-// designated constraints aren't met.
-type AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError struct {
+// PolicyServiceDiffVersionsRequestValidationError is the validation error
+// returned by PolicyServiceDiffVersionsRequest.Validate if the designated
+// constraints aren't met.
+type PolicyServiceDiffVersionsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4158,32 +4283,24 @@ type AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError s
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) Field() string {
-	return e.field
-}
+func (e PolicyServiceDiffVersionsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceDiffVersionsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) Cause() error {
-	return e.cause
-}
+func (e PolicyServiceDiffVersionsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) Key() bool {
-	return e.key
-}
+func (e PolicyServiceDiffVersionsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError"
+func (e PolicyServiceDiffVersionsRequestValidationError) ErrorName() string {
+	return "PolicyServiceDiffVersionsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) Error() string {
+func (e PolicyServiceDiffVersionsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4195,14 +4312,14 @@ func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationErro
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindSubjectValueWithDefinitionRequest.%s: %s%s",
+		"invalid %sPolicyServiceDiffVersionsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError{}
+var _ error = PolicyServiceDiffVersionsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4210,77 +4327,84 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError{}
+} = PolicyServiceDiffVersionsRequestValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceFindSubjectValueWithDefinitionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindSubjectValueWithDefinitionResponse) Validate() error {
+// Validate checks the field values on PolicyServiceDiffVersionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceDiffVersionsResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceFindSubjectValueWithDefinitionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError, or
-// nil if none found.
-func (m *AttributeValueServiceFindSubjectValueWithDefinitionResponse) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceDiffVersionsResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceDiffVersionsResponseMultiError, or nil if none found.
+func (m *PolicyServiceDiffVersionsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindSubjectValueWithDefinitionResponse) validate(all bool) error {
+func (m *PolicyServiceDiffVersionsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetSubject()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError{
-					field:  "Subject",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+	// no validation rules for FromExpression
+
+	// no validation rules for ToExpression
+
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceDiffVersionsResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceDiffVersionsResponseValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			}
-		case interface{ Validate() error }:
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError{
-					field:  "Subject",
+				return PolicyServiceDiffVersionsResponseValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError{
-				field:  "Subject",
-				reason: "embedded message failed validation",
-				cause:  err,
+				}
 			}
 		}
+
 	}
 
 	if len(errors) > 0 {
-		return AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError(errors)
+		return PolicyServiceDiffVersionsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError is an
-// error wrapping multiple validation errors returned by
-// AttributeValueServiceFindSubjectValueWithDefinitionResponse.ValidateAll()
-// if the designated constraints aren't met.
-type AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError []error
+// PolicyServiceDiffVersionsResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceDiffVersionsResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceDiffVersionsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError) Error() string {
+func (m PolicyServiceDiffVersionsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4289,15 +4413,12 @@ func (m AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError) E
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError) AllErrors() []error {
-	return m
-}
+func (m PolicyServiceDiffVersionsResponseMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError
-// is the validation error returned by
-// AttributeValueServiceFindSubjectValueWithDefinitionResponse.Validate if the
-// designated constraints aren't met.
-type AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError struct {
+// PolicyServiceDiffVersionsResponseValidationError is the validation error
+// returned by PolicyServiceDiffVersionsResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceDiffVersionsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4305,32 +4426,24 @@ type AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) Field() string {
-	return e.field
-}
+func (e PolicyServiceDiffVersionsResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceDiffVersionsResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) Cause() error {
-	return e.cause
-}
+func (e PolicyServiceDiffVersionsResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) Key() bool {
-	return e.key
-}
+func (e PolicyServiceDiffVersionsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError"
+func (e PolicyServiceDiffVersionsResponseValidationError) ErrorName() string {
+	return "PolicyServiceDiffVersionsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) Error() string {
+func (e PolicyServiceDiffVersionsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4342,14 +4455,14 @@ func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationErr
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindSubjectValueWithDefinitionResponse.%s: %s%s",
+		"invalid %sPolicyServiceDiffVersionsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError{}
+var _ error = PolicyServiceDiffVersionsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4357,78 +4470,46 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError{}
+} = PolicyServiceDiffVersionsResponseValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceSaveResourceValueRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceSaveResourceValueRequest) Validate() error {
+// Validate checks the field values on PolicyServiceDeleteRuleRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServiceDeleteRuleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceSaveResourceValueRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in
-// AttributeValueServiceSaveResourceValueRequestMultiError, or nil if none found.
-func (m *AttributeValueServiceSaveResourceValueRequest) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceDeleteRuleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceDeleteRuleRequestMultiError, or nil if none found.
+func (m *PolicyServiceDeleteRuleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceSaveResourceValueRequest) validate(all bool) error {
+func (m *PolicyServiceDeleteRuleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ResourceId
-
-	if all {
-		switch v := interface{}(m.GetValue()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeValueServiceSaveResourceValueRequestValidationError{
-					field:  "Value",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeValueServiceSaveResourceValueRequestValidationError{
-					field:  "Value",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AttributeValueServiceSaveResourceValueRequestValidationError{
-				field:  "Value",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for RuleId
 
 	if len(errors) > 0 {
-		return AttributeValueServiceSaveResourceValueRequestMultiError(errors)
+		return PolicyServiceDeleteRuleRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceSaveResourceValueRequestMultiError is an error wrapping
-// multiple validation errors returned by
-// AttributeValueServiceSaveResourceValueRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceSaveResourceValueRequestMultiError []error
+// PolicyServiceDeleteRuleRequestMultiError is an error wrapping multiple
+// validation errors returned by PolicyServiceDeleteRuleRequest.ValidateAll()
+// if the designated constraints aren't met.
+type PolicyServiceDeleteRuleRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceSaveResourceValueRequestMultiError) Error() string {
+func (m PolicyServiceDeleteRuleRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4437,13 +4518,12 @@ func (m AttributeValueServiceSaveResourceValueRequestMultiError) Error() string
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceSaveResourceValueRequestMultiError) AllErrors() []error { return m }
+func (m PolicyServiceDeleteRuleRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceSaveResourceValueRequestValidationError is the
-// validation error returned by
-// AttributeValueServiceSaveResourceValueRequest.Validate if the designated
+// PolicyServiceDeleteRuleRequestValidationError is the validation error
+// returned by PolicyServiceDeleteRuleRequest.Validate if the designated
 // constraints aren't met.
-type AttributeValueServiceSaveResourceValueRequestValidationError struct {
+type PolicyServiceDeleteRuleRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4451,26 +4531,24 @@ type AttributeValueServiceSaveResourceValueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceSaveResourceValueRequestValidationError) Field() string { return e.field }
+func (e PolicyServiceDeleteRuleRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceSaveResourceValueRequestValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceDeleteRuleRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceSaveResourceValueRequestValidationError) Cause() error { return e.cause }
+func (e PolicyServiceDeleteRuleRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceSaveResourceValueRequestValidationError) Key() bool { return e.key }
+func (e PolicyServiceDeleteRuleRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceSaveResourceValueRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceSaveResourceValueRequestValidationError"
+func (e PolicyServiceDeleteRuleRequestValidationError) ErrorName() string {
+	return "PolicyServiceDeleteRuleRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceSaveResourceValueRequestValidationError) Error() string {
+func (e PolicyServiceDeleteRuleRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4482,14 +4560,14 @@ func (e AttributeValueServiceSaveResourceValueRequestValidationError) Error() st
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceSaveResourceValueRequest.%s: %s%s",
+		"invalid %sPolicyServiceDeleteRuleRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceSaveResourceValueRequestValidationError{}
+var _ error = PolicyServiceDeleteRuleRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4497,49 +4575,44 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceSaveResourceValueRequestValidationError{}
+} = PolicyServiceDeleteRuleRequestValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceSaveResourceValueResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceSaveResourceValueResponse) Validate() error {
+// Validate checks the field values on PolicyServiceDeleteRuleResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServiceDeleteRuleResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceSaveResourceValueResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// AttributeValueServiceSaveResourceValueResponseMultiError, or nil if none found.
-func (m *AttributeValueServiceSaveResourceValueResponse) ValidateAll() error {
+// ValidateAll checks the field values on PolicyServiceDeleteRuleResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceDeleteRuleResponseMultiError, or nil if none found.
+func (m *PolicyServiceDeleteRuleResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceSaveResourceValueResponse) validate(all bool) error {
+func (m *PolicyServiceDeleteRuleResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return AttributeValueServiceSaveResourceValueResponseMultiError(errors)
+		return PolicyServiceDeleteRuleResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceSaveResourceValueResponseMultiError is an error
-// wrapping multiple validation errors returned by
-// AttributeValueServiceSaveResourceValueResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceSaveResourceValueResponseMultiError []error
+// PolicyServiceDeleteRuleResponseMultiError is an error wrapping multiple
+// validation errors returned by PolicyServiceDeleteRuleResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PolicyServiceDeleteRuleResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceSaveResourceValueResponseMultiError) Error() string {
+func (m PolicyServiceDeleteRuleResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4548,13 +4621,12 @@ func (m AttributeValueServiceSaveResourceValueResponseMultiError) Error() string
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceSaveResourceValueResponseMultiError) AllErrors() []error { return m }
+func (m PolicyServiceDeleteRuleResponseMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceSaveResourceValueResponseValidationError is the
-// validation error returned by
-// AttributeValueServiceSaveResourceValueResponse.Validate if the designated
+// PolicyServiceDeleteRuleResponseValidationError is the validation error
+// returned by PolicyServiceDeleteRuleResponse.Validate if the designated
 // constraints aren't met.
-type AttributeValueServiceSaveResourceValueResponseValidationError struct {
+type PolicyServiceDeleteRuleResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4562,26 +4634,24 @@ type AttributeValueServiceSaveResourceValueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceSaveResourceValueResponseValidationError) Field() string { return e.field }
+func (e PolicyServiceDeleteRuleResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceSaveResourceValueResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceDeleteRuleResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceSaveResourceValueResponseValidationError) Cause() error { return e.cause }
+func (e PolicyServiceDeleteRuleResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceSaveResourceValueResponseValidationError) Key() bool { return e.key }
+func (e PolicyServiceDeleteRuleResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceSaveResourceValueResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceSaveResourceValueResponseValidationError"
+func (e PolicyServiceDeleteRuleResponseValidationError) ErrorName() string {
+	return "PolicyServiceDeleteRuleResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceSaveResourceValueResponseValidationError) Error() string {
+func (e PolicyServiceDeleteRuleResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4593,14 +4663,14 @@ func (e AttributeValueServiceSaveResourceValueResponseValidationError) Error() s
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceSaveResourceValueResponse.%s: %s%s",
+		"invalid %sPolicyServiceDeleteRuleResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceSaveResourceValueResponseValidationError{}
+var _ error = PolicyServiceDeleteRuleResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4608,49 +4678,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceSaveResourceValueResponseValidationError{}
+} = PolicyServiceDeleteRuleResponseValidationError{}
 
 // Validate checks the field values on
-// AttributeValueServiceDeleteResourceValueRequest with the rules defined in
+// PolicyServiceFindPoliciesByPermissionIDsRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceDeleteResourceValueRequest) Validate() error {
+func (m *PolicyServiceFindPoliciesByPermissionIDsRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceDeleteResourceValueRequest with the rules defined in
+// PolicyServiceFindPoliciesByPermissionIDsRequest with the rules defined in
 // the proto definition for this message. If any rules are violated, the
 // result is a list of violation errors wrapped in
-// AttributeValueServiceDeleteResourceValueRequestMultiError, or nil if none found.
-func (m *AttributeValueServiceDeleteResourceValueRequest) ValidateAll() error {
+// PolicyServiceFindPoliciesByPermissionIDsRequestMultiError, or nil if none found.
+func (m *PolicyServiceFindPoliciesByPermissionIDsRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceDeleteResourceValueRequest) validate(all bool) error {
+func (m *PolicyServiceFindPoliciesByPermissionIDsRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return AttributeValueServiceDeleteResourceValueRequestMultiError(errors)
+		return PolicyServiceFindPoliciesByPermissionIDsRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceDeleteResourceValueRequestMultiError is an error
+// PolicyServiceFindPoliciesByPermissionIDsRequestMultiError is an error
 // wrapping multiple validation errors returned by
-// AttributeValueServiceDeleteResourceValueRequest.ValidateAll() if the
+// PolicyServiceFindPoliciesByPermissionIDsRequest.ValidateAll() if the
 // designated constraints aren't met.
-type AttributeValueServiceDeleteResourceValueRequestMultiError []error
+type PolicyServiceFindPoliciesByPermissionIDsRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceDeleteResourceValueRequestMultiError) Error() string {
+func (m PolicyServiceFindPoliciesByPermissionIDsRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4659,13 +4727,13 @@ func (m AttributeValueServiceDeleteResourceValueRequestMultiError) Error() strin
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceDeleteResourceValueRequestMultiError) AllErrors() []error { return m }
+func (m PolicyServiceFindPoliciesByPermissionIDsRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceDeleteResourceValueRequestValidationError is the
+// PolicyServiceFindPoliciesByPermissionIDsRequestValidationError is the
 // validation error returned by
-// AttributeValueServiceDeleteResourceValueRequest.Validate if the designated
+// PolicyServiceFindPoliciesByPermissionIDsRequest.Validate if the designated
 // constraints aren't met.
-type AttributeValueServiceDeleteResourceValueRequestValidationError struct {
+type PolicyServiceFindPoliciesByPermissionIDsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4673,28 +4741,28 @@ type AttributeValueServiceDeleteResourceValueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Field() string {
+func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Field() string {
 	return e.field
 }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Reason() string {
+func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Reason() string {
 	return e.reason
 }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Cause() error { return e.cause }
+func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Key() bool { return e.key }
+func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceDeleteResourceValueRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceDeleteResourceValueRequestValidationError"
+func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) ErrorName() string {
+	return "PolicyServiceFindPoliciesByPermissionIDsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Error() string {
+func (e PolicyServiceFindPoliciesByPermissionIDsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4706,14 +4774,14 @@ func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Error()
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceDeleteResourceValueRequest.%s: %s%s",
+		"invalid %sPolicyServiceFindPoliciesByPermissionIDsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceDeleteResourceValueRequestValidationError{}
+var _ error = PolicyServiceFindPoliciesByPermissionIDsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4721,47 +4789,81 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceDeleteResourceValueRequestValidationError{}
+} = PolicyServiceFindPoliciesByPermissionIDsRequestValidationError{}
 
 // Validate checks the field values on
-// AttributeValueServiceDeleteResourceValueResponse with the rules defined in
+// PolicyServiceFindPoliciesByPermissionIDsResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceDeleteResourceValueResponse) Validate() error {
+func (m *PolicyServiceFindPoliciesByPermissionIDsResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceDeleteResourceValueResponse with the rules defined in
+// PolicyServiceFindPoliciesByPermissionIDsResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the
 // result is a list of violation errors wrapped in
-// AttributeValueServiceDeleteResourceValueResponseMultiError, or nil if none found.
-func (m *AttributeValueServiceDeleteResourceValueResponse) ValidateAll() error {
+// PolicyServiceFindPoliciesByPermissionIDsResponseMultiError, or nil if none found.
+func (m *PolicyServiceFindPoliciesByPermissionIDsResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceDeleteResourceValueResponse) validate(all bool) error {
+func (m *PolicyServiceFindPoliciesByPermissionIDsResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceFindPoliciesByPermissionIDsResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceFindPoliciesByPermissionIDsResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceFindPoliciesByPermissionIDsResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return AttributeValueServiceDeleteResourceValueResponseMultiError(errors)
+		return PolicyServiceFindPoliciesByPermissionIDsResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceDeleteResourceValueResponseMultiError is an error
+// PolicyServiceFindPoliciesByPermissionIDsResponseMultiError is an error
 // wrapping multiple validation errors returned by
-// AttributeValueServiceDeleteResourceValueResponse.ValidateAll() if the
+// PolicyServiceFindPoliciesByPermissionIDsResponse.ValidateAll() if the
 // designated constraints aren't met.
-type AttributeValueServiceDeleteResourceValueResponseMultiError []error
+type PolicyServiceFindPoliciesByPermissionIDsResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceDeleteResourceValueResponseMultiError) Error() string {
+func (m PolicyServiceFindPoliciesByPermissionIDsResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4770,13 +4872,13 @@ func (m AttributeValueServiceDeleteResourceValueResponseMultiError) Error() stri
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceDeleteResourceValueResponseMultiError) AllErrors() []error { return m }
+func (m PolicyServiceFindPoliciesByPermissionIDsResponseMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceDeleteResourceValueResponseValidationError is the
+// PolicyServiceFindPoliciesByPermissionIDsResponseValidationError is the
 // validation error returned by
-// AttributeValueServiceDeleteResourceValueResponse.Validate if the designated
+// PolicyServiceFindPoliciesByPermissionIDsResponse.Validate if the designated
 // constraints aren't met.
-type AttributeValueServiceDeleteResourceValueResponseValidationError struct {
+type PolicyServiceFindPoliciesByPermissionIDsResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4784,30 +4886,30 @@ type AttributeValueServiceDeleteResourceValueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Field() string {
+func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Field() string {
 	return e.field
 }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Reason() string {
+func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Reason() string {
 	return e.reason
 }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Cause() error {
+func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Cause() error {
 	return e.cause
 }
 
 // Key function returns key value.
-func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Key() bool { return e.key }
+func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceDeleteResourceValueResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceDeleteResourceValueResponseValidationError"
+func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) ErrorName() string {
+	return "PolicyServiceFindPoliciesByPermissionIDsResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Error() string {
+func (e PolicyServiceFindPoliciesByPermissionIDsResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4819,14 +4921,14 @@ func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Error()
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceDeleteResourceValueResponse.%s: %s%s",
+		"invalid %sPolicyServiceFindPoliciesByPermissionIDsResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceDeleteResourceValueResponseValidationError{}
+var _ error = PolicyServiceFindPoliciesByPermissionIDsResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -4834,49 +4936,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceDeleteResourceValueResponseValidationError{}
+} = PolicyServiceFindPoliciesByPermissionIDsResponseValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceFindResourceValueRequest with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindResourceValueRequest) Validate() error {
+// Validate checks the field values on PolicyServiceSavePermissionPolicyRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *PolicyServiceSavePermissionPolicyRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceFindResourceValueRequest with the rules defined in the
+// PolicyServiceSavePermissionPolicyRequest with the rules defined in the
 // proto definition for this message. If any rules are violated, the result is
 // a list of violation errors wrapped in
-// AttributeValueServiceFindResourceValueRequestMultiError, or nil if none found.
-func (m *AttributeValueServiceFindResourceValueRequest) ValidateAll() error {
+// PolicyServiceSavePermissionPolicyRequestMultiError, or nil if none found.
+func (m *PolicyServiceSavePermissionPolicyRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindResourceValueRequest) validate(all bool) error {
+func (m *PolicyServiceSavePermissionPolicyRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ResourceId
+	// no validation rules for PolicyId
+
+	// no validation rules for PermissionId
+
+	// no validation rules for Effect
 
 	if len(errors) > 0 {
-		return AttributeValueServiceFindResourceValueRequestMultiError(errors)
+		return PolicyServiceSavePermissionPolicyRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindResourceValueRequestMultiError is an error wrapping
+// PolicyServiceSavePermissionPolicyRequestMultiError is an error wrapping
 // multiple validation errors returned by
-// AttributeValueServiceFindResourceValueRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceFindResourceValueRequestMultiError []error
+// PolicyServiceSavePermissionPolicyRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceSavePermissionPolicyRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindResourceValueRequestMultiError) Error() string {
+func (m PolicyServiceSavePermissionPolicyRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -4885,13 +4991,12 @@ func (m AttributeValueServiceFindResourceValueRequestMultiError) Error() string
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindResourceValueRequestMultiError) AllErrors() []error { return m }
+func (m PolicyServiceSavePermissionPolicyRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindResourceValueRequestValidationError is the
-// validation error returned by
-// AttributeValueServiceFindResourceValueRequest.Validate if the designated
-// constraints aren't met.
-type AttributeValueServiceFindResourceValueRequestValidationError struct {
+// PolicyServiceSavePermissionPolicyRequestValidationError is the validation
+// error returned by PolicyServiceSavePermissionPolicyRequest.Validate if the
+// designated constraints aren't met.
+type PolicyServiceSavePermissionPolicyRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -4899,26 +5004,24 @@ type AttributeValueServiceFindResourceValueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindResourceValueRequestValidationError) Field() string { return e.field }
+func (e PolicyServiceSavePermissionPolicyRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindResourceValueRequestValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceSavePermissionPolicyRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindResourceValueRequestValidationError) Cause() error { return e.cause }
+func (e PolicyServiceSavePermissionPolicyRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindResourceValueRequestValidationError) Key() bool { return e.key }
+func (e PolicyServiceSavePermissionPolicyRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindResourceValueRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceFindResourceValueRequestValidationError"
+func (e PolicyServiceSavePermissionPolicyRequestValidationError) ErrorName() string {
+	return "PolicyServiceSavePermissionPolicyRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindResourceValueRequestValidationError) Error() string {
+func (e PolicyServiceSavePermissionPolicyRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -4930,14 +5033,14 @@ func (e AttributeValueServiceFindResourceValueRequestValidationError) Error() st
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindResourceValueRequest.%s: %s%s",
+		"invalid %sPolicyServiceSavePermissionPolicyRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindResourceValueRequestValidationError{}
+var _ error = PolicyServiceSavePermissionPolicyRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -4945,76 +5048,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindResourceValueRequestValidationError{}
+} = PolicyServiceSavePermissionPolicyRequestValidationError{}
 
 // Validate checks the field values on
-// AttributeValueServiceFindResourceValueResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// PolicyServiceSavePermissionPolicyResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindResourceValueResponse) Validate() error {
+func (m *PolicyServiceSavePermissionPolicyResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceFindResourceValueResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// AttributeValueServiceFindResourceValueResponseMultiError, or nil if none found.
-func (m *AttributeValueServiceFindResourceValueResponse) ValidateAll() error {
+// PolicyServiceSavePermissionPolicyResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// PolicyServiceSavePermissionPolicyResponseMultiError, or nil if none found.
+func (m *PolicyServiceSavePermissionPolicyResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindResourceValueResponse) validate(all bool) error {
+func (m *PolicyServiceSavePermissionPolicyResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeValueServiceFindResourceValueResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeValueServiceFindResourceValueResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AttributeValueServiceFindResourceValueResponseValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
-
 	if len(errors) > 0 {
-		return AttributeValueServiceFindResourceValueResponseMultiError(errors)
+		return PolicyServiceSavePermissionPolicyResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindResourceValueResponseMultiError is an error
-// wrapping multiple validation errors returned by
-// AttributeValueServiceFindResourceValueResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceFindResourceValueResponseMultiError []error
+// PolicyServiceSavePermissionPolicyResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// PolicyServiceSavePermissionPolicyResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceSavePermissionPolicyResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindResourceValueResponseMultiError) Error() string {
+func (m PolicyServiceSavePermissionPolicyResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5023,13 +5097,12 @@ func (m AttributeValueServiceFindResourceValueResponseMultiError) Error() string
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindResourceValueResponseMultiError) AllErrors() []error { return m }
+func (m PolicyServiceSavePermissionPolicyResponseMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindResourceValueResponseValidationError is the
-// validation error returned by
-// AttributeValueServiceFindResourceValueResponse.Validate if the designated
-// constraints aren't met.
-type AttributeValueServiceFindResourceValueResponseValidationError struct {
+// PolicyServiceSavePermissionPolicyResponseValidationError is the validation
+// error returned by PolicyServiceSavePermissionPolicyResponse.Validate if the
+// designated constraints aren't met.
+type PolicyServiceSavePermissionPolicyResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5037,26 +5110,24 @@ type AttributeValueServiceFindResourceValueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindResourceValueResponseValidationError) Field() string { return e.field }
+func (e PolicyServiceSavePermissionPolicyResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindResourceValueResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e PolicyServiceSavePermissionPolicyResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindResourceValueResponseValidationError) Cause() error { return e.cause }
+func (e PolicyServiceSavePermissionPolicyResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindResourceValueResponseValidationError) Key() bool { return e.key }
+func (e PolicyServiceSavePermissionPolicyResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindResourceValueResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceFindResourceValueResponseValidationError"
+func (e PolicyServiceSavePermissionPolicyResponseValidationError) ErrorName() string {
+	return "PolicyServiceSavePermissionPolicyResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindResourceValueResponseValidationError) Error() string {
+func (e PolicyServiceSavePermissionPolicyResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5068,14 +5139,14 @@ func (e AttributeValueServiceFindResourceValueResponseValidationError) Error() s
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindResourceValueResponse.%s: %s%s",
+		"invalid %sPolicyServiceSavePermissionPolicyResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindResourceValueResponseValidationError{}
+var _ error = PolicyServiceSavePermissionPolicyResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5083,50 +5154,3211 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindResourceValueResponseValidationError{}
+} = PolicyServiceSavePermissionPolicyResponseValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceFindResourceValueWithDefinitionRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// Validate checks the field values on PolicyServiceFindPoliciesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceFindPoliciesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceFindPoliciesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceFindPoliciesRequestMultiError, or nil if none found.
+func (m *PolicyServiceFindPoliciesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceFindPoliciesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return PolicyServiceFindPoliciesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceFindPoliciesRequestMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceFindPoliciesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceFindPoliciesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceFindPoliciesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceFindPoliciesRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServiceFindPoliciesRequestValidationError is the validation error
+// returned by PolicyServiceFindPoliciesRequest.Validate if the designated
+// constraints aren't met.
+type PolicyServiceFindPoliciesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceFindPoliciesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceFindPoliciesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceFindPoliciesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceFindPoliciesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceFindPoliciesRequestValidationError) ErrorName() string {
+	return "PolicyServiceFindPoliciesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceFindPoliciesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceFindPoliciesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceFindPoliciesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceFindPoliciesRequestValidationError{}
+
+// Validate checks the field values on PolicyServiceFindPoliciesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the first error encountered is returned, or nil if there are
+// no violations.
+func (m *PolicyServiceFindPoliciesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceFindPoliciesResponse
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the result is a list of violation errors wrapped in
+// PolicyServiceFindPoliciesResponseMultiError, or nil if none found.
+func (m *PolicyServiceFindPoliciesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceFindPoliciesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceFindPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceFindPoliciesResponseValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceFindPoliciesResponseValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyServiceFindPoliciesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceFindPoliciesResponseMultiError is an error wrapping multiple
+// validation errors returned by
+// PolicyServiceFindPoliciesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyServiceFindPoliciesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceFindPoliciesResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceFindPoliciesResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServiceFindPoliciesResponseValidationError is the validation error
+// returned by PolicyServiceFindPoliciesResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceFindPoliciesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceFindPoliciesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceFindPoliciesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceFindPoliciesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceFindPoliciesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceFindPoliciesResponseValidationError) ErrorName() string {
+	return "PolicyServiceFindPoliciesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceFindPoliciesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceFindPoliciesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceFindPoliciesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceFindPoliciesResponseValidationError{}
+
+// Validate checks the field values on PolicyPermissionBinding with the rules
+// defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindResourceValueWithDefinitionRequest) Validate() error {
+func (m *PolicyPermissionBinding) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyPermissionBinding with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyPermissionBindingMultiError, or nil if none found.
+func (m *PolicyPermissionBinding) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyPermissionBinding) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PermissionId
+
+	// no validation rules for Effect
+
+	if len(errors) > 0 {
+		return PolicyPermissionBindingMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyPermissionBindingMultiError is an error wrapping multiple validation
+// errors returned by PolicyPermissionBinding.ValidateAll() if the designated
+// constraints aren't met.
+type PolicyPermissionBindingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyPermissionBindingMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyPermissionBindingMultiError) AllErrors() []error { return m }
+
+// PolicyPermissionBindingValidationError is the validation error returned by
+// PolicyPermissionBinding.Validate if the designated constraints aren't met.
+type PolicyPermissionBindingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyPermissionBindingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyPermissionBindingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyPermissionBindingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyPermissionBindingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyPermissionBindingValidationError) ErrorName() string {
+	return "PolicyPermissionBindingValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyPermissionBindingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyPermissionBinding.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyPermissionBindingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyPermissionBindingValidationError{}
+
+// Validate checks the field values on SimulationSample with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SimulationSample) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SimulationSample with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SimulationSampleMultiError, or nil if none found.
+func (m *SimulationSample) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SimulationSample) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceKey
+
+	// no validation rules for SubjectAttributes
+
+	// no validation rules for ResourceAttributes
+
+	// no validation rules for EnvironmentAttributes
+
+	if len(errors) > 0 {
+		return SimulationSampleMultiError(errors)
+	}
+
+	return nil
+}
+
+// SimulationSampleMultiError is an error wrapping multiple validation errors
+// returned by SimulationSample.ValidateAll() if the designated constraints
+// aren't met.
+type SimulationSampleMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SimulationSampleMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SimulationSampleMultiError) AllErrors() []error { return m }
+
+// SimulationSampleValidationError is the validation error returned by
+// SimulationSample.Validate if the designated constraints aren't met.
+type SimulationSampleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulationSampleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulationSampleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulationSampleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulationSampleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulationSampleValidationError) ErrorName() string { return "SimulationSampleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SimulationSampleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulationSample.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulationSampleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulationSampleValidationError{}
+
+// Validate checks the field values on SimulationResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SimulationResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SimulationResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SimulationResultMultiError, or nil if none found.
+func (m *SimulationResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SimulationResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Before
+
+	// no validation rules for After
+
+	// no validation rules for Changed
+
+	// no validation rules for Applicable
+
+	// no validation rules for Matched
+
+	if len(errors) > 0 {
+		return SimulationResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// SimulationResultMultiError is an error wrapping multiple validation errors
+// returned by SimulationResult.ValidateAll() if the designated constraints
+// aren't met.
+type SimulationResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SimulationResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SimulationResultMultiError) AllErrors() []error { return m }
+
+// SimulationResultValidationError is the validation error returned by
+// SimulationResult.Validate if the designated constraints aren't met.
+type SimulationResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulationResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulationResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulationResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulationResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulationResultValidationError) ErrorName() string { return "SimulationResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SimulationResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulationResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulationResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulationResultValidationError{}
+
+// Validate checks the field values on PolicyServiceSimulateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServiceSimulateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceSimulateRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PolicyServiceSimulateRequestMultiError, or nil if none found.
+func (m *PolicyServiceSimulateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceSimulateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPolicy()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PolicyServiceSimulateRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PolicyServiceSimulateRequestValidationError{
+					field:  "Policy",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPolicy()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PolicyServiceSimulateRequestValidationError{
+				field:  "Policy",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceSimulateRequestValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceSimulateRequestValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceSimulateRequestValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetSamples()) > 100 {
+		err := PolicyServiceSimulateRequestValidationError{
+			field:  "Samples",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSamples() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceSimulateRequestValidationError{
+						field:  fmt.Sprintf("Samples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceSimulateRequestValidationError{
+						field:  fmt.Sprintf("Samples[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceSimulateRequestValidationError{
+					field:  fmt.Sprintf("Samples[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyServiceSimulateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceSimulateRequestMultiError is an error wrapping multiple
+// validation errors returned by PolicyServiceSimulateRequest.ValidateAll() if
+// the designated constraints aren't met.
+type PolicyServiceSimulateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceSimulateRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceSimulateRequestMultiError) AllErrors() []error { return m }
+
+// PolicyServiceSimulateRequestValidationError is the validation error returned
+// by PolicyServiceSimulateRequest.Validate if the designated constraints
+// aren't met.
+type PolicyServiceSimulateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceSimulateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceSimulateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceSimulateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceSimulateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceSimulateRequestValidationError) ErrorName() string {
+	return "PolicyServiceSimulateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceSimulateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceSimulateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceSimulateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceSimulateRequestValidationError{}
+
+// Validate checks the field values on PolicyServiceSimulateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PolicyServiceSimulateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyServiceSimulateResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// PolicyServiceSimulateResponseMultiError, or nil if none found.
+func (m *PolicyServiceSimulateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyServiceSimulateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyServiceSimulateResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyServiceSimulateResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyServiceSimulateResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return PolicyServiceSimulateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyServiceSimulateResponseMultiError is an error wrapping multiple
+// validation errors returned by PolicyServiceSimulateResponse.ValidateAll()
+// if the designated constraints aren't met.
+type PolicyServiceSimulateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyServiceSimulateResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyServiceSimulateResponseMultiError) AllErrors() []error { return m }
+
+// PolicyServiceSimulateResponseValidationError is the validation error
+// returned by PolicyServiceSimulateResponse.Validate if the designated
+// constraints aren't met.
+type PolicyServiceSimulateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyServiceSimulateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyServiceSimulateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyServiceSimulateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyServiceSimulateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyServiceSimulateResponseValidationError) ErrorName() string {
+	return "PolicyServiceSimulateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PolicyServiceSimulateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyServiceSimulateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyServiceSimulateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyServiceSimulateResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceSaveSubjectValueRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceSaveSubjectValueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceSaveSubjectValueRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeValueServiceSaveSubjectValueRequestMultiError, or nil if none found.
+func (m *AttributeValueServiceSaveSubjectValueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceSaveSubjectValueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubjectId
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeValueServiceSaveSubjectValueRequestValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeValueServiceSaveSubjectValueRequestValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeValueServiceSaveSubjectValueRequestValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeValueServiceSaveSubjectValueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceSaveSubjectValueRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeValueServiceSaveSubjectValueRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceSaveSubjectValueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceSaveSubjectValueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceSaveSubjectValueRequestMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceSaveSubjectValueRequestValidationError is the
+// validation error returned by
+// AttributeValueServiceSaveSubjectValueRequest.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceSaveSubjectValueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceSaveSubjectValueRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceSaveSubjectValueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceSaveSubjectValueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceSaveSubjectValueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceSaveSubjectValueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceSaveSubjectValueRequestValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceSaveSubjectValueResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceSaveSubjectValueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceSaveSubjectValueResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeValueServiceSaveSubjectValueResponseMultiError, or nil if none found.
+func (m *AttributeValueServiceSaveSubjectValueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceSaveSubjectValueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AttributeValueServiceSaveSubjectValueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceSaveSubjectValueResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeValueServiceSaveSubjectValueResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceSaveSubjectValueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceSaveSubjectValueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceSaveSubjectValueResponseMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceSaveSubjectValueResponseValidationError is the
+// validation error returned by
+// AttributeValueServiceSaveSubjectValueResponse.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceSaveSubjectValueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceSaveSubjectValueResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceSaveSubjectValueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceSaveSubjectValueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceSaveSubjectValueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceSaveSubjectValueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceSaveSubjectValueResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceDeleteSubjectValueRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceDeleteSubjectValueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceDeleteSubjectValueRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceDeleteSubjectValueRequestMultiError, or nil if none found.
+func (m *AttributeValueServiceDeleteSubjectValueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceDeleteSubjectValueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AttributeValueServiceDeleteSubjectValueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceDeleteSubjectValueRequestMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceDeleteSubjectValueRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceDeleteSubjectValueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceDeleteSubjectValueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceDeleteSubjectValueRequestMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceDeleteSubjectValueRequestValidationError is the
+// validation error returned by
+// AttributeValueServiceDeleteSubjectValueRequest.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceDeleteSubjectValueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceDeleteSubjectValueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceDeleteSubjectValueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceDeleteSubjectValueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceDeleteSubjectValueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceDeleteSubjectValueRequestValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceDeleteSubjectValueResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceDeleteSubjectValueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceDeleteSubjectValueResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceDeleteSubjectValueResponseMultiError, or nil if none found.
+func (m *AttributeValueServiceDeleteSubjectValueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceDeleteSubjectValueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AttributeValueServiceDeleteSubjectValueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceDeleteSubjectValueResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceDeleteSubjectValueResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceDeleteSubjectValueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceDeleteSubjectValueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceDeleteSubjectValueResponseMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceDeleteSubjectValueResponseValidationError is the
+// validation error returned by
+// AttributeValueServiceDeleteSubjectValueResponse.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceDeleteSubjectValueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceDeleteSubjectValueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceDeleteSubjectValueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceDeleteSubjectValueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceDeleteSubjectValueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceDeleteSubjectValueResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceFindSubjectValueRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindSubjectValueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceFindSubjectValueRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeValueServiceFindSubjectValueRequestMultiError, or nil if none found.
+func (m *AttributeValueServiceFindSubjectValueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceFindSubjectValueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubjectId
+
+	if len(errors) > 0 {
+		return AttributeValueServiceFindSubjectValueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceFindSubjectValueRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeValueServiceFindSubjectValueRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceFindSubjectValueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceFindSubjectValueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceFindSubjectValueRequestMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceFindSubjectValueRequestValidationError is the
+// validation error returned by
+// AttributeValueServiceFindSubjectValueRequest.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceFindSubjectValueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceFindSubjectValueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValueServiceFindSubjectValueRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeValueServiceFindSubjectValueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceFindSubjectValueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceFindSubjectValueRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceFindSubjectValueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceFindSubjectValueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceFindSubjectValueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceFindSubjectValueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceFindSubjectValueRequestValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceFindSubjectValueResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindSubjectValueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceFindSubjectValueResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeValueServiceFindSubjectValueResponseMultiError, or nil if none found.
+func (m *AttributeValueServiceFindSubjectValueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceFindSubjectValueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeValueServiceFindSubjectValueResponseValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeValueServiceFindSubjectValueResponseValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeValueServiceFindSubjectValueResponseValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeValueServiceFindSubjectValueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceFindSubjectValueResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeValueServiceFindSubjectValueResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceFindSubjectValueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceFindSubjectValueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceFindSubjectValueResponseMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceFindSubjectValueResponseValidationError is the
+// validation error returned by
+// AttributeValueServiceFindSubjectValueResponse.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceFindSubjectValueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceFindSubjectValueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValueServiceFindSubjectValueResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceFindSubjectValueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceFindSubjectValueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceFindSubjectValueResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceFindSubjectValueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceFindSubjectValueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceFindSubjectValueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceFindSubjectValueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceFindSubjectValueResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceFindSubjectValueWithDefinitionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindSubjectValueWithDefinitionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceFindSubjectValueWithDefinitionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError, or
+// nil if none found.
+func (m *AttributeValueServiceFindSubjectValueWithDefinitionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceFindSubjectValueWithDefinitionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for SubjectId
+
+	if len(errors) > 0 {
+		return AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError is an
+// error wrapping multiple validation errors returned by
+// AttributeValueServiceFindSubjectValueWithDefinitionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceFindSubjectValueWithDefinitionRequestMultiError) AllErrors() []error {
+	return m
+}
+
+// AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError is
+// the validation error returned by
+// AttributeValueServiceFindSubjectValueWithDefinitionRequest.Validate if the
+// designated constraints aren't met.
+type AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) Key() bool {
+	return e.key
+}
+
+// ErrorName returns error name.
+func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceFindSubjectValueWithDefinitionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceFindSubjectValueWithDefinitionRequestValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceFindSubjectValueWithDefinitionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindSubjectValueWithDefinitionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceFindSubjectValueWithDefinitionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError, or
+// nil if none found.
+func (m *AttributeValueServiceFindSubjectValueWithDefinitionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceFindSubjectValueWithDefinitionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetSubject()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError{
+					field:  "Subject",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSubject()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError{
+				field:  "Subject",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError is an
+// error wrapping multiple validation errors returned by
+// AttributeValueServiceFindSubjectValueWithDefinitionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceFindSubjectValueWithDefinitionResponseMultiError) AllErrors() []error {
+	return m
+}
+
+// AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError
+// is the validation error returned by
+// AttributeValueServiceFindSubjectValueWithDefinitionResponse.Validate if the
+// designated constraints aren't met.
+type AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) Key() bool {
+	return e.key
+}
+
+// ErrorName returns error name.
+func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceFindSubjectValueWithDefinitionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceFindSubjectValueWithDefinitionResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceSaveResourceValueRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceSaveResourceValueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceSaveResourceValueRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeValueServiceSaveResourceValueRequestMultiError, or nil if none found.
+func (m *AttributeValueServiceSaveResourceValueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceSaveResourceValueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceId
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeValueServiceSaveResourceValueRequestValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeValueServiceSaveResourceValueRequestValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeValueServiceSaveResourceValueRequestValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeValueServiceSaveResourceValueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceSaveResourceValueRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeValueServiceSaveResourceValueRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceSaveResourceValueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceSaveResourceValueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceSaveResourceValueRequestMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceSaveResourceValueRequestValidationError is the
+// validation error returned by
+// AttributeValueServiceSaveResourceValueRequest.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceSaveResourceValueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceSaveResourceValueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValueServiceSaveResourceValueRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceSaveResourceValueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceSaveResourceValueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceSaveResourceValueRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceSaveResourceValueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceSaveResourceValueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceSaveResourceValueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceSaveResourceValueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceSaveResourceValueRequestValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceSaveResourceValueResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceSaveResourceValueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceSaveResourceValueResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceSaveResourceValueResponseMultiError, or nil if none found.
+func (m *AttributeValueServiceSaveResourceValueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceSaveResourceValueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AttributeValueServiceSaveResourceValueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceSaveResourceValueResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceSaveResourceValueResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceSaveResourceValueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceSaveResourceValueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceSaveResourceValueResponseMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceSaveResourceValueResponseValidationError is the
+// validation error returned by
+// AttributeValueServiceSaveResourceValueResponse.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceSaveResourceValueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceSaveResourceValueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValueServiceSaveResourceValueResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceSaveResourceValueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceSaveResourceValueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceSaveResourceValueResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceSaveResourceValueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceSaveResourceValueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceSaveResourceValueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceSaveResourceValueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceSaveResourceValueResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceDeleteResourceValueRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceDeleteResourceValueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceDeleteResourceValueRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceDeleteResourceValueRequestMultiError, or nil if none found.
+func (m *AttributeValueServiceDeleteResourceValueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceDeleteResourceValueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AttributeValueServiceDeleteResourceValueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceDeleteResourceValueRequestMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceDeleteResourceValueRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceDeleteResourceValueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceDeleteResourceValueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceDeleteResourceValueRequestMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceDeleteResourceValueRequestValidationError is the
+// validation error returned by
+// AttributeValueServiceDeleteResourceValueRequest.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceDeleteResourceValueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceDeleteResourceValueRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceDeleteResourceValueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceDeleteResourceValueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceDeleteResourceValueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceDeleteResourceValueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceDeleteResourceValueRequestValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceDeleteResourceValueResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceDeleteResourceValueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceDeleteResourceValueResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceDeleteResourceValueResponseMultiError, or nil if none found.
+func (m *AttributeValueServiceDeleteResourceValueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceDeleteResourceValueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return AttributeValueServiceDeleteResourceValueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceDeleteResourceValueResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceDeleteResourceValueResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceDeleteResourceValueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceDeleteResourceValueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceDeleteResourceValueResponseMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceDeleteResourceValueResponseValidationError is the
+// validation error returned by
+// AttributeValueServiceDeleteResourceValueResponse.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceDeleteResourceValueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceDeleteResourceValueResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceDeleteResourceValueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceDeleteResourceValueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceDeleteResourceValueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceDeleteResourceValueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceDeleteResourceValueResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceFindResourceValueRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindResourceValueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceFindResourceValueRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeValueServiceFindResourceValueRequestMultiError, or nil if none found.
+func (m *AttributeValueServiceFindResourceValueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceFindResourceValueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceId
+
+	if len(errors) > 0 {
+		return AttributeValueServiceFindResourceValueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceFindResourceValueRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeValueServiceFindResourceValueRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceFindResourceValueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceFindResourceValueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceFindResourceValueRequestMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceFindResourceValueRequestValidationError is the
+// validation error returned by
+// AttributeValueServiceFindResourceValueRequest.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceFindResourceValueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceFindResourceValueRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValueServiceFindResourceValueRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceFindResourceValueRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceFindResourceValueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceFindResourceValueRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceFindResourceValueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceFindResourceValueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceFindResourceValueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceFindResourceValueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceFindResourceValueRequestValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceFindResourceValueResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindResourceValueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceFindResourceValueResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceFindResourceValueResponseMultiError, or nil if none found.
+func (m *AttributeValueServiceFindResourceValueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceFindResourceValueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeValueServiceFindResourceValueResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeValueServiceFindResourceValueResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeValueServiceFindResourceValueResponseValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeValueServiceFindResourceValueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceFindResourceValueResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceFindResourceValueResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceFindResourceValueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceFindResourceValueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceFindResourceValueResponseMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceFindResourceValueResponseValidationError is the
+// validation error returned by
+// AttributeValueServiceFindResourceValueResponse.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceFindResourceValueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceFindResourceValueResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeValueServiceFindResourceValueResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceFindResourceValueResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeValueServiceFindResourceValueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceFindResourceValueResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceFindResourceValueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceFindResourceValueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceFindResourceValueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceFindResourceValueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceFindResourceValueResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceFindResourceValueWithDefinitionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindResourceValueWithDefinitionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceFindResourceValueWithDefinitionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError, or
+// nil if none found.
+func (m *AttributeValueServiceFindResourceValueWithDefinitionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceFindResourceValueWithDefinitionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceId
+
+	if len(errors) > 0 {
+		return AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError is an
+// error wrapping multiple validation errors returned by
+// AttributeValueServiceFindResourceValueWithDefinitionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError) AllErrors() []error {
+	return m
+}
+
+// AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError
+// is the validation error returned by
+// AttributeValueServiceFindResourceValueWithDefinitionRequest.Validate if the
+// designated constraints aren't met.
+type AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) Key() bool {
+	return e.key
+}
+
+// ErrorName returns error name.
+func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceFindResourceValueWithDefinitionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceFindResourceValueWithDefinitionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindResourceValueWithDefinitionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceFindResourceValueWithDefinitionResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError, or
+// nil if none found.
+func (m *AttributeValueServiceFindResourceValueWithDefinitionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceFindResourceValueWithDefinitionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetResource()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError{
+					field:  "Resource",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError{
+				field:  "Resource",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError is an
+// error wrapping multiple validation errors returned by
+// AttributeValueServiceFindResourceValueWithDefinitionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError) AllErrors() []error {
+	return m
+}
+
+// AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError
+// is the validation error returned by
+// AttributeValueServiceFindResourceValueWithDefinitionResponse.Validate if
+// the designated constraints aren't met.
+type AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) Key() bool {
+	return e.key
+}
+
+// ErrorName returns error name.
+func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceFindResourceValueWithDefinitionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceSaveEnvironmentValueRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceSaveEnvironmentValueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceSaveEnvironmentValueRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceSaveEnvironmentValueRequestMultiError, or nil if none found.
+func (m *AttributeValueServiceSaveEnvironmentValueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceSaveEnvironmentValueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetValue()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeValueServiceSaveEnvironmentValueRequestValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeValueServiceSaveEnvironmentValueRequestValidationError{
+					field:  "Value",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeValueServiceSaveEnvironmentValueRequestValidationError{
+				field:  "Value",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return AttributeValueServiceSaveEnvironmentValueRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceSaveEnvironmentValueRequestMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceSaveEnvironmentValueRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceSaveEnvironmentValueRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceSaveEnvironmentValueRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceSaveEnvironmentValueRequestMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceSaveEnvironmentValueRequestValidationError is the
+// validation error returned by
+// AttributeValueServiceSaveEnvironmentValueRequest.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceSaveEnvironmentValueRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceSaveEnvironmentValueRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceSaveEnvironmentValueRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceSaveEnvironmentValueRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceSaveEnvironmentValueRequestValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceSaveEnvironmentValueResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceSaveEnvironmentValueResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceSaveEnvironmentValueResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceSaveEnvironmentValueResponseMultiError, or nil if none found.
+func (m *AttributeValueServiceSaveEnvironmentValueResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceSaveEnvironmentValueResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if len(errors) > 0 {
+		return AttributeValueServiceSaveEnvironmentValueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceSaveEnvironmentValueResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceSaveEnvironmentValueResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceSaveEnvironmentValueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceSaveEnvironmentValueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceSaveEnvironmentValueResponseMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceSaveEnvironmentValueResponseValidationError is the
+// validation error returned by
+// AttributeValueServiceSaveEnvironmentValueResponse.Validate if the
+// designated constraints aren't met.
+type AttributeValueServiceSaveEnvironmentValueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceSaveEnvironmentValueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceSaveEnvironmentValueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceSaveEnvironmentValueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceSaveEnvironmentValueResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceDeleteEnvironmentValueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceDeleteEnvironmentValueRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceFindResourceValueWithDefinitionRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError, or
-// nil if none found.
-func (m *AttributeValueServiceFindResourceValueWithDefinitionRequest) ValidateAll() error {
+// AttributeValueServiceDeleteEnvironmentValueRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceDeleteEnvironmentValueRequestMultiError, or nil if
+// none found.
+func (m *AttributeValueServiceDeleteEnvironmentValueRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindResourceValueWithDefinitionRequest) validate(all bool) error {
+func (m *AttributeValueServiceDeleteEnvironmentValueRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for ResourceId
+	// no validation rules for Id
 
 	if len(errors) > 0 {
-		return AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError(errors)
+		return AttributeValueServiceDeleteEnvironmentValueRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError is an
-// error wrapping multiple validation errors returned by
-// AttributeValueServiceFindResourceValueWithDefinitionRequest.ValidateAll()
-// if the designated constraints aren't met.
-type AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError []error
+// AttributeValueServiceDeleteEnvironmentValueRequestMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceDeleteEnvironmentValueRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceDeleteEnvironmentValueRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError) Error() string {
+func (m AttributeValueServiceDeleteEnvironmentValueRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5135,15 +8367,13 @@ func (m AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError) E
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindResourceValueWithDefinitionRequestMultiError) AllErrors() []error {
-	return m
-}
+func (m AttributeValueServiceDeleteEnvironmentValueRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError
-// is the validation error returned by
-// AttributeValueServiceFindResourceValueWithDefinitionRequest.Validate if the
+// AttributeValueServiceDeleteEnvironmentValueRequestValidationError is the
+// validation error returned by
+// AttributeValueServiceDeleteEnvironmentValueRequest.Validate if the
 // designated constraints aren't met.
-type AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError struct {
+type AttributeValueServiceDeleteEnvironmentValueRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5151,32 +8381,30 @@ type AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) Field() string {
+func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Field() string {
 	return e.field
 }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) Reason() string {
+func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Reason() string {
 	return e.reason
 }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) Cause() error {
+func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Cause() error {
 	return e.cause
 }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) Key() bool {
-	return e.key
-}
+func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError"
+func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceDeleteEnvironmentValueRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError) Error() string {
+func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5188,14 +8416,14 @@ func (e AttributeValueServiceFindResourceValueWithDefinitionRequestValidationErr
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindResourceValueWithDefinitionRequest.%s: %s%s",
+		"invalid %sAttributeValueServiceDeleteEnvironmentValueRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError{}
+var _ error = AttributeValueServiceDeleteEnvironmentValueRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5203,77 +8431,161 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindResourceValueWithDefinitionRequestValidationError{}
+} = AttributeValueServiceDeleteEnvironmentValueRequestValidationError{}
 
 // Validate checks the field values on
-// AttributeValueServiceFindResourceValueWithDefinitionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindResourceValueWithDefinitionResponse) Validate() error {
+// AttributeValueServiceDeleteEnvironmentValueResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceDeleteEnvironmentValueResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceFindResourceValueWithDefinitionResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError, or
-// nil if none found.
-func (m *AttributeValueServiceFindResourceValueWithDefinitionResponse) ValidateAll() error {
+// AttributeValueServiceDeleteEnvironmentValueResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceDeleteEnvironmentValueResponseMultiError, or nil if
+// none found.
+func (m *AttributeValueServiceDeleteEnvironmentValueResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindResourceValueWithDefinitionResponse) validate(all bool) error {
+func (m *AttributeValueServiceDeleteEnvironmentValueResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetResource()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError{
-					field:  "Resource",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetResource()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError{
-				field:  "Resource",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+	if len(errors) > 0 {
+		return AttributeValueServiceDeleteEnvironmentValueResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeValueServiceDeleteEnvironmentValueResponseMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceDeleteEnvironmentValueResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceDeleteEnvironmentValueResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeValueServiceDeleteEnvironmentValueResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeValueServiceDeleteEnvironmentValueResponseMultiError) AllErrors() []error { return m }
+
+// AttributeValueServiceDeleteEnvironmentValueResponseValidationError is the
+// validation error returned by
+// AttributeValueServiceDeleteEnvironmentValueResponse.Validate if the
+// designated constraints aren't met.
+type AttributeValueServiceDeleteEnvironmentValueResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Cause() error {
+	return e.cause
+}
+
+// Key function returns key value.
+func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceDeleteEnvironmentValueResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeValueServiceDeleteEnvironmentValueResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeValueServiceDeleteEnvironmentValueResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeValueServiceDeleteEnvironmentValueResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeValueServiceFindEnvironmentValueRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindEnvironmentValueRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeValueServiceFindEnvironmentValueRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in
+// AttributeValueServiceFindEnvironmentValueRequestMultiError, or nil if none found.
+func (m *AttributeValueServiceFindEnvironmentValueRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeValueServiceFindEnvironmentValueRequest) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
+	var errors []error
+
 	if len(errors) > 0 {
-		return AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError(errors)
+		return AttributeValueServiceFindEnvironmentValueRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError is an
-// error wrapping multiple validation errors returned by
-// AttributeValueServiceFindResourceValueWithDefinitionResponse.ValidateAll()
-// if the designated constraints aren't met.
-type AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError []error
+// AttributeValueServiceFindEnvironmentValueRequestMultiError is an error
+// wrapping multiple validation errors returned by
+// AttributeValueServiceFindEnvironmentValueRequest.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeValueServiceFindEnvironmentValueRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError) Error() string {
+func (m AttributeValueServiceFindEnvironmentValueRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5282,15 +8594,13 @@ func (m AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError)
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindResourceValueWithDefinitionResponseMultiError) AllErrors() []error {
-	return m
-}
+func (m AttributeValueServiceFindEnvironmentValueRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError
-// is the validation error returned by
-// AttributeValueServiceFindResourceValueWithDefinitionResponse.Validate if
-// the designated constraints aren't met.
-type AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError struct {
+// AttributeValueServiceFindEnvironmentValueRequestValidationError is the
+// validation error returned by
+// AttributeValueServiceFindEnvironmentValueRequest.Validate if the designated
+// constraints aren't met.
+type AttributeValueServiceFindEnvironmentValueRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5298,32 +8608,30 @@ type AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) Field() string {
+func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Field() string {
 	return e.field
 }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) Reason() string {
+func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Reason() string {
 	return e.reason
 }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) Cause() error {
+func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Cause() error {
 	return e.cause
 }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) Key() bool {
-	return e.key
-}
+func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError"
+func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceFindEnvironmentValueRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError) Error() string {
+func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5335,14 +8643,14 @@ func (e AttributeValueServiceFindResourceValueWithDefinitionResponseValidationEr
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindResourceValueWithDefinitionResponse.%s: %s%s",
+		"invalid %sAttributeValueServiceFindEnvironmentValueRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError{}
+var _ error = AttributeValueServiceFindEnvironmentValueRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5350,26 +8658,26 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindResourceValueWithDefinitionResponseValidationError{}
+} = AttributeValueServiceFindEnvironmentValueRequestValidationError{}
 
 // Validate checks the field values on
-// AttributeValueServiceSaveEnvironmentValueRequest with the rules defined in
+// AttributeValueServiceFindEnvironmentValueResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceSaveEnvironmentValueRequest) Validate() error {
+func (m *AttributeValueServiceFindEnvironmentValueResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceSaveEnvironmentValueRequest with the rules defined in
+// AttributeValueServiceFindEnvironmentValueResponse with the rules defined in
 // the proto definition for this message. If any rules are violated, the
 // result is a list of violation errors wrapped in
-// AttributeValueServiceSaveEnvironmentValueRequestMultiError, or nil if none found.
-func (m *AttributeValueServiceSaveEnvironmentValueRequest) ValidateAll() error {
+// AttributeValueServiceFindEnvironmentValueResponseMultiError, or nil if none found.
+func (m *AttributeValueServiceFindEnvironmentValueResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceSaveEnvironmentValueRequest) validate(all bool) error {
+func (m *AttributeValueServiceFindEnvironmentValueResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
@@ -5377,28 +8685,28 @@ func (m *AttributeValueServiceSaveEnvironmentValueRequest) validate(all bool) er
 	var errors []error
 
 	if all {
-		switch v := interface{}(m.GetValue()).(type) {
+		switch v := interface{}(m.GetEnvironment()).(type) {
 		case interface{ ValidateAll() error }:
 			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeValueServiceSaveEnvironmentValueRequestValidationError{
-					field:  "Value",
+				errors = append(errors, AttributeValueServiceFindEnvironmentValueResponseValidationError{
+					field:  "Environment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeValueServiceSaveEnvironmentValueRequestValidationError{
-					field:  "Value",
+				errors = append(errors, AttributeValueServiceFindEnvironmentValueResponseValidationError{
+					field:  "Environment",
 					reason: "embedded message failed validation",
 					cause:  err,
 				})
 			}
 		}
-	} else if v, ok := interface{}(m.GetValue()).(interface{ Validate() error }); ok {
+	} else if v, ok := interface{}(m.GetEnvironment()).(interface{ Validate() error }); ok {
 		if err := v.Validate(); err != nil {
-			return AttributeValueServiceSaveEnvironmentValueRequestValidationError{
-				field:  "Value",
+			return AttributeValueServiceFindEnvironmentValueResponseValidationError{
+				field:  "Environment",
 				reason: "embedded message failed validation",
 				cause:  err,
 			}
@@ -5406,20 +8714,20 @@ func (m *AttributeValueServiceSaveEnvironmentValueRequest) validate(all bool) er
 	}
 
 	if len(errors) > 0 {
-		return AttributeValueServiceSaveEnvironmentValueRequestMultiError(errors)
+		return AttributeValueServiceFindEnvironmentValueResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceSaveEnvironmentValueRequestMultiError is an error
+// AttributeValueServiceFindEnvironmentValueResponseMultiError is an error
 // wrapping multiple validation errors returned by
-// AttributeValueServiceSaveEnvironmentValueRequest.ValidateAll() if the
+// AttributeValueServiceFindEnvironmentValueResponse.ValidateAll() if the
 // designated constraints aren't met.
-type AttributeValueServiceSaveEnvironmentValueRequestMultiError []error
+type AttributeValueServiceFindEnvironmentValueResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceSaveEnvironmentValueRequestMultiError) Error() string {
+func (m AttributeValueServiceFindEnvironmentValueResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5428,13 +8736,13 @@ func (m AttributeValueServiceSaveEnvironmentValueRequestMultiError) Error() stri
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceSaveEnvironmentValueRequestMultiError) AllErrors() []error { return m }
+func (m AttributeValueServiceFindEnvironmentValueResponseMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceSaveEnvironmentValueRequestValidationError is the
+// AttributeValueServiceFindEnvironmentValueResponseValidationError is the
 // validation error returned by
-// AttributeValueServiceSaveEnvironmentValueRequest.Validate if the designated
-// constraints aren't met.
-type AttributeValueServiceSaveEnvironmentValueRequestValidationError struct {
+// AttributeValueServiceFindEnvironmentValueResponse.Validate if the
+// designated constraints aren't met.
+type AttributeValueServiceFindEnvironmentValueResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5442,30 +8750,30 @@ type AttributeValueServiceSaveEnvironmentValueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Field() string {
+func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Field() string {
 	return e.field
 }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Reason() string {
+func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Reason() string {
 	return e.reason
 }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Cause() error {
+func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Cause() error {
 	return e.cause
 }
 
 // Key function returns key value.
-func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Key() bool { return e.key }
+func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceSaveEnvironmentValueRequestValidationError"
+func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceFindEnvironmentValueResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Error() string {
+func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5477,14 +8785,14 @@ func (e AttributeValueServiceSaveEnvironmentValueRequestValidationError) Error()
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceSaveEnvironmentValueRequest.%s: %s%s",
+		"invalid %sAttributeValueServiceFindEnvironmentValueResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceSaveEnvironmentValueRequestValidationError{}
+var _ error = AttributeValueServiceFindEnvironmentValueResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5492,49 +8800,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceSaveEnvironmentValueRequestValidationError{}
+} = AttributeValueServiceFindEnvironmentValueResponseValidationError{}
 
 // Validate checks the field values on
-// AttributeValueServiceSaveEnvironmentValueResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceSaveEnvironmentValueResponse) Validate() error {
+// AttributeValueServiceFindEnvironmentValueWithDefinitionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceSaveEnvironmentValueResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// AttributeValueServiceSaveEnvironmentValueResponseMultiError, or nil if none found.
-func (m *AttributeValueServiceSaveEnvironmentValueResponse) ValidateAll() error {
+// AttributeValueServiceFindEnvironmentValueWithDefinitionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError,
+// or nil if none found.
+func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceSaveEnvironmentValueResponse) validate(all bool) error {
+func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
-
 	if len(errors) > 0 {
-		return AttributeValueServiceSaveEnvironmentValueResponseMultiError(errors)
+		return AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceSaveEnvironmentValueResponseMultiError is an error
-// wrapping multiple validation errors returned by
-// AttributeValueServiceSaveEnvironmentValueResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceSaveEnvironmentValueResponseMultiError []error
+// AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError is
+// an error wrapping multiple validation errors returned by
+// AttributeValueServiceFindEnvironmentValueWithDefinitionRequest.ValidateAll()
+// if the designated constraints aren't met.
+type AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceSaveEnvironmentValueResponseMultiError) Error() string {
+func (m AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5543,13 +8850,15 @@ func (m AttributeValueServiceSaveEnvironmentValueResponseMultiError) Error() str
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceSaveEnvironmentValueResponseMultiError) AllErrors() []error { return m }
+func (m AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError) AllErrors() []error {
+	return m
+}
 
-// AttributeValueServiceSaveEnvironmentValueResponseValidationError is the
-// validation error returned by
-// AttributeValueServiceSaveEnvironmentValueResponse.Validate if the
-// designated constraints aren't met.
-type AttributeValueServiceSaveEnvironmentValueResponseValidationError struct {
+// AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError
+// is the validation error returned by
+// AttributeValueServiceFindEnvironmentValueWithDefinitionRequest.Validate if
+// the designated constraints aren't met.
+type AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5557,30 +8866,32 @@ type AttributeValueServiceSaveEnvironmentValueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Field() string {
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) Field() string {
 	return e.field
 }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Reason() string {
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) Reason() string {
 	return e.reason
 }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Cause() error {
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) Cause() error {
 	return e.cause
 }
 
 // Key function returns key value.
-func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Key() bool { return e.key }
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) Key() bool {
+	return e.key
+}
 
 // ErrorName returns error name.
-func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceSaveEnvironmentValueResponseValidationError"
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Error() string {
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5592,14 +8903,14 @@ func (e AttributeValueServiceSaveEnvironmentValueResponseValidationError) Error(
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceSaveEnvironmentValueResponse.%s: %s%s",
+		"invalid %sAttributeValueServiceFindEnvironmentValueWithDefinitionRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceSaveEnvironmentValueResponseValidationError{}
+var _ error = AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5607,50 +8918,77 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceSaveEnvironmentValueResponseValidationError{}
+} = AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError{}
 
 // Validate checks the field values on
-// AttributeValueServiceDeleteEnvironmentValueRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceDeleteEnvironmentValueRequest) Validate() error {
+// AttributeValueServiceFindEnvironmentValueWithDefinitionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceDeleteEnvironmentValueRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// AttributeValueServiceDeleteEnvironmentValueRequestMultiError, or nil if
-// none found.
-func (m *AttributeValueServiceDeleteEnvironmentValueRequest) ValidateAll() error {
+// AttributeValueServiceFindEnvironmentValueWithDefinitionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError,
+// or nil if none found.
+func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceDeleteEnvironmentValueRequest) validate(all bool) error {
+func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	if all {
+		switch v := interface{}(m.GetEnvironment()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError{
+					field:  "Environment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError{
+					field:  "Environment",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEnvironment()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError{
+				field:  "Environment",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
 
 	if len(errors) > 0 {
-		return AttributeValueServiceDeleteEnvironmentValueRequestMultiError(errors)
+		return AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceDeleteEnvironmentValueRequestMultiError is an error
-// wrapping multiple validation errors returned by
-// AttributeValueServiceDeleteEnvironmentValueRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceDeleteEnvironmentValueRequestMultiError []error
+// AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError is
+// an error wrapping multiple validation errors returned by
+// AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.ValidateAll()
+// if the designated constraints aren't met.
+type AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceDeleteEnvironmentValueRequestMultiError) Error() string {
+func (m AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5659,13 +8997,15 @@ func (m AttributeValueServiceDeleteEnvironmentValueRequestMultiError) Error() st
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceDeleteEnvironmentValueRequestMultiError) AllErrors() []error { return m }
+func (m AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError) AllErrors() []error {
+	return m
+}
 
-// AttributeValueServiceDeleteEnvironmentValueRequestValidationError is the
-// validation error returned by
-// AttributeValueServiceDeleteEnvironmentValueRequest.Validate if the
-// designated constraints aren't met.
-type AttributeValueServiceDeleteEnvironmentValueRequestValidationError struct {
+// AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError
+// is the validation error returned by
+// AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.Validate if
+// the designated constraints aren't met.
+type AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5673,30 +9013,32 @@ type AttributeValueServiceDeleteEnvironmentValueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Field() string {
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) Field() string {
 	return e.field
 }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Reason() string {
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) Reason() string {
 	return e.reason
 }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Cause() error {
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) Cause() error {
 	return e.cause
 }
 
 // Key function returns key value.
-func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Key() bool { return e.key }
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) Key() bool {
+	return e.key
+}
 
 // ErrorName returns error name.
-func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceDeleteEnvironmentValueRequestValidationError"
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Error() string {
+func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5708,14 +9050,14 @@ func (e AttributeValueServiceDeleteEnvironmentValueRequestValidationError) Error
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceDeleteEnvironmentValueRequest.%s: %s%s",
+		"invalid %sAttributeValueServiceFindEnvironmentValueWithDefinitionResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceDeleteEnvironmentValueRequestValidationError{}
+var _ error = AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5723,48 +9065,53 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceDeleteEnvironmentValueRequestValidationError{}
+} = AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceDeleteEnvironmentValueResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceDeleteEnvironmentValueResponse) Validate() error {
+// Validate checks the field values on AttributeValueServiceImportValuesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AttributeValueServiceImportValuesRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceDeleteEnvironmentValueResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// AttributeValueServiceDeleteEnvironmentValueResponseMultiError, or nil if
-// none found.
-func (m *AttributeValueServiceDeleteEnvironmentValueResponse) ValidateAll() error {
+// AttributeValueServiceImportValuesRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeValueServiceImportValuesRequestMultiError, or nil if none found.
+func (m *AttributeValueServiceImportValuesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceDeleteEnvironmentValueResponse) validate(all bool) error {
+func (m *AttributeValueServiceImportValuesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for EntityType
+
+	// no validation rules for Format
+
+	// no validation rules for Data
+
 	if len(errors) > 0 {
-		return AttributeValueServiceDeleteEnvironmentValueResponseMultiError(errors)
+		return AttributeValueServiceImportValuesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceDeleteEnvironmentValueResponseMultiError is an error
-// wrapping multiple validation errors returned by
-// AttributeValueServiceDeleteEnvironmentValueResponse.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceDeleteEnvironmentValueResponseMultiError []error
+// AttributeValueServiceImportValuesRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeValueServiceImportValuesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeValueServiceImportValuesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceDeleteEnvironmentValueResponseMultiError) Error() string {
+func (m AttributeValueServiceImportValuesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5773,13 +9120,12 @@ func (m AttributeValueServiceDeleteEnvironmentValueResponseMultiError) Error() s
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceDeleteEnvironmentValueResponseMultiError) AllErrors() []error { return m }
+func (m AttributeValueServiceImportValuesRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceDeleteEnvironmentValueResponseValidationError is the
-// validation error returned by
-// AttributeValueServiceDeleteEnvironmentValueResponse.Validate if the
+// AttributeValueServiceImportValuesRequestValidationError is the validation
+// error returned by AttributeValueServiceImportValuesRequest.Validate if the
 // designated constraints aren't met.
-type AttributeValueServiceDeleteEnvironmentValueResponseValidationError struct {
+type AttributeValueServiceImportValuesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5787,30 +9133,24 @@ type AttributeValueServiceDeleteEnvironmentValueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Field() string {
-	return e.field
-}
+func (e AttributeValueServiceImportValuesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e AttributeValueServiceImportValuesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Cause() error {
-	return e.cause
-}
+func (e AttributeValueServiceImportValuesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Key() bool { return e.key }
+func (e AttributeValueServiceImportValuesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceDeleteEnvironmentValueResponseValidationError"
+func (e AttributeValueServiceImportValuesRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceImportValuesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Error() string {
+func (e AttributeValueServiceImportValuesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5822,14 +9162,14 @@ func (e AttributeValueServiceDeleteEnvironmentValueResponseValidationError) Erro
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceDeleteEnvironmentValueResponse.%s: %s%s",
+		"invalid %sAttributeValueServiceImportValuesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceDeleteEnvironmentValueResponseValidationError{}
+var _ error = AttributeValueServiceImportValuesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -5837,47 +9177,87 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceDeleteEnvironmentValueResponseValidationError{}
+} = AttributeValueServiceImportValuesRequestValidationError{}
 
 // Validate checks the field values on
-// AttributeValueServiceFindEnvironmentValueRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
+// AttributeValueServiceImportValuesResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindEnvironmentValueRequest) Validate() error {
+func (m *AttributeValueServiceImportValuesResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceFindEnvironmentValueRequest with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// AttributeValueServiceFindEnvironmentValueRequestMultiError, or nil if none found.
-func (m *AttributeValueServiceFindEnvironmentValueRequest) ValidateAll() error {
+// AttributeValueServiceImportValuesResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeValueServiceImportValuesResponseMultiError, or nil if none found.
+func (m *AttributeValueServiceImportValuesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindEnvironmentValueRequest) validate(all bool) error {
+func (m *AttributeValueServiceImportValuesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for Total
+
+	// no validation rules for Succeeded
+
+	// no validation rules for Failed
+
+	for idx, item := range m.GetErrors() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, AttributeValueServiceImportValuesResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, AttributeValueServiceImportValuesResponseValidationError{
+						field:  fmt.Sprintf("Errors[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return AttributeValueServiceImportValuesResponseValidationError{
+					field:  fmt.Sprintf("Errors[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return AttributeValueServiceFindEnvironmentValueRequestMultiError(errors)
+		return AttributeValueServiceImportValuesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindEnvironmentValueRequestMultiError is an error
-// wrapping multiple validation errors returned by
-// AttributeValueServiceFindEnvironmentValueRequest.ValidateAll() if the
-// designated constraints aren't met.
-type AttributeValueServiceFindEnvironmentValueRequestMultiError []error
+// AttributeValueServiceImportValuesResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeValueServiceImportValuesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeValueServiceImportValuesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindEnvironmentValueRequestMultiError) Error() string {
+func (m AttributeValueServiceImportValuesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -5886,13 +9266,12 @@ func (m AttributeValueServiceFindEnvironmentValueRequestMultiError) Error() stri
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindEnvironmentValueRequestMultiError) AllErrors() []error { return m }
+func (m AttributeValueServiceImportValuesResponseMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindEnvironmentValueRequestValidationError is the
-// validation error returned by
-// AttributeValueServiceFindEnvironmentValueRequest.Validate if the designated
-// constraints aren't met.
-type AttributeValueServiceFindEnvironmentValueRequestValidationError struct {
+// AttributeValueServiceImportValuesResponseValidationError is the validation
+// error returned by AttributeValueServiceImportValuesResponse.Validate if the
+// designated constraints aren't met.
+type AttributeValueServiceImportValuesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -5900,30 +9279,24 @@ type AttributeValueServiceFindEnvironmentValueRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Field() string {
-	return e.field
-}
+func (e AttributeValueServiceImportValuesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Reason() string {
-	return e.reason
-}
+func (e AttributeValueServiceImportValuesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Cause() error {
-	return e.cause
-}
+func (e AttributeValueServiceImportValuesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Key() bool { return e.key }
+func (e AttributeValueServiceImportValuesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceFindEnvironmentValueRequestValidationError"
+func (e AttributeValueServiceImportValuesResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceImportValuesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Error() string {
+func (e AttributeValueServiceImportValuesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -5935,14 +9308,14 @@ func (e AttributeValueServiceFindEnvironmentValueRequestValidationError) Error()
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindEnvironmentValueRequest.%s: %s%s",
+		"invalid %sAttributeValueServiceImportValuesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindEnvironmentValueRequestValidationError{}
+var _ error = AttributeValueServiceImportValuesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -5950,76 +9323,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindEnvironmentValueRequestValidationError{}
+} = AttributeValueServiceImportValuesResponseValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceFindEnvironmentValueResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindEnvironmentValueResponse) Validate() error {
+// Validate checks the field values on AttributeValueImportError with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueImportError) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on
-// AttributeValueServiceFindEnvironmentValueResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in
-// AttributeValueServiceFindEnvironmentValueResponseMultiError, or nil if none found.
-func (m *AttributeValueServiceFindEnvironmentValueResponse) ValidateAll() error {
+// ValidateAll checks the field values on AttributeValueImportError with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AttributeValueImportErrorMultiError, or nil if none found.
+func (m *AttributeValueImportError) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindEnvironmentValueResponse) validate(all bool) error {
+func (m *AttributeValueImportError) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetEnvironment()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeValueServiceFindEnvironmentValueResponseValidationError{
-					field:  "Environment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeValueServiceFindEnvironmentValueResponseValidationError{
-					field:  "Environment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEnvironment()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AttributeValueServiceFindEnvironmentValueResponseValidationError{
-				field:  "Environment",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Line
+
+	// no validation rules for Message
 
 	if len(errors) > 0 {
-		return AttributeValueServiceFindEnvironmentValueResponseMultiError(errors)
+		return AttributeValueImportErrorMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindEnvironmentValueResponseMultiError is an error
-// wrapping multiple validation errors returned by
-// AttributeValueServiceFindEnvironmentValueResponse.ValidateAll() if the
+// AttributeValueImportErrorMultiError is an error wrapping multiple validation
+// errors returned by AttributeValueImportError.ValidateAll() if the
 // designated constraints aren't met.
-type AttributeValueServiceFindEnvironmentValueResponseMultiError []error
+type AttributeValueImportErrorMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindEnvironmentValueResponseMultiError) Error() string {
+func (m AttributeValueImportErrorMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6028,13 +9373,11 @@ func (m AttributeValueServiceFindEnvironmentValueResponseMultiError) Error() str
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindEnvironmentValueResponseMultiError) AllErrors() []error { return m }
+func (m AttributeValueImportErrorMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindEnvironmentValueResponseValidationError is the
-// validation error returned by
-// AttributeValueServiceFindEnvironmentValueResponse.Validate if the
-// designated constraints aren't met.
-type AttributeValueServiceFindEnvironmentValueResponseValidationError struct {
+// AttributeValueImportErrorValidationError is the validation error returned by
+// AttributeValueImportError.Validate if the designated constraints aren't met.
+type AttributeValueImportErrorValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6042,30 +9385,24 @@ type AttributeValueServiceFindEnvironmentValueResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Field() string {
-	return e.field
-}
+func (e AttributeValueImportErrorValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e AttributeValueImportErrorValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Cause() error {
-	return e.cause
-}
+func (e AttributeValueImportErrorValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Key() bool { return e.key }
+func (e AttributeValueImportErrorValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceFindEnvironmentValueResponseValidationError"
+func (e AttributeValueImportErrorValidationError) ErrorName() string {
+	return "AttributeValueImportErrorValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Error() string {
+func (e AttributeValueImportErrorValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6077,14 +9414,14 @@ func (e AttributeValueServiceFindEnvironmentValueResponseValidationError) Error(
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindEnvironmentValueResponse.%s: %s%s",
+		"invalid %sAttributeValueImportError.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindEnvironmentValueResponseValidationError{}
+var _ error = AttributeValueImportErrorValidationError{}
 
 var _ interface {
 	Field() string
@@ -6092,48 +9429,51 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindEnvironmentValueResponseValidationError{}
+} = AttributeValueImportErrorValidationError{}
 
-// Validate checks the field values on
-// AttributeValueServiceFindEnvironmentValueWithDefinitionRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) Validate() error {
+// Validate checks the field values on AttributeValueServiceExportValuesRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, the first error encountered is returned, or nil if
+// there are no violations.
+func (m *AttributeValueServiceExportValuesRequest) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceFindEnvironmentValueWithDefinitionRequest with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError,
-// or nil if none found.
-func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) ValidateAll() error {
+// AttributeValueServiceExportValuesRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeValueServiceExportValuesRequestMultiError, or nil if none found.
+func (m *AttributeValueServiceExportValuesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) validate(all bool) error {
+func (m *AttributeValueServiceExportValuesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
+	// no validation rules for EntityType
+
+	// no validation rules for Format
+
 	if len(errors) > 0 {
-		return AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError(errors)
+		return AttributeValueServiceExportValuesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError is
-// an error wrapping multiple validation errors returned by
-// AttributeValueServiceFindEnvironmentValueWithDefinitionRequest.ValidateAll()
-// if the designated constraints aren't met.
-type AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError []error
+// AttributeValueServiceExportValuesRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeValueServiceExportValuesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeValueServiceExportValuesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError) Error() string {
+func (m AttributeValueServiceExportValuesRequestMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6142,15 +9482,12 @@ func (m AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindEnvironmentValueWithDefinitionRequestMultiError) AllErrors() []error {
-	return m
-}
+func (m AttributeValueServiceExportValuesRequestMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError
-// is the validation error returned by
-// AttributeValueServiceFindEnvironmentValueWithDefinitionRequest.Validate if
-// the designated constraints aren't met.
-type AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError struct {
+// AttributeValueServiceExportValuesRequestValidationError is the validation
+// error returned by AttributeValueServiceExportValuesRequest.Validate if the
+// designated constraints aren't met.
+type AttributeValueServiceExportValuesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6158,32 +9495,24 @@ type AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationErr
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) Field() string {
-	return e.field
-}
+func (e AttributeValueServiceExportValuesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) Reason() string {
-	return e.reason
-}
+func (e AttributeValueServiceExportValuesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) Cause() error {
-	return e.cause
-}
+func (e AttributeValueServiceExportValuesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) Key() bool {
-	return e.key
-}
+func (e AttributeValueServiceExportValuesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) ErrorName() string {
-	return "AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError"
+func (e AttributeValueServiceExportValuesRequestValidationError) ErrorName() string {
+	return "AttributeValueServiceExportValuesRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError) Error() string {
+func (e AttributeValueServiceExportValuesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6195,14 +9524,14 @@ func (e AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidation
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindEnvironmentValueWithDefinitionRequest.%s: %s%s",
+		"invalid %sAttributeValueServiceExportValuesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError{}
+var _ error = AttributeValueServiceExportValuesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -6210,77 +9539,49 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindEnvironmentValueWithDefinitionRequestValidationError{}
+} = AttributeValueServiceExportValuesRequestValidationError{}
 
 // Validate checks the field values on
-// AttributeValueServiceFindEnvironmentValueWithDefinitionResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) Validate() error {
+// AttributeValueServiceExportValuesResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeValueServiceExportValuesResponse) Validate() error {
 	return m.validate(false)
 }
 
 // ValidateAll checks the field values on
-// AttributeValueServiceFindEnvironmentValueWithDefinitionResponse with the
-// rules defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError,
-// or nil if none found.
-func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) ValidateAll() error {
+// AttributeValueServiceExportValuesResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeValueServiceExportValuesResponseMultiError, or nil if none found.
+func (m *AttributeValueServiceExportValuesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AttributeValueServiceFindEnvironmentValueWithDefinitionResponse) validate(all bool) error {
+func (m *AttributeValueServiceExportValuesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if all {
-		switch v := interface{}(m.GetEnvironment()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError{
-					field:  "Environment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError{
-					field:  "Environment",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetEnvironment()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError{
-				field:  "Environment",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	// no validation rules for Data
 
 	if len(errors) > 0 {
-		return AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError(errors)
+		return AttributeValueServiceExportValuesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError is
-// an error wrapping multiple validation errors returned by
-// AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.ValidateAll()
-// if the designated constraints aren't met.
-type AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError []error
+// AttributeValueServiceExportValuesResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeValueServiceExportValuesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeValueServiceExportValuesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError) Error() string {
+func (m AttributeValueServiceExportValuesResponseMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -6289,15 +9590,12 @@ func (m AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiErro
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AttributeValueServiceFindEnvironmentValueWithDefinitionResponseMultiError) AllErrors() []error {
-	return m
-}
+func (m AttributeValueServiceExportValuesResponseMultiError) AllErrors() []error { return m }
 
-// AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError
-// is the validation error returned by
-// AttributeValueServiceFindEnvironmentValueWithDefinitionResponse.Validate if
-// the designated constraints aren't met.
-type AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError struct {
+// AttributeValueServiceExportValuesResponseValidationError is the validation
+// error returned by AttributeValueServiceExportValuesResponse.Validate if the
+// designated constraints aren't met.
+type AttributeValueServiceExportValuesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -6305,32 +9603,24 @@ type AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationEr
 }
 
 // Field function returns field value.
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) Field() string {
-	return e.field
-}
+func (e AttributeValueServiceExportValuesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) Reason() string {
-	return e.reason
-}
+func (e AttributeValueServiceExportValuesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) Cause() error {
-	return e.cause
-}
+func (e AttributeValueServiceExportValuesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) Key() bool {
-	return e.key
-}
+func (e AttributeValueServiceExportValuesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) ErrorName() string {
-	return "AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError"
+func (e AttributeValueServiceExportValuesResponseValidationError) ErrorName() string {
+	return "AttributeValueServiceExportValuesResponseValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError) Error() string {
+func (e AttributeValueServiceExportValuesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -6342,14 +9632,14 @@ func (e AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidatio
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAttributeValueServiceFindEnvironmentValueWithDefinitionResponse.%s: %s%s",
+		"invalid %sAttributeValueServiceExportValuesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError{}
+var _ error = AttributeValueServiceExportValuesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -6357,7 +9647,7 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AttributeValueServiceFindEnvironmentValueWithDefinitionResponseValidationError{}
+} = AttributeValueServiceExportValuesResponseValidationError{}
 
 // Validate checks the field values on AttributeDefinitionServiceSaveRequest
 // with the rules defined in the proto definition for this message. If any
@@ -7297,3 +10587,232 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AttributeDefinitionServiceFindResponseValidationError{}
+
+// Validate checks the field values on
+// AttributeProviderServiceGetAttributeRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeProviderServiceGetAttributeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeProviderServiceGetAttributeRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeProviderServiceGetAttributeRequestMultiError, or nil if none found.
+func (m *AttributeProviderServiceGetAttributeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeProviderServiceGetAttributeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for BizId
+
+	// no validation rules for EntityType
+
+	// no validation rules for Attribute
+
+	// no validation rules for Uid
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceKey
+
+	if len(errors) > 0 {
+		return AttributeProviderServiceGetAttributeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeProviderServiceGetAttributeRequestMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeProviderServiceGetAttributeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type AttributeProviderServiceGetAttributeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeProviderServiceGetAttributeRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeProviderServiceGetAttributeRequestMultiError) AllErrors() []error { return m }
+
+// AttributeProviderServiceGetAttributeRequestValidationError is the validation
+// error returned by AttributeProviderServiceGetAttributeRequest.Validate if
+// the designated constraints aren't met.
+type AttributeProviderServiceGetAttributeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeProviderServiceGetAttributeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeProviderServiceGetAttributeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeProviderServiceGetAttributeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeProviderServiceGetAttributeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeProviderServiceGetAttributeRequestValidationError) ErrorName() string {
+	return "AttributeProviderServiceGetAttributeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeProviderServiceGetAttributeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeProviderServiceGetAttributeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeProviderServiceGetAttributeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeProviderServiceGetAttributeRequestValidationError{}
+
+// Validate checks the field values on
+// AttributeProviderServiceGetAttributeResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AttributeProviderServiceGetAttributeResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on
+// AttributeProviderServiceGetAttributeResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in
+// AttributeProviderServiceGetAttributeResponseMultiError, or nil if none found.
+func (m *AttributeProviderServiceGetAttributeResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AttributeProviderServiceGetAttributeResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Found
+
+	// no validation rules for Value
+
+	if len(errors) > 0 {
+		return AttributeProviderServiceGetAttributeResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// AttributeProviderServiceGetAttributeResponseMultiError is an error wrapping
+// multiple validation errors returned by
+// AttributeProviderServiceGetAttributeResponse.ValidateAll() if the
+// designated constraints aren't met.
+type AttributeProviderServiceGetAttributeResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AttributeProviderServiceGetAttributeResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AttributeProviderServiceGetAttributeResponseMultiError) AllErrors() []error { return m }
+
+// AttributeProviderServiceGetAttributeResponseValidationError is the
+// validation error returned by
+// AttributeProviderServiceGetAttributeResponse.Validate if the designated
+// constraints aren't met.
+type AttributeProviderServiceGetAttributeResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AttributeProviderServiceGetAttributeResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AttributeProviderServiceGetAttributeResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AttributeProviderServiceGetAttributeResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AttributeProviderServiceGetAttributeResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AttributeProviderServiceGetAttributeResponseValidationError) ErrorName() string {
+	return "AttributeProviderServiceGetAttributeResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AttributeProviderServiceGetAttributeResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAttributeProviderServiceGetAttributeResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AttributeProviderServiceGetAttributeResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AttributeProviderServiceGetAttributeResponseValidationError{}
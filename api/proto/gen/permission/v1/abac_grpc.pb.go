@@ -26,6 +26,14 @@ const (
 	PolicyService_DeleteRule_FullMethodName           = "/permission.v1.PolicyService/DeleteRule"
 	PolicyService_SavePermissionPolicy_FullMethodName = "/permission.v1.PolicyService/SavePermissionPolicy"
 	PolicyService_FindPolicies_FullMethodName         = "/permission.v1.PolicyService/FindPolicies"
+	PolicyService_Simulate_FullMethodName             = "/permission.v1.PolicyService/Simulate"
+	PolicyService_SaveExpression_FullMethodName       = "/permission.v1.PolicyService/SaveExpression"
+	PolicyService_GetExpression_FullMethodName        = "/permission.v1.PolicyService/GetExpression"
+	PolicyService_Publish_FullMethodName              = "/permission.v1.PolicyService/Publish"
+	PolicyService_Rollback_FullMethodName             = "/permission.v1.PolicyService/Rollback"
+	PolicyService_ListVersions_FullMethodName         = "/permission.v1.PolicyService/ListVersions"
+	PolicyService_GetVersion_FullMethodName           = "/permission.v1.PolicyService/GetVersion"
+	PolicyService_DiffVersions_FullMethodName         = "/permission.v1.PolicyService/DiffVersions"
 )
 
 // PolicyServiceClient is the client API for PolicyService service.
@@ -41,6 +49,21 @@ type PolicyServiceClient interface {
 	DeleteRule(ctx context.Context, in *PolicyServiceDeleteRuleRequest, opts ...grpc.CallOption) (*PolicyServiceDeleteRuleResponse, error)
 	SavePermissionPolicy(ctx context.Context, in *PolicyServiceSavePermissionPolicyRequest, opts ...grpc.CallOption) (*PolicyServiceSavePermissionPolicyResponse, error)
 	FindPolicies(ctx context.Context, in *PolicyServiceFindPoliciesRequest, opts ...grpc.CallOption) (*PolicyServiceFindPoliciesResponse, error)
+	// 模拟候选策略的效果，不会写入任何数据
+	Simulate(ctx context.Context, in *PolicyServiceSimulateRequest, opts ...grpc.CallOption) (*PolicyServiceSimulateResponse, error)
+	// 用表达式替换策略的全部规则，例如 subject.level >= 5 AND resource.owner_dept IN ["a","b"]
+	SaveExpression(ctx context.Context, in *PolicyServiceSaveExpressionRequest, opts ...grpc.CallOption) (*PolicyServiceSaveExpressionResponse, error)
+	// 把策略的规则渲染成表达式
+	GetExpression(ctx context.Context, in *PolicyServiceGetExpressionRequest, opts ...grpc.CallOption) (*PolicyServiceGetExpressionResponse, error)
+	// 以上修改策略、规则的接口都只修改草稿，发布后才会在鉴权中生效
+	Publish(ctx context.Context, in *PolicyServicePublishRequest, opts ...grpc.CallOption) (*PolicyServicePublishResponse, error)
+	// 复制历史版本发布成新版本，草稿也恢复成该版本
+	Rollback(ctx context.Context, in *PolicyServiceRollbackRequest, opts ...grpc.CallOption) (*PolicyServiceRollbackResponse, error)
+	ListVersions(ctx context.Context, in *PolicyServiceListVersionsRequest, opts ...grpc.CallOption) (*PolicyServiceListVersionsResponse, error)
+	// version 为 0 时返回草稿
+	GetVersion(ctx context.Context, in *PolicyServiceGetVersionRequest, opts ...grpc.CallOption) (*PolicyServiceGetVersionResponse, error)
+	// 比较两个版本，version 为 0 表示草稿
+	DiffVersions(ctx context.Context, in *PolicyServiceDiffVersionsRequest, opts ...grpc.CallOption) (*PolicyServiceDiffVersionsResponse, error)
 }
 
 type policyServiceClient struct {
@@ -121,6 +144,86 @@ func (c *policyServiceClient) FindPolicies(ctx context.Context, in *PolicyServic
 	return out, nil
 }
 
+func (c *policyServiceClient) Simulate(ctx context.Context, in *PolicyServiceSimulateRequest, opts ...grpc.CallOption) (*PolicyServiceSimulateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceSimulateResponse)
+	err := c.cc.Invoke(ctx, PolicyService_Simulate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) SaveExpression(ctx context.Context, in *PolicyServiceSaveExpressionRequest, opts ...grpc.CallOption) (*PolicyServiceSaveExpressionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceSaveExpressionResponse)
+	err := c.cc.Invoke(ctx, PolicyService_SaveExpression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) GetExpression(ctx context.Context, in *PolicyServiceGetExpressionRequest, opts ...grpc.CallOption) (*PolicyServiceGetExpressionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceGetExpressionResponse)
+	err := c.cc.Invoke(ctx, PolicyService_GetExpression_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) Publish(ctx context.Context, in *PolicyServicePublishRequest, opts ...grpc.CallOption) (*PolicyServicePublishResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServicePublishResponse)
+	err := c.cc.Invoke(ctx, PolicyService_Publish_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) Rollback(ctx context.Context, in *PolicyServiceRollbackRequest, opts ...grpc.CallOption) (*PolicyServiceRollbackResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceRollbackResponse)
+	err := c.cc.Invoke(ctx, PolicyService_Rollback_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) ListVersions(ctx context.Context, in *PolicyServiceListVersionsRequest, opts ...grpc.CallOption) (*PolicyServiceListVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceListVersionsResponse)
+	err := c.cc.Invoke(ctx, PolicyService_ListVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) GetVersion(ctx context.Context, in *PolicyServiceGetVersionRequest, opts ...grpc.CallOption) (*PolicyServiceGetVersionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceGetVersionResponse)
+	err := c.cc.Invoke(ctx, PolicyService_GetVersion_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *policyServiceClient) DiffVersions(ctx context.Context, in *PolicyServiceDiffVersionsRequest, opts ...grpc.CallOption) (*PolicyServiceDiffVersionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PolicyServiceDiffVersionsResponse)
+	err := c.cc.Invoke(ctx, PolicyService_DiffVersions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PolicyServiceServer is the server API for PolicyService service.
 // All implementations should embed UnimplementedPolicyServiceServer
 // for forward compatibility.
@@ -134,6 +237,21 @@ type PolicyServiceServer interface {
 	DeleteRule(context.Context, *PolicyServiceDeleteRuleRequest) (*PolicyServiceDeleteRuleResponse, error)
 	SavePermissionPolicy(context.Context, *PolicyServiceSavePermissionPolicyRequest) (*PolicyServiceSavePermissionPolicyResponse, error)
 	FindPolicies(context.Context, *PolicyServiceFindPoliciesRequest) (*PolicyServiceFindPoliciesResponse, error)
+	// 模拟候选策略的效果，不会写入任何数据
+	Simulate(context.Context, *PolicyServiceSimulateRequest) (*PolicyServiceSimulateResponse, error)
+	// 用表达式替换策略的全部规则，例如 subject.level >= 5 AND resource.owner_dept IN ["a","b"]
+	SaveExpression(context.Context, *PolicyServiceSaveExpressionRequest) (*PolicyServiceSaveExpressionResponse, error)
+	// 把策略的规则渲染成表达式
+	GetExpression(context.Context, *PolicyServiceGetExpressionRequest) (*PolicyServiceGetExpressionResponse, error)
+	// 以上修改策略、规则的接口都只修改草稿，发布后才会在鉴权中生效
+	Publish(context.Context, *PolicyServicePublishRequest) (*PolicyServicePublishResponse, error)
+	// 复制历史版本发布成新版本，草稿也恢复成该版本
+	Rollback(context.Context, *PolicyServiceRollbackRequest) (*PolicyServiceRollbackResponse, error)
+	ListVersions(context.Context, *PolicyServiceListVersionsRequest) (*PolicyServiceListVersionsResponse, error)
+	// version 为 0 时返回草稿
+	GetVersion(context.Context, *PolicyServiceGetVersionRequest) (*PolicyServiceGetVersionResponse, error)
+	// 比较两个版本，version 为 0 表示草稿
+	DiffVersions(context.Context, *PolicyServiceDiffVersionsRequest) (*PolicyServiceDiffVersionsResponse, error)
 }
 
 // UnimplementedPolicyServiceServer should be embedded to have
@@ -164,6 +282,30 @@ func (UnimplementedPolicyServiceServer) SavePermissionPolicy(context.Context, *P
 func (UnimplementedPolicyServiceServer) FindPolicies(context.Context, *PolicyServiceFindPoliciesRequest) (*PolicyServiceFindPoliciesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindPolicies not implemented")
 }
+func (UnimplementedPolicyServiceServer) Simulate(context.Context, *PolicyServiceSimulateRequest) (*PolicyServiceSimulateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Simulate not implemented")
+}
+func (UnimplementedPolicyServiceServer) SaveExpression(context.Context, *PolicyServiceSaveExpressionRequest) (*PolicyServiceSaveExpressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveExpression not implemented")
+}
+func (UnimplementedPolicyServiceServer) GetExpression(context.Context, *PolicyServiceGetExpressionRequest) (*PolicyServiceGetExpressionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExpression not implemented")
+}
+func (UnimplementedPolicyServiceServer) Publish(context.Context, *PolicyServicePublishRequest) (*PolicyServicePublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (UnimplementedPolicyServiceServer) Rollback(context.Context, *PolicyServiceRollbackRequest) (*PolicyServiceRollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedPolicyServiceServer) ListVersions(context.Context, *PolicyServiceListVersionsRequest) (*PolicyServiceListVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListVersions not implemented")
+}
+func (UnimplementedPolicyServiceServer) GetVersion(context.Context, *PolicyServiceGetVersionRequest) (*PolicyServiceGetVersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (UnimplementedPolicyServiceServer) DiffVersions(context.Context, *PolicyServiceDiffVersionsRequest) (*PolicyServiceDiffVersionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffVersions not implemented")
+}
 func (UnimplementedPolicyServiceServer) testEmbeddedByValue() {}
 
 // UnsafePolicyServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -310,6 +452,150 @@ func _PolicyService_FindPolicies_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PolicyService_Simulate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceSimulateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).Simulate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_Simulate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).Simulate(ctx, req.(*PolicyServiceSimulateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_SaveExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceSaveExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).SaveExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_SaveExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).SaveExpression(ctx, req.(*PolicyServiceSaveExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_GetExpression_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceGetExpressionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).GetExpression(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_GetExpression_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).GetExpression(ctx, req.(*PolicyServiceGetExpressionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServicePublishRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).Publish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_Publish_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).Publish(ctx, req.(*PolicyServicePublishRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceRollbackRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).Rollback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_Rollback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).Rollback(ctx, req.(*PolicyServiceRollbackRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_ListVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceListVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).ListVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_ListVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).ListVersions(ctx, req.(*PolicyServiceListVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceGetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_GetVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).GetVersion(ctx, req.(*PolicyServiceGetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PolicyService_DiffVersions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolicyServiceDiffVersionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PolicyServiceServer).DiffVersions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PolicyService_DiffVersions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PolicyServiceServer).DiffVersions(ctx, req.(*PolicyServiceDiffVersionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PolicyService_ServiceDesc is the grpc.ServiceDesc for PolicyService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -345,6 +631,38 @@ var PolicyService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindPolicies",
 			Handler:    _PolicyService_FindPolicies_Handler,
 		},
+		{
+			MethodName: "Simulate",
+			Handler:    _PolicyService_Simulate_Handler,
+		},
+		{
+			MethodName: "SaveExpression",
+			Handler:    _PolicyService_SaveExpression_Handler,
+		},
+		{
+			MethodName: "GetExpression",
+			Handler:    _PolicyService_GetExpression_Handler,
+		},
+		{
+			MethodName: "Publish",
+			Handler:    _PolicyService_Publish_Handler,
+		},
+		{
+			MethodName: "Rollback",
+			Handler:    _PolicyService_Rollback_Handler,
+		},
+		{
+			MethodName: "ListVersions",
+			Handler:    _PolicyService_ListVersions_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _PolicyService_GetVersion_Handler,
+		},
+		{
+			MethodName: "DiffVersions",
+			Handler:    _PolicyService_DiffVersions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "permission/v1/abac.proto",
@@ -360,6 +678,8 @@ const (
 	AttributeValueService_SaveEnvironmentValue_FullMethodName               = "/permission.v1.AttributeValueService/SaveEnvironmentValue"
 	AttributeValueService_DeleteEnvironmentValue_FullMethodName             = "/permission.v1.AttributeValueService/DeleteEnvironmentValue"
 	AttributeValueService_FindEnvironmentValueWithDefinition_FullMethodName = "/permission.v1.AttributeValueService/FindEnvironmentValueWithDefinition"
+	AttributeValueService_ImportValues_FullMethodName                       = "/permission.v1.AttributeValueService/ImportValues"
+	AttributeValueService_ExportValues_FullMethodName                       = "/permission.v1.AttributeValueService/ExportValues"
 )
 
 // AttributeValueServiceClient is the client API for AttributeValueService service.
@@ -377,6 +697,11 @@ type AttributeValueServiceClient interface {
 	SaveEnvironmentValue(ctx context.Context, in *AttributeValueServiceSaveEnvironmentValueRequest, opts ...grpc.CallOption) (*AttributeValueServiceSaveEnvironmentValueResponse, error)
 	DeleteEnvironmentValue(ctx context.Context, in *AttributeValueServiceDeleteEnvironmentValueRequest, opts ...grpc.CallOption) (*AttributeValueServiceDeleteEnvironmentValueResponse, error)
 	FindEnvironmentValueWithDefinition(ctx context.Context, in *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest, opts ...grpc.CallOption) (*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse, error)
+	// ImportValues 批量写入主体或者资源的属性值，客户端分块上传 CSV 或者 NDJSON，按属性名匹配属性定义，
+	// 每一行单独校验，合法的行按批在事务中写入，上传结束后返回每一行的错误
+	ImportValues(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AttributeValueServiceImportValuesRequest, AttributeValueServiceImportValuesResponse], error)
+	// ExportValues 分块导出主体或者资源的全部属性值，格式与 ImportValues 相同
+	ExportValues(ctx context.Context, in *AttributeValueServiceExportValuesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AttributeValueServiceExportValuesResponse], error)
 }
 
 type attributeValueServiceClient struct {
@@ -477,6 +802,38 @@ func (c *attributeValueServiceClient) FindEnvironmentValueWithDefinition(ctx con
 	return out, nil
 }
 
+func (c *attributeValueServiceClient) ImportValues(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[AttributeValueServiceImportValuesRequest, AttributeValueServiceImportValuesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttributeValueService_ServiceDesc.Streams[0], AttributeValueService_ImportValues_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AttributeValueServiceImportValuesRequest, AttributeValueServiceImportValuesResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttributeValueService_ImportValuesClient = grpc.ClientStreamingClient[AttributeValueServiceImportValuesRequest, AttributeValueServiceImportValuesResponse]
+
+func (c *attributeValueServiceClient) ExportValues(ctx context.Context, in *AttributeValueServiceExportValuesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[AttributeValueServiceExportValuesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AttributeValueService_ServiceDesc.Streams[1], AttributeValueService_ExportValues_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[AttributeValueServiceExportValuesRequest, AttributeValueServiceExportValuesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttributeValueService_ExportValuesClient = grpc.ServerStreamingClient[AttributeValueServiceExportValuesResponse]
+
 // AttributeValueServiceServer is the server API for AttributeValueService service.
 // All implementations should embed UnimplementedAttributeValueServiceServer
 // for forward compatibility.
@@ -492,6 +849,11 @@ type AttributeValueServiceServer interface {
 	SaveEnvironmentValue(context.Context, *AttributeValueServiceSaveEnvironmentValueRequest) (*AttributeValueServiceSaveEnvironmentValueResponse, error)
 	DeleteEnvironmentValue(context.Context, *AttributeValueServiceDeleteEnvironmentValueRequest) (*AttributeValueServiceDeleteEnvironmentValueResponse, error)
 	FindEnvironmentValueWithDefinition(context.Context, *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) (*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse, error)
+	// ImportValues 批量写入主体或者资源的属性值，客户端分块上传 CSV 或者 NDJSON，按属性名匹配属性定义，
+	// 每一行单独校验，合法的行按批在事务中写入，上传结束后返回每一行的错误
+	ImportValues(grpc.ClientStreamingServer[AttributeValueServiceImportValuesRequest, AttributeValueServiceImportValuesResponse]) error
+	// ExportValues 分块导出主体或者资源的全部属性值，格式与 ImportValues 相同
+	ExportValues(*AttributeValueServiceExportValuesRequest, grpc.ServerStreamingServer[AttributeValueServiceExportValuesResponse]) error
 }
 
 // UnimplementedAttributeValueServiceServer should be embedded to have
@@ -528,6 +890,12 @@ func (UnimplementedAttributeValueServiceServer) DeleteEnvironmentValue(context.C
 func (UnimplementedAttributeValueServiceServer) FindEnvironmentValueWithDefinition(context.Context, *AttributeValueServiceFindEnvironmentValueWithDefinitionRequest) (*AttributeValueServiceFindEnvironmentValueWithDefinitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindEnvironmentValueWithDefinition not implemented")
 }
+func (UnimplementedAttributeValueServiceServer) ImportValues(grpc.ClientStreamingServer[AttributeValueServiceImportValuesRequest, AttributeValueServiceImportValuesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ImportValues not implemented")
+}
+func (UnimplementedAttributeValueServiceServer) ExportValues(*AttributeValueServiceExportValuesRequest, grpc.ServerStreamingServer[AttributeValueServiceExportValuesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportValues not implemented")
+}
 func (UnimplementedAttributeValueServiceServer) testEmbeddedByValue() {}
 
 // UnsafeAttributeValueServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -710,6 +1078,24 @@ func _AttributeValueService_FindEnvironmentValueWithDefinition_Handler(srv inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AttributeValueService_ImportValues_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AttributeValueServiceServer).ImportValues(&grpc.GenericServerStream[AttributeValueServiceImportValuesRequest, AttributeValueServiceImportValuesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttributeValueService_ImportValuesServer = grpc.ClientStreamingServer[AttributeValueServiceImportValuesRequest, AttributeValueServiceImportValuesResponse]
+
+func _AttributeValueService_ExportValues_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AttributeValueServiceExportValuesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AttributeValueServiceServer).ExportValues(m, &grpc.GenericServerStream[AttributeValueServiceExportValuesRequest, AttributeValueServiceExportValuesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AttributeValueService_ExportValuesServer = grpc.ServerStreamingServer[AttributeValueServiceExportValuesResponse]
+
 // AttributeValueService_ServiceDesc is the grpc.ServiceDesc for AttributeValueService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -754,7 +1140,18 @@ var AttributeValueService_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _AttributeValueService_FindEnvironmentValueWithDefinition_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ImportValues",
+			Handler:       _AttributeValueService_ImportValues_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ExportValues",
+			Handler:       _AttributeValueService_ExportValues_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "permission/v1/abac.proto",
 }
 
@@ -975,3 +1372,107 @@ var AttributeDefinitionService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "permission/v1/abac.proto",
 }
+
+const (
+	AttributeProviderService_GetAttribute_FullMethodName = "/permission.v1.AttributeProviderService/GetAttribute"
+)
+
+// AttributeProviderServiceClient is the client API for AttributeProviderService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// AttributeProviderService 由外部系统实现，校验权限时平台调用它获取既没有存储也没有在请求中传入的属性值
+type AttributeProviderServiceClient interface {
+	GetAttribute(ctx context.Context, in *AttributeProviderServiceGetAttributeRequest, opts ...grpc.CallOption) (*AttributeProviderServiceGetAttributeResponse, error)
+}
+
+type attributeProviderServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAttributeProviderServiceClient(cc grpc.ClientConnInterface) AttributeProviderServiceClient {
+	return &attributeProviderServiceClient{cc}
+}
+
+func (c *attributeProviderServiceClient) GetAttribute(ctx context.Context, in *AttributeProviderServiceGetAttributeRequest, opts ...grpc.CallOption) (*AttributeProviderServiceGetAttributeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttributeProviderServiceGetAttributeResponse)
+	err := c.cc.Invoke(ctx, AttributeProviderService_GetAttribute_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AttributeProviderServiceServer is the server API for AttributeProviderService service.
+// All implementations should embed UnimplementedAttributeProviderServiceServer
+// for forward compatibility.
+//
+// AttributeProviderService 由外部系统实现，校验权限时平台调用它获取既没有存储也没有在请求中传入的属性值
+type AttributeProviderServiceServer interface {
+	GetAttribute(context.Context, *AttributeProviderServiceGetAttributeRequest) (*AttributeProviderServiceGetAttributeResponse, error)
+}
+
+// UnimplementedAttributeProviderServiceServer should be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedAttributeProviderServiceServer struct{}
+
+func (UnimplementedAttributeProviderServiceServer) GetAttribute(context.Context, *AttributeProviderServiceGetAttributeRequest) (*AttributeProviderServiceGetAttributeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttribute not implemented")
+}
+func (UnimplementedAttributeProviderServiceServer) testEmbeddedByValue() {}
+
+// UnsafeAttributeProviderServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AttributeProviderServiceServer will
+// result in compilation errors.
+type UnsafeAttributeProviderServiceServer interface {
+	mustEmbedUnimplementedAttributeProviderServiceServer()
+}
+
+func RegisterAttributeProviderServiceServer(s grpc.ServiceRegistrar, srv AttributeProviderServiceServer) {
+	// If the following call pancis, it indicates UnimplementedAttributeProviderServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&AttributeProviderService_ServiceDesc, srv)
+}
+
+func _AttributeProviderService_GetAttribute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttributeProviderServiceGetAttributeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AttributeProviderServiceServer).GetAttribute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AttributeProviderService_GetAttribute_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AttributeProviderServiceServer).GetAttribute(ctx, req.(*AttributeProviderServiceGetAttributeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// AttributeProviderService_ServiceDesc is the grpc.ServiceDesc for AttributeProviderService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AttributeProviderService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "permission.v1.AttributeProviderService",
+	HandlerType: (*AttributeProviderServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAttribute",
+			Handler:    _AttributeProviderService_GetAttribute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "permission/v1/abac.proto",
+}
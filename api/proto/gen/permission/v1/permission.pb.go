@@ -7,6 +7,7 @@
 package permissionv1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
@@ -28,6 +29,8 @@ type CheckPermissionRequest struct {
 	SubjectAttributes     map[string]string      `protobuf:"bytes,3,rep,name=subject_attributes,json=subjectAttributes,proto3" json:"subject_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	ResourceAttributes    map[string]string      `protobuf:"bytes,4,rep,name=resource_attributes,json=resourceAttributes,proto3" json:"resource_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 	EnvironmentAttributes map[string]string      `protobuf:"bytes,5,rep,name=environment_attributes,json=environmentAttributes,proto3" json:"environment_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Explain               bool                   `protobuf:"varint,6,opt,name=explain,proto3" json:"explain,omitempty"`       // 为 true 时在响应中返回校验过程
+	AsOf                  int64                  `protobuf:"varint,7,opt,name=as_of,json=asOf,proto3" json:"as_of,omitempty"` // 毫秒时间戳，不为 0 时使用该时刻的授权、属性值和策略校验
 	unknownFields         protoimpl.UnknownFields
 	sizeCache             protoimpl.SizeCache
 }
@@ -97,9 +100,24 @@ func (x *CheckPermissionRequest) GetEnvironmentAttributes() map[string]string {
 	return nil
 }
 
+func (x *CheckPermissionRequest) GetExplain() bool {
+	if x != nil {
+		return x.Explain
+	}
+	return false
+}
+
+func (x *CheckPermissionRequest) GetAsOf() int64 {
+	if x != nil {
+		return x.AsOf
+	}
+	return 0
+}
+
 type CheckPermissionResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Trace         *CheckTrace            `protobuf:"bytes,2,opt,name=trace,proto3" json:"trace,omitempty"` // 仅在请求 explain 为 true 时返回
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -141,11 +159,623 @@ func (x *CheckPermissionResponse) GetAllowed() bool {
 	return false
 }
 
+func (x *CheckPermissionResponse) GetTrace() *CheckTrace {
+	if x != nil {
+		return x.Trace
+	}
+	return nil
+}
+
+// CheckTrace 权限校验过程
+type CheckTrace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Rbac          *RBACTrace             `protobuf:"bytes,1,opt,name=rbac,proto3" json:"rbac,omitempty"`
+	Abac          *ABACTrace             `protobuf:"bytes,2,opt,name=abac,proto3" json:"abac,omitempty"`
+	CheckMode     string                 `protobuf:"bytes,3,opt,name=check_mode,json=checkMode,proto3" json:"check_mode,omitempty"` // 本次校验使用的校验模式
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckTrace) Reset() {
+	*x = CheckTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckTrace) ProtoMessage() {}
+
+func (x *CheckTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckTrace.ProtoReflect.Descriptor instead.
+func (*CheckTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CheckTrace) GetRbac() *RBACTrace {
+	if x != nil {
+		return x.Rbac
+	}
+	return nil
+}
+
+func (x *CheckTrace) GetAbac() *ABACTrace {
+	if x != nil {
+		return x.Abac
+	}
+	return nil
+}
+
+func (x *CheckTrace) GetCheckMode() string {
+	if x != nil {
+		return x.CheckMode
+	}
+	return ""
+}
+
+type RBACTrace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Matches       []*RBACMatch           `protobuf:"bytes,2,rep,name=matches,proto3" json:"matches,omitempty"` // 命中的用户权限
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RBACTrace) Reset() {
+	*x = RBACTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RBACTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RBACTrace) ProtoMessage() {}
+
+func (x *RBACTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RBACTrace.ProtoReflect.Descriptor instead.
+func (*RBACTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RBACTrace) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *RBACTrace) GetMatches() []*RBACMatch {
+	if x != nil {
+		return x.Matches
+	}
+	return nil
+}
+
+type RBACMatch struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserPermission *UserPermission        `protobuf:"bytes,1,opt,name=user_permission,json=userPermission,proto3" json:"user_permission,omitempty"`
+	RolePath       []int64                `protobuf:"varint,2,rep,packed,name=role_path,json=rolePath,proto3" json:"role_path,omitempty"` // 产生该权限的角色路径，从用户直接拥有的角色到授予该权限的角色；为空表示直接授予用户
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RBACMatch) Reset() {
+	*x = RBACMatch{}
+	mi := &file_permission_v1_permission_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RBACMatch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RBACMatch) ProtoMessage() {}
+
+func (x *RBACMatch) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RBACMatch.ProtoReflect.Descriptor instead.
+func (*RBACMatch) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *RBACMatch) GetUserPermission() *UserPermission {
+	if x != nil {
+		return x.UserPermission
+	}
+	return nil
+}
+
+func (x *RBACMatch) GetRolePath() []int64 {
+	if x != nil {
+		return x.RolePath
+	}
+	return nil
+}
+
+type ABACTrace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Allowed       bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	Policies      []*PolicyTrace         `protobuf:"bytes,2,rep,name=policies,proto3" json:"policies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ABACTrace) Reset() {
+	*x = ABACTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ABACTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ABACTrace) ProtoMessage() {}
+
+func (x *ABACTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ABACTrace.ProtoReflect.Descriptor instead.
+func (*ABACTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ABACTrace) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ABACTrace) GetPolicies() []*PolicyTrace {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+type PolicyTrace struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PolicyId      int64                  `protobuf:"varint,1,opt,name=policy_id,json=policyId,proto3" json:"policy_id,omitempty"`
+	PolicyName    string                 `protobuf:"bytes,2,opt,name=policy_name,json=policyName,proto3" json:"policy_name,omitempty"`
+	Result        bool                   `protobuf:"varint,3,opt,name=result,proto3" json:"result,omitempty"` // 策略规则是否全部满足
+	Rules         []*RuleTrace           `protobuf:"bytes,4,rep,name=rules,proto3" json:"rules,omitempty"`
+	Indeterminate bool                   `protobuf:"varint,5,opt,name=indeterminate,proto3" json:"indeterminate,omitempty"` // 无法判定，例如属性缺失或者规则执行出错，此时 result 为 false
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PolicyTrace) Reset() {
+	*x = PolicyTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PolicyTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PolicyTrace) ProtoMessage() {}
+
+func (x *PolicyTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PolicyTrace.ProtoReflect.Descriptor instead.
+func (*PolicyTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PolicyTrace) GetPolicyId() int64 {
+	if x != nil {
+		return x.PolicyId
+	}
+	return 0
+}
+
+func (x *PolicyTrace) GetPolicyName() string {
+	if x != nil {
+		return x.PolicyName
+	}
+	return ""
+}
+
+func (x *PolicyTrace) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *PolicyTrace) GetRules() []*RuleTrace {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+func (x *PolicyTrace) GetIndeterminate() bool {
+	if x != nil {
+		return x.Indeterminate
+	}
+	return false
+}
+
+type RuleTrace struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	RuleId             int64                  `protobuf:"varint,1,opt,name=rule_id,json=ruleId,proto3" json:"rule_id,omitempty"`
+	AttributeId        int64                  `protobuf:"varint,2,opt,name=attribute_id,json=attributeId,proto3" json:"attribute_id,omitempty"`
+	AttributeName      string                 `protobuf:"bytes,3,opt,name=attribute_name,json=attributeName,proto3" json:"attribute_name,omitempty"`
+	Operator           string                 `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
+	WantedValue        string                 `protobuf:"bytes,5,opt,name=wanted_value,json=wantedValue,proto3" json:"wanted_value,omitempty"`
+	ActualValue        string                 `protobuf:"bytes,6,opt,name=actual_value,json=actualValue,proto3" json:"actual_value,omitempty"`
+	Result             bool                   `protobuf:"varint,7,opt,name=result,proto3" json:"result,omitempty"`
+	Error              string                 `protobuf:"bytes,8,opt,name=error,proto3" json:"error,omitempty"` // 规则执行出错时的错误信息
+	Left               *RuleTrace             `protobuf:"bytes,9,opt,name=left,proto3" json:"left,omitempty"`
+	Right              *RuleTrace             `protobuf:"bytes,10,opt,name=right,proto3" json:"right,omitempty"`
+	ValueAttributeId   int64                  `protobuf:"varint,11,opt,name=value_attribute_id,json=valueAttributeId,proto3" json:"value_attribute_id,omitempty"` // 右侧引用的属性，此时 wanted_value 为该属性的值
+	ValueAttributeName string                 `protobuf:"bytes,12,opt,name=value_attribute_name,json=valueAttributeName,proto3" json:"value_attribute_name,omitempty"`
+	Indeterminate      bool                   `protobuf:"varint,13,opt,name=indeterminate,proto3" json:"indeterminate,omitempty"` // 无法判定，此时 result 为 false，原因见 error
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RuleTrace) Reset() {
+	*x = RuleTrace{}
+	mi := &file_permission_v1_permission_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RuleTrace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RuleTrace) ProtoMessage() {}
+
+func (x *RuleTrace) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RuleTrace.ProtoReflect.Descriptor instead.
+func (*RuleTrace) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RuleTrace) GetRuleId() int64 {
+	if x != nil {
+		return x.RuleId
+	}
+	return 0
+}
+
+func (x *RuleTrace) GetAttributeId() int64 {
+	if x != nil {
+		return x.AttributeId
+	}
+	return 0
+}
+
+func (x *RuleTrace) GetAttributeName() string {
+	if x != nil {
+		return x.AttributeName
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetWantedValue() string {
+	if x != nil {
+		return x.WantedValue
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetActualValue() string {
+	if x != nil {
+		return x.ActualValue
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetResult() bool {
+	if x != nil {
+		return x.Result
+	}
+	return false
+}
+
+func (x *RuleTrace) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetLeft() *RuleTrace {
+	if x != nil {
+		return x.Left
+	}
+	return nil
+}
+
+func (x *RuleTrace) GetRight() *RuleTrace {
+	if x != nil {
+		return x.Right
+	}
+	return nil
+}
+
+func (x *RuleTrace) GetValueAttributeId() int64 {
+	if x != nil {
+		return x.ValueAttributeId
+	}
+	return 0
+}
+
+func (x *RuleTrace) GetValueAttributeName() string {
+	if x != nil {
+		return x.ValueAttributeName
+	}
+	return ""
+}
+
+func (x *RuleTrace) GetIndeterminate() bool {
+	if x != nil {
+		return x.Indeterminate
+	}
+	return false
+}
+
+type BatchCheckPermissionRequest struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	Uid                   int64                  `protobuf:"varint,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	Permissions           []*Permission          `protobuf:"bytes,2,rep,name=permissions,proto3" json:"permissions,omitempty"` // 待校验的权限列表，每一项独立给出结果，最多 100 项
+	SubjectAttributes     map[string]string      `protobuf:"bytes,3,rep,name=subject_attributes,json=subjectAttributes,proto3" json:"subject_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ResourceAttributes    map[string]string      `protobuf:"bytes,4,rep,name=resource_attributes,json=resourceAttributes,proto3" json:"resource_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	EnvironmentAttributes map[string]string      `protobuf:"bytes,5,rep,name=environment_attributes,json=environmentAttributes,proto3" json:"environment_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *BatchCheckPermissionRequest) Reset() {
+	*x = BatchCheckPermissionRequest{}
+	mi := &file_permission_v1_permission_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCheckPermissionRequest) ProtoMessage() {}
+
+func (x *BatchCheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*BatchCheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *BatchCheckPermissionRequest) GetUid() int64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
+}
+
+func (x *BatchCheckPermissionRequest) GetPermissions() []*Permission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *BatchCheckPermissionRequest) GetSubjectAttributes() map[string]string {
+	if x != nil {
+		return x.SubjectAttributes
+	}
+	return nil
+}
+
+func (x *BatchCheckPermissionRequest) GetResourceAttributes() map[string]string {
+	if x != nil {
+		return x.ResourceAttributes
+	}
+	return nil
+}
+
+func (x *BatchCheckPermissionRequest) GetEnvironmentAttributes() map[string]string {
+	if x != nil {
+		return x.EnvironmentAttributes
+	}
+	return nil
+}
+
+type PermissionCheckResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permission    *Permission            `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
+	Allowed       bool                   `protobuf:"varint,2,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PermissionCheckResult) Reset() {
+	*x = PermissionCheckResult{}
+	mi := &file_permission_v1_permission_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionCheckResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionCheckResult) ProtoMessage() {}
+
+func (x *PermissionCheckResult) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionCheckResult.ProtoReflect.Descriptor instead.
+func (*PermissionCheckResult) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PermissionCheckResult) GetPermission() *Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+func (x *PermissionCheckResult) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+type BatchCheckPermissionResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Results       []*PermissionCheckResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"` // 与请求中 permissions 顺序一一对应
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchCheckPermissionResponse) Reset() {
+	*x = BatchCheckPermissionResponse{}
+	mi := &file_permission_v1_permission_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchCheckPermissionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchCheckPermissionResponse) ProtoMessage() {}
+
+func (x *BatchCheckPermissionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_permission_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchCheckPermissionResponse.ProtoReflect.Descriptor instead.
+func (*BatchCheckPermissionResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_permission_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *BatchCheckPermissionResponse) GetResults() []*PermissionCheckResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
 var File_permission_v1_permission_proto protoreflect.FileDescriptor
 
 const file_permission_v1_permission_proto_rawDesc = "" +
 	"\n" +
-	"\x1epermission/v1/permission.proto\x12\rpermission.v1\x1a\x18permission/v1/rbac.proto\"\x92\x05\n" +
+	"\x1epermission/v1/permission.proto\x12\rpermission.v1\x1a\x18permission/v1/rbac.proto\x1a\x17validate/validate.proto\"\xc1\x05\n" +
 	"\x16CheckPermissionRequest\x12\x10\n" +
 	"\x03uid\x18\x01 \x01(\x03R\x03uid\x129\n" +
 	"\n" +
@@ -153,7 +783,9 @@ const file_permission_v1_permission_proto_rawDesc = "" +
 	"permission\x12k\n" +
 	"\x12subject_attributes\x18\x03 \x03(\v2<.permission.v1.CheckPermissionRequest.SubjectAttributesEntryR\x11subjectAttributes\x12n\n" +
 	"\x13resource_attributes\x18\x04 \x03(\v2=.permission.v1.CheckPermissionRequest.ResourceAttributesEntryR\x12resourceAttributes\x12w\n" +
-	"\x16environment_attributes\x18\x05 \x03(\v2@.permission.v1.CheckPermissionRequest.EnvironmentAttributesEntryR\x15environmentAttributes\x1aD\n" +
+	"\x16environment_attributes\x18\x05 \x03(\v2@.permission.v1.CheckPermissionRequest.EnvironmentAttributesEntryR\x15environmentAttributes\x12\x18\n" +
+	"\aexplain\x18\x06 \x01(\bR\aexplain\x12\x13\n" +
+	"\x05as_of\x18\a \x01(\x03R\x04asOf\x1aD\n" +
 	"\x16SubjectAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aE\n" +
@@ -162,11 +794,72 @@ const file_permission_v1_permission_proto_rawDesc = "" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aH\n" +
 	"\x1aEnvironmentAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"3\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"d\n" +
 	"\x17CheckPermissionResponse\x12\x18\n" +
-	"\aallowed\x18\x01 \x01(\bR\aallowed2u\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12/\n" +
+	"\x05trace\x18\x02 \x01(\v2\x19.permission.v1.CheckTraceR\x05trace\"\x87\x01\n" +
+	"\n" +
+	"CheckTrace\x12,\n" +
+	"\x04rbac\x18\x01 \x01(\v2\x18.permission.v1.RBACTraceR\x04rbac\x12,\n" +
+	"\x04abac\x18\x02 \x01(\v2\x18.permission.v1.ABACTraceR\x04abac\x12\x1d\n" +
+	"\n" +
+	"check_mode\x18\x03 \x01(\tR\tcheckMode\"Y\n" +
+	"\tRBACTrace\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x122\n" +
+	"\amatches\x18\x02 \x03(\v2\x18.permission.v1.RBACMatchR\amatches\"p\n" +
+	"\tRBACMatch\x12F\n" +
+	"\x0fuser_permission\x18\x01 \x01(\v2\x1d.permission.v1.UserPermissionR\x0euserPermission\x12\x1b\n" +
+	"\trole_path\x18\x02 \x03(\x03R\brolePath\"]\n" +
+	"\tABACTrace\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x126\n" +
+	"\bpolicies\x18\x02 \x03(\v2\x1a.permission.v1.PolicyTraceR\bpolicies\"\xb9\x01\n" +
+	"\vPolicyTrace\x12\x1b\n" +
+	"\tpolicy_id\x18\x01 \x01(\x03R\bpolicyId\x12\x1f\n" +
+	"\vpolicy_name\x18\x02 \x01(\tR\n" +
+	"policyName\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\bR\x06result\x12.\n" +
+	"\x05rules\x18\x04 \x03(\v2\x18.permission.v1.RuleTraceR\x05rules\x12$\n" +
+	"\rindeterminate\x18\x05 \x01(\bR\rindeterminate\"\xe2\x03\n" +
+	"\tRuleTrace\x12\x17\n" +
+	"\arule_id\x18\x01 \x01(\x03R\x06ruleId\x12!\n" +
+	"\fattribute_id\x18\x02 \x01(\x03R\vattributeId\x12%\n" +
+	"\x0eattribute_name\x18\x03 \x01(\tR\rattributeName\x12\x1a\n" +
+	"\boperator\x18\x04 \x01(\tR\boperator\x12!\n" +
+	"\fwanted_value\x18\x05 \x01(\tR\vwantedValue\x12!\n" +
+	"\factual_value\x18\x06 \x01(\tR\vactualValue\x12\x16\n" +
+	"\x06result\x18\a \x01(\bR\x06result\x12\x14\n" +
+	"\x05error\x18\b \x01(\tR\x05error\x12,\n" +
+	"\x04left\x18\t \x01(\v2\x18.permission.v1.RuleTraceR\x04left\x12.\n" +
+	"\x05right\x18\n" +
+	" \x01(\v2\x18.permission.v1.RuleTraceR\x05right\x12,\n" +
+	"\x12value_attribute_id\x18\v \x01(\x03R\x10valueAttributeId\x120\n" +
+	"\x14value_attribute_name\x18\f \x01(\tR\x12valueAttributeName\x12$\n" +
+	"\rindeterminate\x18\r \x01(\bR\rindeterminate\"\xb2\x05\n" +
+	"\x1bBatchCheckPermissionRequest\x12\x10\n" +
+	"\x03uid\x18\x01 \x01(\x03R\x03uid\x12E\n" +
+	"\vpermissions\x18\x02 \x03(\v2\x19.permission.v1.PermissionB\b\xfaB\x05\x92\x01\x02\x10dR\vpermissions\x12p\n" +
+	"\x12subject_attributes\x18\x03 \x03(\v2A.permission.v1.BatchCheckPermissionRequest.SubjectAttributesEntryR\x11subjectAttributes\x12s\n" +
+	"\x13resource_attributes\x18\x04 \x03(\v2B.permission.v1.BatchCheckPermissionRequest.ResourceAttributesEntryR\x12resourceAttributes\x12|\n" +
+	"\x16environment_attributes\x18\x05 \x03(\v2E.permission.v1.BatchCheckPermissionRequest.EnvironmentAttributesEntryR\x15environmentAttributes\x1aD\n" +
+	"\x16SubjectAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aE\n" +
+	"\x17ResourceAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aH\n" +
+	"\x1aEnvironmentAttributesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"l\n" +
+	"\x15PermissionCheckResult\x129\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\v2\x19.permission.v1.PermissionR\n" +
+	"permission\x12\x18\n" +
+	"\aallowed\x18\x02 \x01(\bR\aallowed\"^\n" +
+	"\x1cBatchCheckPermissionResponse\x12>\n" +
+	"\aresults\x18\x01 \x03(\v2$.permission.v1.PermissionCheckResultR\aresults2\xe6\x01\n" +
 	"\x11PermissionService\x12`\n" +
-	"\x0fCheckPermission\x12%.permission.v1.CheckPermissionRequest\x1a&.permission.v1.CheckPermissionResponseB\xbd\x01\n" +
+	"\x0fCheckPermission\x12%.permission.v1.CheckPermissionRequest\x1a&.permission.v1.CheckPermissionResponse\x12o\n" +
+	"\x14BatchCheckPermission\x12*.permission.v1.BatchCheckPermissionRequest\x1a+.permission.v1.BatchCheckPermissionResponseB\xbd\x01\n" +
 	"\x11com.permission.v1B\x0fPermissionProtoP\x01ZBgithub.com/permission-dev/api/proto/gen/permission/v1;permissionv1\xa2\x02\x03PXX\xaa\x02\rPermission.V1\xca\x02\rPermission\\V1\xe2\x02\x19Permission\\V1\\GPBMetadata\xea\x02\x0ePermission::V1b\x06proto3"
 
 var (
@@ -181,27 +874,57 @@ func file_permission_v1_permission_proto_rawDescGZIP() []byte {
 	return file_permission_v1_permission_proto_rawDescData
 }
 
-var file_permission_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_permission_v1_permission_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_permission_v1_permission_proto_goTypes = []any{
-	(*CheckPermissionRequest)(nil),  // 0: permission.v1.CheckPermissionRequest
-	(*CheckPermissionResponse)(nil), // 1: permission.v1.CheckPermissionResponse
-	nil,                             // 2: permission.v1.CheckPermissionRequest.SubjectAttributesEntry
-	nil,                             // 3: permission.v1.CheckPermissionRequest.ResourceAttributesEntry
-	nil,                             // 4: permission.v1.CheckPermissionRequest.EnvironmentAttributesEntry
-	(*Permission)(nil),              // 5: permission.v1.Permission
+	(*CheckPermissionRequest)(nil),       // 0: permission.v1.CheckPermissionRequest
+	(*CheckPermissionResponse)(nil),      // 1: permission.v1.CheckPermissionResponse
+	(*CheckTrace)(nil),                   // 2: permission.v1.CheckTrace
+	(*RBACTrace)(nil),                    // 3: permission.v1.RBACTrace
+	(*RBACMatch)(nil),                    // 4: permission.v1.RBACMatch
+	(*ABACTrace)(nil),                    // 5: permission.v1.ABACTrace
+	(*PolicyTrace)(nil),                  // 6: permission.v1.PolicyTrace
+	(*RuleTrace)(nil),                    // 7: permission.v1.RuleTrace
+	(*BatchCheckPermissionRequest)(nil),  // 8: permission.v1.BatchCheckPermissionRequest
+	(*PermissionCheckResult)(nil),        // 9: permission.v1.PermissionCheckResult
+	(*BatchCheckPermissionResponse)(nil), // 10: permission.v1.BatchCheckPermissionResponse
+	nil,                                  // 11: permission.v1.CheckPermissionRequest.SubjectAttributesEntry
+	nil,                                  // 12: permission.v1.CheckPermissionRequest.ResourceAttributesEntry
+	nil,                                  // 13: permission.v1.CheckPermissionRequest.EnvironmentAttributesEntry
+	nil,                                  // 14: permission.v1.BatchCheckPermissionRequest.SubjectAttributesEntry
+	nil,                                  // 15: permission.v1.BatchCheckPermissionRequest.ResourceAttributesEntry
+	nil,                                  // 16: permission.v1.BatchCheckPermissionRequest.EnvironmentAttributesEntry
+	(*Permission)(nil),                   // 17: permission.v1.Permission
+	(*UserPermission)(nil),               // 18: permission.v1.UserPermission
 }
 var file_permission_v1_permission_proto_depIdxs = []int32{
-	5, // 0: permission.v1.CheckPermissionRequest.permission:type_name -> permission.v1.Permission
-	2, // 1: permission.v1.CheckPermissionRequest.subject_attributes:type_name -> permission.v1.CheckPermissionRequest.SubjectAttributesEntry
-	3, // 2: permission.v1.CheckPermissionRequest.resource_attributes:type_name -> permission.v1.CheckPermissionRequest.ResourceAttributesEntry
-	4, // 3: permission.v1.CheckPermissionRequest.environment_attributes:type_name -> permission.v1.CheckPermissionRequest.EnvironmentAttributesEntry
-	0, // 4: permission.v1.PermissionService.CheckPermission:input_type -> permission.v1.CheckPermissionRequest
-	1, // 5: permission.v1.PermissionService.CheckPermission:output_type -> permission.v1.CheckPermissionResponse
-	5, // [5:6] is the sub-list for method output_type
-	4, // [4:5] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	17, // 0: permission.v1.CheckPermissionRequest.permission:type_name -> permission.v1.Permission
+	11, // 1: permission.v1.CheckPermissionRequest.subject_attributes:type_name -> permission.v1.CheckPermissionRequest.SubjectAttributesEntry
+	12, // 2: permission.v1.CheckPermissionRequest.resource_attributes:type_name -> permission.v1.CheckPermissionRequest.ResourceAttributesEntry
+	13, // 3: permission.v1.CheckPermissionRequest.environment_attributes:type_name -> permission.v1.CheckPermissionRequest.EnvironmentAttributesEntry
+	2,  // 4: permission.v1.CheckPermissionResponse.trace:type_name -> permission.v1.CheckTrace
+	3,  // 5: permission.v1.CheckTrace.rbac:type_name -> permission.v1.RBACTrace
+	5,  // 6: permission.v1.CheckTrace.abac:type_name -> permission.v1.ABACTrace
+	4,  // 7: permission.v1.RBACTrace.matches:type_name -> permission.v1.RBACMatch
+	18, // 8: permission.v1.RBACMatch.user_permission:type_name -> permission.v1.UserPermission
+	6,  // 9: permission.v1.ABACTrace.policies:type_name -> permission.v1.PolicyTrace
+	7,  // 10: permission.v1.PolicyTrace.rules:type_name -> permission.v1.RuleTrace
+	7,  // 11: permission.v1.RuleTrace.left:type_name -> permission.v1.RuleTrace
+	7,  // 12: permission.v1.RuleTrace.right:type_name -> permission.v1.RuleTrace
+	17, // 13: permission.v1.BatchCheckPermissionRequest.permissions:type_name -> permission.v1.Permission
+	14, // 14: permission.v1.BatchCheckPermissionRequest.subject_attributes:type_name -> permission.v1.BatchCheckPermissionRequest.SubjectAttributesEntry
+	15, // 15: permission.v1.BatchCheckPermissionRequest.resource_attributes:type_name -> permission.v1.BatchCheckPermissionRequest.ResourceAttributesEntry
+	16, // 16: permission.v1.BatchCheckPermissionRequest.environment_attributes:type_name -> permission.v1.BatchCheckPermissionRequest.EnvironmentAttributesEntry
+	17, // 17: permission.v1.PermissionCheckResult.permission:type_name -> permission.v1.Permission
+	9,  // 18: permission.v1.BatchCheckPermissionResponse.results:type_name -> permission.v1.PermissionCheckResult
+	0,  // 19: permission.v1.PermissionService.CheckPermission:input_type -> permission.v1.CheckPermissionRequest
+	8,  // 20: permission.v1.PermissionService.BatchCheckPermission:input_type -> permission.v1.BatchCheckPermissionRequest
+	1,  // 21: permission.v1.PermissionService.CheckPermission:output_type -> permission.v1.CheckPermissionResponse
+	10, // 22: permission.v1.PermissionService.BatchCheckPermission:output_type -> permission.v1.BatchCheckPermissionResponse
+	21, // [21:23] is the sub-list for method output_type
+	19, // [19:21] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_permission_v1_permission_proto_init() }
@@ -216,7 +939,7 @@ func file_permission_v1_permission_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permission_v1_permission_proto_rawDesc), len(file_permission_v1_permission_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -94,6 +94,10 @@ func (m *CheckPermissionRequest) validate(all bool) error {
 
 	// no validation rules for EnvironmentAttributes
 
+	// no validation rules for Explain
+
+	// no validation rules for AsOf
+
 	if len(errors) > 0 {
 		return CheckPermissionRequestMultiError(errors)
 	}
@@ -198,6 +202,35 @@ func (m *CheckPermissionResponse) validate(all bool) error {
 
 	// no validation rules for Allowed
 
+	if all {
+		switch v := interface{}(m.GetTrace()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckPermissionResponseValidationError{
+					field:  "Trace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckPermissionResponseValidationError{
+					field:  "Trace",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTrace()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckPermissionResponseValidationError{
+				field:  "Trace",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CheckPermissionResponseMultiError(errors)
 	}
@@ -277,3 +310,1306 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = CheckPermissionResponseValidationError{}
+
+// Validate checks the field values on CheckTrace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *CheckTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in CheckTraceMultiError, or
+// nil if none found.
+func (m *CheckTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetRbac()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckTraceValidationError{
+					field:  "Rbac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckTraceValidationError{
+					field:  "Rbac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRbac()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckTraceValidationError{
+				field:  "Rbac",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetAbac()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckTraceValidationError{
+					field:  "Abac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckTraceValidationError{
+					field:  "Abac",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAbac()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckTraceValidationError{
+				field:  "Abac",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for CheckMode
+
+	if len(errors) > 0 {
+		return CheckTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckTraceMultiError is an error wrapping multiple validation errors
+// returned by CheckTrace.ValidateAll() if the designated constraints aren't met.
+type CheckTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckTraceMultiError) AllErrors() []error { return m }
+
+// CheckTraceValidationError is the validation error returned by
+// CheckTrace.Validate if the designated constraints aren't met.
+type CheckTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckTraceValidationError) ErrorName() string { return "CheckTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CheckTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckTraceValidationError{}
+
+// Validate checks the field values on RBACTrace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RBACTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RBACTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RBACTraceMultiError, or nil
+// if none found.
+func (m *RBACTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RBACTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	for idx, item := range m.GetMatches() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RBACTraceValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RBACTraceValidationError{
+						field:  fmt.Sprintf("Matches[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RBACTraceValidationError{
+					field:  fmt.Sprintf("Matches[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RBACTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// RBACTraceMultiError is an error wrapping multiple validation errors returned
+// by RBACTrace.ValidateAll() if the designated constraints aren't met.
+type RBACTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RBACTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RBACTraceMultiError) AllErrors() []error { return m }
+
+// RBACTraceValidationError is the validation error returned by
+// RBACTrace.Validate if the designated constraints aren't met.
+type RBACTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RBACTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RBACTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RBACTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RBACTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RBACTraceValidationError) ErrorName() string { return "RBACTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RBACTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRBACTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RBACTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RBACTraceValidationError{}
+
+// Validate checks the field values on RBACMatch with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RBACMatch) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RBACMatch with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RBACMatchMultiError, or nil
+// if none found.
+func (m *RBACMatch) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RBACMatch) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetUserPermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RBACMatchValidationError{
+					field:  "UserPermission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RBACMatchValidationError{
+					field:  "UserPermission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUserPermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RBACMatchValidationError{
+				field:  "UserPermission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return RBACMatchMultiError(errors)
+	}
+
+	return nil
+}
+
+// RBACMatchMultiError is an error wrapping multiple validation errors returned
+// by RBACMatch.ValidateAll() if the designated constraints aren't met.
+type RBACMatchMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RBACMatchMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RBACMatchMultiError) AllErrors() []error { return m }
+
+// RBACMatchValidationError is the validation error returned by
+// RBACMatch.Validate if the designated constraints aren't met.
+type RBACMatchValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RBACMatchValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RBACMatchValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RBACMatchValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RBACMatchValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RBACMatchValidationError) ErrorName() string { return "RBACMatchValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RBACMatchValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRBACMatch.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RBACMatchValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RBACMatchValidationError{}
+
+// Validate checks the field values on ABACTrace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *ABACTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ABACTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ABACTraceMultiError, or nil
+// if none found.
+func (m *ABACTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ABACTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ABACTraceValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ABACTraceValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ABACTraceValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ABACTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ABACTraceMultiError is an error wrapping multiple validation errors returned
+// by ABACTrace.ValidateAll() if the designated constraints aren't met.
+type ABACTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ABACTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ABACTraceMultiError) AllErrors() []error { return m }
+
+// ABACTraceValidationError is the validation error returned by
+// ABACTrace.Validate if the designated constraints aren't met.
+type ABACTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ABACTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ABACTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ABACTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ABACTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ABACTraceValidationError) ErrorName() string { return "ABACTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ABACTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sABACTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ABACTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ABACTraceValidationError{}
+
+// Validate checks the field values on PolicyTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PolicyTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PolicyTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PolicyTraceMultiError, or
+// nil if none found.
+func (m *PolicyTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PolicyTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PolicyId
+
+	// no validation rules for PolicyName
+
+	// no validation rules for Result
+
+	for idx, item := range m.GetRules() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PolicyTraceValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PolicyTraceValidationError{
+						field:  fmt.Sprintf("Rules[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PolicyTraceValidationError{
+					field:  fmt.Sprintf("Rules[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Indeterminate
+
+	if len(errors) > 0 {
+		return PolicyTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// PolicyTraceMultiError is an error wrapping multiple validation errors
+// returned by PolicyTrace.ValidateAll() if the designated constraints aren't met.
+type PolicyTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PolicyTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PolicyTraceMultiError) AllErrors() []error { return m }
+
+// PolicyTraceValidationError is the validation error returned by
+// PolicyTrace.Validate if the designated constraints aren't met.
+type PolicyTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PolicyTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PolicyTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PolicyTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PolicyTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PolicyTraceValidationError) ErrorName() string { return "PolicyTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PolicyTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPolicyTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PolicyTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PolicyTraceValidationError{}
+
+// Validate checks the field values on RuleTrace with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RuleTrace) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RuleTrace with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RuleTraceMultiError, or nil
+// if none found.
+func (m *RuleTrace) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RuleTrace) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RuleId
+
+	// no validation rules for AttributeId
+
+	// no validation rules for AttributeName
+
+	// no validation rules for Operator
+
+	// no validation rules for WantedValue
+
+	// no validation rules for ActualValue
+
+	// no validation rules for Result
+
+	// no validation rules for Error
+
+	if all {
+		switch v := interface{}(m.GetLeft()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuleTraceValidationError{
+					field:  "Left",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuleTraceValidationError{
+					field:  "Left",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetLeft()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleTraceValidationError{
+				field:  "Left",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetRight()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, RuleTraceValidationError{
+					field:  "Right",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, RuleTraceValidationError{
+					field:  "Right",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetRight()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return RuleTraceValidationError{
+				field:  "Right",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for ValueAttributeId
+
+	// no validation rules for ValueAttributeName
+
+	// no validation rules for Indeterminate
+
+	if len(errors) > 0 {
+		return RuleTraceMultiError(errors)
+	}
+
+	return nil
+}
+
+// RuleTraceMultiError is an error wrapping multiple validation errors returned
+// by RuleTrace.ValidateAll() if the designated constraints aren't met.
+type RuleTraceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RuleTraceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RuleTraceMultiError) AllErrors() []error { return m }
+
+// RuleTraceValidationError is the validation error returned by
+// RuleTrace.Validate if the designated constraints aren't met.
+type RuleTraceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RuleTraceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RuleTraceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RuleTraceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RuleTraceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RuleTraceValidationError) ErrorName() string { return "RuleTraceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RuleTraceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRuleTrace.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RuleTraceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RuleTraceValidationError{}
+
+// Validate checks the field values on BatchCheckPermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCheckPermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCheckPermissionRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCheckPermissionRequestMultiError, or nil if none found.
+func (m *BatchCheckPermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCheckPermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Uid
+
+	if len(m.GetPermissions()) > 100 {
+		err := BatchCheckPermissionRequestValidationError{
+			field:  "Permissions",
+			reason: "value must contain no more than 100 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCheckPermissionRequestValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCheckPermissionRequestValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCheckPermissionRequestValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for SubjectAttributes
+
+	// no validation rules for ResourceAttributes
+
+	// no validation rules for EnvironmentAttributes
+
+	if len(errors) > 0 {
+		return BatchCheckPermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCheckPermissionRequestMultiError is an error wrapping multiple
+// validation errors returned by BatchCheckPermissionRequest.ValidateAll() if
+// the designated constraints aren't met.
+type BatchCheckPermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCheckPermissionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCheckPermissionRequestMultiError) AllErrors() []error { return m }
+
+// BatchCheckPermissionRequestValidationError is the validation error returned
+// by BatchCheckPermissionRequest.Validate if the designated constraints
+// aren't met.
+type BatchCheckPermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCheckPermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCheckPermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCheckPermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCheckPermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCheckPermissionRequestValidationError) ErrorName() string {
+	return "BatchCheckPermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCheckPermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCheckPermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCheckPermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCheckPermissionRequestValidationError{}
+
+// Validate checks the field values on PermissionCheckResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PermissionCheckResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionCheckResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionCheckResultMultiError, or nil if none found.
+func (m *PermissionCheckResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PermissionCheckResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PermissionCheckResultValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PermissionCheckResultValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PermissionCheckResultValidationError{
+				field:  "Permission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Allowed
+
+	if len(errors) > 0 {
+		return PermissionCheckResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// PermissionCheckResultMultiError is an error wrapping multiple validation
+// errors returned by PermissionCheckResult.ValidateAll() if the designated
+// constraints aren't met.
+type PermissionCheckResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionCheckResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionCheckResultMultiError) AllErrors() []error { return m }
+
+// PermissionCheckResultValidationError is the validation error returned by
+// PermissionCheckResult.Validate if the designated constraints aren't met.
+type PermissionCheckResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionCheckResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionCheckResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionCheckResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionCheckResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionCheckResultValidationError) ErrorName() string {
+	return "PermissionCheckResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PermissionCheckResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionCheckResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionCheckResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionCheckResultValidationError{}
+
+// Validate checks the field values on BatchCheckPermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *BatchCheckPermissionResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchCheckPermissionResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchCheckPermissionResponseMultiError, or nil if none found.
+func (m *BatchCheckPermissionResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchCheckPermissionResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetResults() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchCheckPermissionResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchCheckPermissionResponseValidationError{
+						field:  fmt.Sprintf("Results[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchCheckPermissionResponseValidationError{
+					field:  fmt.Sprintf("Results[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchCheckPermissionResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchCheckPermissionResponseMultiError is an error wrapping multiple
+// validation errors returned by BatchCheckPermissionResponse.ValidateAll() if
+// the designated constraints aren't met.
+type BatchCheckPermissionResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchCheckPermissionResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchCheckPermissionResponseMultiError) AllErrors() []error { return m }
+
+// BatchCheckPermissionResponseValidationError is the validation error returned
+// by BatchCheckPermissionResponse.Validate if the designated constraints
+// aren't met.
+type BatchCheckPermissionResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchCheckPermissionResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchCheckPermissionResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchCheckPermissionResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchCheckPermissionResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchCheckPermissionResponseValidationError) ErrorName() string {
+	return "BatchCheckPermissionResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchCheckPermissionResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchCheckPermissionResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchCheckPermissionResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchCheckPermissionResponseValidationError{}
@@ -1153,18 +1153,19 @@ func (x *ListResourcesResponse) GetResources() []*Resource {
 
 // 权限定义（资源 + 操作）
 type Permission struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	BizId         int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
-	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	ResourceId    int64                  `protobuf:"varint,5,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
-	ResourceType  string                 `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // 资源类型
-	ResourceKey   string                 `protobuf:"bytes,7,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`    // 资源标识符，类似于 /xxx/xxx/xxx 的格式
-	Actions       []string               `protobuf:"bytes,8,rep,name=actions,proto3" json:"actions,omitempty"`                               // 允许的操作列表
-	Metadata      string                 `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	Id                 int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	BizId              int64                  `protobuf:"varint,2,opt,name=biz_id,json=bizId,proto3" json:"biz_id,omitempty"`
+	Name               string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description        string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	ResourceId         int64                  `protobuf:"varint,5,opt,name=resource_id,json=resourceId,proto3" json:"resource_id,omitempty"`
+	ResourceType       string                 `protobuf:"bytes,6,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"` // 资源类型
+	ResourceKey        string                 `protobuf:"bytes,7,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`    // 资源标识符，类似于 /xxx/xxx/xxx 的格式
+	Actions            []string               `protobuf:"bytes,8,rep,name=actions,proto3" json:"actions,omitempty"`                               // 允许的操作列表
+	Metadata           string                 `protobuf:"bytes,9,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	CombiningAlgorithm string                 `protobuf:"bytes,10,opt,name=combining_algorithm,json=combiningAlgorithm,proto3" json:"combining_algorithm,omitempty"` // ABAC 策略合并算法，为空时使用业务配置
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *Permission) Reset() {
@@ -1260,6 +1261,13 @@ func (x *Permission) GetMetadata() string {
 	return ""
 }
 
+func (x *Permission) GetCombiningAlgorithm() string {
+	if x != nil {
+		return x.CombiningAlgorithm
+	}
+	return ""
+}
+
 type CreatePermissionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Permission    *Permission            `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
@@ -3476,23 +3484,459 @@ func (x *GetAllPermissionsResponse) GetUserPermissions() []*UserPermission {
 	return nil
 }
 
-type BusinessConfig struct {
+type GetEffectivePermissionsRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	ResourceType      string                 `protobuf:"bytes,2,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`                  // 按资源类型过滤，为空不过滤
+	ResourceKeyPrefix string                 `protobuf:"bytes,3,opt,name=resource_key_prefix,json=resourceKeyPrefix,proto3" json:"resource_key_prefix,omitempty"` // 按资源标识前缀过滤，为空不过滤
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetEffectivePermissionsRequest) Reset() {
+	*x = GetEffectivePermissionsRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePermissionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePermissionsRequest) ProtoMessage() {}
+
+func (x *GetEffectivePermissionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePermissionsRequest.ProtoReflect.Descriptor instead.
+func (*GetEffectivePermissionsRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetEffectivePermissionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetEffectivePermissionsRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *GetEffectivePermissionsRequest) GetResourceKeyPrefix() string {
+	if x != nil {
+		return x.ResourceKeyPrefix
+	}
+	return ""
+}
+
+// PermissionSource 权限的来源
+type PermissionSource struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // 业务ID
-	OwnerId       int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`       // 业务方ID
-	OwnerType     string                 `protobuf:"bytes,3,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"`  // 业务方类型
-	Name          string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                             // 业务名称
-	RateLimit     int32                  `protobuf:"varint,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"` // 每秒最大请求数
-	Token         string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`                           // 业务方Token，内部包含bizID也就是上方的id
-	Ctime         int64                  `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`                          // 创建时间戳
-	Utime         int64                  `protobuf:"varint,8,opt,name=utime,proto3" json:"utime,omitempty"`                          // 更新时间戳
+	Direct        bool                   `protobuf:"varint,1,opt,name=direct,proto3" json:"direct,omitempty"`                            // 是否直接授予用户
+	RoleId        int64                  `protobuf:"varint,2,opt,name=role_id,json=roleId,proto3" json:"role_id,omitempty"`              // 授予该权限的角色，直接授予时为 0
+	RolePath      []int64                `protobuf:"varint,3,rep,packed,name=role_path,json=rolePath,proto3" json:"role_path,omitempty"` // 从用户直接拥有的角色到 role_id 的包含路径
+	Effect        string                 `protobuf:"bytes,4,opt,name=effect,proto3" json:"effect,omitempty"`
+	StartTime     int64                  `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime       int64                  `protobuf:"varint,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *PermissionSource) Reset() {
+	*x = PermissionSource{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PermissionSource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionSource) ProtoMessage() {}
+
+func (x *PermissionSource) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionSource.ProtoReflect.Descriptor instead.
+func (*PermissionSource) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *PermissionSource) GetDirect() bool {
+	if x != nil {
+		return x.Direct
+	}
+	return false
+}
+
+func (x *PermissionSource) GetRoleId() int64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *PermissionSource) GetRolePath() []int64 {
+	if x != nil {
+		return x.RolePath
+	}
+	return nil
+}
+
+func (x *PermissionSource) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *PermissionSource) GetStartTime() int64 {
+	if x != nil {
+		return x.StartTime
+	}
+	return 0
+}
+
+func (x *PermissionSource) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+// EffectivePermission 当前生效的权限，同一资源和操作上 deny 优先
+type EffectivePermission struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permission    *Permission            `protobuf:"bytes,1,opt,name=permission,proto3" json:"permission,omitempty"`
+	Effect        string                 `protobuf:"bytes,2,opt,name=effect,proto3" json:"effect,omitempty"`
+	Sources       []*PermissionSource    `protobuf:"bytes,3,rep,name=sources,proto3" json:"sources,omitempty"` // 产生该效果的全部来源
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EffectivePermission) Reset() {
+	*x = EffectivePermission{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EffectivePermission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EffectivePermission) ProtoMessage() {}
+
+func (x *EffectivePermission) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EffectivePermission.ProtoReflect.Descriptor instead.
+func (*EffectivePermission) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EffectivePermission) GetPermission() *Permission {
+	if x != nil {
+		return x.Permission
+	}
+	return nil
+}
+
+func (x *EffectivePermission) GetEffect() string {
+	if x != nil {
+		return x.Effect
+	}
+	return ""
+}
+
+func (x *EffectivePermission) GetSources() []*PermissionSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type GetEffectivePermissionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Permissions   []*EffectivePermission `protobuf:"bytes,1,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEffectivePermissionsResponse) Reset() {
+	*x = GetEffectivePermissionsResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEffectivePermissionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEffectivePermissionsResponse) ProtoMessage() {}
+
+func (x *GetEffectivePermissionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEffectivePermissionsResponse.ProtoReflect.Descriptor instead.
+func (*GetEffectivePermissionsResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *GetEffectivePermissionsResponse) GetPermissions() []*EffectivePermission {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+type WhoCanAccessRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ResourceType  string                 `protobuf:"bytes,1,opt,name=resource_type,json=resourceType,proto3" json:"resource_type,omitempty"`
+	ResourceKey   string                 `protobuf:"bytes,2,opt,name=resource_key,json=resourceKey,proto3" json:"resource_key,omitempty"`
+	Action        string                 `protobuf:"bytes,3,opt,name=action,proto3" json:"action,omitempty"`
+	Offset        int32                  `protobuf:"varint,4,opt,name=offset,proto3" json:"offset,omitempty"`
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WhoCanAccessRequest) Reset() {
+	*x = WhoCanAccessRequest{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhoCanAccessRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoCanAccessRequest) ProtoMessage() {}
+
+func (x *WhoCanAccessRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoCanAccessRequest.ProtoReflect.Descriptor instead.
+func (*WhoCanAccessRequest) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *WhoCanAccessRequest) GetResourceType() string {
+	if x != nil {
+		return x.ResourceType
+	}
+	return ""
+}
+
+func (x *WhoCanAccessRequest) GetResourceKey() string {
+	if x != nil {
+		return x.ResourceKey
+	}
+	return ""
+}
+
+func (x *WhoCanAccessRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *WhoCanAccessRequest) GetOffset() int32 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *WhoCanAccessRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// UserAccess 能访问资源的用户以及授予访问权的来源
+type UserAccess struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Sources       []*PermissionSource    `protobuf:"bytes,2,rep,name=sources,proto3" json:"sources,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserAccess) Reset() {
+	*x = UserAccess{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserAccess) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserAccess) ProtoMessage() {}
+
+func (x *UserAccess) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserAccess.ProtoReflect.Descriptor instead.
+func (*UserAccess) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *UserAccess) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UserAccess) GetSources() []*PermissionSource {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+type WhoCanAccessResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserAccess          `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WhoCanAccessResponse) Reset() {
+	*x = WhoCanAccessResponse{}
+	mi := &file_permission_v1_rbac_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WhoCanAccessResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WhoCanAccessResponse) ProtoMessage() {}
+
+func (x *WhoCanAccessResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_permission_v1_rbac_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WhoCanAccessResponse.ProtoReflect.Descriptor instead.
+func (*WhoCanAccessResponse) Descriptor() ([]byte, []int) {
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *WhoCanAccessResponse) GetUsers() []*UserAccess {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *WhoCanAccessResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type BusinessConfig struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                // 业务ID
+	OwnerId   int64                  `protobuf:"varint,2,opt,name=owner_id,json=ownerId,proto3" json:"owner_id,omitempty"`       // 业务方ID
+	OwnerType string                 `protobuf:"bytes,3,opt,name=owner_type,json=ownerType,proto3" json:"owner_type,omitempty"`  // 业务方类型
+	Name      string                 `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`                             // 业务名称
+	RateLimit int32                  `protobuf:"varint,5,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit,omitempty"` // 每秒最大请求数
+	Token     string                 `protobuf:"bytes,6,opt,name=token,proto3" json:"token,omitempty"`                           // 业务方Token，内部包含bizID也就是上方的id
+	Ctime     int64                  `protobuf:"varint,7,opt,name=ctime,proto3" json:"ctime,omitempty"`                          // 创建时间戳
+	Utime     int64                  `protobuf:"varint,8,opt,name=utime,proto3" json:"utime,omitempty"`                          // 更新时间戳
+	CheckMode string                 `protobuf:"bytes,9,opt,name=check_mode,json=checkMode,proto3" json:"check_mode,omitempty"`  // 权限校验模式：rbac（默认）、abac、rbac_and_abac、rbac_or_abac
+	// ABAC 策略合并算法：deny_overrides（默认）、permit_overrides、first_applicable、
+	// only_one_applicable、deny_unless_permit、permit_unless_deny
+	CombiningAlgorithm    string `protobuf:"bytes,10,opt,name=combining_algorithm,json=combiningAlgorithm,proto3" json:"combining_algorithm,omitempty"`
+	NotApplicableDecision string `protobuf:"bytes,11,opt,name=not_applicable_decision,json=notApplicableDecision,proto3" json:"not_applicable_decision,omitempty"` // ABAC 没有适用策略时的结果：deny（默认）、allow
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
 func (x *BusinessConfig) Reset() {
 	*x = BusinessConfig{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[65]
+	mi := &file_permission_v1_rbac_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3504,7 +3948,7 @@ func (x *BusinessConfig) String() string {
 func (*BusinessConfig) ProtoMessage() {}
 
 func (x *BusinessConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[65]
+	mi := &file_permission_v1_rbac_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3517,7 +3961,7 @@ func (x *BusinessConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BusinessConfig.ProtoReflect.Descriptor instead.
 func (*BusinessConfig) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{65}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *BusinessConfig) GetId() int64 {
@@ -3576,6 +4020,27 @@ func (x *BusinessConfig) GetUtime() int64 {
 	return 0
 }
 
+func (x *BusinessConfig) GetCheckMode() string {
+	if x != nil {
+		return x.CheckMode
+	}
+	return ""
+}
+
+func (x *BusinessConfig) GetCombiningAlgorithm() string {
+	if x != nil {
+		return x.CombiningAlgorithm
+	}
+	return ""
+}
+
+func (x *BusinessConfig) GetNotApplicableDecision() string {
+	if x != nil {
+		return x.NotApplicableDecision
+	}
+	return ""
+}
+
 type CreateBusinessConfigRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Config        *BusinessConfig        `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
@@ -3585,7 +4050,7 @@ type CreateBusinessConfigRequest struct {
 
 func (x *CreateBusinessConfigRequest) Reset() {
 	*x = CreateBusinessConfigRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[66]
+	mi := &file_permission_v1_rbac_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3597,7 +4062,7 @@ func (x *CreateBusinessConfigRequest) String() string {
 func (*CreateBusinessConfigRequest) ProtoMessage() {}
 
 func (x *CreateBusinessConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[66]
+	mi := &file_permission_v1_rbac_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3610,7 +4075,7 @@ func (x *CreateBusinessConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBusinessConfigRequest.ProtoReflect.Descriptor instead.
 func (*CreateBusinessConfigRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{66}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *CreateBusinessConfigRequest) GetConfig() *BusinessConfig {
@@ -3629,7 +4094,7 @@ type CreateBusinessConfigResponse struct {
 
 func (x *CreateBusinessConfigResponse) Reset() {
 	*x = CreateBusinessConfigResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[67]
+	mi := &file_permission_v1_rbac_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3641,7 +4106,7 @@ func (x *CreateBusinessConfigResponse) String() string {
 func (*CreateBusinessConfigResponse) ProtoMessage() {}
 
 func (x *CreateBusinessConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[67]
+	mi := &file_permission_v1_rbac_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3654,7 +4119,7 @@ func (x *CreateBusinessConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateBusinessConfigResponse.ProtoReflect.Descriptor instead.
 func (*CreateBusinessConfigResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{67}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *CreateBusinessConfigResponse) GetConfig() *BusinessConfig {
@@ -3674,7 +4139,7 @@ type GetBusinessConfigRequest struct {
 
 func (x *GetBusinessConfigRequest) Reset() {
 	*x = GetBusinessConfigRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[68]
+	mi := &file_permission_v1_rbac_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3686,7 +4151,7 @@ func (x *GetBusinessConfigRequest) String() string {
 func (*GetBusinessConfigRequest) ProtoMessage() {}
 
 func (x *GetBusinessConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[68]
+	mi := &file_permission_v1_rbac_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3699,7 +4164,7 @@ func (x *GetBusinessConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBusinessConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetBusinessConfigRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{68}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *GetBusinessConfigRequest) GetBizId() int64 {
@@ -3725,7 +4190,7 @@ type GetBusinessConfigResponse struct {
 
 func (x *GetBusinessConfigResponse) Reset() {
 	*x = GetBusinessConfigResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[69]
+	mi := &file_permission_v1_rbac_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3737,7 +4202,7 @@ func (x *GetBusinessConfigResponse) String() string {
 func (*GetBusinessConfigResponse) ProtoMessage() {}
 
 func (x *GetBusinessConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[69]
+	mi := &file_permission_v1_rbac_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3750,7 +4215,7 @@ func (x *GetBusinessConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetBusinessConfigResponse.ProtoReflect.Descriptor instead.
 func (*GetBusinessConfigResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{69}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *GetBusinessConfigResponse) GetConfig() *BusinessConfig {
@@ -3761,15 +4226,16 @@ func (x *GetBusinessConfigResponse) GetConfig() *BusinessConfig {
 }
 
 type UpdateBusinessConfigRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Config        *BusinessConfig        `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// check_mode、combining_algorithm、not_applicable_decision 为空时保留原有的值
+	Config        *BusinessConfig `protobuf:"bytes,1,opt,name=config,proto3" json:"config,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *UpdateBusinessConfigRequest) Reset() {
 	*x = UpdateBusinessConfigRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[70]
+	mi := &file_permission_v1_rbac_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3781,7 +4247,7 @@ func (x *UpdateBusinessConfigRequest) String() string {
 func (*UpdateBusinessConfigRequest) ProtoMessage() {}
 
 func (x *UpdateBusinessConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[70]
+	mi := &file_permission_v1_rbac_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3794,7 +4260,7 @@ func (x *UpdateBusinessConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBusinessConfigRequest.ProtoReflect.Descriptor instead.
 func (*UpdateBusinessConfigRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{70}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *UpdateBusinessConfigRequest) GetConfig() *BusinessConfig {
@@ -3813,7 +4279,7 @@ type UpdateBusinessConfigResponse struct {
 
 func (x *UpdateBusinessConfigResponse) Reset() {
 	*x = UpdateBusinessConfigResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[71]
+	mi := &file_permission_v1_rbac_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3825,7 +4291,7 @@ func (x *UpdateBusinessConfigResponse) String() string {
 func (*UpdateBusinessConfigResponse) ProtoMessage() {}
 
 func (x *UpdateBusinessConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[71]
+	mi := &file_permission_v1_rbac_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3838,7 +4304,7 @@ func (x *UpdateBusinessConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateBusinessConfigResponse.ProtoReflect.Descriptor instead.
 func (*UpdateBusinessConfigResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{71}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *UpdateBusinessConfigResponse) GetSuccess() bool {
@@ -3858,7 +4324,7 @@ type DeleteBusinessConfigRequest struct {
 
 func (x *DeleteBusinessConfigRequest) Reset() {
 	*x = DeleteBusinessConfigRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[72]
+	mi := &file_permission_v1_rbac_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3870,7 +4336,7 @@ func (x *DeleteBusinessConfigRequest) String() string {
 func (*DeleteBusinessConfigRequest) ProtoMessage() {}
 
 func (x *DeleteBusinessConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[72]
+	mi := &file_permission_v1_rbac_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3883,7 +4349,7 @@ func (x *DeleteBusinessConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBusinessConfigRequest.ProtoReflect.Descriptor instead.
 func (*DeleteBusinessConfigRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{72}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *DeleteBusinessConfigRequest) GetBizId() int64 {
@@ -3909,7 +4375,7 @@ type DeleteBusinessConfigResponse struct {
 
 func (x *DeleteBusinessConfigResponse) Reset() {
 	*x = DeleteBusinessConfigResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[73]
+	mi := &file_permission_v1_rbac_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3921,7 +4387,7 @@ func (x *DeleteBusinessConfigResponse) String() string {
 func (*DeleteBusinessConfigResponse) ProtoMessage() {}
 
 func (x *DeleteBusinessConfigResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[73]
+	mi := &file_permission_v1_rbac_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3934,7 +4400,7 @@ func (x *DeleteBusinessConfigResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteBusinessConfigResponse.ProtoReflect.Descriptor instead.
 func (*DeleteBusinessConfigResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{73}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *DeleteBusinessConfigResponse) GetSuccess() bool {
@@ -3954,7 +4420,7 @@ type ListBusinessConfigsRequest struct {
 
 func (x *ListBusinessConfigsRequest) Reset() {
 	*x = ListBusinessConfigsRequest{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[74]
+	mi := &file_permission_v1_rbac_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3966,7 +4432,7 @@ func (x *ListBusinessConfigsRequest) String() string {
 func (*ListBusinessConfigsRequest) ProtoMessage() {}
 
 func (x *ListBusinessConfigsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[74]
+	mi := &file_permission_v1_rbac_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3979,7 +4445,7 @@ func (x *ListBusinessConfigsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBusinessConfigsRequest.ProtoReflect.Descriptor instead.
 func (*ListBusinessConfigsRequest) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{74}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *ListBusinessConfigsRequest) GetOffset() int32 {
@@ -4005,7 +4471,7 @@ type ListBusinessConfigsResponse struct {
 
 func (x *ListBusinessConfigsResponse) Reset() {
 	*x = ListBusinessConfigsResponse{}
-	mi := &file_permission_v1_rbac_proto_msgTypes[75]
+	mi := &file_permission_v1_rbac_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4017,7 +4483,7 @@ func (x *ListBusinessConfigsResponse) String() string {
 func (*ListBusinessConfigsResponse) ProtoMessage() {}
 
 func (x *ListBusinessConfigsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_permission_v1_rbac_proto_msgTypes[75]
+	mi := &file_permission_v1_rbac_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4030,7 +4496,7 @@ func (x *ListBusinessConfigsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ListBusinessConfigsResponse.ProtoReflect.Descriptor instead.
 func (*ListBusinessConfigsResponse) Descriptor() ([]byte, []int) {
-	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{75}
+	return file_permission_v1_rbac_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *ListBusinessConfigsResponse) GetConfigs() []*BusinessConfig {
@@ -4108,7 +4574,7 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x06offset\x18\x02 \x01(\x05R\x06offset\x12\x14\n" +
 	"\x05limit\x18\x03 \x01(\x05R\x05limit\"N\n" +
 	"\x15ListResourcesResponse\x125\n" +
-	"\tresources\x18\x01 \x03(\v2\x17.permission.v1.ResourceR\tresources\"\x88\x02\n" +
+	"\tresources\x18\x01 \x03(\v2\x17.permission.v1.ResourceR\tresources\"\xb9\x02\n" +
 	"\n" +
 	"Permission\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
@@ -4120,7 +4586,9 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\rresource_type\x18\x06 \x01(\tR\fresourceType\x12!\n" +
 	"\fresource_key\x18\a \x01(\tR\vresourceKey\x12\x18\n" +
 	"\aactions\x18\b \x03(\tR\aactions\x12\x1a\n" +
-	"\bmetadata\x18\t \x01(\tR\bmetadata\"T\n" +
+	"\bmetadata\x18\t \x01(\tR\bmetadata\x12/\n" +
+	"\x13combining_algorithm\x18\n" +
+	" \x01(\tR\x12combiningAlgorithm\"T\n" +
 	"\x17CreatePermissionRequest\x129\n" +
 	"\n" +
 	"permission\x18\x01 \x01(\v2\x19.permission.v1.PermissionR\n" +
@@ -4262,7 +4730,40 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x06biz_id\x18\x01 \x01(\x03R\x05bizId\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\"e\n" +
 	"\x19GetAllPermissionsResponse\x12H\n" +
-	"\x10user_permissions\x18\x01 \x03(\v2\x1d.permission.v1.UserPermissionR\x0fuserPermissions\"\xcf\x01\n" +
+	"\x10user_permissions\x18\x01 \x03(\v2\x1d.permission.v1.UserPermissionR\x0fuserPermissions\"\x8e\x01\n" +
+	"\x1eGetEffectivePermissionsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
+	"\rresource_type\x18\x02 \x01(\tR\fresourceType\x12.\n" +
+	"\x13resource_key_prefix\x18\x03 \x01(\tR\x11resourceKeyPrefix\"\xb2\x01\n" +
+	"\x10PermissionSource\x12\x16\n" +
+	"\x06direct\x18\x01 \x01(\bR\x06direct\x12\x17\n" +
+	"\arole_id\x18\x02 \x01(\x03R\x06roleId\x12\x1b\n" +
+	"\trole_path\x18\x03 \x03(\x03R\brolePath\x12\x16\n" +
+	"\x06effect\x18\x04 \x01(\tR\x06effect\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x05 \x01(\x03R\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x06 \x01(\x03R\aendTime\"\xa3\x01\n" +
+	"\x13EffectivePermission\x129\n" +
+	"\n" +
+	"permission\x18\x01 \x01(\v2\x19.permission.v1.PermissionR\n" +
+	"permission\x12\x16\n" +
+	"\x06effect\x18\x02 \x01(\tR\x06effect\x129\n" +
+	"\asources\x18\x03 \x03(\v2\x1f.permission.v1.PermissionSourceR\asources\"g\n" +
+	"\x1fGetEffectivePermissionsResponse\x12D\n" +
+	"\vpermissions\x18\x01 \x03(\v2\".permission.v1.EffectivePermissionR\vpermissions\"\xa3\x01\n" +
+	"\x13WhoCanAccessRequest\x12#\n" +
+	"\rresource_type\x18\x01 \x01(\tR\fresourceType\x12!\n" +
+	"\fresource_key\x18\x02 \x01(\tR\vresourceKey\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\tR\x06action\x12\x16\n" +
+	"\x06offset\x18\x04 \x01(\x05R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\"`\n" +
+	"\n" +
+	"UserAccess\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x129\n" +
+	"\asources\x18\x02 \x03(\v2\x1f.permission.v1.PermissionSourceR\asources\"]\n" +
+	"\x14WhoCanAccessResponse\x12/\n" +
+	"\x05users\x18\x01 \x03(\v2\x19.permission.v1.UserAccessR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\"\xd7\x02\n" +
 	"\x0eBusinessConfig\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
 	"\bowner_id\x18\x02 \x01(\x03R\aownerId\x12\x1d\n" +
@@ -4273,7 +4774,12 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"rate_limit\x18\x05 \x01(\x05R\trateLimit\x12\x14\n" +
 	"\x05token\x18\x06 \x01(\tR\x05token\x12\x14\n" +
 	"\x05ctime\x18\a \x01(\x03R\x05ctime\x12\x14\n" +
-	"\x05utime\x18\b \x01(\x03R\x05utime\"T\n" +
+	"\x05utime\x18\b \x01(\x03R\x05utime\x12\x1d\n" +
+	"\n" +
+	"check_mode\x18\t \x01(\tR\tcheckMode\x12/\n" +
+	"\x13combining_algorithm\x18\n" +
+	" \x01(\tR\x12combiningAlgorithm\x126\n" +
+	"\x17not_applicable_decision\x18\v \x01(\tR\x15notApplicableDecision\"T\n" +
 	"\x1bCreateBusinessConfigRequest\x125\n" +
 	"\x06config\x18\x01 \x01(\v2\x1d.permission.v1.BusinessConfigR\x06config\"U\n" +
 	"\x1cCreateBusinessConfigResponse\x125\n" +
@@ -4296,7 +4802,7 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x06offset\x18\x01 \x01(\x05R\x06offset\x12\x14\n" +
 	"\x05limit\x18\x02 \x01(\x05R\x05limit\"V\n" +
 	"\x1bListBusinessConfigsResponse\x127\n" +
-	"\aconfigs\x18\x01 \x03(\v2\x1d.permission.v1.BusinessConfigR\aconfigs2\xca\x1a\n" +
+	"\aconfigs\x18\x01 \x03(\v2\x1d.permission.v1.BusinessConfigR\aconfigs2\x9d\x1c\n" +
 	"\vRBACService\x12Q\n" +
 	"\n" +
 	"CreateRole\x12 .permission.v1.CreateRoleRequest\x1a!.permission.v1.CreateRoleResponse\x12H\n" +
@@ -4329,7 +4835,9 @@ const file_permission_v1_rbac_proto_rawDesc = "" +
 	"\x13GrantUserPermission\x12).permission.v1.GrantUserPermissionRequest\x1a*.permission.v1.GrantUserPermissionResponse\x12o\n" +
 	"\x14RevokeUserPermission\x12*.permission.v1.RevokeUserPermissionRequest\x1a+.permission.v1.RevokeUserPermissionResponse\x12l\n" +
 	"\x13ListUserPermissions\x12).permission.v1.ListUserPermissionsRequest\x1a*.permission.v1.ListUserPermissionsResponse\x12f\n" +
-	"\x11GetAllPermissions\x12'.permission.v1.GetAllPermissionsRequest\x1a(.permission.v1.GetAllPermissionsResponse\x12o\n" +
+	"\x11GetAllPermissions\x12'.permission.v1.GetAllPermissionsRequest\x1a(.permission.v1.GetAllPermissionsResponse\x12x\n" +
+	"\x17GetEffectivePermissions\x12-.permission.v1.GetEffectivePermissionsRequest\x1a..permission.v1.GetEffectivePermissionsResponse\x12W\n" +
+	"\fWhoCanAccess\x12\".permission.v1.WhoCanAccessRequest\x1a#.permission.v1.WhoCanAccessResponse\x12o\n" +
 	"\x14CreateBusinessConfig\x12*.permission.v1.CreateBusinessConfigRequest\x1a+.permission.v1.CreateBusinessConfigResponse\x12f\n" +
 	"\x11GetBusinessConfig\x12'.permission.v1.GetBusinessConfigRequest\x1a(.permission.v1.GetBusinessConfigResponse\x12o\n" +
 	"\x14UpdateBusinessConfig\x12*.permission.v1.UpdateBusinessConfigRequest\x1a+.permission.v1.UpdateBusinessConfigResponse\x12o\n" +
@@ -4349,84 +4857,91 @@ func file_permission_v1_rbac_proto_rawDescGZIP() []byte {
 	return file_permission_v1_rbac_proto_rawDescData
 }
 
-var file_permission_v1_rbac_proto_msgTypes = make([]protoimpl.MessageInfo, 76)
+var file_permission_v1_rbac_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_permission_v1_rbac_proto_goTypes = []any{
-	(*Role)(nil),                         // 0: permission.v1.Role
-	(*CreateRoleRequest)(nil),            // 1: permission.v1.CreateRoleRequest
-	(*CreateRoleResponse)(nil),           // 2: permission.v1.CreateRoleResponse
-	(*GetRoleRequest)(nil),               // 3: permission.v1.GetRoleRequest
-	(*GetRoleResponse)(nil),              // 4: permission.v1.GetRoleResponse
-	(*UpdateRoleRequest)(nil),            // 5: permission.v1.UpdateRoleRequest
-	(*UpdateRoleResponse)(nil),           // 6: permission.v1.UpdateRoleResponse
-	(*DeleteRoleRequest)(nil),            // 7: permission.v1.DeleteRoleRequest
-	(*DeleteRoleResponse)(nil),           // 8: permission.v1.DeleteRoleResponse
-	(*ListRolesRequest)(nil),             // 9: permission.v1.ListRolesRequest
-	(*ListRolesResponse)(nil),            // 10: permission.v1.ListRolesResponse
-	(*Resource)(nil),                     // 11: permission.v1.Resource
-	(*CreateResourceRequest)(nil),        // 12: permission.v1.CreateResourceRequest
-	(*CreateResourceResponse)(nil),       // 13: permission.v1.CreateResourceResponse
-	(*GetResourceRequest)(nil),           // 14: permission.v1.GetResourceRequest
-	(*GetResourceResponse)(nil),          // 15: permission.v1.GetResourceResponse
-	(*UpdateResourceRequest)(nil),        // 16: permission.v1.UpdateResourceRequest
-	(*UpdateResourceResponse)(nil),       // 17: permission.v1.UpdateResourceResponse
-	(*DeleteResourceRequest)(nil),        // 18: permission.v1.DeleteResourceRequest
-	(*DeleteResourceResponse)(nil),       // 19: permission.v1.DeleteResourceResponse
-	(*ListResourcesRequest)(nil),         // 20: permission.v1.ListResourcesRequest
-	(*ListResourcesResponse)(nil),        // 21: permission.v1.ListResourcesResponse
-	(*Permission)(nil),                   // 22: permission.v1.Permission
-	(*CreatePermissionRequest)(nil),      // 23: permission.v1.CreatePermissionRequest
-	(*CreatePermissionResponse)(nil),     // 24: permission.v1.CreatePermissionResponse
-	(*GetPermissionRequest)(nil),         // 25: permission.v1.GetPermissionRequest
-	(*GetPermissionResponse)(nil),        // 26: permission.v1.GetPermissionResponse
-	(*UpdatePermissionRequest)(nil),      // 27: permission.v1.UpdatePermissionRequest
-	(*UpdatePermissionResponse)(nil),     // 28: permission.v1.UpdatePermissionResponse
-	(*DeletePermissionRequest)(nil),      // 29: permission.v1.DeletePermissionRequest
-	(*DeletePermissionResponse)(nil),     // 30: permission.v1.DeletePermissionResponse
-	(*ListPermissionsRequest)(nil),       // 31: permission.v1.ListPermissionsRequest
-	(*ListPermissionsResponse)(nil),      // 32: permission.v1.ListPermissionsResponse
-	(*UserRole)(nil),                     // 33: permission.v1.UserRole
-	(*GrantUserRoleRequest)(nil),         // 34: permission.v1.GrantUserRoleRequest
-	(*GrantUserRoleResponse)(nil),        // 35: permission.v1.GrantUserRoleResponse
-	(*RevokeUserRoleRequest)(nil),        // 36: permission.v1.RevokeUserRoleRequest
-	(*RevokeUserRoleResponse)(nil),       // 37: permission.v1.RevokeUserRoleResponse
-	(*ListUserRolesRequest)(nil),         // 38: permission.v1.ListUserRolesRequest
-	(*ListUserRolesResponse)(nil),        // 39: permission.v1.ListUserRolesResponse
-	(*RolePermission)(nil),               // 40: permission.v1.RolePermission
-	(*GrantRolePermissionRequest)(nil),   // 41: permission.v1.GrantRolePermissionRequest
-	(*GrantRolePermissionResponse)(nil),  // 42: permission.v1.GrantRolePermissionResponse
-	(*RevokeRolePermissionRequest)(nil),  // 43: permission.v1.RevokeRolePermissionRequest
-	(*RevokeRolePermissionResponse)(nil), // 44: permission.v1.RevokeRolePermissionResponse
-	(*ListRolePermissionsRequest)(nil),   // 45: permission.v1.ListRolePermissionsRequest
-	(*ListRolePermissionsResponse)(nil),  // 46: permission.v1.ListRolePermissionsResponse
-	(*RoleInclusion)(nil),                // 47: permission.v1.RoleInclusion
-	(*CreateRoleInclusionRequest)(nil),   // 48: permission.v1.CreateRoleInclusionRequest
-	(*CreateRoleInclusionResponse)(nil),  // 49: permission.v1.CreateRoleInclusionResponse
-	(*GetRoleInclusionRequest)(nil),      // 50: permission.v1.GetRoleInclusionRequest
-	(*GetRoleInclusionResponse)(nil),     // 51: permission.v1.GetRoleInclusionResponse
-	(*DeleteRoleInclusionRequest)(nil),   // 52: permission.v1.DeleteRoleInclusionRequest
-	(*DeleteRoleInclusionResponse)(nil),  // 53: permission.v1.DeleteRoleInclusionResponse
-	(*ListRoleInclusionsRequest)(nil),    // 54: permission.v1.ListRoleInclusionsRequest
-	(*ListRoleInclusionsResponse)(nil),   // 55: permission.v1.ListRoleInclusionsResponse
-	(*UserPermission)(nil),               // 56: permission.v1.UserPermission
-	(*GrantUserPermissionRequest)(nil),   // 57: permission.v1.GrantUserPermissionRequest
-	(*GrantUserPermissionResponse)(nil),  // 58: permission.v1.GrantUserPermissionResponse
-	(*RevokeUserPermissionRequest)(nil),  // 59: permission.v1.RevokeUserPermissionRequest
-	(*RevokeUserPermissionResponse)(nil), // 60: permission.v1.RevokeUserPermissionResponse
-	(*ListUserPermissionsRequest)(nil),   // 61: permission.v1.ListUserPermissionsRequest
-	(*ListUserPermissionsResponse)(nil),  // 62: permission.v1.ListUserPermissionsResponse
-	(*GetAllPermissionsRequest)(nil),     // 63: permission.v1.GetAllPermissionsRequest
-	(*GetAllPermissionsResponse)(nil),    // 64: permission.v1.GetAllPermissionsResponse
-	(*BusinessConfig)(nil),               // 65: permission.v1.BusinessConfig
-	(*CreateBusinessConfigRequest)(nil),  // 66: permission.v1.CreateBusinessConfigRequest
-	(*CreateBusinessConfigResponse)(nil), // 67: permission.v1.CreateBusinessConfigResponse
-	(*GetBusinessConfigRequest)(nil),     // 68: permission.v1.GetBusinessConfigRequest
-	(*GetBusinessConfigResponse)(nil),    // 69: permission.v1.GetBusinessConfigResponse
-	(*UpdateBusinessConfigRequest)(nil),  // 70: permission.v1.UpdateBusinessConfigRequest
-	(*UpdateBusinessConfigResponse)(nil), // 71: permission.v1.UpdateBusinessConfigResponse
-	(*DeleteBusinessConfigRequest)(nil),  // 72: permission.v1.DeleteBusinessConfigRequest
-	(*DeleteBusinessConfigResponse)(nil), // 73: permission.v1.DeleteBusinessConfigResponse
-	(*ListBusinessConfigsRequest)(nil),   // 74: permission.v1.ListBusinessConfigsRequest
-	(*ListBusinessConfigsResponse)(nil),  // 75: permission.v1.ListBusinessConfigsResponse
+	(*Role)(nil),                            // 0: permission.v1.Role
+	(*CreateRoleRequest)(nil),               // 1: permission.v1.CreateRoleRequest
+	(*CreateRoleResponse)(nil),              // 2: permission.v1.CreateRoleResponse
+	(*GetRoleRequest)(nil),                  // 3: permission.v1.GetRoleRequest
+	(*GetRoleResponse)(nil),                 // 4: permission.v1.GetRoleResponse
+	(*UpdateRoleRequest)(nil),               // 5: permission.v1.UpdateRoleRequest
+	(*UpdateRoleResponse)(nil),              // 6: permission.v1.UpdateRoleResponse
+	(*DeleteRoleRequest)(nil),               // 7: permission.v1.DeleteRoleRequest
+	(*DeleteRoleResponse)(nil),              // 8: permission.v1.DeleteRoleResponse
+	(*ListRolesRequest)(nil),                // 9: permission.v1.ListRolesRequest
+	(*ListRolesResponse)(nil),               // 10: permission.v1.ListRolesResponse
+	(*Resource)(nil),                        // 11: permission.v1.Resource
+	(*CreateResourceRequest)(nil),           // 12: permission.v1.CreateResourceRequest
+	(*CreateResourceResponse)(nil),          // 13: permission.v1.CreateResourceResponse
+	(*GetResourceRequest)(nil),              // 14: permission.v1.GetResourceRequest
+	(*GetResourceResponse)(nil),             // 15: permission.v1.GetResourceResponse
+	(*UpdateResourceRequest)(nil),           // 16: permission.v1.UpdateResourceRequest
+	(*UpdateResourceResponse)(nil),          // 17: permission.v1.UpdateResourceResponse
+	(*DeleteResourceRequest)(nil),           // 18: permission.v1.DeleteResourceRequest
+	(*DeleteResourceResponse)(nil),          // 19: permission.v1.DeleteResourceResponse
+	(*ListResourcesRequest)(nil),            // 20: permission.v1.ListResourcesRequest
+	(*ListResourcesResponse)(nil),           // 21: permission.v1.ListResourcesResponse
+	(*Permission)(nil),                      // 22: permission.v1.Permission
+	(*CreatePermissionRequest)(nil),         // 23: permission.v1.CreatePermissionRequest
+	(*CreatePermissionResponse)(nil),        // 24: permission.v1.CreatePermissionResponse
+	(*GetPermissionRequest)(nil),            // 25: permission.v1.GetPermissionRequest
+	(*GetPermissionResponse)(nil),           // 26: permission.v1.GetPermissionResponse
+	(*UpdatePermissionRequest)(nil),         // 27: permission.v1.UpdatePermissionRequest
+	(*UpdatePermissionResponse)(nil),        // 28: permission.v1.UpdatePermissionResponse
+	(*DeletePermissionRequest)(nil),         // 29: permission.v1.DeletePermissionRequest
+	(*DeletePermissionResponse)(nil),        // 30: permission.v1.DeletePermissionResponse
+	(*ListPermissionsRequest)(nil),          // 31: permission.v1.ListPermissionsRequest
+	(*ListPermissionsResponse)(nil),         // 32: permission.v1.ListPermissionsResponse
+	(*UserRole)(nil),                        // 33: permission.v1.UserRole
+	(*GrantUserRoleRequest)(nil),            // 34: permission.v1.GrantUserRoleRequest
+	(*GrantUserRoleResponse)(nil),           // 35: permission.v1.GrantUserRoleResponse
+	(*RevokeUserRoleRequest)(nil),           // 36: permission.v1.RevokeUserRoleRequest
+	(*RevokeUserRoleResponse)(nil),          // 37: permission.v1.RevokeUserRoleResponse
+	(*ListUserRolesRequest)(nil),            // 38: permission.v1.ListUserRolesRequest
+	(*ListUserRolesResponse)(nil),           // 39: permission.v1.ListUserRolesResponse
+	(*RolePermission)(nil),                  // 40: permission.v1.RolePermission
+	(*GrantRolePermissionRequest)(nil),      // 41: permission.v1.GrantRolePermissionRequest
+	(*GrantRolePermissionResponse)(nil),     // 42: permission.v1.GrantRolePermissionResponse
+	(*RevokeRolePermissionRequest)(nil),     // 43: permission.v1.RevokeRolePermissionRequest
+	(*RevokeRolePermissionResponse)(nil),    // 44: permission.v1.RevokeRolePermissionResponse
+	(*ListRolePermissionsRequest)(nil),      // 45: permission.v1.ListRolePermissionsRequest
+	(*ListRolePermissionsResponse)(nil),     // 46: permission.v1.ListRolePermissionsResponse
+	(*RoleInclusion)(nil),                   // 47: permission.v1.RoleInclusion
+	(*CreateRoleInclusionRequest)(nil),      // 48: permission.v1.CreateRoleInclusionRequest
+	(*CreateRoleInclusionResponse)(nil),     // 49: permission.v1.CreateRoleInclusionResponse
+	(*GetRoleInclusionRequest)(nil),         // 50: permission.v1.GetRoleInclusionRequest
+	(*GetRoleInclusionResponse)(nil),        // 51: permission.v1.GetRoleInclusionResponse
+	(*DeleteRoleInclusionRequest)(nil),      // 52: permission.v1.DeleteRoleInclusionRequest
+	(*DeleteRoleInclusionResponse)(nil),     // 53: permission.v1.DeleteRoleInclusionResponse
+	(*ListRoleInclusionsRequest)(nil),       // 54: permission.v1.ListRoleInclusionsRequest
+	(*ListRoleInclusionsResponse)(nil),      // 55: permission.v1.ListRoleInclusionsResponse
+	(*UserPermission)(nil),                  // 56: permission.v1.UserPermission
+	(*GrantUserPermissionRequest)(nil),      // 57: permission.v1.GrantUserPermissionRequest
+	(*GrantUserPermissionResponse)(nil),     // 58: permission.v1.GrantUserPermissionResponse
+	(*RevokeUserPermissionRequest)(nil),     // 59: permission.v1.RevokeUserPermissionRequest
+	(*RevokeUserPermissionResponse)(nil),    // 60: permission.v1.RevokeUserPermissionResponse
+	(*ListUserPermissionsRequest)(nil),      // 61: permission.v1.ListUserPermissionsRequest
+	(*ListUserPermissionsResponse)(nil),     // 62: permission.v1.ListUserPermissionsResponse
+	(*GetAllPermissionsRequest)(nil),        // 63: permission.v1.GetAllPermissionsRequest
+	(*GetAllPermissionsResponse)(nil),       // 64: permission.v1.GetAllPermissionsResponse
+	(*GetEffectivePermissionsRequest)(nil),  // 65: permission.v1.GetEffectivePermissionsRequest
+	(*PermissionSource)(nil),                // 66: permission.v1.PermissionSource
+	(*EffectivePermission)(nil),             // 67: permission.v1.EffectivePermission
+	(*GetEffectivePermissionsResponse)(nil), // 68: permission.v1.GetEffectivePermissionsResponse
+	(*WhoCanAccessRequest)(nil),             // 69: permission.v1.WhoCanAccessRequest
+	(*UserAccess)(nil),                      // 70: permission.v1.UserAccess
+	(*WhoCanAccessResponse)(nil),            // 71: permission.v1.WhoCanAccessResponse
+	(*BusinessConfig)(nil),                  // 72: permission.v1.BusinessConfig
+	(*CreateBusinessConfigRequest)(nil),     // 73: permission.v1.CreateBusinessConfigRequest
+	(*CreateBusinessConfigResponse)(nil),    // 74: permission.v1.CreateBusinessConfigResponse
+	(*GetBusinessConfigRequest)(nil),        // 75: permission.v1.GetBusinessConfigRequest
+	(*GetBusinessConfigResponse)(nil),       // 76: permission.v1.GetBusinessConfigResponse
+	(*UpdateBusinessConfigRequest)(nil),     // 77: permission.v1.UpdateBusinessConfigRequest
+	(*UpdateBusinessConfigResponse)(nil),    // 78: permission.v1.UpdateBusinessConfigResponse
+	(*DeleteBusinessConfigRequest)(nil),     // 79: permission.v1.DeleteBusinessConfigRequest
+	(*DeleteBusinessConfigResponse)(nil),    // 80: permission.v1.DeleteBusinessConfigResponse
+	(*ListBusinessConfigsRequest)(nil),      // 81: permission.v1.ListBusinessConfigsRequest
+	(*ListBusinessConfigsResponse)(nil),     // 82: permission.v1.ListBusinessConfigsResponse
 }
 var file_permission_v1_rbac_proto_depIdxs = []int32{
 	0,  // 0: permission.v1.CreateRoleRequest.role:type_name -> permission.v1.Role
@@ -4458,84 +4973,93 @@ var file_permission_v1_rbac_proto_depIdxs = []int32{
 	56, // 26: permission.v1.GrantUserPermissionResponse.user_permission:type_name -> permission.v1.UserPermission
 	56, // 27: permission.v1.ListUserPermissionsResponse.user_permissions:type_name -> permission.v1.UserPermission
 	56, // 28: permission.v1.GetAllPermissionsResponse.user_permissions:type_name -> permission.v1.UserPermission
-	65, // 29: permission.v1.CreateBusinessConfigRequest.config:type_name -> permission.v1.BusinessConfig
-	65, // 30: permission.v1.CreateBusinessConfigResponse.config:type_name -> permission.v1.BusinessConfig
-	65, // 31: permission.v1.GetBusinessConfigResponse.config:type_name -> permission.v1.BusinessConfig
-	65, // 32: permission.v1.UpdateBusinessConfigRequest.config:type_name -> permission.v1.BusinessConfig
-	65, // 33: permission.v1.ListBusinessConfigsResponse.configs:type_name -> permission.v1.BusinessConfig
-	1,  // 34: permission.v1.RBACService.CreateRole:input_type -> permission.v1.CreateRoleRequest
-	3,  // 35: permission.v1.RBACService.GetRole:input_type -> permission.v1.GetRoleRequest
-	5,  // 36: permission.v1.RBACService.UpdateRole:input_type -> permission.v1.UpdateRoleRequest
-	7,  // 37: permission.v1.RBACService.DeleteRole:input_type -> permission.v1.DeleteRoleRequest
-	9,  // 38: permission.v1.RBACService.ListRoles:input_type -> permission.v1.ListRolesRequest
-	12, // 39: permission.v1.RBACService.CreateResource:input_type -> permission.v1.CreateResourceRequest
-	14, // 40: permission.v1.RBACService.GetResource:input_type -> permission.v1.GetResourceRequest
-	16, // 41: permission.v1.RBACService.UpdateResource:input_type -> permission.v1.UpdateResourceRequest
-	18, // 42: permission.v1.RBACService.DeleteResource:input_type -> permission.v1.DeleteResourceRequest
-	20, // 43: permission.v1.RBACService.ListResources:input_type -> permission.v1.ListResourcesRequest
-	23, // 44: permission.v1.RBACService.CreatePermission:input_type -> permission.v1.CreatePermissionRequest
-	25, // 45: permission.v1.RBACService.GetPermission:input_type -> permission.v1.GetPermissionRequest
-	27, // 46: permission.v1.RBACService.UpdatePermission:input_type -> permission.v1.UpdatePermissionRequest
-	29, // 47: permission.v1.RBACService.DeletePermission:input_type -> permission.v1.DeletePermissionRequest
-	31, // 48: permission.v1.RBACService.ListPermissions:input_type -> permission.v1.ListPermissionsRequest
-	34, // 49: permission.v1.RBACService.GrantUserRole:input_type -> permission.v1.GrantUserRoleRequest
-	36, // 50: permission.v1.RBACService.RevokeUserRole:input_type -> permission.v1.RevokeUserRoleRequest
-	38, // 51: permission.v1.RBACService.ListUserRoles:input_type -> permission.v1.ListUserRolesRequest
-	41, // 52: permission.v1.RBACService.GrantRolePermission:input_type -> permission.v1.GrantRolePermissionRequest
-	43, // 53: permission.v1.RBACService.RevokeRolePermission:input_type -> permission.v1.RevokeRolePermissionRequest
-	45, // 54: permission.v1.RBACService.ListRolePermissions:input_type -> permission.v1.ListRolePermissionsRequest
-	48, // 55: permission.v1.RBACService.CreateRoleInclusion:input_type -> permission.v1.CreateRoleInclusionRequest
-	50, // 56: permission.v1.RBACService.GetRoleInclusion:input_type -> permission.v1.GetRoleInclusionRequest
-	52, // 57: permission.v1.RBACService.DeleteRoleInclusion:input_type -> permission.v1.DeleteRoleInclusionRequest
-	54, // 58: permission.v1.RBACService.ListRoleInclusions:input_type -> permission.v1.ListRoleInclusionsRequest
-	57, // 59: permission.v1.RBACService.GrantUserPermission:input_type -> permission.v1.GrantUserPermissionRequest
-	59, // 60: permission.v1.RBACService.RevokeUserPermission:input_type -> permission.v1.RevokeUserPermissionRequest
-	61, // 61: permission.v1.RBACService.ListUserPermissions:input_type -> permission.v1.ListUserPermissionsRequest
-	63, // 62: permission.v1.RBACService.GetAllPermissions:input_type -> permission.v1.GetAllPermissionsRequest
-	66, // 63: permission.v1.RBACService.CreateBusinessConfig:input_type -> permission.v1.CreateBusinessConfigRequest
-	68, // 64: permission.v1.RBACService.GetBusinessConfig:input_type -> permission.v1.GetBusinessConfigRequest
-	70, // 65: permission.v1.RBACService.UpdateBusinessConfig:input_type -> permission.v1.UpdateBusinessConfigRequest
-	72, // 66: permission.v1.RBACService.DeleteBusinessConfig:input_type -> permission.v1.DeleteBusinessConfigRequest
-	74, // 67: permission.v1.RBACService.ListBusinessConfigs:input_type -> permission.v1.ListBusinessConfigsRequest
-	2,  // 68: permission.v1.RBACService.CreateRole:output_type -> permission.v1.CreateRoleResponse
-	4,  // 69: permission.v1.RBACService.GetRole:output_type -> permission.v1.GetRoleResponse
-	6,  // 70: permission.v1.RBACService.UpdateRole:output_type -> permission.v1.UpdateRoleResponse
-	8,  // 71: permission.v1.RBACService.DeleteRole:output_type -> permission.v1.DeleteRoleResponse
-	10, // 72: permission.v1.RBACService.ListRoles:output_type -> permission.v1.ListRolesResponse
-	13, // 73: permission.v1.RBACService.CreateResource:output_type -> permission.v1.CreateResourceResponse
-	15, // 74: permission.v1.RBACService.GetResource:output_type -> permission.v1.GetResourceResponse
-	17, // 75: permission.v1.RBACService.UpdateResource:output_type -> permission.v1.UpdateResourceResponse
-	19, // 76: permission.v1.RBACService.DeleteResource:output_type -> permission.v1.DeleteResourceResponse
-	21, // 77: permission.v1.RBACService.ListResources:output_type -> permission.v1.ListResourcesResponse
-	24, // 78: permission.v1.RBACService.CreatePermission:output_type -> permission.v1.CreatePermissionResponse
-	26, // 79: permission.v1.RBACService.GetPermission:output_type -> permission.v1.GetPermissionResponse
-	28, // 80: permission.v1.RBACService.UpdatePermission:output_type -> permission.v1.UpdatePermissionResponse
-	30, // 81: permission.v1.RBACService.DeletePermission:output_type -> permission.v1.DeletePermissionResponse
-	32, // 82: permission.v1.RBACService.ListPermissions:output_type -> permission.v1.ListPermissionsResponse
-	35, // 83: permission.v1.RBACService.GrantUserRole:output_type -> permission.v1.GrantUserRoleResponse
-	37, // 84: permission.v1.RBACService.RevokeUserRole:output_type -> permission.v1.RevokeUserRoleResponse
-	39, // 85: permission.v1.RBACService.ListUserRoles:output_type -> permission.v1.ListUserRolesResponse
-	42, // 86: permission.v1.RBACService.GrantRolePermission:output_type -> permission.v1.GrantRolePermissionResponse
-	44, // 87: permission.v1.RBACService.RevokeRolePermission:output_type -> permission.v1.RevokeRolePermissionResponse
-	46, // 88: permission.v1.RBACService.ListRolePermissions:output_type -> permission.v1.ListRolePermissionsResponse
-	49, // 89: permission.v1.RBACService.CreateRoleInclusion:output_type -> permission.v1.CreateRoleInclusionResponse
-	51, // 90: permission.v1.RBACService.GetRoleInclusion:output_type -> permission.v1.GetRoleInclusionResponse
-	53, // 91: permission.v1.RBACService.DeleteRoleInclusion:output_type -> permission.v1.DeleteRoleInclusionResponse
-	55, // 92: permission.v1.RBACService.ListRoleInclusions:output_type -> permission.v1.ListRoleInclusionsResponse
-	58, // 93: permission.v1.RBACService.GrantUserPermission:output_type -> permission.v1.GrantUserPermissionResponse
-	60, // 94: permission.v1.RBACService.RevokeUserPermission:output_type -> permission.v1.RevokeUserPermissionResponse
-	62, // 95: permission.v1.RBACService.ListUserPermissions:output_type -> permission.v1.ListUserPermissionsResponse
-	64, // 96: permission.v1.RBACService.GetAllPermissions:output_type -> permission.v1.GetAllPermissionsResponse
-	67, // 97: permission.v1.RBACService.CreateBusinessConfig:output_type -> permission.v1.CreateBusinessConfigResponse
-	69, // 98: permission.v1.RBACService.GetBusinessConfig:output_type -> permission.v1.GetBusinessConfigResponse
-	71, // 99: permission.v1.RBACService.UpdateBusinessConfig:output_type -> permission.v1.UpdateBusinessConfigResponse
-	73, // 100: permission.v1.RBACService.DeleteBusinessConfig:output_type -> permission.v1.DeleteBusinessConfigResponse
-	75, // 101: permission.v1.RBACService.ListBusinessConfigs:output_type -> permission.v1.ListBusinessConfigsResponse
-	68, // [68:102] is the sub-list for method output_type
-	34, // [34:68] is the sub-list for method input_type
-	34, // [34:34] is the sub-list for extension type_name
-	34, // [34:34] is the sub-list for extension extendee
-	0,  // [0:34] is the sub-list for field type_name
+	22, // 29: permission.v1.EffectivePermission.permission:type_name -> permission.v1.Permission
+	66, // 30: permission.v1.EffectivePermission.sources:type_name -> permission.v1.PermissionSource
+	67, // 31: permission.v1.GetEffectivePermissionsResponse.permissions:type_name -> permission.v1.EffectivePermission
+	66, // 32: permission.v1.UserAccess.sources:type_name -> permission.v1.PermissionSource
+	70, // 33: permission.v1.WhoCanAccessResponse.users:type_name -> permission.v1.UserAccess
+	72, // 34: permission.v1.CreateBusinessConfigRequest.config:type_name -> permission.v1.BusinessConfig
+	72, // 35: permission.v1.CreateBusinessConfigResponse.config:type_name -> permission.v1.BusinessConfig
+	72, // 36: permission.v1.GetBusinessConfigResponse.config:type_name -> permission.v1.BusinessConfig
+	72, // 37: permission.v1.UpdateBusinessConfigRequest.config:type_name -> permission.v1.BusinessConfig
+	72, // 38: permission.v1.ListBusinessConfigsResponse.configs:type_name -> permission.v1.BusinessConfig
+	1,  // 39: permission.v1.RBACService.CreateRole:input_type -> permission.v1.CreateRoleRequest
+	3,  // 40: permission.v1.RBACService.GetRole:input_type -> permission.v1.GetRoleRequest
+	5,  // 41: permission.v1.RBACService.UpdateRole:input_type -> permission.v1.UpdateRoleRequest
+	7,  // 42: permission.v1.RBACService.DeleteRole:input_type -> permission.v1.DeleteRoleRequest
+	9,  // 43: permission.v1.RBACService.ListRoles:input_type -> permission.v1.ListRolesRequest
+	12, // 44: permission.v1.RBACService.CreateResource:input_type -> permission.v1.CreateResourceRequest
+	14, // 45: permission.v1.RBACService.GetResource:input_type -> permission.v1.GetResourceRequest
+	16, // 46: permission.v1.RBACService.UpdateResource:input_type -> permission.v1.UpdateResourceRequest
+	18, // 47: permission.v1.RBACService.DeleteResource:input_type -> permission.v1.DeleteResourceRequest
+	20, // 48: permission.v1.RBACService.ListResources:input_type -> permission.v1.ListResourcesRequest
+	23, // 49: permission.v1.RBACService.CreatePermission:input_type -> permission.v1.CreatePermissionRequest
+	25, // 50: permission.v1.RBACService.GetPermission:input_type -> permission.v1.GetPermissionRequest
+	27, // 51: permission.v1.RBACService.UpdatePermission:input_type -> permission.v1.UpdatePermissionRequest
+	29, // 52: permission.v1.RBACService.DeletePermission:input_type -> permission.v1.DeletePermissionRequest
+	31, // 53: permission.v1.RBACService.ListPermissions:input_type -> permission.v1.ListPermissionsRequest
+	34, // 54: permission.v1.RBACService.GrantUserRole:input_type -> permission.v1.GrantUserRoleRequest
+	36, // 55: permission.v1.RBACService.RevokeUserRole:input_type -> permission.v1.RevokeUserRoleRequest
+	38, // 56: permission.v1.RBACService.ListUserRoles:input_type -> permission.v1.ListUserRolesRequest
+	41, // 57: permission.v1.RBACService.GrantRolePermission:input_type -> permission.v1.GrantRolePermissionRequest
+	43, // 58: permission.v1.RBACService.RevokeRolePermission:input_type -> permission.v1.RevokeRolePermissionRequest
+	45, // 59: permission.v1.RBACService.ListRolePermissions:input_type -> permission.v1.ListRolePermissionsRequest
+	48, // 60: permission.v1.RBACService.CreateRoleInclusion:input_type -> permission.v1.CreateRoleInclusionRequest
+	50, // 61: permission.v1.RBACService.GetRoleInclusion:input_type -> permission.v1.GetRoleInclusionRequest
+	52, // 62: permission.v1.RBACService.DeleteRoleInclusion:input_type -> permission.v1.DeleteRoleInclusionRequest
+	54, // 63: permission.v1.RBACService.ListRoleInclusions:input_type -> permission.v1.ListRoleInclusionsRequest
+	57, // 64: permission.v1.RBACService.GrantUserPermission:input_type -> permission.v1.GrantUserPermissionRequest
+	59, // 65: permission.v1.RBACService.RevokeUserPermission:input_type -> permission.v1.RevokeUserPermissionRequest
+	61, // 66: permission.v1.RBACService.ListUserPermissions:input_type -> permission.v1.ListUserPermissionsRequest
+	63, // 67: permission.v1.RBACService.GetAllPermissions:input_type -> permission.v1.GetAllPermissionsRequest
+	65, // 68: permission.v1.RBACService.GetEffectivePermissions:input_type -> permission.v1.GetEffectivePermissionsRequest
+	69, // 69: permission.v1.RBACService.WhoCanAccess:input_type -> permission.v1.WhoCanAccessRequest
+	73, // 70: permission.v1.RBACService.CreateBusinessConfig:input_type -> permission.v1.CreateBusinessConfigRequest
+	75, // 71: permission.v1.RBACService.GetBusinessConfig:input_type -> permission.v1.GetBusinessConfigRequest
+	77, // 72: permission.v1.RBACService.UpdateBusinessConfig:input_type -> permission.v1.UpdateBusinessConfigRequest
+	79, // 73: permission.v1.RBACService.DeleteBusinessConfig:input_type -> permission.v1.DeleteBusinessConfigRequest
+	81, // 74: permission.v1.RBACService.ListBusinessConfigs:input_type -> permission.v1.ListBusinessConfigsRequest
+	2,  // 75: permission.v1.RBACService.CreateRole:output_type -> permission.v1.CreateRoleResponse
+	4,  // 76: permission.v1.RBACService.GetRole:output_type -> permission.v1.GetRoleResponse
+	6,  // 77: permission.v1.RBACService.UpdateRole:output_type -> permission.v1.UpdateRoleResponse
+	8,  // 78: permission.v1.RBACService.DeleteRole:output_type -> permission.v1.DeleteRoleResponse
+	10, // 79: permission.v1.RBACService.ListRoles:output_type -> permission.v1.ListRolesResponse
+	13, // 80: permission.v1.RBACService.CreateResource:output_type -> permission.v1.CreateResourceResponse
+	15, // 81: permission.v1.RBACService.GetResource:output_type -> permission.v1.GetResourceResponse
+	17, // 82: permission.v1.RBACService.UpdateResource:output_type -> permission.v1.UpdateResourceResponse
+	19, // 83: permission.v1.RBACService.DeleteResource:output_type -> permission.v1.DeleteResourceResponse
+	21, // 84: permission.v1.RBACService.ListResources:output_type -> permission.v1.ListResourcesResponse
+	24, // 85: permission.v1.RBACService.CreatePermission:output_type -> permission.v1.CreatePermissionResponse
+	26, // 86: permission.v1.RBACService.GetPermission:output_type -> permission.v1.GetPermissionResponse
+	28, // 87: permission.v1.RBACService.UpdatePermission:output_type -> permission.v1.UpdatePermissionResponse
+	30, // 88: permission.v1.RBACService.DeletePermission:output_type -> permission.v1.DeletePermissionResponse
+	32, // 89: permission.v1.RBACService.ListPermissions:output_type -> permission.v1.ListPermissionsResponse
+	35, // 90: permission.v1.RBACService.GrantUserRole:output_type -> permission.v1.GrantUserRoleResponse
+	37, // 91: permission.v1.RBACService.RevokeUserRole:output_type -> permission.v1.RevokeUserRoleResponse
+	39, // 92: permission.v1.RBACService.ListUserRoles:output_type -> permission.v1.ListUserRolesResponse
+	42, // 93: permission.v1.RBACService.GrantRolePermission:output_type -> permission.v1.GrantRolePermissionResponse
+	44, // 94: permission.v1.RBACService.RevokeRolePermission:output_type -> permission.v1.RevokeRolePermissionResponse
+	46, // 95: permission.v1.RBACService.ListRolePermissions:output_type -> permission.v1.ListRolePermissionsResponse
+	49, // 96: permission.v1.RBACService.CreateRoleInclusion:output_type -> permission.v1.CreateRoleInclusionResponse
+	51, // 97: permission.v1.RBACService.GetRoleInclusion:output_type -> permission.v1.GetRoleInclusionResponse
+	53, // 98: permission.v1.RBACService.DeleteRoleInclusion:output_type -> permission.v1.DeleteRoleInclusionResponse
+	55, // 99: permission.v1.RBACService.ListRoleInclusions:output_type -> permission.v1.ListRoleInclusionsResponse
+	58, // 100: permission.v1.RBACService.GrantUserPermission:output_type -> permission.v1.GrantUserPermissionResponse
+	60, // 101: permission.v1.RBACService.RevokeUserPermission:output_type -> permission.v1.RevokeUserPermissionResponse
+	62, // 102: permission.v1.RBACService.ListUserPermissions:output_type -> permission.v1.ListUserPermissionsResponse
+	64, // 103: permission.v1.RBACService.GetAllPermissions:output_type -> permission.v1.GetAllPermissionsResponse
+	68, // 104: permission.v1.RBACService.GetEffectivePermissions:output_type -> permission.v1.GetEffectivePermissionsResponse
+	71, // 105: permission.v1.RBACService.WhoCanAccess:output_type -> permission.v1.WhoCanAccessResponse
+	74, // 106: permission.v1.RBACService.CreateBusinessConfig:output_type -> permission.v1.CreateBusinessConfigResponse
+	76, // 107: permission.v1.RBACService.GetBusinessConfig:output_type -> permission.v1.GetBusinessConfigResponse
+	78, // 108: permission.v1.RBACService.UpdateBusinessConfig:output_type -> permission.v1.UpdateBusinessConfigResponse
+	80, // 109: permission.v1.RBACService.DeleteBusinessConfig:output_type -> permission.v1.DeleteBusinessConfigResponse
+	82, // 110: permission.v1.RBACService.ListBusinessConfigs:output_type -> permission.v1.ListBusinessConfigsResponse
+	75, // [75:111] is the sub-list for method output_type
+	39, // [39:75] is the sub-list for method input_type
+	39, // [39:39] is the sub-list for extension type_name
+	39, // [39:39] is the sub-list for extension extendee
+	0,  // [0:39] is the sub-list for field type_name
 }
 
 func init() { file_permission_v1_rbac_proto_init() }
@@ -4549,7 +5073,7 @@ func file_permission_v1_rbac_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_permission_v1_rbac_proto_rawDesc), len(file_permission_v1_rbac_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   76,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2668,6 +2668,8 @@ func (m *Permission) validate(all bool) error {
 
 	// no validation rules for Metadata
 
+	// no validation rules for CombiningAlgorithm
+
 	if len(errors) > 0 {
 		return PermissionMultiError(errors)
 	}
@@ -7749,43 +7751,957 @@ var _ interface {
 	ErrorName() string
 } = GetAllPermissionsResponseValidationError{}
 
-// Validate checks the field values on BusinessConfig with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *BusinessConfig) Validate() error {
+// Validate checks the field values on GetEffectivePermissionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEffectivePermissionsRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on BusinessConfig with the rules defined
+// ValidateAll checks the field values on GetEffectivePermissionsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetEffectivePermissionsRequestMultiError, or nil if none found.
+func (m *GetEffectivePermissionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEffectivePermissionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceKeyPrefix
+
+	if len(errors) > 0 {
+		return GetEffectivePermissionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEffectivePermissionsRequestMultiError is an error wrapping multiple
+// validation errors returned by GetEffectivePermissionsRequest.ValidateAll()
+// if the designated constraints aren't met.
+type GetEffectivePermissionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEffectivePermissionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEffectivePermissionsRequestMultiError) AllErrors() []error { return m }
+
+// GetEffectivePermissionsRequestValidationError is the validation error
+// returned by GetEffectivePermissionsRequest.Validate if the designated
+// constraints aren't met.
+type GetEffectivePermissionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEffectivePermissionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEffectivePermissionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEffectivePermissionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEffectivePermissionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEffectivePermissionsRequestValidationError) ErrorName() string {
+	return "GetEffectivePermissionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEffectivePermissionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEffectivePermissionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEffectivePermissionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEffectivePermissionsRequestValidationError{}
+
+// Validate checks the field values on PermissionSource with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in BusinessConfigMultiError,
-// or nil if none found.
-func (m *BusinessConfig) ValidateAll() error {
+// first error encountered is returned, or nil if there are no violations.
+func (m *PermissionSource) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PermissionSource with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PermissionSourceMultiError, or nil if none found.
+func (m *PermissionSource) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *BusinessConfig) validate(all bool) error {
+func (m *PermissionSource) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Id
+	// no validation rules for Direct
 
-	// no validation rules for OwnerId
+	// no validation rules for RoleId
 
-	// no validation rules for OwnerType
+	// no validation rules for Effect
 
-	// no validation rules for Name
+	// no validation rules for StartTime
 
-	// no validation rules for RateLimit
+	// no validation rules for EndTime
 
-	// no validation rules for Token
+	if len(errors) > 0 {
+		return PermissionSourceMultiError(errors)
+	}
 
-	// no validation rules for Ctime
+	return nil
+}
 
-	// no validation rules for Utime
+// PermissionSourceMultiError is an error wrapping multiple validation errors
+// returned by PermissionSource.ValidateAll() if the designated constraints
+// aren't met.
+type PermissionSourceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PermissionSourceMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PermissionSourceMultiError) AllErrors() []error { return m }
+
+// PermissionSourceValidationError is the validation error returned by
+// PermissionSource.Validate if the designated constraints aren't met.
+type PermissionSourceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PermissionSourceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PermissionSourceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PermissionSourceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PermissionSourceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PermissionSourceValidationError) ErrorName() string { return "PermissionSourceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PermissionSourceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPermissionSource.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PermissionSourceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PermissionSourceValidationError{}
+
+// Validate checks the field values on EffectivePermission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *EffectivePermission) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on EffectivePermission with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// EffectivePermissionMultiError, or nil if none found.
+func (m *EffectivePermission) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *EffectivePermission) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetPermission()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, EffectivePermissionValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, EffectivePermissionValidationError{
+					field:  "Permission",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPermission()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return EffectivePermissionValidationError{
+				field:  "Permission",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Effect
+
+	for idx, item := range m.GetSources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, EffectivePermissionValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, EffectivePermissionValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return EffectivePermissionValidationError{
+					field:  fmt.Sprintf("Sources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return EffectivePermissionMultiError(errors)
+	}
+
+	return nil
+}
+
+// EffectivePermissionMultiError is an error wrapping multiple validation
+// errors returned by EffectivePermission.ValidateAll() if the designated
+// constraints aren't met.
+type EffectivePermissionMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m EffectivePermissionMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m EffectivePermissionMultiError) AllErrors() []error { return m }
+
+// EffectivePermissionValidationError is the validation error returned by
+// EffectivePermission.Validate if the designated constraints aren't met.
+type EffectivePermissionValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EffectivePermissionValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EffectivePermissionValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EffectivePermissionValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EffectivePermissionValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EffectivePermissionValidationError) ErrorName() string {
+	return "EffectivePermissionValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EffectivePermissionValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEffectivePermission.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EffectivePermissionValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EffectivePermissionValidationError{}
+
+// Validate checks the field values on GetEffectivePermissionsResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetEffectivePermissionsResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetEffectivePermissionsResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// GetEffectivePermissionsResponseMultiError, or nil if none found.
+func (m *GetEffectivePermissionsResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetEffectivePermissionsResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetPermissions() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, GetEffectivePermissionsResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, GetEffectivePermissionsResponseValidationError{
+						field:  fmt.Sprintf("Permissions[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return GetEffectivePermissionsResponseValidationError{
+					field:  fmt.Sprintf("Permissions[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return GetEffectivePermissionsResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetEffectivePermissionsResponseMultiError is an error wrapping multiple
+// validation errors returned by GetEffectivePermissionsResponse.ValidateAll()
+// if the designated constraints aren't met.
+type GetEffectivePermissionsResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetEffectivePermissionsResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetEffectivePermissionsResponseMultiError) AllErrors() []error { return m }
+
+// GetEffectivePermissionsResponseValidationError is the validation error
+// returned by GetEffectivePermissionsResponse.Validate if the designated
+// constraints aren't met.
+type GetEffectivePermissionsResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetEffectivePermissionsResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetEffectivePermissionsResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetEffectivePermissionsResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetEffectivePermissionsResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetEffectivePermissionsResponseValidationError) ErrorName() string {
+	return "GetEffectivePermissionsResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetEffectivePermissionsResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetEffectivePermissionsResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetEffectivePermissionsResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetEffectivePermissionsResponseValidationError{}
+
+// Validate checks the field values on WhoCanAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WhoCanAccessRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WhoCanAccessRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WhoCanAccessRequestMultiError, or nil if none found.
+func (m *WhoCanAccessRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WhoCanAccessRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for ResourceType
+
+	// no validation rules for ResourceKey
+
+	// no validation rules for Action
+
+	// no validation rules for Offset
+
+	// no validation rules for Limit
+
+	if len(errors) > 0 {
+		return WhoCanAccessRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// WhoCanAccessRequestMultiError is an error wrapping multiple validation
+// errors returned by WhoCanAccessRequest.ValidateAll() if the designated
+// constraints aren't met.
+type WhoCanAccessRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WhoCanAccessRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WhoCanAccessRequestMultiError) AllErrors() []error { return m }
+
+// WhoCanAccessRequestValidationError is the validation error returned by
+// WhoCanAccessRequest.Validate if the designated constraints aren't met.
+type WhoCanAccessRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WhoCanAccessRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WhoCanAccessRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WhoCanAccessRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WhoCanAccessRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WhoCanAccessRequestValidationError) ErrorName() string {
+	return "WhoCanAccessRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WhoCanAccessRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWhoCanAccessRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WhoCanAccessRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WhoCanAccessRequestValidationError{}
+
+// Validate checks the field values on UserAccess with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *UserAccess) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserAccess with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in UserAccessMultiError, or
+// nil if none found.
+func (m *UserAccess) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserAccess) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	for idx, item := range m.GetSources() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserAccessValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserAccessValidationError{
+						field:  fmt.Sprintf("Sources[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserAccessValidationError{
+					field:  fmt.Sprintf("Sources[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserAccessMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserAccessMultiError is an error wrapping multiple validation errors
+// returned by UserAccess.ValidateAll() if the designated constraints aren't met.
+type UserAccessMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserAccessMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserAccessMultiError) AllErrors() []error { return m }
+
+// UserAccessValidationError is the validation error returned by
+// UserAccess.Validate if the designated constraints aren't met.
+type UserAccessValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserAccessValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserAccessValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserAccessValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserAccessValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserAccessValidationError) ErrorName() string { return "UserAccessValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserAccessValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserAccess.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserAccessValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserAccessValidationError{}
+
+// Validate checks the field values on WhoCanAccessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *WhoCanAccessResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on WhoCanAccessResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// WhoCanAccessResponseMultiError, or nil if none found.
+func (m *WhoCanAccessResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *WhoCanAccessResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetUsers() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, WhoCanAccessResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, WhoCanAccessResponseValidationError{
+						field:  fmt.Sprintf("Users[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return WhoCanAccessResponseValidationError{
+					field:  fmt.Sprintf("Users[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Total
+
+	if len(errors) > 0 {
+		return WhoCanAccessResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// WhoCanAccessResponseMultiError is an error wrapping multiple validation
+// errors returned by WhoCanAccessResponse.ValidateAll() if the designated
+// constraints aren't met.
+type WhoCanAccessResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m WhoCanAccessResponseMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m WhoCanAccessResponseMultiError) AllErrors() []error { return m }
+
+// WhoCanAccessResponseValidationError is the validation error returned by
+// WhoCanAccessResponse.Validate if the designated constraints aren't met.
+type WhoCanAccessResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e WhoCanAccessResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e WhoCanAccessResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e WhoCanAccessResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e WhoCanAccessResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e WhoCanAccessResponseValidationError) ErrorName() string {
+	return "WhoCanAccessResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e WhoCanAccessResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sWhoCanAccessResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = WhoCanAccessResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = WhoCanAccessResponseValidationError{}
+
+// Validate checks the field values on BusinessConfig with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *BusinessConfig) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BusinessConfig with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in BusinessConfigMultiError,
+// or nil if none found.
+func (m *BusinessConfig) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BusinessConfig) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for OwnerId
+
+	// no validation rules for OwnerType
+
+	// no validation rules for Name
+
+	// no validation rules for RateLimit
+
+	// no validation rules for Token
+
+	// no validation rules for Ctime
+
+	// no validation rules for Utime
+
+	// no validation rules for CheckMode
+
+	// no validation rules for CombiningAlgorithm
+
+	// no validation rules for NotApplicableDecision
 
 	if len(errors) > 0 {
 		return BusinessConfigMultiError(errors)
@@ -19,40 +19,42 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	RBACService_CreateRole_FullMethodName           = "/permission.v1.RBACService/CreateRole"
-	RBACService_GetRole_FullMethodName              = "/permission.v1.RBACService/GetRole"
-	RBACService_UpdateRole_FullMethodName           = "/permission.v1.RBACService/UpdateRole"
-	RBACService_DeleteRole_FullMethodName           = "/permission.v1.RBACService/DeleteRole"
-	RBACService_ListRoles_FullMethodName            = "/permission.v1.RBACService/ListRoles"
-	RBACService_CreateResource_FullMethodName       = "/permission.v1.RBACService/CreateResource"
-	RBACService_GetResource_FullMethodName          = "/permission.v1.RBACService/GetResource"
-	RBACService_UpdateResource_FullMethodName       = "/permission.v1.RBACService/UpdateResource"
-	RBACService_DeleteResource_FullMethodName       = "/permission.v1.RBACService/DeleteResource"
-	RBACService_ListResources_FullMethodName        = "/permission.v1.RBACService/ListResources"
-	RBACService_CreatePermission_FullMethodName     = "/permission.v1.RBACService/CreatePermission"
-	RBACService_GetPermission_FullMethodName        = "/permission.v1.RBACService/GetPermission"
-	RBACService_UpdatePermission_FullMethodName     = "/permission.v1.RBACService/UpdatePermission"
-	RBACService_DeletePermission_FullMethodName     = "/permission.v1.RBACService/DeletePermission"
-	RBACService_ListPermissions_FullMethodName      = "/permission.v1.RBACService/ListPermissions"
-	RBACService_GrantUserRole_FullMethodName        = "/permission.v1.RBACService/GrantUserRole"
-	RBACService_RevokeUserRole_FullMethodName       = "/permission.v1.RBACService/RevokeUserRole"
-	RBACService_ListUserRoles_FullMethodName        = "/permission.v1.RBACService/ListUserRoles"
-	RBACService_GrantRolePermission_FullMethodName  = "/permission.v1.RBACService/GrantRolePermission"
-	RBACService_RevokeRolePermission_FullMethodName = "/permission.v1.RBACService/RevokeRolePermission"
-	RBACService_ListRolePermissions_FullMethodName  = "/permission.v1.RBACService/ListRolePermissions"
-	RBACService_CreateRoleInclusion_FullMethodName  = "/permission.v1.RBACService/CreateRoleInclusion"
-	RBACService_GetRoleInclusion_FullMethodName     = "/permission.v1.RBACService/GetRoleInclusion"
-	RBACService_DeleteRoleInclusion_FullMethodName  = "/permission.v1.RBACService/DeleteRoleInclusion"
-	RBACService_ListRoleInclusions_FullMethodName   = "/permission.v1.RBACService/ListRoleInclusions"
-	RBACService_GrantUserPermission_FullMethodName  = "/permission.v1.RBACService/GrantUserPermission"
-	RBACService_RevokeUserPermission_FullMethodName = "/permission.v1.RBACService/RevokeUserPermission"
-	RBACService_ListUserPermissions_FullMethodName  = "/permission.v1.RBACService/ListUserPermissions"
-	RBACService_GetAllPermissions_FullMethodName    = "/permission.v1.RBACService/GetAllPermissions"
-	RBACService_CreateBusinessConfig_FullMethodName = "/permission.v1.RBACService/CreateBusinessConfig"
-	RBACService_GetBusinessConfig_FullMethodName    = "/permission.v1.RBACService/GetBusinessConfig"
-	RBACService_UpdateBusinessConfig_FullMethodName = "/permission.v1.RBACService/UpdateBusinessConfig"
-	RBACService_DeleteBusinessConfig_FullMethodName = "/permission.v1.RBACService/DeleteBusinessConfig"
-	RBACService_ListBusinessConfigs_FullMethodName  = "/permission.v1.RBACService/ListBusinessConfigs"
+	RBACService_CreateRole_FullMethodName              = "/permission.v1.RBACService/CreateRole"
+	RBACService_GetRole_FullMethodName                 = "/permission.v1.RBACService/GetRole"
+	RBACService_UpdateRole_FullMethodName              = "/permission.v1.RBACService/UpdateRole"
+	RBACService_DeleteRole_FullMethodName              = "/permission.v1.RBACService/DeleteRole"
+	RBACService_ListRoles_FullMethodName               = "/permission.v1.RBACService/ListRoles"
+	RBACService_CreateResource_FullMethodName          = "/permission.v1.RBACService/CreateResource"
+	RBACService_GetResource_FullMethodName             = "/permission.v1.RBACService/GetResource"
+	RBACService_UpdateResource_FullMethodName          = "/permission.v1.RBACService/UpdateResource"
+	RBACService_DeleteResource_FullMethodName          = "/permission.v1.RBACService/DeleteResource"
+	RBACService_ListResources_FullMethodName           = "/permission.v1.RBACService/ListResources"
+	RBACService_CreatePermission_FullMethodName        = "/permission.v1.RBACService/CreatePermission"
+	RBACService_GetPermission_FullMethodName           = "/permission.v1.RBACService/GetPermission"
+	RBACService_UpdatePermission_FullMethodName        = "/permission.v1.RBACService/UpdatePermission"
+	RBACService_DeletePermission_FullMethodName        = "/permission.v1.RBACService/DeletePermission"
+	RBACService_ListPermissions_FullMethodName         = "/permission.v1.RBACService/ListPermissions"
+	RBACService_GrantUserRole_FullMethodName           = "/permission.v1.RBACService/GrantUserRole"
+	RBACService_RevokeUserRole_FullMethodName          = "/permission.v1.RBACService/RevokeUserRole"
+	RBACService_ListUserRoles_FullMethodName           = "/permission.v1.RBACService/ListUserRoles"
+	RBACService_GrantRolePermission_FullMethodName     = "/permission.v1.RBACService/GrantRolePermission"
+	RBACService_RevokeRolePermission_FullMethodName    = "/permission.v1.RBACService/RevokeRolePermission"
+	RBACService_ListRolePermissions_FullMethodName     = "/permission.v1.RBACService/ListRolePermissions"
+	RBACService_CreateRoleInclusion_FullMethodName     = "/permission.v1.RBACService/CreateRoleInclusion"
+	RBACService_GetRoleInclusion_FullMethodName        = "/permission.v1.RBACService/GetRoleInclusion"
+	RBACService_DeleteRoleInclusion_FullMethodName     = "/permission.v1.RBACService/DeleteRoleInclusion"
+	RBACService_ListRoleInclusions_FullMethodName      = "/permission.v1.RBACService/ListRoleInclusions"
+	RBACService_GrantUserPermission_FullMethodName     = "/permission.v1.RBACService/GrantUserPermission"
+	RBACService_RevokeUserPermission_FullMethodName    = "/permission.v1.RBACService/RevokeUserPermission"
+	RBACService_ListUserPermissions_FullMethodName     = "/permission.v1.RBACService/ListUserPermissions"
+	RBACService_GetAllPermissions_FullMethodName       = "/permission.v1.RBACService/GetAllPermissions"
+	RBACService_GetEffectivePermissions_FullMethodName = "/permission.v1.RBACService/GetEffectivePermissions"
+	RBACService_WhoCanAccess_FullMethodName            = "/permission.v1.RBACService/WhoCanAccess"
+	RBACService_CreateBusinessConfig_FullMethodName    = "/permission.v1.RBACService/CreateBusinessConfig"
+	RBACService_GetBusinessConfig_FullMethodName       = "/permission.v1.RBACService/GetBusinessConfig"
+	RBACService_UpdateBusinessConfig_FullMethodName    = "/permission.v1.RBACService/UpdateBusinessConfig"
+	RBACService_DeleteBusinessConfig_FullMethodName    = "/permission.v1.RBACService/DeleteBusinessConfig"
+	RBACService_ListBusinessConfigs_FullMethodName     = "/permission.v1.RBACService/ListBusinessConfigs"
 )
 
 // RBACServiceClient is the client API for RBACService service.
@@ -96,6 +98,10 @@ type RBACServiceClient interface {
 	ListUserPermissions(ctx context.Context, in *ListUserPermissionsRequest, opts ...grpc.CallOption) (*ListUserPermissionsResponse, error)
 	// 获取用户所有权限
 	GetAllPermissions(ctx context.Context, in *GetAllPermissionsRequest, opts ...grpc.CallOption) (*GetAllPermissionsResponse, error)
+	// 获取用户当前生效的权限以及来源
+	GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*GetEffectivePermissionsResponse, error)
+	// 获取能对资源执行某个操作的用户
+	WhoCanAccess(ctx context.Context, in *WhoCanAccessRequest, opts ...grpc.CallOption) (*WhoCanAccessResponse, error)
 	// 业务配置相关接口
 	CreateBusinessConfig(ctx context.Context, in *CreateBusinessConfigRequest, opts ...grpc.CallOption) (*CreateBusinessConfigResponse, error)
 	GetBusinessConfig(ctx context.Context, in *GetBusinessConfigRequest, opts ...grpc.CallOption) (*GetBusinessConfigResponse, error)
@@ -402,6 +408,26 @@ func (c *rBACServiceClient) GetAllPermissions(ctx context.Context, in *GetAllPer
 	return out, nil
 }
 
+func (c *rBACServiceClient) GetEffectivePermissions(ctx context.Context, in *GetEffectivePermissionsRequest, opts ...grpc.CallOption) (*GetEffectivePermissionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEffectivePermissionsResponse)
+	err := c.cc.Invoke(ctx, RBACService_GetEffectivePermissions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rBACServiceClient) WhoCanAccess(ctx context.Context, in *WhoCanAccessRequest, opts ...grpc.CallOption) (*WhoCanAccessResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(WhoCanAccessResponse)
+	err := c.cc.Invoke(ctx, RBACService_WhoCanAccess_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rBACServiceClient) CreateBusinessConfig(ctx context.Context, in *CreateBusinessConfigRequest, opts ...grpc.CallOption) (*CreateBusinessConfigResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateBusinessConfigResponse)
@@ -493,6 +519,10 @@ type RBACServiceServer interface {
 	ListUserPermissions(context.Context, *ListUserPermissionsRequest) (*ListUserPermissionsResponse, error)
 	// 获取用户所有权限
 	GetAllPermissions(context.Context, *GetAllPermissionsRequest) (*GetAllPermissionsResponse, error)
+	// 获取用户当前生效的权限以及来源
+	GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error)
+	// 获取能对资源执行某个操作的用户
+	WhoCanAccess(context.Context, *WhoCanAccessRequest) (*WhoCanAccessResponse, error)
 	// 业务配置相关接口
 	CreateBusinessConfig(context.Context, *CreateBusinessConfigRequest) (*CreateBusinessConfigResponse, error)
 	GetBusinessConfig(context.Context, *GetBusinessConfigRequest) (*GetBusinessConfigResponse, error)
@@ -595,6 +625,12 @@ func (UnimplementedRBACServiceServer) ListUserPermissions(context.Context, *List
 func (UnimplementedRBACServiceServer) GetAllPermissions(context.Context, *GetAllPermissionsRequest) (*GetAllPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAllPermissions not implemented")
 }
+func (UnimplementedRBACServiceServer) GetEffectivePermissions(context.Context, *GetEffectivePermissionsRequest) (*GetEffectivePermissionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEffectivePermissions not implemented")
+}
+func (UnimplementedRBACServiceServer) WhoCanAccess(context.Context, *WhoCanAccessRequest) (*WhoCanAccessResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhoCanAccess not implemented")
+}
 func (UnimplementedRBACServiceServer) CreateBusinessConfig(context.Context, *CreateBusinessConfigRequest) (*CreateBusinessConfigResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBusinessConfig not implemented")
 }
@@ -1152,6 +1188,42 @@ func _RBACService_GetAllPermissions_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RBACService_GetEffectivePermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEffectivePermissionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).GetEffectivePermissions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_GetEffectivePermissions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).GetEffectivePermissions(ctx, req.(*GetEffectivePermissionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RBACService_WhoCanAccess_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhoCanAccessRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RBACServiceServer).WhoCanAccess(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: RBACService_WhoCanAccess_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RBACServiceServer).WhoCanAccess(ctx, req.(*WhoCanAccessRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RBACService_CreateBusinessConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateBusinessConfigRequest)
 	if err := dec(in); err != nil {
@@ -1365,6 +1437,14 @@ var RBACService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAllPermissions",
 			Handler:    _RBACService_GetAllPermissions_Handler,
 		},
+		{
+			MethodName: "GetEffectivePermissions",
+			Handler:    _RBACService_GetEffectivePermissions_Handler,
+		},
+		{
+			MethodName: "WhoCanAccess",
+			Handler:    _RBACService_WhoCanAccess_Handler,
+		},
 		{
 			MethodName: "CreateBusinessConfig",
 			Handler:    _RBACService_CreateBusinessConfig_Handler,
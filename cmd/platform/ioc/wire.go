@@ -4,12 +4,16 @@ package ioc
 
 import (
 	"github.com/google/wire"
+	abacGrpc "github.com/permission-dev/internal/api/grpc/abac"
 	"github.com/permission-dev/internal/api/grpc/rbac"
 	"github.com/permission-dev/internal/ioc"
 	"github.com/permission-dev/internal/repository"
 	"github.com/permission-dev/internal/repository/dao"
 	"github.com/permission-dev/internal/repository/dao/audit"
 	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/provider"
+	"github.com/permission-dev/internal/service/hybrid"
 	rbacSvc "github.com/permission-dev/internal/service/rbac"
 )
 
@@ -21,6 +25,10 @@ var (
 		ioc.InitCacheKeyFunc,
 		ioc.InitMultiLevelCache,
 		ioc.InitRedisClient,
+		ioc.InitRoleInclusionConfig,
+		ioc.InitBusinessConfigRepository,
+		ioc.InitClientIPConfig,
+		ioc.InitAttributeProviderRegistry,
 	)
 	rbacSet = wire.NewSet(
 		dao.NewRoleDao,
@@ -31,11 +39,7 @@ var (
 		dao.NewUserPermissionDAO,
 		dao.NewRoleInclusionDAO,
 		dao.NewBusinessConfigDAO,
-
-		dao.NewAttributeDefinitionDAO,
-		dao.NewResourceAttributeValueDAO,
-		dao.NewEnvironmentAttributeValueDAO,
-		dao.NewSubjectAttributeValueDAO,
+		dao.NewGrantHistoryDAO,
 
 		repository.NewRoleRepository,
 		repository.NewResourceRepository,
@@ -44,20 +48,35 @@ var (
 		repository.NewRolePermissionRepository,
 		repository.NewUserPermissionRepository,
 		repository.NewRoleIncludeRepository,
-		repository.NewBusinessConfigRepository,
+
+		rbacSvc.NewService,
+		rbacSvc.NewPermissionService,
+
+		hybrid.NewPermissionService,
+
+		audit.NewOperationLogDao,
+	)
+	abacSet = wire.NewSet(
+		dao.NewAttributeDefinitionDAO,
+		dao.NewResourceAttributeValueDAO,
+		dao.NewEnvironmentAttributeValueDAO,
+		dao.NewSubjectAttributeValueDAO,
+		dao.NewPolicyDAO,
+		dao.NewAttributeValueHistoryDAO,
 
 		repository.NewAttributeDefinitionRepository,
 		repository.NewAttributeValueRepository,
 		repository.NewAttributePolicyRepository,
 
-		rbacSvc.NewService,
-		rbacSvc.NewPermissionService,
-
 		abac.NewAttributeDefinitionSvc,
 		abac.NewAttributeValueSvc,
 		abac.NewPolicySvc,
-
-		audit.NewOperationLogDao,
+		abac.NewPermissionSvc,
+		abac.NewPolicyExecutor,
+		abac.NewPolicyCache,
+		evaluator.NewSelector,
+		provider.NewSystemClock,
+		provider.NewBuiltinEnv,
 	)
 )
 
@@ -69,10 +88,22 @@ func InitApp() *ioc.App {
 		// RBAC 服务
 		rbacSet,
 
+		// ABAC 服务
+		abacSet,
+
 		// GRPC服务器
 		rbac.NewServer,
 		rbac.NewPermissionServer,
+		abacGrpc.NewABACPolicyServer,
+		abacGrpc.NewABACAttributeValServer,
+		abacGrpc.NewABACAttributeDefinitionServer,
 		ioc.InitGRPC,
+
+		// 定时任务
+		ioc.InitUserPermissionEventProducer,
+		ioc.InitUserPermissionCacheReloader,
+		ioc.InitGrantValidityReloadTask,
+		ioc.InitTasks,
 		wire.Struct(new(ioc.App), "*"),
 	)
 
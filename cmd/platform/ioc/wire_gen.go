@@ -8,41 +8,80 @@ package ioc
 
 import (
 	"github.com/google/wire"
+	abac2 "github.com/permission-dev/internal/api/grpc/abac"
 	rbac2 "github.com/permission-dev/internal/api/grpc/rbac"
 	"github.com/permission-dev/internal/ioc"
 	"github.com/permission-dev/internal/repository"
 	"github.com/permission-dev/internal/repository/dao"
+	"github.com/permission-dev/internal/repository/dao/audit"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/provider"
+	"github.com/permission-dev/internal/service/hybrid"
 	"github.com/permission-dev/internal/service/rbac"
 )
 
 // Injectors from wire.go:
 
 func InitApp() *ioc.App {
-	db := ioc.InitDB()
-	roleDAO := dao.NewRoleDao(db)
+	v := ioc.InitDB()
+	roleDAO := dao.NewRoleDao(v)
 	roleRepository := repository.NewRoleRepository(roleDAO)
-	resourceDao := dao.NewResourceDao(db)
+	resourceDao := dao.NewResourceDao(v)
 	resourceRepository := repository.NewResourceRepository(resourceDao)
-	permissionDAO := dao.NewPermissionDAO(db)
+	permissionDAO := dao.NewPermissionDAO(v)
 	permissionRepository := repository.NewPermissionRepository(permissionDAO)
-	userRoleDAO := dao.NewUserDaoDAO(db)
+	userRoleDAO := dao.NewUserDaoDAO(v)
 	userRoleRepository := repository.NewUserRoleRepository(userRoleDAO)
-	rolePermissionDAO := dao.NewRolePermissionDAO(db)
+	rolePermissionDAO := dao.NewRolePermissionDAO(v)
 	rolePermissionRepository := repository.NewRolePermissionRepository(rolePermissionDAO)
-	roleInclusionDAO := dao.NewRoleInclusionDAO(db)
+	roleInclusionDAO := dao.NewRoleInclusionDAO(v)
 	roleIncludeRepository := repository.NewRoleIncludeRepository(roleInclusionDAO)
-	userPermissionDAO := dao.NewUserPermissionDAO(db)
-	userPermissionRepository := repository.NewUserPermissionRepository(userPermissionDAO)
-	businessConfigDAO := dao.NewBusinessConfigDAO(db)
-	businessConfigRepository := repository.NewBusinessConfigRepository(businessConfigDAO)
+	userPermissionDAO := dao.NewUserPermissionDAO(v)
+	grantHistoryDAO := dao.NewGrantHistoryDAO(v)
+	roleInclusionConfig := ioc.InitRoleInclusionConfig()
+	userPermissionRepository := repository.NewUserPermissionRepository(userRoleDAO, roleInclusionDAO, rolePermissionDAO, userPermissionDAO, grantHistoryDAO, roleInclusionConfig)
+	businessConfigDAO := dao.NewBusinessConfigDAO(v)
+	cache := ioc.InitLocalCache()
+	businessConfigRepository := ioc.InitBusinessConfigRepository(businessConfigDAO, cache)
 	token := ioc.InitJwtToken()
-	service := rbac.NewService(roleRepository, resourceRepository, permissionRepository, userRoleRepository, rolePermissionRepository, roleIncludeRepository, userPermissionRepository, businessConfigRepository, token)
+	service := rbac.NewService(roleRepository, resourceRepository, permissionRepository, userRoleRepository, rolePermissionRepository, roleIncludeRepository, userPermissionRepository, businessConfigRepository, token, roleInclusionConfig)
 	server := rbac2.NewServer(service)
 	permissionService := rbac.NewPermissionService(userPermissionRepository)
-	permissionServer := rbac2.NewPermissionServer(permissionService)
-	v := ioc.InitGRPC(server, permissionServer, token)
+	policyDAO := dao.NewPolicyDAO(v)
+	attributePolicyRepository := repository.NewAttributePolicyRepository(policyDAO)
+	attributeDefinitionDAO := dao.NewAttributeDefinitionDAO(v)
+	attributeDefinitionRepository := repository.NewAttributeDefinitionRepository(attributeDefinitionDAO)
+	selector := evaluator.NewSelector()
+	policyExecutor := abac.NewPolicyExecutor(selector)
+	policyCache := abac.NewPolicyCache(attributePolicyRepository, attributeDefinitionRepository, policyExecutor)
+	resourceAttributeValueDAO := dao.NewResourceAttributeValueDAO(v)
+	environmentAttributeValueDAO := dao.NewEnvironmentAttributeValueDAO(v)
+	subjectAttributeValueDAO := dao.NewSubjectAttributeValueDAO(v)
+	attributeValueHistoryDAO := dao.NewAttributeValueHistoryDAO(v)
+	attributeValueRepository := repository.NewAttributeValueRepository(resourceAttributeValueDAO, environmentAttributeValueDAO, subjectAttributeValueDAO, attributeDefinitionDAO, attributeValueHistoryDAO)
+	registry := ioc.InitAttributeProviderRegistry()
+	clock := provider.NewSystemClock()
+	clientIPConfig := ioc.InitClientIPConfig()
+	builtinEnv := provider.NewBuiltinEnv(clock, clientIPConfig)
+	permissionSvc := abac.NewPermissionSvc(permissionRepository, resourceRepository, policyCache, attributeValueRepository, attributeDefinitionRepository, businessConfigRepository, policyExecutor, registry, builtinEnv)
+	hybridPermissionService := hybrid.NewPermissionService(permissionService, permissionSvc, businessConfigRepository)
+	permissionServer := rbac2.NewPermissionServer(hybridPermissionService)
+	policySvc := abac.NewPolicySvc(attributePolicyRepository, attributeDefinitionRepository, permissionRepository, selector, policyCache)
+	abacPolicyServer := abac2.NewABACPolicyServer(policySvc, permissionSvc)
+	attributeValueSvc := abac.NewAttributeValueSvc(attributeValueRepository, attributeDefinitionRepository)
+	abacAttributeValServer := abac2.NewABACAttributeValServer(attributeValueSvc)
+	attributeDefinitionSvc := abac.NewAttributeDefinitionSvc(attributeDefinitionRepository, policyCache)
+	abacAttributeDefinitionServer := abac2.NewABACAttributeDefinitionServer(attributeDefinitionSvc)
+	v2 := ioc.InitGRPC(server, permissionServer, abacPolicyServer, abacAttributeValServer, abacAttributeDefinitionServer, token)
+	v3 := ioc.InitCacheKeyFunc()
+	userPermissionEventProducer := ioc.InitUserPermissionEventProducer()
+	userPermissionCacheReloader := ioc.InitUserPermissionCacheReloader(userPermissionRepository, cache, v3, userPermissionEventProducer)
+	grantValidityReloadTask := ioc.InitGrantValidityReloadTask(userPermissionRepository, userPermissionCacheReloader)
+	v4 := ioc.InitTasks(grantValidityReloadTask)
 	app := &ioc.App{
-		GrpcServers: v,
+		GrpcServers: v2,
+		Tasks:       v4,
 	}
 	return app
 }
@@ -50,6 +89,7 @@ func InitApp() *ioc.App {
 // wire.go:
 
 var (
-	baseSet = wire.NewSet(ioc.InitDB, ioc.InitJwtToken)
-	rbacSet = wire.NewSet(dao.NewRoleDao, dao.NewResourceDao, dao.NewPermissionDAO, dao.NewUserDaoDAO, dao.NewRolePermissionDAO, dao.NewUserPermissionDAO, dao.NewRoleInclusionDAO, dao.NewBusinessConfigDAO, repository.NewRoleRepository, repository.NewResourceRepository, repository.NewPermissionRepository, repository.NewUserRoleRepository, repository.NewRolePermissionRepository, repository.NewUserPermissionRepository, repository.NewRoleIncludeRepository, repository.NewBusinessConfigRepository, rbac.NewService, rbac.NewPermissionService)
+	baseSet = wire.NewSet(ioc.InitDB, ioc.InitJwtToken, ioc.InitLocalCache, ioc.InitCacheKeyFunc, ioc.InitMultiLevelCache, ioc.InitRedisClient, ioc.InitRoleInclusionConfig, ioc.InitBusinessConfigRepository, ioc.InitClientIPConfig, ioc.InitAttributeProviderRegistry)
+	rbacSet = wire.NewSet(dao.NewRoleDao, dao.NewResourceDao, dao.NewPermissionDAO, dao.NewUserDaoDAO, dao.NewRolePermissionDAO, dao.NewUserPermissionDAO, dao.NewRoleInclusionDAO, dao.NewBusinessConfigDAO, dao.NewGrantHistoryDAO, repository.NewRoleRepository, repository.NewResourceRepository, repository.NewPermissionRepository, repository.NewUserRoleRepository, repository.NewRolePermissionRepository, repository.NewUserPermissionRepository, repository.NewRoleIncludeRepository, rbac.NewService, rbac.NewPermissionService, hybrid.NewPermissionService, audit.NewOperationLogDao)
+	abacSet = wire.NewSet(dao.NewAttributeDefinitionDAO, dao.NewResourceAttributeValueDAO, dao.NewEnvironmentAttributeValueDAO, dao.NewSubjectAttributeValueDAO, dao.NewPolicyDAO, dao.NewAttributeValueHistoryDAO, repository.NewAttributeDefinitionRepository, repository.NewAttributeValueRepository, repository.NewAttributePolicyRepository, abac.NewAttributeDefinitionSvc, abac.NewAttributeValueSvc, abac.NewPolicySvc, abac.NewPermissionSvc, abac.NewPolicyExecutor, abac.NewPolicyCache, evaluator.NewSelector, provider.NewSystemClock, provider.NewBuiltinEnv)
 )
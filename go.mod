@@ -11,6 +11,7 @@ require (
 	github.com/ecodeclub/ginx v0.0.2
 	github.com/ego-component/eetcd v1.0.0
 	github.com/ego-component/egorm v1.1.4
+	github.com/envoyproxy/protoc-gen-validate v1.2.1
 	github.com/gin-gonic/gin v1.9.1
 	github.com/go-sql-driver/mysql v1.6.0
 	github.com/golang-jwt/jwt/v4 v4.5.0
@@ -60,3 +60,7 @@ func (a *ABACAttributeDefinitionServer) Find(ctx context.Context, request *permi
 	}
 	return &permissionv1.AttributeDefinitionServiceFindResponse{BizDefinition: a.convertToProtoBizDefinition(bizDefinitions)}, nil
 }
+
+func NewABACAttributeDefinitionServer(svc abac.AttributeDefinitionSvc) *ABACAttributeDefinitionServer {
+	return &ABACAttributeDefinitionServer{svc: svc}
+}
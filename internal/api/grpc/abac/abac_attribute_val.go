@@ -1,10 +1,18 @@
 package abac
 
 import (
+	"bufio"
 	"context"
+	"errors"
+	"io"
+
+	"github.com/ecodeclub/ekit/slice"
 	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
 	"github.com/permission-dev/internal/domain"
 	"github.com/permission-dev/internal/service/abac"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ABACAttributeValServer struct {
@@ -148,6 +156,85 @@ func (a *ABACAttributeValServer) FindEnvironmentValueWithDefinition(ctx context.
 	}, nil
 }
 
+func (a *ABACAttributeValServer) ImportValues(stream grpc.ClientStreamingServer[permissionv1.AttributeValueServiceImportValuesRequest, permissionv1.AttributeValueServiceImportValuesResponse]) error {
+	ctx := stream.Context()
+	bizId, err := a.baseServer.getBizIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	first, err := stream.Recv()
+	if errors.Is(err, io.EOF) {
+		return status.Error(codes.InvalidArgument, "没有数据")
+	}
+	if err != nil {
+		return err
+	}
+	res, err := a.svc.ImportValues(ctx, bizId, a.toDomainEntityType(first.EntityType),
+		a.convertToDomainAttrValueFormat(first.Format), &importStreamReader{stream: stream, buf: first.Data})
+	if err != nil {
+		return err
+	}
+	return stream.SendAndClose(&permissionv1.AttributeValueServiceImportValuesResponse{
+		Total:     res.Total,
+		Succeeded: res.Succeeded,
+		Failed:    res.Failed,
+		Errors: slice.Map(res.Errors, func(_ int, src domain.AttrValueRowError) *permissionv1.AttributeValueImportError {
+			return &permissionv1.AttributeValueImportError{Line: src.Line, Message: src.Message}
+		}),
+	})
+}
+
+func (a *ABACAttributeValServer) ExportValues(request *permissionv1.AttributeValueServiceExportValuesRequest, stream grpc.ServerStreamingServer[permissionv1.AttributeValueServiceExportValuesResponse]) error {
+	ctx := stream.Context()
+	bizId, err := a.baseServer.getBizIDFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	w := bufio.NewWriterSize(&exportStreamWriter{stream: stream}, exportChunkSize)
+	err = a.svc.ExportValues(ctx, bizId, a.toDomainEntityType(request.EntityType), a.convertToDomainAttrValueFormat(request.Format), w)
+	if err != nil {
+		return err
+	}
+	return w.Flush()
+}
+
+// exportChunkSize 导出时每条消息的大小
+const exportChunkSize = 64 * 1024
+
+// importStreamReader 把上传的数据块拼成 io.Reader
+type importStreamReader struct {
+	stream grpc.ClientStreamingServer[permissionv1.AttributeValueServiceImportValuesRequest, permissionv1.AttributeValueServiceImportValuesResponse]
+	buf    []byte
+}
+
+func (r *importStreamReader) Read(p []byte) (int, error) {
+	for len(r.buf) == 0 {
+		msg, err := r.stream.Recv()
+		if err != nil {
+			return 0, err
+		}
+		r.buf = msg.Data
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// exportStreamWriter 每次 Write 发送一条消息
+type exportStreamWriter struct {
+	stream grpc.ServerStreamingServer[permissionv1.AttributeValueServiceExportValuesResponse]
+}
+
+func (w *exportStreamWriter) Write(p []byte) (int, error) {
+	// bufio.Writer 会复用 p，发送之后消息不能再修改
+	data := make([]byte, len(p))
+	copy(data, p)
+	if err := w.stream.Send(&permissionv1.AttributeValueServiceExportValuesResponse{Data: data}); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
 func NewABACAttributeValServer(svc abac.AttributeValueSvc) *ABACAttributeValServer {
 	return &ABACAttributeValServer{svc: svc}
 }
@@ -2,14 +2,21 @@ package abac
 
 import (
 	"context"
+	"errors"
+	"github.com/ecodeclub/ekit/slice"
 	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
 	"github.com/permission-dev/internal/service/abac"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type ABACPolicyServer struct {
 	baseServer
 	permissionv1.UnsafePolicyServiceServer
-	svc abac.PolicySvc
+	svc           abac.PolicySvc
+	permissionSvc abac.PermissionSvc
 }
 
 func (a *ABACPolicyServer) Save(ctx context.Context, request *permissionv1.PolicyServiceSaveRequest) (*permissionv1.PolicyServiceSaveResponse, error) {
@@ -19,6 +26,9 @@ func (a *ABACPolicyServer) Save(ctx context.Context, request *permissionv1.Polic
 	}
 	request.Policy.BizId = bizId
 	id, err := a.svc.Save(ctx, a.convertToDomainPolicy(request.Policy))
+	if err != nil {
+		return nil, err
+	}
 	return &permissionv1.PolicyServiceSaveResponse{Id: id}, nil
 }
 
@@ -55,14 +65,45 @@ func (a *ABACPolicyServer) SaveRule(ctx context.Context, request *permissionv1.P
 	}
 	rule := a.convertToDomainPolicyRule(request.Rule)
 	id, err := a.svc.SaveRule(ctx, bizId, request.PolicyId, rule) // Dereference the pointer
+	if errors.Is(err, errs.ErrInvalidPolicyRule) {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
 	if err != nil {
-		return nil, err
+		return nil, policyStatusError(err, "保存规则失败")
 	}
 	return &permissionv1.PolicyServiceSaveRuleResponse{
 		Id: id,
 	}, nil
 }
 
+func (a *ABACPolicyServer) SaveExpression(ctx context.Context, request *permissionv1.PolicyServiceSaveExpressionRequest) (*permissionv1.PolicyServiceSaveExpressionResponse, error) {
+	bizId, err := a.baseServer.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id, err := a.svc.SaveExpression(ctx, bizId, request.PolicyId, request.Expression)
+	if err != nil {
+		return nil, policyStatusError(err, "保存表达式失败")
+	}
+	return &permissionv1.PolicyServiceSaveExpressionResponse{RuleId: id}, nil
+}
+
+func (a *ABACPolicyServer) GetExpression(ctx context.Context, request *permissionv1.PolicyServiceGetExpressionRequest) (*permissionv1.PolicyServiceGetExpressionResponse, error) {
+	bizId, err := a.baseServer.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	expr, err := a.svc.Expression(ctx, bizId, request.PolicyId)
+	// 已有的规则无法渲染成表达式，不是请求的问题
+	if errors.Is(err, errs.ErrInvalidPolicyExpression) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, policyStatusError(err, "获取表达式失败")
+	}
+	return &permissionv1.PolicyServiceGetExpressionResponse{Expression: expr}, nil
+}
+
 func (a *ABACPolicyServer) DeleteRule(ctx context.Context, request *permissionv1.PolicyServiceDeleteRuleRequest) (*permissionv1.PolicyServiceDeleteRuleResponse, error) {
 	bizId, err := a.baseServer.getBizIDFromContext(ctx)
 	if err != nil {
@@ -103,6 +144,148 @@ func (a *ABACPolicyServer) FindPolicies(ctx context.Context, request *permission
 	}, nil
 }
 
-func NewABACPolicyServer(svc abac.PolicySvc) *ABACPolicyServer {
-	return &ABACPolicyServer{svc: svc}
+func (a *ABACPolicyServer) Simulate(ctx context.Context, request *permissionv1.PolicyServiceSimulateRequest) (*permissionv1.PolicyServiceSimulateResponse, error) {
+	bizId, err := a.baseServer.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if request.Policy == nil || len(request.Permissions) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "候选策略以及绑定的权限不能为空")
+	}
+	if len(request.Samples) > domain.MaxSimulationSamples {
+		return nil, status.Errorf(codes.InvalidArgument, "样本数量不能超过 %d", domain.MaxSimulationSamples)
+	}
+	request.Policy.BizId = bizId
+	candidate := a.convertToDomainPolicy(request.Policy)
+	candidate.Permissions = slice.Map(request.Permissions, func(_ int, src *permissionv1.PolicyPermissionBinding) domain.UserPermission {
+		return domain.UserPermission{
+			BizID:      bizId,
+			Permission: domain.Permission{ID: src.PermissionId, BizID: bizId},
+			Effect:     a.convertToDomainEffect(src.Effect),
+		}
+	})
+	samples := slice.Map(request.Samples, func(_ int, src *permissionv1.SimulationSample) domain.SimulationSample {
+		return domain.SimulationSample{
+			UserID: src.Uid,
+			Resource: domain.Resource{
+				BizID: bizId,
+				Type:  src.ResourceType,
+				Key:   src.ResourceKey,
+			},
+			Actions: src.Actions,
+			Attrs: domain.Attributes{
+				Subject:     src.SubjectAttributes,
+				Resource:    src.ResourceAttributes,
+				Environment: src.EnvironmentAttributes,
+			},
+		}
+	})
+	// 候选策略的规则和保存时一样校验，否则无效的规则只会被当作无法判定
+	if err = a.svc.ValidatePolicy(ctx, bizId, candidate); err != nil {
+		return nil, policyStatusError(err, "校验候选策略失败")
+	}
+	results, err := a.permissionSvc.Simulate(ctx, bizId, candidate, samples)
+	if err != nil {
+		return nil, policyStatusError(err, "模拟策略失败")
+	}
+	return &permissionv1.PolicyServiceSimulateResponse{
+		Results: slice.Map(results, func(_ int, src domain.SimulationResult) *permissionv1.SimulationResult {
+			return &permissionv1.SimulationResult{
+				Before:     src.Before,
+				After:      src.After,
+				Changed:    src.Changed(),
+				Applicable: src.Applicable,
+				Matched:    src.Matched,
+			}
+		}),
+	}, nil
+}
+
+func (a *ABACPolicyServer) Publish(ctx context.Context, request *permissionv1.PolicyServicePublishRequest) (*permissionv1.PolicyServicePublishResponse, error) {
+	bizId, err := a.baseServer.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := a.svc.Publish(ctx, bizId, request.PolicyId, request.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &permissionv1.PolicyServicePublishResponse{Version: version}, nil
+}
+
+func (a *ABACPolicyServer) Rollback(ctx context.Context, request *permissionv1.PolicyServiceRollbackRequest) (*permissionv1.PolicyServiceRollbackResponse, error) {
+	bizId, err := a.baseServer.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := a.svc.Rollback(ctx, bizId, request.PolicyId, request.Version, request.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &permissionv1.PolicyServiceRollbackResponse{Version: version}, nil
+}
+
+func (a *ABACPolicyServer) ListVersions(ctx context.Context, request *permissionv1.PolicyServiceListVersionsRequest) (*permissionv1.PolicyServiceListVersionsResponse, error) {
+	bizId, err := a.baseServer.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := a.svc.FindVersions(ctx, bizId, request.PolicyId)
+	if err != nil {
+		return nil, err
+	}
+	return &permissionv1.PolicyServiceListVersionsResponse{
+		Versions: slice.Map(versions, func(_ int, src domain.PolicyVersion) *permissionv1.PolicyVersion {
+			return a.convertToProtoPolicyVersion(src)
+		}),
+	}, nil
+}
+
+func (a *ABACPolicyServer) GetVersion(ctx context.Context, request *permissionv1.PolicyServiceGetVersionRequest) (*permissionv1.PolicyServiceGetVersionResponse, error) {
+	bizId, err := a.baseServer.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	version, err := a.svc.FindVersion(ctx, bizId, request.PolicyId, request.Version)
+	if err != nil {
+		return nil, err
+	}
+	return &permissionv1.PolicyServiceGetVersionResponse{Version: a.convertToProtoPolicyVersion(version)}, nil
+}
+
+func (a *ABACPolicyServer) DiffVersions(ctx context.Context, request *permissionv1.PolicyServiceDiffVersionsRequest) (*permissionv1.PolicyServiceDiffVersionsResponse, error) {
+	bizId, err := a.baseServer.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diff, err := a.svc.DiffVersions(ctx, bizId, request.PolicyId, request.From, request.To)
+	if err != nil {
+		return nil, err
+	}
+	return &permissionv1.PolicyServiceDiffVersionsResponse{
+		FromExpression: diff.FromExpression,
+		ToExpression:   diff.ToExpression,
+		AddedRules:     diff.AddedRules,
+		RemovedRules:   diff.RemovedRules,
+		Changes: slice.Map(diff.Changes, func(_ int, src domain.PolicyFieldChange) *permissionv1.PolicyFieldChange {
+			return &permissionv1.PolicyFieldChange{Field: src.Field, From: src.From, To: src.To}
+		}),
+	}, nil
+}
+
+// policyStatusError 策略不存在时返回 NotFound，表达式或者规则无效时返回 InvalidArgument，
+// 其他错误（例如数据库错误）返回 Internal，msg 说明失败的操作
+func policyStatusError(err error, msg string) error {
+	switch {
+	case errors.Is(err, errs.ErrPolicyNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, errs.ErrInvalidPolicyExpression), errors.Is(err, errs.ErrInvalidPolicyRule):
+		return status.Error(codes.InvalidArgument, err.Error())
+	default:
+		return status.Error(codes.Internal, msg+": "+err.Error())
+	}
+}
+
+func NewABACPolicyServer(svc abac.PolicySvc, permissionSvc abac.PermissionSvc) *ABACPolicyServer {
+	return &ABACPolicyServer{svc: svc, permissionSvc: permissionSvc}
 }
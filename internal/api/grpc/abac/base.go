@@ -19,8 +19,12 @@ func (s *baseServer) toProtoAttributeDefinition(definition domain.AttributeDefin
 		Name:           definition.Name,
 		Description:    definition.Description,
 		DataType:       s.convertToProtoDataType(definition.DataType),
+		CustomDataType: s.convertToProtoCustomDataType(definition.DataType),
 		EntityType:     s.convertToProtoEntityType(definition.EntityType),
 		ValidationRule: definition.ValidationRule,
+		DefaultValue:   definition.DefaultValue,
+		Constraints:    s.convertToProtoAttrConstraints(definition.Constraints),
+		ResourceType:   definition.ResourceType,
 		Ctime:          definition.Ctime,
 		Utime:          definition.Utime,
 	}
@@ -33,14 +37,17 @@ func (s *baseServer) toDomainAttributeDefinition(definition *permissionv1.Attrib
 		ID:             definition.Id,
 		Name:           definition.Name,
 		Description:    definition.Description,
-		DataType:       s.toDomainDataType(definition.DataType),
+		DataType:       s.toDomainDataType(definition.DataType, definition.CustomDataType),
 		EntityType:     s.toDomainEntityType(definition.EntityType),
 		ValidationRule: definition.ValidationRule,
+		DefaultValue:   definition.DefaultValue,
+		Constraints:    s.convertToDomainAttrConstraints(definition.Constraints),
+		ResourceType:   definition.ResourceType,
 		Ctime:          definition.Ctime,
 		Utime:          definition.Utime,
 	}
 }
-func (s *baseServer) toDomainDataType(dataType permissionv1.DataType) domain.DataType {
+func (s *baseServer) toDomainDataType(dataType permissionv1.DataType, custom string) domain.DataType {
 	switch dataType {
 	case permissionv1.DataType_DATA_TYPE_STRING:
 		return domain.DataTypeString
@@ -52,8 +59,13 @@ func (s *baseServer) toDomainDataType(dataType permissionv1.DataType) domain.Dat
 		return domain.DataTypeFloat
 	case permissionv1.DataType_DATA_TYPE_DATETIME:
 		return domain.DataTypeDatetime
+	case permissionv1.DataType_DATA_TYPE_IP:
+		return domain.DataTypeIP
+	case permissionv1.DataType_DATA_TYPE_ARRAY:
+		return domain.DataTypeArray
 	default:
-		return domain.DataType("")
+		// 自定义数据类型
+		return domain.DataType(custom)
 	}
 
 }
@@ -76,8 +88,12 @@ func (s *baseServer) convertToProtoAttributeDefinition(d domain.AttributeDefinit
 		Name:           d.Name,
 		Description:    d.Description,
 		DataType:       s.convertToProtoDataType(d.DataType),
+		CustomDataType: s.convertToProtoCustomDataType(d.DataType),
 		EntityType:     s.convertToProtoEntityType(d.EntityType),
 		ValidationRule: d.ValidationRule,
+		DefaultValue:   d.DefaultValue,
+		Constraints:    s.convertToProtoAttrConstraints(d.Constraints),
+		ResourceType:   d.ResourceType,
 		Ctime:          d.Ctime,
 		Utime:          d.Utime,
 	}
@@ -94,10 +110,63 @@ func (s *baseServer) convertToProtoDataType(d domain.DataType) permissionv1.Data
 		return permissionv1.DataType_DATA_TYPE_FLOAT
 	case domain.DataTypeDatetime:
 		return permissionv1.DataType_DATA_TYPE_DATETIME
+	case domain.DataTypeIP:
+		return permissionv1.DataType_DATA_TYPE_IP
+	case domain.DataTypeArray:
+		return permissionv1.DataType_DATA_TYPE_ARRAY
 	default:
 		return permissionv1.DataType_DATA_TYPE_UNKNOWN
 	}
 }
+
+func (s *baseServer) convertToProtoAttrConstraints(c domain.AttrConstraints) *permissionv1.AttributeConstraints {
+	if c.IsZero() {
+		return nil
+	}
+	return &permissionv1.AttributeConstraints{
+		Min:         c.Min,
+		Max:         c.Max,
+		EnumValues:  c.Enum,
+		ElementType: s.convertToProtoDataType(c.ElemType),
+		MaxLength:   int32(c.MaxLen),
+		NotBefore:   c.NotBefore,
+		NotAfter:    c.NotAfter,
+	}
+}
+
+func (s *baseServer) convertToDomainAttrConstraints(c *permissionv1.AttributeConstraints) domain.AttrConstraints {
+	if c == nil {
+		return domain.AttrConstraints{}
+	}
+	return domain.AttrConstraints{
+		Min:       c.Min,
+		Max:       c.Max,
+		Enum:      c.EnumValues,
+		ElemType:  s.convertToDomainDataType(c.ElementType, ""),
+		MaxLen:    int(c.MaxLength),
+		NotBefore: c.NotBefore,
+		NotAfter:  c.NotAfter,
+	}
+}
+
+func (s *baseServer) convertToDomainAttrValueFormat(format permissionv1.AttributeValueFormat) domain.AttrValueFormat {
+	switch format {
+	case permissionv1.AttributeValueFormat_ATTRIBUTE_VALUE_FORMAT_CSV:
+		return domain.AttrValueFormatCSV
+	case permissionv1.AttributeValueFormat_ATTRIBUTE_VALUE_FORMAT_NDJSON:
+		return domain.AttrValueFormatNDJSON
+	default:
+		return ""
+	}
+}
+
+// convertToProtoCustomDataType 枚举中没有的数据类型通过 custom_data_type 返回
+func (s *baseServer) convertToProtoCustomDataType(d domain.DataType) string {
+	if s.convertToProtoDataType(d) != permissionv1.DataType_DATA_TYPE_UNKNOWN {
+		return ""
+	}
+	return string(d)
+}
 func (s *baseServer) convertToProtoEntityType(e domain.EntityType) permissionv1.EntityType {
 	switch e {
 	case domain.SubjectTypeEntity:
@@ -174,6 +243,8 @@ func (s *baseServer) convertToDomainPolicy(p *permissionv1.Policy) domain.Policy
 		Name:        p.Name,
 		Description: p.Description,
 		Status:      s.convertToDomainPolicyStatus(p.Status),
+		Priority:    int(p.Priority),
+		MissingAttr: s.convertToDomainMissingAttrMode(p.MissingAttr),
 		Permissions: []domain.UserPermission{
 			{
 				Effect: domain.Effect(p.Effect),
@@ -195,6 +266,30 @@ func (s *baseServer) convertToDomainPolicyStatus(status permissionv1.PolicyStatu
 		return ""
 	}
 }
+func (s *baseServer) convertToDomainMissingAttrMode(mode permissionv1.MissingAttrMode) domain.MissingAttrMode {
+	switch mode {
+	case permissionv1.MissingAttrMode_MISSING_ATTR_MODE_FALSE:
+		return domain.MissingAttrFalse
+	case permissionv1.MissingAttrMode_MISSING_ATTR_MODE_INDETERMINATE:
+		return domain.MissingAttrIndeterminate
+	case permissionv1.MissingAttrMode_MISSING_ATTR_MODE_DEFAULT:
+		return domain.MissingAttrDefault
+	default:
+		return ""
+	}
+}
+func (s *baseServer) convertToProtoMissingAttrMode(mode domain.MissingAttrMode) permissionv1.MissingAttrMode {
+	switch mode {
+	case domain.MissingAttrFalse:
+		return permissionv1.MissingAttrMode_MISSING_ATTR_MODE_FALSE
+	case domain.MissingAttrIndeterminate:
+		return permissionv1.MissingAttrMode_MISSING_ATTR_MODE_INDETERMINATE
+	case domain.MissingAttrDefault:
+		return permissionv1.MissingAttrMode_MISSING_ATTR_MODE_DEFAULT
+	default:
+		return permissionv1.MissingAttrMode_MISSING_ATTR_MODE_UNKNOWN
+	}
+}
 func (s *baseServer) convertToDomainPolicyRules(rules []*permissionv1.PolicyRule) []domain.PolicyRule {
 	if rules == nil {
 		return nil
@@ -209,18 +304,26 @@ func (s *baseServer) convertToDomainPolicyRule(r *permissionv1.PolicyRule) domai
 	if r == nil {
 		return domain.PolicyRule{}
 	}
-	left := s.convertToDomainPolicyRule(r.LeftRule)
-	right := s.convertToDomainPolicyRule(r.RightRule)
-	return domain.PolicyRule{
-		ID:        r.Id,
-		AttrDef:   s.convertToDomainAttributeDefinition(r.AttributeDefinition),
-		Value:     r.Value,
-		Operator:  s.convertToDomainOperator(r.Operator),
-		LeftRule:  &left,
-		RightRule: &right,
+	rule := domain.PolicyRule{
+		ID:       r.Id,
+		AttrDef:  s.convertToDomainAttributeDefinition(r.AttributeDefinition),
+		Value:    r.Value,
+		Operator: s.convertToDomainOperator(r.Operator, r.CustomOperator),
+		// 未引用属性时为零值
+		ValueAttrDef: s.convertToDomainAttributeDefinition(r.ValueAttributeDefinition),
+	}
+	// 没有子规则时保持为 nil，执行器据此区分叶子规则和逻辑运算规则
+	if r.LeftRule != nil {
+		left := s.convertToDomainPolicyRule(r.LeftRule)
+		rule.LeftRule = &left
+	}
+	if r.RightRule != nil {
+		right := s.convertToDomainPolicyRule(r.RightRule)
+		rule.RightRule = &right
 	}
+	return rule
 }
-func (s *baseServer) convertToDomainOperator(o permissionv1.RuleOperator) domain.RuleOperator {
+func (s *baseServer) convertToDomainOperator(o permissionv1.RuleOperator, custom string) domain.RuleOperator {
 	switch o {
 	case permissionv1.RuleOperator_RULE_OPERATOR_EQUALS:
 		return domain.Equals
@@ -244,8 +347,13 @@ func (s *baseServer) convertToDomainOperator(o permissionv1.RuleOperator) domain
 		return domain.NotIn
 	case permissionv1.RuleOperator_RULE_OPERATOR_NOT:
 		return domain.NOT
+	case permissionv1.RuleOperator_RULE_OPERATOR_IN_CIDR:
+		return domain.InCIDR
+	case permissionv1.RuleOperator_RULE_OPERATOR_NOT_IN_CIDR:
+		return domain.NotInCIDR
 	default:
-		return domain.RuleOperator("")
+		// 自定义运算符，例如 MATCHES
+		return domain.RuleOperator(custom)
 	}
 }
 func (s *baseServer) convertToDomainAttributeDefinition(d *permissionv1.AttributeDefinition) domain.AttributeDefinition {
@@ -256,14 +364,17 @@ func (s *baseServer) convertToDomainAttributeDefinition(d *permissionv1.Attribut
 		ID:             d.Id,
 		Name:           d.Name,
 		Description:    d.Description,
-		DataType:       s.convertToDomainDataType(d.DataType),
+		DataType:       s.convertToDomainDataType(d.DataType, d.CustomDataType),
 		EntityType:     s.convertToDomainEntityType(d.EntityType),
 		ValidationRule: d.ValidationRule,
+		DefaultValue:   d.DefaultValue,
+		Constraints:    s.convertToDomainAttrConstraints(d.Constraints),
+		ResourceType:   d.ResourceType,
 		Ctime:          d.Ctime,
 		Utime:          d.Utime,
 	}
 }
-func (s *baseServer) convertToDomainDataType(d permissionv1.DataType) domain.DataType {
+func (s *baseServer) convertToDomainDataType(d permissionv1.DataType, custom string) domain.DataType {
 	switch d {
 	case permissionv1.DataType_DATA_TYPE_STRING:
 		return domain.DataTypeString
@@ -275,8 +386,13 @@ func (s *baseServer) convertToDomainDataType(d permissionv1.DataType) domain.Dat
 		return domain.DataTypeFloat
 	case permissionv1.DataType_DATA_TYPE_DATETIME:
 		return domain.DataTypeDatetime
+	case permissionv1.DataType_DATA_TYPE_IP:
+		return domain.DataTypeIP
+	case permissionv1.DataType_DATA_TYPE_ARRAY:
+		return domain.DataTypeArray
 	default:
-		return domain.DataType("")
+		// 自定义数据类型
+		return domain.DataType(custom)
 	}
 }
 func (s *baseServer) convertToDomainEntityType(e permissionv1.EntityType) domain.EntityType {
@@ -303,11 +419,25 @@ func (s *baseServer) convertToProtoPolicy(p domain.Policy) *permissionv1.Policy
 		Description: p.Description,
 		Status:      s.convertToProtoPolicyStatus(p.Status),
 		Effect:      s.convertToProtoEffect(effect),
+		Priority:    int32(p.Priority),
+		MissingAttr: s.convertToProtoMissingAttrMode(p.MissingAttr),
+		Version:     p.Version,
 		Rules:       s.convertToProtoPolicyRules(p.Rules),
 		Ctime:       p.Ctime,
 		Utime:       p.Utime,
 	}
 }
+
+func (s *baseServer) convertToProtoPolicyVersion(v domain.PolicyVersion) *permissionv1.PolicyVersion {
+	return &permissionv1.PolicyVersion{
+		Version:       v.Version,
+		SourceVersion: v.SourceVersion,
+		Comment:       v.Comment,
+		Published:     v.Published,
+		Policy:        s.convertToProtoPolicy(v.Policy),
+		Ctime:         v.Ctime,
+	}
+}
 func (s *baseServer) convertToProtoPolicyStatus(status domain.PolicyStatusType) permissionv1.PolicyStatus {
 	switch status {
 	case domain.PolicyStatusActive:
@@ -340,14 +470,25 @@ func (s *baseServer) convertToProtoPolicyRules(rules []domain.PolicyRule) []*per
 	return result
 }
 func (s *baseServer) convertToProtoPolicyRule(r domain.PolicyRule) *permissionv1.PolicyRule {
-	return &permissionv1.PolicyRule{
+	res := &permissionv1.PolicyRule{
 		Id:                  r.ID,
 		AttributeDefinition: s.convertToProtoAttributeDefinition(r.AttrDef),
 		Value:               r.Value,
 		Operator:            s.convertToProtoOperator(r.Operator),
-		LeftRule:            s.convertToProtoPolicyRule(r.SafeLeft()),
-		RightRule:           s.convertToProtoPolicyRule(r.SafeRight()),
 	}
+	if res.Operator == permissionv1.RuleOperator_RULE_OPERATOR_UNKNOWN {
+		res.CustomOperator = string(r.Operator)
+	}
+	if r.ReferencesAttr() {
+		res.ValueAttributeDefinition = s.convertToProtoAttributeDefinition(r.ValueAttrDef)
+	}
+	if r.LeftRule != nil {
+		res.LeftRule = s.convertToProtoPolicyRule(*r.LeftRule)
+	}
+	if r.RightRule != nil {
+		res.RightRule = s.convertToProtoPolicyRule(*r.RightRule)
+	}
+	return res
 }
 func (s *baseServer) convertToProtoOperator(o domain.RuleOperator) permissionv1.RuleOperator {
 	switch o {
@@ -373,6 +514,10 @@ func (s *baseServer) convertToProtoOperator(o domain.RuleOperator) permissionv1.
 		return permissionv1.RuleOperator_RULE_OPERATOR_NOT_IN
 	case domain.NOT:
 		return permissionv1.RuleOperator_RULE_OPERATOR_NOT
+	case domain.InCIDR:
+		return permissionv1.RuleOperator_RULE_OPERATOR_IN_CIDR
+	case domain.NotInCIDR:
+		return permissionv1.RuleOperator_RULE_OPERATOR_NOT_IN_CIDR
 	default:
 		return permissionv1.RuleOperator_RULE_OPERATOR_UNKNOWN
 	}
@@ -27,39 +27,70 @@ func New(token *jwt.Token) *InterceptorBuilder {
 
 func (ib *InterceptorBuilder) Build() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
-		//提取metadata
-		md, ok := metadata.FromIncomingContext(ctx)
-		if !ok {
-			return nil, status.Error(codes.Unauthenticated, "missing metadata")
-		}
-		//获取Authorization头
-		authHeaders := md.Get("Authorization")
-		if len(authHeaders) == 0 {
-			return nil, status.Error(codes.Unauthenticated, "authorization is required")
+		ctx, err = ib.authenticate(ctx)
+		if err != nil {
+			return nil, err
 		}
-		//处理token
-		tokenStr := authHeaders[0]
-		claim, err := ib.token.Decode(tokenStr)
+		return handler(ctx, req)
+	}
+}
+
+// BuildStream 流式接口使用，校验方式与 Build 相同
+func (ib *InterceptorBuilder) BuildStream() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := ib.authenticate(ss.Context())
 		if err != nil {
-			//细化错误返回
-			if errors.Is(err, jwt.ErrTokenExpired) {
-				return nil, status.Error(codes.Unauthenticated, "token expired")
-			}
-			if errors.Is(err, jwt.ErrTokenSignatureInvaild) {
-				return nil, status.Error(codes.Unauthenticated, "invaild signatrue")
-			}
-			return nil, status.Error(codes.Unauthenticated, "invalid token"+err.Error())
+			return err
 		}
-		val, ok := claim[BizIDName]
+		return handler(srv, &serverStream{ServerStream: ss, ctx: ctx})
+	}
+}
 
-		if ok {
-			bizId := val.(float64)
-			ctx = context.WithValue(ctx, BizIDName, int64(bizId))
-			elog.Info("用户请求信息", elog.FieldExtMessage(bizId))
+// authenticate 校验 token，并把业务ID放到返回的 context 中
+func (ib *InterceptorBuilder) authenticate(ctx context.Context) (context.Context, error) {
+	//提取metadata
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, "missing metadata")
+	}
+	//获取Authorization头
+	authHeaders := md.Get("Authorization")
+	if len(authHeaders) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "authorization is required")
+	}
+	//处理token
+	tokenStr := authHeaders[0]
+	claim, err := ib.token.Decode(tokenStr)
+	if err != nil {
+		//细化错误返回
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			return nil, status.Error(codes.Unauthenticated, "token expired")
 		}
-		return handler(ctx, req)
+		if errors.Is(err, jwt.ErrTokenSignatureInvaild) {
+			return nil, status.Error(codes.Unauthenticated, "invaild signatrue")
+		}
+		return nil, status.Error(codes.Unauthenticated, "invalid token"+err.Error())
+	}
+	val, ok := claim[BizIDName]
+
+	if ok {
+		bizId := val.(float64)
+		ctx = context.WithValue(ctx, BizIDName, int64(bizId))
+		elog.Info("用户请求信息", elog.FieldExtMessage(bizId))
 	}
+	return ctx, nil
 }
+
+// serverStream 替换流的 context
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
 func GetBizIDFromContext(ctx context.Context) (int64, error) {
 	val := ctx.Value(BizIDName)
 	if val == nil {
@@ -2,9 +2,11 @@ package rbac
 
 import (
 	"context"
+
+	"github.com/ecodeclub/ekit/slice"
 	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
 	"github.com/permission-dev/internal/domain"
-	"github.com/permission-dev/internal/service/rbac"
+	"github.com/permission-dev/internal/service/hybrid"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -12,23 +14,35 @@ import (
 type PermissionServer struct {
 	baseServer
 	permissionv1.UnimplementedPermissionServiceServer
-	permissionSvc rbac.PermissionService
+	permissionSvc hybrid.PermissionService
 }
 
 func (p *PermissionServer) CheckPermission(ctx context.Context, in *permissionv1.CheckPermissionRequest) (*permissionv1.CheckPermissionResponse, error) {
 	//参数校验
-	if in.Uid <= 0 || in.Permission.ResourceKey == "" || in.Permission.ResourceType == "" || len(in.Permission.Actions) == 0 {
+	if in.Uid <= 0 || in.Permission.ResourceKey == "" || in.Permission.ResourceType == "" || len(in.Permission.Actions) == 0 || in.AsOf < 0 {
 		return &permissionv1.CheckPermissionResponse{Allowed: false}, status.Error(codes.InvalidArgument, "参数无效")
 	}
 	bizId, err := p.getBizIDFromContext(ctx)
 	if err != nil {
 		return &permissionv1.CheckPermissionResponse{Allowed: false}, status.Error(codes.Unauthenticated, err.Error())
 	}
-	allow, err := p.permissionSvc.Check(ctx, bizId, in.Uid, domain.Resource{
+	resource := domain.Resource{
 		BizID: bizId,
 		Type:  in.Permission.ResourceType,
 		Key:   in.Permission.ResourceKey,
-	}, in.Permission.Actions)
+	}
+	attrs := p.toAttributes(in.SubjectAttributes, in.ResourceAttributes, in.EnvironmentAttributes)
+	if in.Explain {
+		trace, err := p.permissionSvc.ExplainAsOf(ctx, bizId, in.Uid, resource, in.Permission.Actions, attrs, in.AsOf)
+		if err != nil {
+			return &permissionv1.CheckPermissionResponse{Allowed: false}, status.Error(codes.Internal, err.Error())
+		}
+		return &permissionv1.CheckPermissionResponse{
+			Allowed: trace.Allowed,
+			Trace:   p.toCheckTraceProto(trace),
+		}, nil
+	}
+	allow, err := p.permissionSvc.CheckAsOf(ctx, bizId, in.Uid, resource, in.Permission.Actions, attrs, in.AsOf)
 	if err != nil {
 		return &permissionv1.CheckPermissionResponse{Allowed: false}, status.Error(codes.Internal, err.Error())
 
@@ -36,6 +50,116 @@ func (p *PermissionServer) CheckPermission(ctx context.Context, in *permissionv1
 	return &permissionv1.CheckPermissionResponse{Allowed: allow}, nil
 }
 
-func NewPermissionServer(permissionSvc rbac.PermissionService) *PermissionServer {
+func (p *PermissionServer) BatchCheckPermission(ctx context.Context, in *permissionv1.BatchCheckPermissionRequest) (*permissionv1.BatchCheckPermissionResponse, error) {
+	//参数校验
+	if in.Uid <= 0 || len(in.Permissions) == 0 || len(in.Permissions) > domain.MaxCheckItems {
+		return &permissionv1.BatchCheckPermissionResponse{}, status.Error(codes.InvalidArgument, "参数无效")
+	}
+	items := make([]domain.CheckItem, 0, len(in.Permissions))
+	for _, perm := range in.Permissions {
+		if perm == nil || perm.ResourceKey == "" || perm.ResourceType == "" || len(perm.Actions) == 0 {
+			return &permissionv1.BatchCheckPermissionResponse{}, status.Error(codes.InvalidArgument, "参数无效")
+		}
+		items = append(items, domain.CheckItem{
+			Resource: domain.Resource{
+				Type: perm.ResourceType,
+				Key:  perm.ResourceKey,
+			},
+			Actions: perm.Actions,
+		})
+	}
+	bizId, err := p.getBizIDFromContext(ctx)
+	if err != nil {
+		return &permissionv1.BatchCheckPermissionResponse{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	for idx := range items {
+		items[idx].Resource.BizID = bizId
+	}
+	attrs := p.toAttributes(in.SubjectAttributes, in.ResourceAttributes, in.EnvironmentAttributes)
+	allows, err := p.permissionSvc.BatchCheck(ctx, bizId, in.Uid, items, attrs)
+	if err != nil {
+		return &permissionv1.BatchCheckPermissionResponse{}, status.Error(codes.Internal, err.Error())
+	}
+	results := make([]*permissionv1.PermissionCheckResult, 0, len(allows))
+	for idx := range allows {
+		results = append(results, &permissionv1.PermissionCheckResult{
+			Permission: in.Permissions[idx],
+			Allowed:    allows[idx],
+		})
+	}
+	return &permissionv1.BatchCheckPermissionResponse{Results: results}, nil
+}
+
+func NewPermissionServer(permissionSvc hybrid.PermissionService) *PermissionServer {
 	return &PermissionServer{permissionSvc: permissionSvc}
 }
+
+func (p *PermissionServer) toCheckTraceProto(trace domain.CheckTrace) *permissionv1.CheckTrace {
+	return &permissionv1.CheckTrace{
+		CheckMode: trace.Mode.String(),
+		Rbac: &permissionv1.RBACTrace{
+			Allowed: trace.RBAC.Allowed,
+			Matches: slice.Map(trace.RBAC.Matches, func(idx int, src domain.UserPermission) *permissionv1.RBACMatch {
+				return &permissionv1.RBACMatch{
+					UserPermission: &permissionv1.UserPermission{
+						Id:               src.ID,
+						BizId:            src.BizID,
+						UserId:           src.UserID,
+						PermissionId:     src.Permission.ID,
+						PermissionName:   src.Permission.Name,
+						ResourceType:     src.Permission.Resource.Type,
+						ResourceKey:      src.Permission.Resource.Key,
+						PermissionAction: src.Permission.Action,
+						Effect:           src.Effect.String(),
+						StartTime:        src.StartTime,
+						EndTime:          src.EndTime,
+					},
+					RolePath: src.RolePath,
+				}
+			}),
+		},
+		Abac: &permissionv1.ABACTrace{
+			Allowed: trace.ABAC.Allowed,
+			Policies: slice.Map(trace.ABAC.Policies, func(idx int, src domain.PolicyTrace) *permissionv1.PolicyTrace {
+				return &permissionv1.PolicyTrace{
+					PolicyId:      src.Policy.ID,
+					PolicyName:    src.Policy.Name,
+					Result:        src.Result,
+					Indeterminate: src.Indeterminate,
+					Rules: slice.Map(src.Rules, func(idx int, src domain.RuleTrace) *permissionv1.RuleTrace {
+						return p.toRuleTraceProto(&src)
+					}),
+				}
+			}),
+		},
+	}
+}
+
+func (p *PermissionServer) toAttributes(subject, resource, environment map[string]string) domain.Attributes {
+	return domain.Attributes{
+		Subject:     subject,
+		Resource:    resource,
+		Environment: environment,
+	}
+}
+
+func (p *PermissionServer) toRuleTraceProto(trace *domain.RuleTrace) *permissionv1.RuleTrace {
+	if trace == nil {
+		return nil
+	}
+	return &permissionv1.RuleTrace{
+		RuleId:             trace.RuleID,
+		AttributeId:        trace.AttrDef.ID,
+		AttributeName:      trace.AttrDef.Name,
+		Operator:           trace.Operator.String(),
+		WantedValue:        trace.WantVal,
+		ActualValue:        trace.ActualVal,
+		Result:             trace.Result,
+		Error:              trace.Err,
+		Left:               p.toRuleTraceProto(trace.Left),
+		Right:              p.toRuleTraceProto(trace.Right),
+		ValueAttributeId:   trace.ValueAttrDef.ID,
+		ValueAttributeName: trace.ValueAttrDef.Name,
+		Indeterminate:      trace.Indeterminate,
+	}
+}
@@ -2,9 +2,11 @@ package rbac
 
 import (
 	"context"
+	"errors"
 	"github.com/ecodeclub/ekit/slice"
 	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
 	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
 	"github.com/permission-dev/internal/service/rbac"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -31,6 +33,19 @@ func (s *Server) CreateBusinessConfig(ctx context.Context, in *permissionv1.Crea
 	// 将proto中的业务配置转换为领域模型
 	in.Config.Id = 0
 	domainConfig := s.toBusniessConfigDomain(in.Config)
+	// 未指定时没有适用策略默认拒绝
+	if domainConfig.NotApplicableDecision == "" {
+		domainConfig.NotApplicableDecision = domain.EffectDeny
+	}
+	if !domainConfig.CheckMode.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "权限校验模式无效")
+	}
+	if !domainConfig.CombiningAlgorithm.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "策略合并算法无效")
+	}
+	if !domainConfig.NotApplicableDecision.IsAllow() && !domainConfig.NotApplicableDecision.IsDeny() {
+		return nil, status.Error(codes.InvalidArgument, "无适用策略时的结果无效")
+	}
 
 	// 调用服务创建业务配置
 	created, err := s.rbacService.CreateBusinessConfig(ctx, domainConfig)
@@ -64,8 +79,18 @@ func (s *Server) UpdateBusinessConfig(ctx context.Context, in *permissionv1.Upda
 		return nil, status.Error(codes.InvalidArgument, "业务配置不能为空且ID必须大于0")
 	}
 
-	// 将proto中的业务配置转换为领域模型
+	// 将proto中的业务配置转换为领域模型，校验模式、合并算法以及无适用策略时的结果为空时保留原有的值
 	domainConfig := s.toBusniessConfigDomain(in.Config)
+	if !domainConfig.CheckMode.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "权限校验模式无效")
+	}
+	if !domainConfig.CombiningAlgorithm.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "策略合并算法无效")
+	}
+	if domainConfig.NotApplicableDecision != "" &&
+		!domainConfig.NotApplicableDecision.IsAllow() && !domainConfig.NotApplicableDecision.IsDeny() {
+		return nil, status.Error(codes.InvalidArgument, "无适用策略时的结果无效")
+	}
 
 	// 调用服务更新业务配置
 	_, err := s.rbacService.UpdateBusinessConfig(ctx, domainConfig)
@@ -130,6 +155,9 @@ func (s *Server) CreateRoleInclusion(ctx context.Context, in *permissionv1.Creat
 
 	// 调用服务创建角色包含关系
 	created, err := s.rbacService.CreateRoleInclusion(ctx, domainRoleInclusion)
+	if errors.Is(err, errs.ErrRoleInclusionCycle) || errors.Is(err, errs.ErrRoleInclusionTooDeep) {
+		return nil, status.Error(codes.InvalidArgument, "创建角色包含关系失败: "+err.Error())
+	}
 	if err != nil {
 		return nil, status.Error(codes.Internal, "创建角色包含关系失败: "+err.Error())
 	}
@@ -296,6 +324,71 @@ func (s *Server) ListUserPermissions(ctx context.Context, in *permissionv1.ListU
 	}, nil
 }
 
+func (s *Server) GetEffectivePermissions(ctx context.Context, in *permissionv1.GetEffectivePermissionsRequest) (*permissionv1.GetEffectivePermissionsResponse, error) {
+	if in.UserId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "用户ID必须大于0")
+	}
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := s.rbacService.GetEffectivePermissions(ctx, bizID, in.UserId, in.ResourceType, in.ResourceKeyPrefix)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "获取用户生效权限失败: "+err.Error())
+	}
+
+	return &permissionv1.GetEffectivePermissionsResponse{
+		Permissions: slice.Map(permissions, func(_ int, src domain.EffectivePermission) *permissionv1.EffectivePermission {
+			return s.toEffectivePermissionProto(src)
+		}),
+	}, nil
+}
+
+// maxWhoCanAccessLimit WhoCanAccess 每页最多返回的用户数
+const maxWhoCanAccessLimit = 100
+
+func (s *Server) WhoCanAccess(ctx context.Context, in *permissionv1.WhoCanAccessRequest) (*permissionv1.WhoCanAccessResponse, error) {
+	if in.ResourceType == "" || in.ResourceKey == "" || in.Action == "" {
+		return nil, status.Error(codes.InvalidArgument, "资源类型、资源标识以及操作不能为空")
+	}
+
+	if in.Offset < 0 {
+		return nil, status.Error(codes.InvalidArgument, "offset不能小于0")
+	}
+	offset := int(in.Offset)
+	limit := int(in.Limit)
+	if limit <= 0 {
+		limit = 10 // 默认每页10条
+	}
+	limit = min(limit, maxWhoCanAccessLimit)
+
+	bizID, err := s.getBizIDFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resource := domain.Resource{BizID: bizID, Type: in.ResourceType, Key: in.ResourceKey}
+	users, total, err := s.rbacService.WhoCanAccess(ctx, bizID, resource, in.Action, offset, limit)
+	if errors.Is(err, errs.ErrTooManyGrants) {
+		return nil, status.Error(codes.ResourceExhausted, "有权限的用户过多，请缩小资源范围: "+err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, "获取有权限的用户失败: "+err.Error())
+	}
+
+	return &permissionv1.WhoCanAccessResponse{
+		Users: slice.Map(users, func(_ int, src domain.UserAccess) *permissionv1.UserAccess {
+			return &permissionv1.UserAccess{
+				UserId:  src.UserID,
+				Sources: slice.Map(src.Sources, s.toPermissionSourceProto),
+			}
+		}),
+		Total: int64(total),
+	}, nil
+}
+
 func (s *Server) GrantRolePermission(ctx context.Context, in *permissionv1.GrantRolePermissionRequest) (*permissionv1.GrantRolePermissionResponse, error) {
 	if in.RolePermission == nil {
 		return nil, status.Error(codes.InvalidArgument, "角色权限关系不能为空")
@@ -429,6 +522,9 @@ func (s *Server) CreatePermission(ctx context.Context, in *permissionv1.CreatePe
 	}
 	in.Permission.Id = 0
 	in.Permission.BizId = biz_id
+	if !domain.CombiningAlgorithm(in.Permission.CombiningAlgorithm).IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "策略合并算法无效")
+	}
 	created, err := s.rbacService.CreatePermission(ctx, s.toPermissionDomain(in.Permission))
 	if err != nil {
 		return nil, status.Error(codes.Internal, "创建权限失败")
@@ -464,6 +560,9 @@ func (s *Server) UpdatePermission(ctx context.Context, in *permissionv1.UpdatePe
 	// 构建domain层的Permission对象
 	in.Permission.BizId = bizID
 	domainPermission := s.toPermissionDomain(in.Permission)
+	if !domainPermission.CombiningAlgorithm.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "策略合并算法无效")
+	}
 
 	// 调用服务更新权限
 	_, err = s.rbacService.UpdatePermission(ctx, domainPermission)
@@ -768,8 +867,9 @@ func (s *Server) toPermissionDomain(in *permissionv1.Permission) domain.Permissi
 			Type: in.ResourceType,
 			Key:  in.ResourceKey,
 		},
-		Action:   actions,
-		Metadata: metadata,
+		Action:             actions,
+		Metadata:           metadata,
+		CombiningAlgorithm: domain.CombiningAlgorithm(in.CombiningAlgorithm),
 	}
 }
 func (s *Server) toPermissionProto(permission domain.Permission) *permissionv1.Permission {
@@ -778,15 +878,16 @@ func (s *Server) toPermissionProto(permission domain.Permission) *permissionv1.P
 		actions = append(actions, permission.Action)
 	}
 	return &permissionv1.Permission{
-		Id:           permission.ID,
-		BizId:        permission.BizID,
-		Name:         permission.Name,
-		Description:  permission.Description,
-		ResourceId:   permission.Resource.ID,
-		ResourceType: permission.Resource.Type,
-		ResourceKey:  permission.Resource.Key,
-		Actions:      actions,
-		Metadata:     permission.Metadata,
+		Id:                 permission.ID,
+		BizId:              permission.BizID,
+		Name:               permission.Name,
+		Description:        permission.Description,
+		ResourceId:         permission.Resource.ID,
+		ResourceType:       permission.Resource.Type,
+		ResourceKey:        permission.Resource.Key,
+		Actions:            actions,
+		Metadata:           permission.Metadata,
+		CombiningAlgorithm: permission.CombiningAlgorithm.String(),
 	}
 }
 
@@ -809,7 +910,8 @@ func (s *Server) toUserRoleDomain(userRole *permissionv1.UserRole) domain.UserRo
 		start_time = time.Now().Unix()
 	}
 	if end_time == 0 {
-		end_time = time.Now().Unix()
+		// 未指定失效时间视为长期有效，和用户权限保持一致
+		end_time = time.Now().AddDate(100, 0, 0).Unix()
 	}
 	return domain.UserRole{
 		ID:     userRole.Id,
@@ -908,6 +1010,27 @@ func (s *Server) toUserPermissionProto(up domain.UserPermission) *permissionv1.U
 		EndTime:          up.EndTime,
 	}
 }
+func (s *Server) toEffectivePermissionProto(ep domain.EffectivePermission) *permissionv1.EffectivePermission {
+	return &permissionv1.EffectivePermission{
+		Permission: s.toPermissionProto(ep.Permission),
+		Effect:     ep.Effect.String(),
+		Sources:    slice.Map(ep.Sources, s.toPermissionSourceProto),
+	}
+}
+
+func (s *Server) toPermissionSourceProto(_ int, src domain.UserPermission) *permissionv1.PermissionSource {
+	source := &permissionv1.PermissionSource{
+		Direct:    len(src.RolePath) == 0,
+		RolePath:  src.RolePath,
+		Effect:    src.Effect.String(),
+		StartTime: src.StartTime,
+		EndTime:   src.EndTime,
+	}
+	if !source.Direct {
+		source.RoleId = src.RolePath[len(src.RolePath)-1]
+	}
+	return source
+}
 func (s *Server) toRoleInclusionDomain(ri *permissionv1.RoleInclusion) domain.RoleInclusion {
 	return domain.RoleInclusion{
 		ID:    ri.Id,
@@ -940,25 +1063,31 @@ func (s *Server) toRoleInclusionProto(created domain.RoleInclusion) *permissionv
 
 func (s *Server) toBusniessConfigProto(config domain.BusinessConfig) *permissionv1.BusinessConfig {
 	return &permissionv1.BusinessConfig{
-		Id:        config.ID,
-		OwnerId:   config.OwnerID,
-		OwnerType: config.OwnerType,
-		Name:      config.Name,
-		RateLimit: int32(config.RateLimit),
-		Token:     config.Token,
-		Ctime:     config.Ctime,
-		Utime:     config.Utime,
+		Id:                    config.ID,
+		OwnerId:               config.OwnerID,
+		OwnerType:             config.OwnerType,
+		Name:                  config.Name,
+		RateLimit:             int32(config.RateLimit),
+		Token:                 config.Token,
+		CheckMode:             config.CheckMode.String(),
+		CombiningAlgorithm:    config.CombiningAlgorithm.String(),
+		NotApplicableDecision: config.NotApplicableDecision.String(),
+		Ctime:                 config.Ctime,
+		Utime:                 config.Utime,
 	}
 }
 func (s *Server) toBusniessConfigDomain(config *permissionv1.BusinessConfig) domain.BusinessConfig {
 	return domain.BusinessConfig{
-		ID:        config.Id,
-		OwnerID:   config.OwnerId,
-		OwnerType: config.OwnerType,
-		Name:      config.Name,
-		RateLimit: int(config.RateLimit),
-		Token:     config.Token,
-		Ctime:     config.Ctime,
-		Utime:     config.Utime,
+		ID:                    config.Id,
+		OwnerID:               config.OwnerId,
+		OwnerType:             config.OwnerType,
+		Name:                  config.Name,
+		RateLimit:             int(config.RateLimit),
+		Token:                 config.Token,
+		CheckMode:             domain.CheckMode(config.CheckMode),
+		CombiningAlgorithm:    domain.CombiningAlgorithm(config.CombiningAlgorithm),
+		NotApplicableDecision: domain.Effect(config.NotApplicableDecision),
+		Ctime:                 config.Ctime,
+		Utime:                 config.Utime,
 	}
 }
@@ -0,0 +1,30 @@
+package domain
+
+import "time"
+
+type AttributeProviderType string
+
+const (
+	// AttributeProviderStatic 固定返回配置的值
+	AttributeProviderStatic AttributeProviderType = "static"
+	// AttributeProviderHTTP 以 JSON 调用 HTTP 接口
+	AttributeProviderHTTP AttributeProviderType = "http"
+	// AttributeProviderGRPC 调用实现了 AttributeProviderService 的 gRPC 服务
+	AttributeProviderGRPC AttributeProviderType = "grpc"
+)
+
+// AttributeProviderConfig 属性的外部来源，按业务、实体类型以及属性名对应到属性定义上
+type AttributeProviderConfig struct {
+	BizID      int64
+	EntityType EntityType
+	Attribute  string // 属性名
+	Type       AttributeProviderType
+	// Endpoint http 类型为接口地址，grpc 类型为服务地址
+	Endpoint string
+	// Value static 类型返回的值
+	Value string
+	// Timeout 单次获取的超时时间，为 0 时使用默认值
+	Timeout time.Duration
+	// CacheTTL 获取结果的缓存时间，为 0 时不缓存
+	CacheTTL time.Duration
+}
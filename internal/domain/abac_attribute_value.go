@@ -22,11 +22,17 @@ func (s *ABACObject) ValuesMap() map[int64]AttributeValue {
 	})
 }
 
+// HasAttr 是否已经有该属性的值
+func (s *ABACObject) HasAttr(defID int64) bool {
+	return slice.ContainsFunc(s.AttrValues, func(src AttributeValue) bool {
+		return src.AttrDef.ID == defID
+	})
+}
+
 func (s *ABACObject) FillDefinitions(attrs AttrDefs) {
 	for index := range s.AttrValues {
-		attrValue := s.AttrValues[index]
-		if attrDefinition, ok := attrs.GetByID(attrValue.ID); ok {
-			attrValue.AttrDef = attrDefinition
+		if attrDefinition, ok := attrs.GetByID(s.AttrValues[index].AttrDef.ID); ok {
+			s.AttrValues[index].AttrDef = attrDefinition
 		}
 	}
 }
@@ -0,0 +1,35 @@
+package domain
+
+// AttrValueFormat 批量导入、导出属性值的格式，每一行是一个实体的一个属性值，按属性名匹配属性定义
+type AttrValueFormat string
+
+const (
+	// AttrValueFormatCSV 第一行为表头 entity_id,attribute,value，列的顺序可以调整
+	AttrValueFormatCSV AttrValueFormat = "csv"
+	// AttrValueFormatNDJSON 每行一个 JSON 对象，例如 {"entity_id":1,"attribute":"dept","value":"rd"}，
+	// value 也可以是数字、布尔值或者数组，会按原样转成字符串
+	AttrValueFormatNDJSON AttrValueFormat = "ndjson"
+)
+
+// EntityAttributeValue 批量导入、导出的一行，EntityID 主体为用户ID，资源为资源ID
+type EntityAttributeValue struct {
+	EntityID int64
+	Value    AttributeValue
+}
+
+// AttrValueImportResult 批量导入的结果，不合法的行不会写入，其余行照常写入
+type AttrValueImportResult struct {
+	Total     int64 // 数据行数，不包括 CSV 表头以及空行
+	Succeeded int64
+	Failed    int64
+	// Errors 按行号升序，最多返回 MaxAttrValueImportErrors 条
+	Errors []AttrValueRowError
+}
+
+// MaxAttrValueImportErrors 导入结果中最多返回的错误行数
+const MaxAttrValueImportErrors = 1000
+
+type AttrValueRowError struct {
+	Line    int64 // 行号，从 1 开始，CSV 包括表头
+	Message string
+}
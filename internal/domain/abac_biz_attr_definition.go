@@ -40,3 +40,34 @@ func (biz *BizAttrDefinition) GetByDefId(id int64) (AttributeDefinition, bool) {
 	val, ok := biz.AllDefs[id]
 	return val, ok
 }
+
+// ForResourceType 返回校验 resourceType 的资源时使用的属性定义：资源属性只保留所有资源类型共用的
+// 以及 resourceType 专属的，同名时专属的优先。主体、环境属性以及 AllDefs 不变
+func (biz BizAttrDefinition) ForResourceType(resourceType string) BizAttrDefinition {
+	res := biz
+	res.ResourceAttrDefs = make(AttrDefs, 0, len(biz.ResourceAttrDefs))
+	for _, def := range biz.ResourceAttrDefs {
+		if resourceType != "" && def.ResourceType == resourceType {
+			res.ResourceAttrDefs = append(res.ResourceAttrDefs, def)
+		}
+	}
+	for _, def := range biz.ResourceAttrDefs {
+		if _, ok := res.ResourceAttrDefs.GetByName(def.Name); def.ResourceType == "" && !ok {
+			res.ResourceAttrDefs = append(res.ResourceAttrDefs, def)
+		}
+	}
+	return res
+}
+
+// ForResourceTypes 返回策略可以使用的属性定义，resourceTypes 为策略关联的权限的资源类型，已经去重。
+// 关联了多个资源类型时只能使用共用的资源属性；没有关联时不限制，同名的资源属性需要关联权限后才能区分
+func (biz BizAttrDefinition) ForResourceTypes(resourceTypes []string) BizAttrDefinition {
+	switch len(resourceTypes) {
+	case 0:
+		return biz
+	case 1:
+		return biz.ForResourceType(resourceTypes[0])
+	default:
+		return biz.ForResourceType("")
+	}
+}
@@ -0,0 +1,127 @@
+package domain
+
+import "sort"
+
+// CombiningAlgorithm 多个策略同时适用时的合并算法，语义参考 XACML
+type CombiningAlgorithm string
+
+const (
+	// CombiningDenyOverrides 任一适用策略为 deny 则拒绝，否则任一为 allow 则允许
+	CombiningDenyOverrides CombiningAlgorithm = "deny_overrides"
+	// CombiningPermitOverrides 任一适用策略为 allow 则允许，否则任一为 deny 则拒绝
+	CombiningPermitOverrides CombiningAlgorithm = "permit_overrides"
+	// CombiningFirstApplicable 按策略优先级从高到低，第一个适用策略的效果生效
+	CombiningFirstApplicable CombiningAlgorithm = "first_applicable"
+	// CombiningOnlyOneApplicable 只能有一个适用策略，多于一个时视为无法判定，拒绝
+	CombiningOnlyOneApplicable CombiningAlgorithm = "only_one_applicable"
+	// CombiningDenyUnlessPermit 任一适用策略为 allow 则允许，其余情况（包括没有适用策略）都拒绝
+	CombiningDenyUnlessPermit CombiningAlgorithm = "deny_unless_permit"
+	// CombiningPermitUnlessDeny 任一适用策略为 deny 则拒绝，其余情况（包括没有适用策略）都允许
+	CombiningPermitUnlessDeny CombiningAlgorithm = "permit_unless_deny"
+)
+
+func (c CombiningAlgorithm) String() string {
+	return string(c)
+}
+
+// IsValid 空值视为合法，按 deny-overrides 处理
+func (c CombiningAlgorithm) IsValid() bool {
+	switch c {
+	case "", CombiningDenyOverrides, CombiningPermitOverrides, CombiningFirstApplicable,
+		CombiningOnlyOneApplicable, CombiningDenyUnlessPermit, CombiningPermitUnlessDeny:
+		return true
+	default:
+		return false
+	}
+}
+
+// Normalize 未配置时默认 deny-overrides
+func (c CombiningAlgorithm) Normalize() CombiningAlgorithm {
+	if c == "" {
+		return CombiningDenyOverrides
+	}
+	return c
+}
+
+// PolicyEffect 一个适用（规则满足）或者无法判定的策略对某个权限给出的效果
+type PolicyEffect struct {
+	PolicyID    int64
+	Priority    int
+	Specificity int
+	Effect      Effect
+	// Indeterminate 策略的规则无法判定，Effect 表示策略可能给出的效果
+	Indeterminate bool
+}
+
+// CombinePolicyEffects 按合并算法得出最终结果。
+// 与 RBAC 一致，只有资源 key 最具体的权限参与合并；
+// 没有任何适用策略时返回 notApplicable。
+// 无法判定的策略参考 XACML：deny-overrides、permit-overrides、first-applicable、only-one-applicable
+// 在结果取决于它时视为无法判定并拒绝，deny-unless-permit、permit-unless-deny 忽略它
+func (c CombiningAlgorithm) CombinePolicyEffects(effects []PolicyEffect, notApplicable bool) bool {
+	maxSpecificity := 0
+	for idx, src := range effects {
+		if idx == 0 || src.Specificity > maxSpecificity {
+			maxSpecificity = src.Specificity
+		}
+	}
+	applicable := make([]PolicyEffect, 0, len(effects))
+	var allow, deny, indeterminateAllow, indeterminateDeny bool
+	policyIDs := make(map[int64]struct{}, len(effects))
+	for _, src := range effects {
+		if src.Specificity != maxSpecificity {
+			continue
+		}
+		applicable = append(applicable, src)
+		policyIDs[src.PolicyID] = struct{}{}
+		if src.Indeterminate {
+			indeterminateAllow = indeterminateAllow || src.Effect.IsAllow()
+			indeterminateDeny = indeterminateDeny || src.Effect.IsDeny()
+			continue
+		}
+		allow = allow || src.Effect.IsAllow()
+		deny = deny || src.Effect.IsDeny()
+	}
+
+	switch c.Normalize() {
+	case CombiningPermitOverrides:
+		if allow {
+			return true
+		}
+		// 无法判定的 allow 策略可能允许，结果无法判定
+		if deny || indeterminateAllow || indeterminateDeny {
+			return false
+		}
+	case CombiningFirstApplicable:
+		if len(applicable) > 0 {
+			// 稳定排序，优先级相同时保持策略原有顺序
+			sort.SliceStable(applicable, func(i, j int) bool {
+				return applicable[i].Priority > applicable[j].Priority
+			})
+			return !applicable[0].Indeterminate && applicable[0].Effect.IsAllow()
+		}
+	case CombiningOnlyOneApplicable:
+		if len(policyIDs) > 1 || indeterminateAllow || indeterminateDeny {
+			return false
+		}
+		if len(applicable) > 0 {
+			return allow && !deny
+		}
+	case CombiningDenyUnlessPermit:
+		return allow
+	case CombiningPermitUnlessDeny:
+		return !deny
+	default:
+		// 无法判定的 deny 策略可能拒绝，无法判定的 allow 策略在没有其他 allow 时也无法判定
+		if deny || indeterminateDeny {
+			return false
+		}
+		if allow {
+			return true
+		}
+		if indeterminateAllow {
+			return false
+		}
+	}
+	return notApplicable
+}
@@ -9,7 +9,46 @@ type AttributeDefinition struct {
 	ValidationRule string
 	Ctime          int64
 	Utime          int64
+	// DefaultValue 属性缺失时使用的默认值，为空表示没有默认值，只在策略的 MissingAttr 为 MissingAttrDefault 时生效
+	DefaultValue string
+	// Constraints 按数据类型生效的结构化约束，和 ValidationRule 同时生效
+	Constraints AttrConstraints
+	// ResourceType 只对资源属性生效，不为空时属性只属于该资源类型，不同资源类型可以定义同名的属性；
+	// 为空表示所有资源类型共用
+	ResourceType string
 }
+
+// AvailableFor 属性能否用于 resourceTypes 中的每一种资源，resourceTypes 为空时不限制
+func (a AttributeDefinition) AvailableFor(resourceTypes []string) bool {
+	if a.EntityType != ResourceTypeEntity || a.ResourceType == "" {
+		return true
+	}
+	for _, t := range resourceTypes {
+		if t != a.ResourceType {
+			return false
+		}
+	}
+	return true
+}
+
+// AttrConstraints 属性值的结构化约束，零值表示不限制
+type AttrConstraints struct {
+	Min *float64 `json:"min,omitempty"` // number、float 的最小值，包含
+	Max *float64 `json:"max,omitempty"` // number、float 的最大值，包含
+	// Enum 允许的取值，array 约束的是每个元素
+	Enum     []string `json:"enum,omitempty"`
+	ElemType DataType `json:"elemType,omitempty"` // array 元素的数据类型
+	MaxLen   int      `json:"maxLen,omitempty"`   // array 的最大长度
+	// NotBefore、NotAfter datetime 的上下界，毫秒时间戳，包含，0 表示不限制
+	NotBefore int64 `json:"notBefore,omitempty"`
+	NotAfter  int64 `json:"notAfter,omitempty"`
+}
+
+func (c AttrConstraints) IsZero() bool {
+	return c.Min == nil && c.Max == nil && len(c.Enum) == 0 && c.ElemType == "" &&
+		c.MaxLen == 0 && c.NotBefore == 0 && c.NotAfter == 0
+}
+
 type DataType string
 
 func (d DataType) String() string {
@@ -23,6 +62,7 @@ const (
 	DataTypeFloat    DataType = "float"
 	DataTypeDatetime DataType = "datetime"
 	DataTypeArray    DataType = "array"
+	DataTypeIP       DataType = "ip" // IPv4 或者 IPv6 地址
 )
 
 type EntityType string
@@ -44,6 +84,32 @@ type Attributes struct {
 }
 type SubAttrs map[string]string
 
+// DefaultClientIPAttribute 默认使用调用方地址填充的环境属性名
+const DefaultClientIPAttribute = "client_ip"
+
+// ClientIPConfig 校验权限时用调用方的地址自动填充环境属性，由 provider.BuiltinEnv 填充
+type ClientIPConfig struct {
+	Attribute string // 环境属性名，为空时不填充，请求中传的同名属性会被调用方地址覆盖
+}
+
+// 内置的环境属性，业务定义了同名的环境属性后，校验权限时自动填充，没有定义时不填充
+const (
+	BuiltinEnvCurrentTime = "current_time"           // 服务端当前时间，毫秒时间戳
+	BuiltinEnvWeekday     = "weekday"                // 服务端时区的星期，0 为周日
+	BuiltinEnvClientIP    = DefaultClientIPAttribute // 调用方地址，属性名可以通过 ClientIPConfig 修改
+	BuiltinEnvGRPCMethod  = "grpc_method"            // gRPC 方法全名，例如 /permission.v1.PermissionService/CheckPermission
+	BuiltinEnvClientApp   = "client_app"             // 调用方的应用名，来自 metadata 中的 app
+)
+
+// BuiltinEnvAttrs 内置环境属性要求的数据类型，定义同名的环境属性时需要使用对应的数据类型
+var BuiltinEnvAttrs = map[string]DataType{
+	BuiltinEnvCurrentTime: DataTypeDatetime,
+	BuiltinEnvWeekday:     DataTypeNumber,
+	BuiltinEnvClientIP:    DataTypeIP,
+	BuiltinEnvGRPCMethod:  DataTypeString,
+	BuiltinEnvClientApp:   DataTypeString,
+}
+
 func (s SubAttrs) SetKv(k, v string) SubAttrs {
 	if s == nil {
 		s = map[string]string{
@@ -11,6 +11,72 @@ const (
 
 type ExecuteType string
 
+// MissingAttrMode 规则引用的属性没有值时的处理方式
+type MissingAttrMode string
+
+const (
+	// MissingAttrFalse 比较结果为 false，NOT 取反后为 true，未配置时的默认值
+	MissingAttrFalse MissingAttrMode = "false"
+	// MissingAttrIndeterminate 比较结果无法判定，参考 XACML Indeterminate 向上传播
+	MissingAttrIndeterminate MissingAttrMode = "indeterminate"
+	// MissingAttrDefault 使用属性定义上的默认值，没有默认值时无法判定
+	MissingAttrDefault MissingAttrMode = "default"
+)
+
+// Normalize 未配置时按 MissingAttrFalse 处理
+func (m MissingAttrMode) Normalize() MissingAttrMode {
+	if m == "" {
+		return MissingAttrFalse
+	}
+	return m
+}
+
+// EvalResult 规则以及策略的执行结果
+type EvalResult uint8
+
+const (
+	EvalFalse EvalResult = iota
+	EvalTrue
+	// EvalIndeterminate 无法判定，例如属性缺失或者执行出错
+	EvalIndeterminate
+)
+
+// Not 无法判定时取反仍然无法判定
+func (r EvalResult) Not() EvalResult {
+	switch r {
+	case EvalTrue:
+		return EvalFalse
+	case EvalFalse:
+		return EvalTrue
+	default:
+		return EvalIndeterminate
+	}
+}
+
+// And 任一为 false 则为 false，否则任一无法判定则无法判定
+func (r EvalResult) And(other EvalResult) EvalResult {
+	switch {
+	case r == EvalFalse || other == EvalFalse:
+		return EvalFalse
+	case r == EvalIndeterminate || other == EvalIndeterminate:
+		return EvalIndeterminate
+	default:
+		return EvalTrue
+	}
+}
+
+// Or 任一为 true 则为 true，否则任一无法判定则无法判定
+func (r EvalResult) Or(other EvalResult) EvalResult {
+	switch {
+	case r == EvalTrue || other == EvalTrue:
+		return EvalTrue
+	case r == EvalIndeterminate || other == EvalIndeterminate:
+		return EvalIndeterminate
+	default:
+		return EvalFalse
+	}
+}
+
 type Policy struct {
 	ID          int64
 	BizID       int64
@@ -18,6 +84,12 @@ type Policy struct {
 	Description string
 	ExecuteType ExecuteType
 	Status      PolicyStatusType
+	// Priority 优先级，数值越大越优先，first-applicable 合并算法按它排序
+	Priority int
+	// MissingAttr 规则引用的属性没有值时的处理方式
+	MissingAttr MissingAttrMode
+	// Version 生效的版本号，0 表示还没有发布
+	Version     int64
 	Permissions []UserPermission
 	Rules       []PolicyRule
 	Ctime       int64
@@ -29,7 +101,7 @@ func (p Policy) ContainsAnyPermissions(permissionIDs []int64) bool {
 		permissionID := permissionIDs[idx]
 		for jdx := range p.Permissions {
 			permission := p.Permissions[jdx]
-			if permission.ID == permissionID {
+			if permission.Permission.ID == permissionID {
 				return true
 			}
 		}
@@ -38,14 +110,21 @@ func (p Policy) ContainsAnyPermissions(permissionIDs []int64) bool {
 }
 
 type PolicyRule struct {
-	ID        int64
-	AttrDef   AttributeDefinition
-	Value     string
-	LeftRule  *PolicyRule
-	RightRule *PolicyRule
-	Operator  RuleOperator
-	Ctime     int64
-	Utime     int64
+	ID      int64
+	AttrDef AttributeDefinition
+	Value   string
+	// ValueAttrDef 右侧引用的属性定义，ID 不为 0 时和该属性的值比较，Value 不生效
+	ValueAttrDef AttributeDefinition
+	LeftRule     *PolicyRule
+	RightRule    *PolicyRule
+	Operator     RuleOperator
+	Ctime        int64
+	Utime        int64
+}
+
+// ReferencesAttr 右侧是否引用了另一个属性
+func (p PolicyRule) ReferencesAttr() bool {
+	return p.ValueAttrDef.ID > 0
 }
 
 func (p PolicyRule) SafeLeft() PolicyRule {
@@ -0,0 +1,37 @@
+package domain
+
+// PolicyDraftVersion 草稿的版本号，草稿就是正在编辑的策略，发布后才会生效
+const PolicyDraftVersion int64 = 0
+
+// PolicyVersion 策略发布的版本
+type PolicyVersion struct {
+	Version int64
+	// SourceVersion 回滚时复制的版本号，正常发布时为 0
+	SourceVersion int64
+	Comment       string
+	// Published 是否为当前生效的版本
+	Published bool
+	// Policy 发布时策略以及规则的快照
+	Policy Policy
+	Ctime  int64
+}
+
+// PolicyVersionDiff 两个版本之间的差异，顶层规则渲染成表达式后比较
+type PolicyVersionDiff struct {
+	From           int64
+	To             int64
+	FromExpression string
+	ToExpression   string
+	// AddedRules To 中有而 From 中没有的顶层规则
+	AddedRules []string
+	// RemovedRules From 中有而 To 中没有的顶层规则
+	RemovedRules []string
+	Changes      []PolicyFieldChange
+}
+
+// PolicyFieldChange 策略字段的变化，例如优先级、状态
+type PolicyFieldChange struct {
+	Field string
+	From  string
+	To    string
+}
@@ -14,6 +14,8 @@ const (
 	NOT            RuleOperator = "NOT"
 	AllMatch       RuleOperator = "ALL MATCH"
 	AnyMatch       RuleOperator = "ANY MATCH"
+	InCIDR         RuleOperator = "IN CIDR"     // IP 属于网段列表中的任意一个
+	NotInCIDR      RuleOperator = "NOT IN CIDR" // IP 不属于网段列表中的任何一个
 )
 
 type RuleOperator string
@@ -21,3 +23,19 @@ type RuleOperator string
 func (r RuleOperator) String() string {
 	return string(r)
 }
+
+// AttrRefComparable 判断规则两侧都是属性时类型是否匹配：
+// IN、NOT IN 要求左侧为字符串，右侧为数组；ANY MATCH、ALL MATCH 要求两侧都是数组；
+// IN CIDR、NOT IN CIDR 要求左侧为 IP，右侧为网段数组；其余运算符要求两侧类型相同
+func AttrRefComparable(op RuleOperator, left, right DataType) bool {
+	switch op {
+	case IN, NotIn:
+		return left == DataTypeString && right == DataTypeArray
+	case AnyMatch, AllMatch:
+		return left == DataTypeArray && right == DataTypeArray
+	case InCIDR, NotInCIDR:
+		return left == DataTypeIP && right == DataTypeArray
+	default:
+		return left == right
+	}
+}
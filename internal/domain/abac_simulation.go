@@ -0,0 +1,24 @@
+package domain
+
+// MaxSimulationSamples 单次策略模拟最多的样本数量
+const MaxSimulationSamples = 100
+
+// SimulationSample 策略模拟的一个样本，等价于一次 ABAC 校验的入参
+type SimulationSample struct {
+	UserID   int64
+	Resource Resource
+	Actions  []string
+	Attrs    Attributes
+}
+
+// SimulationResult 单个样本在加入候选策略前后的校验结果
+type SimulationResult struct {
+	Before     bool // 只使用当前生效策略的结果
+	After      bool // 加入候选策略后的结果
+	Applicable bool // 候选策略是否绑定了样本涉及的权限
+	Matched    bool // 候选策略的规则是否满足
+}
+
+func (s SimulationResult) Changed() bool {
+	return s.Before != s.After
+}
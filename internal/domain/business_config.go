@@ -2,12 +2,54 @@ package domain
 
 // BusinessConfig 业务配置领域模型
 type BusinessConfig struct {
-	ID        int64  // 业务ID
-	OwnerID   int64  // 业务方ID
-	OwnerType string // 业务方类型
-	Name      string // 业务名称
-	RateLimit int    // 每秒最大请求数
-	Token     string // 业务方Token，内部包含bizID也就是上方的ID，需要先插入一个空的Token获取ID，再根据ID生成token再更新
-	Ctime     int64
-	Utime     int64
+	ID        int64     // 业务ID
+	OwnerID   int64     // 业务方ID
+	OwnerType string    // 业务方类型
+	Name      string    // 业务名称
+	RateLimit int       // 每秒最大请求数
+	Token     string    // 业务方Token，内部包含bizID也就是上方的ID，需要先插入一个空的Token获取ID，再根据ID生成token再更新
+	CheckMode CheckMode // 权限校验模式
+	// CombiningAlgorithm ABAC 多个策略适用时的合并算法，权限上可以单独覆盖
+	CombiningAlgorithm CombiningAlgorithm
+	// NotApplicableDecision ABAC 没有任何适用策略时的结果，默认 deny
+	NotApplicableDecision Effect
+	Ctime                 int64
+	Utime                 int64
+}
+
+// NotApplicableAllowed 没有任何适用策略时是否允许
+func (b BusinessConfig) NotApplicableAllowed() bool {
+	return b.NotApplicableDecision.IsAllow()
+}
+
+// CheckMode 权限校验模式，决定 RBAC 和 ABAC 的组合方式
+type CheckMode string
+
+const (
+	CheckModeRBAC        CheckMode = "rbac"          // 只使用 RBAC
+	CheckModeABAC        CheckMode = "abac"          // 只使用 ABAC
+	CheckModeRBACAndABAC CheckMode = "rbac_and_abac" // RBAC 和 ABAC 都通过才允许
+	CheckModeRBACOrABAC  CheckMode = "rbac_or_abac"  // RBAC 和 ABAC 任一通过即允许
+)
+
+func (c CheckMode) String() string {
+	return string(c)
+}
+
+// IsValid 空值视为合法，按 RBAC 处理
+func (c CheckMode) IsValid() bool {
+	switch c {
+	case "", CheckModeRBAC, CheckModeABAC, CheckModeRBACAndABAC, CheckModeRBACOrABAC:
+		return true
+	default:
+		return false
+	}
+}
+
+// Normalize 未配置时默认只使用 RBAC
+func (c CheckMode) Normalize() CheckMode {
+	if c == "" {
+		return CheckModeRBAC
+	}
+	return c
 }
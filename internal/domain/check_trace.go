@@ -0,0 +1,45 @@
+package domain
+
+// CheckTrace 权限校验过程，用于解释校验结果
+type CheckTrace struct {
+	Allowed bool
+	Mode    CheckMode
+	RBAC    RBACTrace
+	ABAC    ABACTrace
+}
+
+type RBACTrace struct {
+	Allowed bool
+	// Matches 命中本次校验的用户权限，角色来源的权限通过 RolePath 给出角色路径
+	Matches []UserPermission
+}
+
+type ABACTrace struct {
+	Allowed  bool
+	Policies []PolicyTrace
+}
+
+type PolicyTrace struct {
+	Policy Policy
+	Result bool
+	Rules  []RuleTrace
+	// Indeterminate 无法判定，此时 Result 为 false
+	Indeterminate bool
+}
+
+// RuleTrace 单个规则节点的执行过程，逻辑运算节点通过 Left、Right 展开子节点
+type RuleTrace struct {
+	RuleID   int64
+	AttrDef  AttributeDefinition
+	Operator RuleOperator
+	// ValueAttrDef 右侧引用的属性，此时 WantVal 为该属性的值
+	ValueAttrDef AttributeDefinition
+	WantVal      string
+	ActualVal    string
+	Result       bool
+	Err          string
+	Left         *RuleTrace
+	Right        *RuleTrace
+	// Indeterminate 无法判定，此时 Result 为 false，原因见 Err
+	Indeterminate bool
+}
@@ -8,6 +8,17 @@ type Permission struct {
 	Resource    Resource `json:"resource,omitzero"`
 	Action      string   `json:"action,omitzero"`
 	Metadata    string   `json:"metadata,omitzero"`
-	Ctime       int64    `json:"ctime,omitzero"`
-	Utime       int64    `json:"utime,omitzero"`
+	// CombiningAlgorithm 覆盖业务配置的 ABAC 策略合并算法，为空时使用业务配置
+	CombiningAlgorithm CombiningAlgorithm `json:"combiningAlgorithm,omitzero"`
+	Ctime              int64              `json:"ctime,omitzero"`
+	Utime              int64              `json:"utime,omitzero"`
+}
+
+// MaxCheckItems 批量校验单次最多的校验项
+const MaxCheckItems = 100
+
+// CheckItem 批量校验时的单个校验项
+type CheckItem struct {
+	Resource Resource
+	Actions  []string
 }
@@ -9,3 +9,11 @@ type RoleInclusion struct {
 	Ctime         int64
 	Utime         int64
 }
+
+// DefaultMaxRoleInclusionDepth 默认的角色包含最大深度
+const DefaultMaxRoleInclusionDepth = 8
+
+// RoleInclusionConfig 角色包含关系的限制
+type RoleInclusionConfig struct {
+	MaxDepth int // 一条包含链上最多的包含关系数，超过的部分不会展开
+}
@@ -34,4 +34,38 @@ type UserPermission struct {
 	Effect     Effect     `json:"effect,omitzero"`
 	Ctime      int64      `json:"cTime,omitzero"`
 	Utime      int64      `json:"uTime,omitzero"`
+	// RolePath 角色授予的权限所经过的角色路径，从用户直接拥有的角色到授予该权限的角色，直接授予用户的权限为空
+	RolePath []int64 `json:"rolePath,omitzero"`
+}
+
+// IsValidAt 判断在 ts（秒级时间戳）时刻是否处于有效期内
+func (u UserPermission) IsValidAt(ts int64) bool {
+	return u.StartTime <= ts && ts <= u.EndTime
+}
+
+// NextValidityChange 返回 ts 之后第一次进入或者离开有效期的时刻（秒级时间戳），已经失效的返回 false
+func (u UserPermission) NextValidityChange(ts int64) (int64, bool) {
+	switch {
+	case ts < u.StartTime:
+		return u.StartTime, true
+	case ts <= u.EndTime:
+		return u.EndTime + 1, true
+	default:
+		return 0, false
+	}
+}
+
+// EffectivePermission 用户当前生效的权限，已经按最具体优先、同样具体时 deny 优先合并，与权限校验的结果一致
+type EffectivePermission struct {
+	Permission Permission
+	Effect     Effect
+	// Sources 产生该效果的全部用户权限，RolePath 为空表示直接授予用户
+	Sources []UserPermission
+}
+
+// UserAccess 能够访问某个资源的用户
+type UserAccess struct {
+	UserID int64
+	// Sources 授予该用户访问权的用户权限，RolePath 为空表示直接授予用户
+	Sources []UserPermission
 }
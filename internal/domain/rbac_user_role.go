@@ -11,3 +11,8 @@ type UserRole struct {
 	Ctime     int64
 	Utime     int64
 }
+
+// IsValidAt 判断在 ts（秒级时间戳）时刻是否处于有效期内
+func (u UserRole) IsValidAt(ts int64) bool {
+	return u.StartTime <= ts && ts <= u.EndTime
+}
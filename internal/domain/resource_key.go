@@ -0,0 +1,94 @@
+package domain
+
+import (
+	"path"
+	"strings"
+)
+
+// 资源 key 按 "/" 分段，权限上的 key 支持以下通配：
+//   - 段内的 *、?、[...]，语义同 path.Match，只匹配一段，例如 /order/* 匹配 /order/123
+//   - 整段为 **，匹配零个或者多个段，例如 /order/** 匹配 /order、/order/123、/order/123/items
+//
+// 多个权限同时匹配时，最具体的权限生效，同样具体时 deny 优先，见 EffectDecision
+
+const resourceKeyAnySegments = "**"
+
+// IsResourceKeyPattern 判断 key 是否包含通配符
+func IsResourceKeyPattern(key string) bool {
+	return strings.ContainsAny(key, "*?[")
+}
+
+// MatchResourceKey 判断资源 key 是否被 pattern 覆盖，pattern 不含通配符时要求完全相等
+func MatchResourceKey(pattern, key string) bool {
+	if !IsResourceKeyPattern(pattern) {
+		return pattern == key
+	}
+	return matchSegments(strings.Split(pattern, "/"), strings.Split(key, "/"))
+}
+
+func matchSegments(patterns, segments []string) bool {
+	for len(patterns) > 0 {
+		if patterns[0] == resourceKeyAnySegments {
+			rest := patterns[1:]
+			for i := 0; i <= len(segments); i++ {
+				if matchSegments(rest, segments[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(segments) == 0 {
+			return false
+		}
+		ok, err := path.Match(patterns[0], segments[0])
+		if err != nil || !ok {
+			return false
+		}
+		patterns, segments = patterns[1:], segments[1:]
+	}
+	return len(segments) == 0
+}
+
+// ResourceKeySpecificity 返回 pattern 的具体程度，值越大越具体。
+// 先比较不含通配符的段数，再比较只匹配一段的通配段数，
+// 因此 /order/123 > /order/* > /order/** > /**
+func ResourceKeySpecificity(pattern string) int {
+	const segmentWeight = 1 << 16
+	var literal, single int
+	for _, seg := range strings.Split(pattern, "/") {
+		switch {
+		case seg == resourceKeyAnySegments:
+		case IsResourceKeyPattern(seg):
+			single++
+		default:
+			literal++
+		}
+	}
+	return literal*segmentWeight + single
+}
+
+// EffectDecision 合并多个匹配到的权限效果：
+// 只有最具体的权限参与决策，同样具体时 deny 优先
+type EffectDecision struct {
+	matched     bool
+	specificity int
+	allow       bool
+	deny        bool
+}
+
+func (d *EffectDecision) Add(specificity int, effect Effect) {
+	if !d.matched || specificity > d.specificity {
+		*d = EffectDecision{matched: true, specificity: specificity}
+	} else if specificity < d.specificity {
+		return
+	}
+	if effect.IsDeny() {
+		d.deny = true
+	} else if effect.IsAllow() {
+		d.allow = true
+	}
+}
+
+func (d EffectDecision) Allowed() bool {
+	return d.allow && !d.deny
+}
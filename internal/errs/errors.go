@@ -13,4 +13,18 @@ var (
 	ErrBizIDNotFound           = errors.New("BizID不存在")
 	ErrUnkonwOperator          = errors.New("未知操作")
 	ErrUnkonwDataType          = errors.New("未知类型")
+	ErrRoleInclusionCycle      = errors.New("角色包含关系存在环")
+	ErrRoleInclusionTooDeep    = errors.New("角色包含关系超过最大深度")
+	ErrTooManyGrants           = errors.New("匹配的授权记录过多")
+	ErrInvalidPolicyRule       = errors.New("策略规则无效")
+	ErrInvalidPolicyExpression = errors.New("策略表达式无效")
+	ErrPolicyNotFound          = errors.New("策略不存在")
+	ErrRuleAttrRefInvalid      = errors.New("规则引用的属性无效")
+	ErrAttributeMissing        = errors.New("属性没有值")
+	ErrUnknownAttrProvider     = errors.New("未知的属性来源")
+	ErrAttrProviderFailed      = errors.New("从属性来源获取属性失败")
+	ErrInvalidAttrValue        = errors.New("属性值无效")
+	ErrInvalidAttrConstraint   = errors.New("属性约束无效")
+	ErrUnsupportedBulkEntity   = errors.New("只支持批量处理主体和资源的属性值")
+	ErrUnsupportedValueFormat  = errors.New("不支持的属性值格式")
 )
@@ -26,6 +26,9 @@ type PermissionV1 struct {
 	Resource Resource `json:"resource"`
 	Action   string   `json:"action"`
 	Effect   string   `json:"effect"`
+	// 有效期，消费方需要自行判断是否生效
+	StartTime int64 `json:"startTime"`
+	EndTime   int64 `json:"endTime"`
 }
 type Resource struct {
 	Key  string `json:"key"`
@@ -0,0 +1,56 @@
+package ioc
+
+import (
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/gotomicro/ego/core/econf"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/service/abac/provider"
+)
+
+func InitClientIPConfig() domain.ClientIPConfig {
+	type Config struct {
+		Attribute string `yaml:"attribute"`
+	}
+	cfg := Config{Attribute: domain.DefaultClientIPAttribute}
+	err := econf.UnmarshalKey("abac.clientIP", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return domain.ClientIPConfig{Attribute: cfg.Attribute}
+}
+
+func InitAttributeProviderRegistry() provider.Registry {
+	type Config struct {
+		BizID      int64         `yaml:"bizId"`
+		EntityType string        `yaml:"entityType"`
+		Attribute  string        `yaml:"attribute"`
+		Type       string        `yaml:"type"`
+		Endpoint   string        `yaml:"endpoint"`
+		Value      string        `yaml:"value"`
+		Timeout    time.Duration `yaml:"timeout"`
+		CacheTTL   time.Duration `yaml:"cacheTTL"`
+	}
+	var cfgs []Config
+	err := econf.UnmarshalKey("abac.attributeProviders", &cfgs)
+	if err != nil {
+		panic(err)
+	}
+	registry, err := provider.NewRegistry(slice.Map(cfgs, func(_ int, src Config) domain.AttributeProviderConfig {
+		return domain.AttributeProviderConfig{
+			BizID:      src.BizID,
+			EntityType: domain.EntityType(src.EntityType),
+			Attribute:  src.Attribute,
+			Type:       domain.AttributeProviderType(src.Type),
+			Endpoint:   src.Endpoint,
+			Value:      src.Value,
+			Timeout:    src.Timeout,
+			CacheTTL:   src.CacheTTL,
+		}
+	}))
+	if err != nil {
+		panic(err)
+	}
+	return registry
+}
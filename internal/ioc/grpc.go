@@ -3,6 +3,7 @@ package ioc
 import (
 	"github.com/gotomicro/ego/server/egrpc"
 	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
+	"github.com/permission-dev/internal/api/grpc/abac"
 	"github.com/permission-dev/internal/api/grpc/interceptor/auth"
 	"github.com/permission-dev/internal/api/grpc/rbac"
 	"github.com/permission-dev/internal/pkg/jwt"
@@ -11,6 +12,9 @@ import (
 func InitGRPC(
 	crudServer *rbac.Server,
 	permissionServer *rbac.PermissionServer,
+	policyServer *abac.ABACPolicyServer,
+	attrValServer *abac.ABACAttributeValServer,
+	attrDefinitionServer *abac.ABACAttributeDefinitionServer,
 	token *jwt.Token,
 ) []*egrpc.Component {
 	authInterceptor := auth.New(token).Build()
@@ -19,5 +23,14 @@ func InitGRPC(
 	)
 	permissionv1.RegisterRBACServiceServer(rbacServer.Server, crudServer)
 	permissionv1.RegisterPermissionServiceServer(rbacServer.Server, permissionServer)
-	return []*egrpc.Component{rbacServer}
+
+	// 属性值的批量导入、导出是流式接口
+	abacServer := egrpc.Load("server.grpc.abac").Build(
+		egrpc.WithUnaryInterceptor(authInterceptor),
+		egrpc.WithStreamInterceptor(auth.New(token).BuildStream()),
+	)
+	permissionv1.RegisterPolicyServiceServer(abacServer.Server, policyServer)
+	permissionv1.RegisterAttributeValueServiceServer(abacServer.Server, attrValServer)
+	permissionv1.RegisterAttributeDefinitionServiceServer(abacServer.Server, attrDefinitionServer)
+	return []*egrpc.Component{rbacServer, abacServer}
 }
@@ -3,6 +3,7 @@ package ioc
 import (
 	"github.com/confluentinc/confluent-kafka-go/v2/kafka"
 	"github.com/gotomicro/ego/core/econf"
+	"github.com/permission-dev/internal/event/permission"
 )
 
 func InitKafkaConsumer(groupID string) *kafka.Consumer {
@@ -25,3 +26,30 @@ func InitKafkaConsumer(groupID string) *kafka.Consumer {
 	}
 	return consumer
 }
+
+// InitUserPermissionEventProducer 没有配置 kafka 地址或者 topic 时不发送用户权限事件
+func InitUserPermissionEventProducer() permission.UserPermissionEventProducer {
+	type Config struct {
+		Addr string `yaml:"addr"`
+	}
+	var cfg Config
+	err := econf.UnmarshalKey("kafka", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	topic := econf.GetString("userPermissionEvent.topic")
+	if cfg.Addr == "" || topic == "" {
+		return nil
+	}
+	producer, err := kafka.NewProducer(&kafka.ConfigMap{
+		"bootstrap.servers": cfg.Addr,
+	})
+	if err != nil {
+		panic(err)
+	}
+	eventProducer, err := permission.NewUserPermissionEventProducer(producer, topic)
+	if err != nil {
+		panic(err)
+	}
+	return eventProducer
+}
@@ -0,0 +1,39 @@
+package ioc
+
+import (
+	"time"
+
+	"github.com/ecodeclub/ecache"
+	"github.com/gotomicro/ego/core/econf"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/repository/dao"
+)
+
+func InitRoleInclusionConfig() domain.RoleInclusionConfig {
+	type Config struct {
+		MaxDepth int `yaml:"maxDepth"`
+	}
+	cfg := Config{MaxDepth: domain.DefaultMaxRoleInclusionDepth}
+	err := econf.UnmarshalKey("rbac.roleInclusion", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	if cfg.MaxDepth <= 0 {
+		cfg.MaxDepth = domain.DefaultMaxRoleInclusionDepth
+	}
+	return domain.RoleInclusionConfig{MaxDepth: cfg.MaxDepth}
+}
+
+// InitBusinessConfigRepository 业务配置缓存在本地，expiration 为其他实例修改后最长的生效延迟
+func InitBusinessConfigRepository(businessConfigDao dao.BusinessConfigDAO, local ecache.Cache) repository.BusinessConfigRepository {
+	type Config struct {
+		Expiration time.Duration `yaml:"expiration"`
+	}
+	cfg := Config{Expiration: time.Minute}
+	err := econf.UnmarshalKey("cache.businessConfig", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return repository.NewBusinessConfigCachedRepository(repository.NewBusinessConfigRepository(businessConfigDao), local, cfg.Expiration)
+}
@@ -0,0 +1,41 @@
+package ioc
+
+import (
+	"time"
+
+	"github.com/ecodeclub/ecache"
+	"github.com/gotomicro/ego/core/econf"
+	"github.com/permission-dev/internal/event/permission"
+	"github.com/permission-dev/internal/job"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/repository/cache"
+)
+
+func InitTasks(grantValidityReloadTask *job.GrantValidityReloadTask) []Task {
+	return []Task{grantValidityReloadTask}
+}
+
+func InitGrantValidityReloadTask(
+	repo repository.UserPermissionRepository,
+	reloader repository.UserPermissionCacheReloader,
+) *job.GrantValidityReloadTask {
+	type Config struct {
+		Interval time.Duration `yaml:"interval"`
+	}
+	cfg := Config{Interval: time.Minute}
+	err := econf.UnmarshalKey("job.grantValidityReload", &cfg)
+	if err != nil {
+		panic(err)
+	}
+	return job.NewGrantValidityReloadTask(repo, reloader, cfg.Interval)
+}
+
+// InitUserPermissionCacheReloader 基于本地缓存的用户权限缓存，重新加载后通过 producer 通知下游
+func InitUserPermissionCacheReloader(
+	repo repository.UserPermissionRepository,
+	local ecache.Cache,
+	cacheKeyFunc func(bizID, userID int64) string,
+	producer permission.UserPermissionEventProducer,
+) repository.UserPermissionCacheReloader {
+	return repository.NewUserPermissionCachedRepository(repo, cache.NewUserPermissionCache(local, cacheKeyFunc), producer)
+}
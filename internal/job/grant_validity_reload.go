@@ -0,0 +1,67 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/permission-dev/internal/repository"
+)
+
+// GrantValidityReloadTask 定时找出个人权限、角色在上一个周期内生效或者失效的用户，
+// 重新加载这些用户的权限缓存，保证有效期变化后缓存及时更新
+type GrantValidityReloadTask struct {
+	repo     repository.UserPermissionRepository
+	reloader repository.UserPermissionCacheReloader
+	interval time.Duration
+	timeout  time.Duration
+	logger   *elog.Component
+}
+
+func NewGrantValidityReloadTask(
+	repo repository.UserPermissionRepository,
+	reloader repository.UserPermissionCacheReloader,
+	interval time.Duration,
+) *GrantValidityReloadTask {
+	return &GrantValidityReloadTask{
+		repo:     repo,
+		reloader: reloader,
+		interval: interval,
+		timeout:  interval,
+		logger:   elog.DefaultLogger.With(elog.FieldName("GrantValidityReloadTask")),
+	}
+}
+
+func (g *GrantValidityReloadTask) Start(ctx context.Context) {
+	ticker := time.NewTicker(g.interval)
+	defer ticker.Stop()
+	last := time.Now().Unix()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := time.Now().Unix()
+			if err := g.Run(ctx, last, now); err != nil {
+				// 失败时不推进时间，下一个周期重试
+				g.logger.Error("重新加载有效期变化的用户权限失败", elog.FieldErr(err))
+				continue
+			}
+			last = now
+		}
+	}
+}
+
+// Run 重新加载在 (from, to] 内有效期发生变化的用户权限
+func (g *GrantValidityReloadTask) Run(ctx context.Context, from, to int64) error {
+	ctx, cancel := context.WithTimeout(ctx, g.timeout)
+	defer cancel()
+	users, err := g.repo.FindUsersWithValidityChange(ctx, from, to)
+	if err != nil {
+		return err
+	}
+	if len(users) == 0 {
+		return nil
+	}
+	return g.reloader.Reload(ctx, users)
+}
@@ -2,11 +2,10 @@ package repository
 
 import (
 	"context"
-	"fmt"
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
 	"github.com/permission-dev/internal/repository/dao"
-	"regexp"
 )
 
 type AttributeValueRepository interface {
@@ -24,6 +23,14 @@ type AttributeValueRepository interface {
 	DeleteEnvironmentValue(ctx context.Context, bizID, id int64) error
 	FindEnvironmentValue(ctx context.Context, bizID int64) (domain.ABACObject, error)
 	FindEnvironmentValueWithDefinition(ctx context.Context, bizID int64) (domain.ABACObject, error)
+
+	// FindValueAsOf 返回实体在 asOf（毫秒）时刻的属性值，属性定义只有 ID，entityID 主体为用户ID，资源为资源ID，环境为 0
+	FindValueAsOf(ctx context.Context, bizID int64, entityType domain.EntityType, entityID, asOf int64) (domain.ABACObject, error)
+
+	// BatchSaveValues 在一个事务中批量写入主体或者资源的属性值，只使用属性定义的 ID
+	BatchSaveValues(ctx context.Context, bizID int64, entityType domain.EntityType, values []domain.EntityAttributeValue) error
+	// FindValuesByBizID 按属性值 ID 升序分页返回主体或者资源的属性值，属性定义只有 ID
+	FindValuesByBizID(ctx context.Context, bizID int64, entityType domain.EntityType, afterID int64, limit int) ([]domain.EntityAttributeValue, error)
 }
 
 type attributeValueRepository struct {
@@ -31,13 +38,83 @@ type attributeValueRepository struct {
 	envAttrDao        dao.EnvironmentAttributeValueDAO
 	subjectAttrDao    dao.SubjectAttributeValueDAO
 	attrDefinitionDao dao.AttributeDefinitionDAO
+	historyDao        dao.AttributeValueHistoryDAO
 }
 
-func (a *attributeValueRepository) SaveEnvironmentValue(ctx context.Context, bizID int64, val domain.AttributeValue) (int64, error) {
-	err := a.checkVal(ctx, bizID, val.AttrDef.ID, val.Value)
+func (a *attributeValueRepository) FindValueAsOf(ctx context.Context, bizID int64, entityType domain.EntityType, entityID, asOf int64) (domain.ABACObject, error) {
+	histories, err := a.historyDao.FindAsOf(ctx, bizID, entityType.String(), entityID, asOf)
 	if err != nil {
-		return 0, err
+		return domain.ABACObject{}, err
 	}
+	return domain.ABACObject{
+		ID:    entityID,
+		BizId: bizID,
+		AttrValues: slice.Map(histories, func(_ int, src dao.AttributeValueHistory) domain.AttributeValue {
+			return domain.AttributeValue{
+				AttrDef: domain.AttributeDefinition{ID: src.AttrDefID},
+				Value:   src.Value,
+				Ctime:   src.ValidFrom,
+				Utime:   src.ValidFrom,
+			}
+		}),
+	}, nil
+}
+
+func (a *attributeValueRepository) BatchSaveValues(ctx context.Context, bizID int64, entityType domain.EntityType, values []domain.EntityAttributeValue) error {
+	switch entityType {
+	case domain.SubjectTypeEntity:
+		return a.subjectAttrDao.BatchCreate(ctx, slice.Map(values, func(_ int, src domain.EntityAttributeValue) dao.SubjectAttributeValue {
+			return dao.SubjectAttributeValue{
+				BizID:     bizID,
+				SubjectID: src.EntityID,
+				AttrDefID: src.Value.AttrDef.ID,
+				Value:     src.Value.Value,
+			}
+		}))
+	case domain.ResourceTypeEntity:
+		return a.resourceAttrDao.BatchCreate(ctx, slice.Map(values, func(_ int, src domain.EntityAttributeValue) dao.ResourceAttributeValue {
+			return dao.ResourceAttributeValue{
+				BizID:      bizID,
+				ResourceID: src.EntityID,
+				AttrDefID:  src.Value.AttrDef.ID,
+				Value:      src.Value.Value,
+			}
+		}))
+	default:
+		return errs.ErrUnsupportedBulkEntity
+	}
+}
+
+func (a *attributeValueRepository) FindValuesByBizID(ctx context.Context, bizID int64, entityType domain.EntityType, afterID int64, limit int) ([]domain.EntityAttributeValue, error) {
+	toDomain := func(id, entityID, attrDefID int64, value string, ctime, utime int64) domain.EntityAttributeValue {
+		return domain.EntityAttributeValue{
+			EntityID: entityID,
+			Value: domain.AttributeValue{
+				ID:      id,
+				AttrDef: domain.AttributeDefinition{ID: attrDefID},
+				Value:   value,
+				Ctime:   ctime,
+				Utime:   utime,
+			},
+		}
+	}
+	switch entityType {
+	case domain.SubjectTypeEntity:
+		values, err := a.subjectAttrDao.FindByBizID(ctx, bizID, afterID, limit)
+		return slice.Map(values, func(_ int, src dao.SubjectAttributeValue) domain.EntityAttributeValue {
+			return toDomain(src.ID, src.SubjectID, src.AttrDefID, src.Value, src.Ctime, src.Utime)
+		}), err
+	case domain.ResourceTypeEntity:
+		values, err := a.resourceAttrDao.FindByBizID(ctx, bizID, afterID, limit)
+		return slice.Map(values, func(_ int, src dao.ResourceAttributeValue) domain.EntityAttributeValue {
+			return toDomain(src.ID, src.ResourceID, src.AttrDefID, src.Value, src.Ctime, src.Utime)
+		}), err
+	default:
+		return nil, errs.ErrUnsupportedBulkEntity
+	}
+}
+
+func (a *attributeValueRepository) SaveEnvironmentValue(ctx context.Context, bizID int64, val domain.AttributeValue) (int64, error) {
 	daoVal := dao.EnvironmentAttributeValue{
 		ID:        val.ID,
 		BizID:     bizID,
@@ -57,7 +134,7 @@ func (a *attributeValueRepository) DeleteEnvironmentValue(ctx context.Context, b
 
 func (a *attributeValueRepository) FindEnvironmentValue(ctx context.Context, bizID int64) (domain.ABACObject, error) {
 	//to-do 使用缓存
-	return a.FindEnvironmentValue(ctx, bizID)
+	return a.FindEnvironmentValueWithDefinition(ctx, bizID)
 }
 
 func (a *attributeValueRepository) FindEnvironmentValueWithDefinition(ctx context.Context, bizID int64) (domain.ABACObject, error) {
@@ -81,10 +158,6 @@ func (a *attributeValueRepository) FindEnvironmentValueWithDefinition(ctx contex
 }
 
 func (a *attributeValueRepository) SaveResourceValue(ctx context.Context, bizID, resourceID int64, val domain.AttributeValue) (int64, error) {
-	err := a.checkVal(ctx, bizID, val.AttrDef.ID, val.Value)
-	if err != nil {
-		return 0, err
-	}
 	daoVal := dao.ResourceAttributeValue{
 		ID:         val.ID,
 		BizID:      bizID,
@@ -105,7 +178,7 @@ func (a *attributeValueRepository) DeleteResourceValue(ctx context.Context, bizI
 
 func (a *attributeValueRepository) FindResourceValue(ctx context.Context, bizID, resourceID int64) (domain.ABACObject, error) {
 	//三级缓存 -todo
-	return domain.ABACObject{}, nil
+	return a.FindResourceValueWithDefinition(ctx, bizID, resourceID)
 }
 
 func (a *attributeValueRepository) FindResourceValueWithDefinition(ctx context.Context, bizID, resourceID int64) (domain.ABACObject, error) {
@@ -130,30 +203,7 @@ func (a *attributeValueRepository) FindResourceValueWithDefinition(ctx context.C
 	return result, nil
 }
 
-func (a *attributeValueRepository) matchRex(pattern, input string) error {
-	matched, err := regexp.MatchString(pattern, input)
-	if err != nil {
-		return fmt.Errorf("正则表达式语法错误: ", err)
-	}
-	if !matched {
-		return fmt.Errorf("填写的值不符合规范: ", err)
-	}
-	return nil
-}
-func (a *attributeValueRepository) checkVal(ctx context.Context, bizId, definitionId int64, value string) error {
-	definitionDao, err := a.attrDefinitionDao.FindByBizIdAndID(ctx, bizId, definitionId)
-	if err != nil {
-		return err
-	}
-	return a.matchRex(definitionDao.ValidationRule, value)
-
-}
-
 func (a *attributeValueRepository) SaveSubjectValue(ctx context.Context, bizID, subjectID int64, val domain.AttributeValue) (int64, error) {
-	err := a.checkVal(ctx, bizID, val.AttrDef.ID, val.Value)
-	if err != nil {
-		return 0, err
-	}
 	id, err := a.subjectAttrDao.Create(ctx, dao.SubjectAttributeValue{
 		BizID:     bizID,
 		SubjectID: subjectID,
@@ -172,7 +222,7 @@ func (a *attributeValueRepository) DeleteSubjectValue(ctx context.Context, bizID
 
 func (a *attributeValueRepository) FindSubjectValue(ctx context.Context, bizID, subjectID int64) (domain.ABACObject, error) {
 	//使用缓存- todo
-	return domain.ABACObject{}, nil
+	return a.FindSubjectValueWithDefinition(ctx, bizID, subjectID)
 }
 
 func (a *attributeValueRepository) ToDomainAttributeValue(value dao.SubjectAttributeValue, definition dao.AttributeDefinition) domain.AttributeValue {
@@ -185,6 +235,8 @@ func (a *attributeValueRepository) ToDomainAttributeValue(value dao.SubjectAttri
 			DataType:       domain.DataType(definition.DataType),
 			EntityType:     domain.EntityType(definition.EntityType),
 			ValidationRule: definition.ValidationRule,
+			DefaultValue:   definition.DefaultValue,
+			Constraints:    toDomainAttrConstraints(definition.Constraints),
 			Ctime:          definition.Ctime,
 			Utime:          definition.Utime,
 		},
@@ -203,6 +255,9 @@ func (a *attributeValueRepository) ToDomainResourceValue(value dao.ResourceAttri
 			DataType:       domain.DataType(definition.DataType),
 			EntityType:     domain.EntityType(definition.EntityType),
 			ValidationRule: definition.ValidationRule,
+			DefaultValue:   definition.DefaultValue,
+			Constraints:    toDomainAttrConstraints(definition.Constraints),
+			ResourceType:   definition.ResourceType,
 			Ctime:          definition.Ctime,
 			Utime:          definition.Utime,
 		},
@@ -221,6 +276,8 @@ func (a *attributeValueRepository) ToDomainEnvValue(value dao.EnvironmentAttribu
 			DataType:       domain.DataType(definition.DataType),
 			EntityType:     domain.EntityType(definition.EntityType),
 			ValidationRule: definition.ValidationRule,
+			DefaultValue:   definition.DefaultValue,
+			Constraints:    toDomainAttrConstraints(definition.Constraints),
 			Ctime:          definition.Ctime,
 			Utime:          definition.Utime,
 		},
@@ -258,11 +315,13 @@ func NewAttributeValueRepository(
 	envAttrDao dao.EnvironmentAttributeValueDAO,
 	subjectAttrDao dao.SubjectAttributeValueDAO,
 	attrDefinitionDao dao.AttributeDefinitionDAO,
+	historyDao dao.AttributeValueHistoryDAO,
 ) AttributeValueRepository {
 	return &attributeValueRepository{
 		resourceAttrDao:   resourceAttrDao,
 		envAttrDao:        envAttrDao,
 		subjectAttrDao:    subjectAttrDao,
 		attrDefinitionDao: attrDefinitionDao,
+		historyDao:        historyDao,
 	}
 }
@@ -2,6 +2,7 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/permission-dev/internal/domain"
 	"github.com/permission-dev/internal/repository/dao"
 )
@@ -82,6 +83,9 @@ func (a *attributeDefinitionRepository) toDomain(definition dao.AttributeDefinit
 		DataType:       domain.DataType(definition.DataType),
 		EntityType:     domain.EntityType(definition.EntityType),
 		ValidationRule: definition.ValidationRule,
+		DefaultValue:   definition.DefaultValue,
+		Constraints:    toDomainAttrConstraints(definition.Constraints),
+		ResourceType:   definition.ResourceType,
 		Ctime:          definition.Ctime,
 		Utime:          definition.Utime,
 	}
@@ -95,7 +99,27 @@ func (a *attributeDefinitionRepository) toDao(bizId int64, definition domain.Att
 		DataType:       definition.DataType.String(),
 		EntityType:     definition.EntityType.String(),
 		ValidationRule: definition.ValidationRule,
+		DefaultValue:   definition.DefaultValue,
+		Constraints:    toDaoAttrConstraints(definition.Constraints),
+		ResourceType:   definition.ResourceType,
 		Ctime:          definition.Ctime,
 		Utime:          definition.Utime,
 	}
 }
+
+// toDomainAttrConstraints 约束以 JSON 保存，空字符串表示没有约束
+func toDomainAttrConstraints(str string) domain.AttrConstraints {
+	var res domain.AttrConstraints
+	if str != "" {
+		_ = json.Unmarshal([]byte(str), &res)
+	}
+	return res
+}
+
+func toDaoAttrConstraints(constraints domain.AttrConstraints) string {
+	if constraints.IsZero() {
+		return ""
+	}
+	data, _ := json.Marshal(constraints)
+	return string(data)
+}
@@ -55,7 +55,10 @@ func genRule(rule domain.PolicyRule, ruleMap map[int64]dao.PolicyRule) domain.Po
 		AttrDef: domain.AttributeDefinition{
 			ID: ruleDao.AttrDefID,
 		},
-		Value:    ruleDao.Value,
+		Value: ruleDao.Value,
+		ValueAttrDef: domain.AttributeDefinition{
+			ID: ruleDao.ValueAttrDefID,
+		},
 		Operator: domain.RuleOperator(ruleDao.Operator),
 		Ctime:    ruleDao.Ctime,
 		Utime:    ruleDao.Utime,
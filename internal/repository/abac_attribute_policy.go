@@ -2,25 +2,39 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/permission-dev/internal/domain"
 	"github.com/permission-dev/internal/repository/dao"
 	"golang.org/x/sync/errgroup"
+	"sort"
 )
 
 type AttributePolicyRepository interface {
 	//policy相关
 	Save(ctx context.Context, policy domain.Policy) (int64, error)
 	Delete(ctx context.Context, bizID, id int64) error
-	First(ctx context.Context, bizID, id int64) (domain.Policy, error) // 包含规则
+	First(ctx context.Context, bizID, id int64) (domain.Policy, error) // 包含规则以及关联的权限，权限只有ID
 	//policy rule相关
 	SaveRule(ctx context.Context, bizID, policyId int64, rule domain.PolicyRule) (int64, error)
 	DeleteRule(ctx context.Context, bizID, ruleID int64, cascade bool) error
+	// ReplaceRules 用一棵规则树替换策略已有的全部规则，返回根规则ID
+	ReplaceRules(ctx context.Context, bizID, policyID int64, rule domain.PolicyRule) (int64, error)
 	//policy permission相关
 	FindPoliciesByPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64) ([]domain.Policy, error)
 	SavePermissionPolicy(ctx context.Context, bizID, policyID, permissionID int64, effect domain.Effect) error
 	FindPolicies(ctx context.Context, bizID int64, offset, limit int) (int64, []domain.Policy, error)
 	FindBizPolicies(ctx context.Context, bizID int64) ([]domain.Policy, error)
+	// FindBizPoliciesAsOf 返回 asOf（毫秒）时刻生效的策略，包括之后删除的策略
+	FindBizPoliciesAsOf(ctx context.Context, bizID, asOf int64) ([]domain.Policy, error)
+	//policy version相关，上面的修改（包括策略与权限的关联）都只修改草稿，查询权限关联的策略时只返回已经发布的版本
+	// Publish 把草稿发布成新版本并切换生效版本，返回新版本号
+	Publish(ctx context.Context, bizID, policyID int64, comment string) (int64, error)
+	// Rollback 复制历史版本发布成新版本，草稿也恢复成该版本，返回新版本号
+	Rollback(ctx context.Context, bizID, policyID, version int64, comment string) (int64, error)
+	// FindVersions 返回策略的全部版本，新版本在前，不包含规则
+	FindVersions(ctx context.Context, bizID, policyID int64) ([]domain.PolicyVersion, error)
+	FindVersion(ctx context.Context, bizID, policyID, version int64) (domain.PolicyVersion, error)
 }
 
 type attributePolicyRepository struct {
@@ -29,14 +43,15 @@ type attributePolicyRepository struct {
 
 func (a *attributePolicyRepository) SaveRule(ctx context.Context, bizID, policyId int64, rule domain.PolicyRule) (int64, error) {
 	ruleDao := dao.PolicyRule{
-		ID:        rule.ID,
-		BizID:     bizID,
-		PolicyID:  policyId,
-		AttrDefID: rule.AttrDef.ID,
-		Value:     rule.Value,
-		Operator:  rule.Operator.String(),
-		Ctime:     rule.Ctime,
-		Utime:     rule.Utime,
+		ID:             rule.ID,
+		BizID:          bizID,
+		PolicyID:       policyId,
+		AttrDefID:      rule.AttrDef.ID,
+		Value:          rule.Value,
+		ValueAttrDefID: rule.ValueAttrDef.ID,
+		Operator:       rule.Operator.String(),
+		Ctime:          rule.Ctime,
+		Utime:          rule.Utime,
 	}
 	if rule.RightRule == nil {
 		ruleDao.Right = 0
@@ -48,6 +63,30 @@ func (a *attributePolicyRepository) SaveRule(ctx context.Context, bizID, policyI
 	return ruleId, err
 }
 
+func (a *attributePolicyRepository) ReplaceRules(ctx context.Context, bizID, policyID int64, rule domain.PolicyRule) (int64, error) {
+	return a.policyDAO.ReplacePolicyRules(ctx, bizID, policyID, toPolicyRuleNode(rule))
+}
+
+func toPolicyRuleNode(rule domain.PolicyRule) dao.PolicyRuleNode {
+	node := dao.PolicyRuleNode{
+		Rule: dao.PolicyRule{
+			AttrDefID:      rule.AttrDef.ID,
+			Value:          rule.Value,
+			ValueAttrDefID: rule.ValueAttrDef.ID,
+			Operator:       rule.Operator.String(),
+		},
+	}
+	if rule.LeftRule != nil {
+		left := toPolicyRuleNode(*rule.LeftRule)
+		node.Left = &left
+	}
+	if rule.RightRule != nil {
+		right := toPolicyRuleNode(*rule.RightRule)
+		node.Right = &right
+	}
+	return node
+}
+
 /*
 这里直接删除，会产生孤儿规则
 1、级联删除 可以做
@@ -69,6 +108,8 @@ func (a *attributePolicyRepository) Save(ctx context.Context, policy domain.Poli
 		ExecuteType: string(policy.ExecuteType),
 		Description: policy.Description,
 		Status:      string(policy.Status),
+		Priority:    policy.Priority,
+		MissingAttr: string(policy.MissingAttr),
 	}
 	// 保存策略
 	id, err := a.policyDAO.SavePolicy(ctx, policyDAO)
@@ -82,6 +123,7 @@ func (a *attributePolicyRepository) Delete(ctx context.Context, bizID, id int64)
 func (a *attributePolicyRepository) First(ctx context.Context, bizID, id int64) (domain.Policy, error) {
 	var policy dao.Policy
 	var policyRules []dao.PolicyRule
+	var permissionPolicies []dao.PermissionPolicy
 	var eg errgroup.Group
 	eg.Go(func() error {
 		var err error
@@ -93,25 +135,26 @@ func (a *attributePolicyRepository) First(ctx context.Context, bizID, id int64)
 		policyRules, err = a.policyDAO.FindPolicyRulesByPolicyID(ctx, bizID, id)
 		return err
 	})
+	eg.Go(func() error {
+		var err error
+		permissionPolicies, err = a.policyDAO.FindPermissionPolicyByPolicyID(ctx, bizID, id)
+		return err
+	})
 	if err := eg.Wait(); err != nil {
 		return domain.Policy{}, err
 	}
 	//toPolicyDomain
 	//将所有的policy--policyrule转换成domain.policyRule
-	return a.toPolicyDomain(policy, policyRules, map[int64][]dao.PermissionPolicy{}), nil
+	return a.toPolicyDomain(policy, policyRules, map[int64][]dao.PermissionPolicy{id: permissionPolicies}), nil
 }
+
+// getPolicies 返回业务下已经发布的策略，策略的内容来自生效版本的快照
 func (p *attributePolicyRepository) getPolicies(ctx context.Context, bizID int64) ([]domain.Policy, error) {
 	var (
-		eg                   errgroup.Group
-		daoPolicies          []dao.Policy
-		daoPolicyRules       map[int64][]dao.PolicyRule
-		daoPolicyPermissions map[int64][]dao.PermissionPolicy
+		eg          errgroup.Group
+		daoPolicies []dao.Policy
+		daoVersions map[int64]dao.PolicyVersion
 	)
-	eg.Go(func() error {
-		var eerr error
-		daoPolicyPermissions, eerr = p.policyDAO.FindPermissionPolicy(ctx, bizID)
-		return eerr
-	})
 	eg.Go(func() error {
 		var eerr error
 		daoPolicies, eerr = p.policyDAO.FindPoliciesByBizId(ctx, bizID)
@@ -119,7 +162,7 @@ func (p *attributePolicyRepository) getPolicies(ctx context.Context, bizID int64
 	})
 	eg.Go(func() error {
 		var eerr error
-		daoPolicyRules, eerr = p.policyDAO.FindPolicyRulesByBiz(ctx, bizID)
+		daoVersions, eerr = p.policyDAO.FindPublishedPolicyVersions(ctx, bizID)
 		return eerr
 	})
 	if err := eg.Wait(); err != nil {
@@ -128,11 +171,34 @@ func (p *attributePolicyRepository) getPolicies(ctx context.Context, bizID int64
 	res := make([]domain.Policy, 0, len(daoPolicies))
 	for index := range daoPolicies {
 		daoPolicy := daoPolicies[index]
-		rules := daoPolicyRules[daoPolicy.ID]
-		res = append(res, p.toPolicyDomain(daoPolicy, rules, daoPolicyPermissions))
+		version, ok := daoVersions[daoPolicy.ID]
+		if !ok {
+			// 还没有发布
+			continue
+		}
+		policy, err := p.toPublishedPolicyDomain(daoPolicy, version)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, policy)
 	}
 	return res, nil
 }
+
+// toPublishedPolicyDomain 用版本快照替换策略的草稿，关联的权限也来自快照
+func (p *attributePolicyRepository) toPublishedPolicyDomain(policy dao.Policy, version dao.PolicyVersion) (domain.Policy, error) {
+	var snapshot dao.PolicySnapshot
+	if err := json.Unmarshal([]byte(version.Snapshot), &snapshot); err != nil {
+		return domain.Policy{}, err
+	}
+	policy.Description = snapshot.Description
+	policy.Status = snapshot.Status
+	policy.ExecuteType = snapshot.ExecuteType
+	policy.Priority = snapshot.Priority
+	policy.MissingAttr = snapshot.MissingAttr
+	policy.Version = version.Version
+	return p.toPolicyDomain(policy, snapshot.Rules, map[int64][]dao.PermissionPolicy{policy.ID: snapshot.Permissions}), nil
+}
 func (p *attributePolicyRepository) FindPoliciesByPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64) ([]domain.Policy, error) {
 	//1、获取daoPolicys
 	//2、获取daoPolicyRlues
@@ -163,6 +229,9 @@ func (p *attributePolicyRepository) toPolicyDomain(policy dao.Policy, rules []da
 		ExecuteType: domain.ExecuteType(policy.ExecuteType),
 		Description: policy.Description,
 		Status:      domain.PolicyStatusType(policy.Status),
+		Priority:    policy.Priority,
+		MissingAttr: domain.MissingAttrMode(policy.MissingAttr),
+		Version:     policy.Version,
 		Rules:       GenDomainPolicyRules(rules),
 	}
 
@@ -217,6 +286,117 @@ func (p *attributePolicyRepository) FindBizPolicies(ctx context.Context, bizID i
 	return p.getPolicies(ctx, bizID)
 }
 
-func NewAttributePolicyRepository(dao dao.PolicyDAO) AttributePolicyRepository {
-	return &attributePolicyRepository{policyDAO: dao}
+func (p *attributePolicyRepository) FindBizPoliciesAsOf(ctx context.Context, bizID, asOf int64) ([]domain.Policy, error) {
+	var (
+		eg          errgroup.Group
+		daoPolicies []dao.Policy
+		daoVersions map[int64]dao.PolicyVersion
+	)
+	eg.Go(func() error {
+		var eerr error
+		daoPolicies, eerr = p.policyDAO.FindPoliciesByBizId(ctx, bizID)
+		return eerr
+	})
+	eg.Go(func() error {
+		var eerr error
+		daoVersions, eerr = p.policyDAO.FindPolicyVersionsAsOf(ctx, bizID, asOf)
+		return eerr
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	// 已经删除的策略只有版本，名称等不在快照中的字段为空
+	policyMap := slice.ToMap(daoPolicies, func(src dao.Policy) int64 {
+		return src.ID
+	})
+	res := make([]domain.Policy, 0, len(daoVersions))
+	for policyID, version := range daoVersions {
+		daoPolicy, ok := policyMap[policyID]
+		if !ok {
+			daoPolicy = dao.Policy{ID: policyID, BizID: version.BizID}
+		}
+		policy, err := p.toPublishedPolicyDomain(daoPolicy, version)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, policy)
+	}
+	// 与 FindBizPolicies 的顺序保持一致
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].ID < res[j].ID
+	})
+	return res, nil
+}
+
+func (p *attributePolicyRepository) Publish(ctx context.Context, bizID, policyID int64, comment string) (int64, error) {
+	return p.policyDAO.PublishPolicy(ctx, bizID, policyID, comment)
+}
+
+func (p *attributePolicyRepository) Rollback(ctx context.Context, bizID, policyID, version int64, comment string) (int64, error) {
+	return p.policyDAO.RollbackPolicy(ctx, bizID, policyID, version, comment)
+}
+
+func (p *attributePolicyRepository) FindVersions(ctx context.Context, bizID, policyID int64) ([]domain.PolicyVersion, error) {
+	var (
+		eg       errgroup.Group
+		policy   dao.Policy
+		versions []dao.PolicyVersion
+	)
+	eg.Go(func() error {
+		var err error
+		policy, err = p.policyDAO.FindPolicyById(ctx, bizID, policyID)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		versions, err = p.policyDAO.FindPolicyVersions(ctx, bizID, policyID)
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return slice.Map(versions, func(_ int, src dao.PolicyVersion) domain.PolicyVersion {
+		return p.toPolicyVersionDomain(policy, src)
+	}), nil
+}
+
+func (p *attributePolicyRepository) FindVersion(ctx context.Context, bizID, policyID, version int64) (domain.PolicyVersion, error) {
+	var (
+		eg         errgroup.Group
+		policy     dao.Policy
+		daoVersion dao.PolicyVersion
+	)
+	eg.Go(func() error {
+		var err error
+		policy, err = p.policyDAO.FindPolicyById(ctx, bizID, policyID)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		daoVersion, err = p.policyDAO.FindPolicyVersion(ctx, bizID, policyID, version)
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return domain.PolicyVersion{}, err
+	}
+	res := p.toPolicyVersionDomain(policy, daoVersion)
+	var err error
+	res.Policy, err = p.toPublishedPolicyDomain(policy, daoVersion)
+	return res, err
+}
+
+// toPolicyVersionDomain 不解析快照，只有查询单个版本时才需要规则
+func (p *attributePolicyRepository) toPolicyVersionDomain(policy dao.Policy, version dao.PolicyVersion) domain.PolicyVersion {
+	return domain.PolicyVersion{
+		Version:       version.Version,
+		SourceVersion: version.SourceVersion,
+		Comment:       version.Comment,
+		Published:     policy.Version == version.Version,
+		Policy:        domain.Policy{ID: policy.ID, BizID: policy.BizID, Name: policy.Name},
+		Ctime:         version.Ctime,
+	}
+}
+
+func NewAttributePolicyRepository(policyDAO dao.PolicyDAO) AttributePolicyRepository {
+	return &attributePolicyRepository{policyDAO: policyDAO}
 }
@@ -71,26 +71,32 @@ func (b *businessConfigRepository) Delete(ctx context.Context, id int64) error {
 }
 func (b *businessConfigRepository) toEntity(bc domain.BusinessConfig) dao.BusinessConfig {
 	return dao.BusinessConfig{
-		ID:        bc.ID,
-		OwnerID:   bc.OwnerID,
-		OwnerType: bc.OwnerType,
-		Name:      bc.Name,
-		RateLimit: bc.RateLimit,
-		Token:     bc.Token,
-		Ctime:     bc.Ctime,
-		Utime:     bc.Utime,
+		ID:                    bc.ID,
+		OwnerID:               bc.OwnerID,
+		OwnerType:             bc.OwnerType,
+		Name:                  bc.Name,
+		RateLimit:             bc.RateLimit,
+		Token:                 bc.Token,
+		CheckMode:             bc.CheckMode.Normalize().String(),
+		CombiningAlgorithm:    bc.CombiningAlgorithm.Normalize().String(),
+		NotApplicableDecision: bc.NotApplicableDecision.String(),
+		Ctime:                 bc.Ctime,
+		Utime:                 bc.Utime,
 	}
 }
 
 func (b *businessConfigRepository) toDomain(bc dao.BusinessConfig) domain.BusinessConfig {
 	return domain.BusinessConfig{
-		ID:        bc.ID,
-		OwnerID:   bc.OwnerID,
-		OwnerType: bc.OwnerType,
-		Name:      bc.Name,
-		RateLimit: bc.RateLimit,
-		Token:     bc.Token,
-		Ctime:     bc.Ctime,
-		Utime:     bc.Utime,
+		ID:                    bc.ID,
+		OwnerID:               bc.OwnerID,
+		OwnerType:             bc.OwnerType,
+		Name:                  bc.Name,
+		RateLimit:             bc.RateLimit,
+		Token:                 bc.Token,
+		CheckMode:             domain.CheckMode(bc.CheckMode).Normalize(),
+		CombiningAlgorithm:    domain.CombiningAlgorithm(bc.CombiningAlgorithm).Normalize(),
+		NotApplicableDecision: domain.Effect(bc.NotApplicableDecision),
+		Ctime:                 bc.Ctime,
+		Utime:                 bc.Utime,
 	}
 }
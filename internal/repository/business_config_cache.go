@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ecodeclub/ecache"
+	"github.com/gotomicro/ego/core/elog"
+	"github.com/permission-dev/internal/domain"
+)
+
+var _ BusinessConfigRepository = (*BusinessConfigCachedRepository)(nil)
+
+// BusinessConfigCachedRepository 每次校验权限都要读取业务配置（校验模式、合并算法），所以缓存在本地。
+// 通过本实例修改时删除缓存，其他实例修改后最多 expiration 之后生效
+type BusinessConfigCachedRepository struct {
+	repo       BusinessConfigRepository
+	cache      ecache.Cache
+	expiration time.Duration
+	logger     *elog.Component
+}
+
+func NewBusinessConfigCachedRepository(repo BusinessConfigRepository, cache ecache.Cache, expiration time.Duration) *BusinessConfigCachedRepository {
+	return &BusinessConfigCachedRepository{
+		repo:       repo,
+		cache:      cache,
+		expiration: expiration,
+		logger:     elog.DefaultLogger.With(elog.FieldName("BusinessConfigCachedRepository")),
+	}
+}
+
+func (b *BusinessConfigCachedRepository) Create(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
+	return b.repo.Create(ctx, config)
+}
+
+func (b *BusinessConfigCachedRepository) Find(ctx context.Context, offset, limit int) ([]domain.BusinessConfig, error) {
+	return b.repo.Find(ctx, offset, limit)
+}
+
+func (b *BusinessConfigCachedRepository) FindByID(ctx context.Context, id int64) (domain.BusinessConfig, error) {
+	val := b.cache.Get(ctx, b.key(id))
+	if config, ok := val.Val.(domain.BusinessConfig); ok && val.Err == nil {
+		return config, nil
+	}
+	config, err := b.repo.FindByID(ctx, id)
+	if err != nil {
+		return domain.BusinessConfig{}, err
+	}
+	if err1 := b.cache.Set(ctx, b.key(id), config, b.expiration); err1 != nil {
+		b.logger.Warn("缓存业务配置失败", elog.FieldErr(err1), elog.Int64("bizID", id))
+	}
+	return config, nil
+}
+
+func (b *BusinessConfigCachedRepository) UpdateToken(ctx context.Context, id int64, token string) error {
+	err := b.repo.UpdateToken(ctx, id, token)
+	b.invalidate(ctx, id)
+	return err
+}
+
+func (b *BusinessConfigCachedRepository) Update(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
+	updated, err := b.repo.Update(ctx, config)
+	b.invalidate(ctx, config.ID)
+	return updated, err
+}
+
+func (b *BusinessConfigCachedRepository) Delete(ctx context.Context, id int64) error {
+	err := b.repo.Delete(ctx, id)
+	b.invalidate(ctx, id)
+	return err
+}
+
+// invalidate 修改失败时也删除缓存，避免数据库中的结果不确定时继续使用旧值
+func (b *BusinessConfigCachedRepository) invalidate(ctx context.Context, id int64) {
+	if _, err := b.cache.Delete(ctx, b.key(id)); err != nil {
+		b.logger.Warn("删除业务配置缓存失败", elog.FieldErr(err), elog.Int64("bizID", id))
+	}
+}
+
+func (b *BusinessConfigCachedRepository) key(id int64) string {
+	return fmt.Sprintf("business_config:%d", id)
+}
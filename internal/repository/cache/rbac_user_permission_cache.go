@@ -46,7 +46,18 @@ func (u *userPermissionCache) Set(ctx context.Context, permissions []domain.User
 		return err
 	}
 	bizID, userID := permissions[0].BizID, permissions[0].UserID
-	return u.c.Set(ctx, u.cacheKeyFunc(bizID, userID), value, defaultExpiration)
+	return u.c.Set(ctx, u.cacheKeyFunc(bizID, userID), value, expiration(permissions, time.Now().Unix()))
+}
+
+// expiration 缓存到最近一次有权限生效或者失效为止，之后重新从数据库加载
+func expiration(permissions []domain.UserPermission, now int64) time.Duration {
+	res := defaultExpiration
+	for idx := range permissions {
+		if next, ok := permissions[idx].NextValidityChange(now); ok {
+			res = min(res, time.Duration(next-now)*time.Second)
+		}
+	}
+	return res
 }
 
 func NewUserPermissionCache(c cache.Cache, cacheKeyFunc func(bizID, userID int64) string) UserPermissionCache {
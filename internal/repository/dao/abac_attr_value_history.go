@@ -0,0 +1,104 @@
+package dao
+
+import (
+	"context"
+
+	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
+)
+
+// 属性值历史的实体类型，与属性定义的 entity_type 一致
+const (
+	AttrEntitySubject     = "subject"
+	AttrEntityResource    = "resource"
+	AttrEntityEnvironment = "environment"
+)
+
+// AttributeValueHistory 属性值的变更历史，每条记录是属性在 [valid_from, valid_to) 内的值，
+// 保存属性值时结束上一条记录并写入新的记录，删除时只结束上一条记录
+type AttributeValueHistory struct {
+	ID         int64  `gorm:"column:id;primaryKey;autoIncrement;"`
+	BizID      int64  `gorm:"column:biz_id;not null;index:idx_biz_entity,priority:1;comment:业务ID"`
+	EntityType string `gorm:"column:entity_type;type:varchar(32);not null;index:idx_biz_entity,priority:2;comment:实体类型"`
+	// EntityID 主体为用户ID，资源为资源ID，环境属性为 0
+	EntityID  int64  `gorm:"column:entity_id;not null;index:idx_biz_entity,priority:3;comment:主体ID或者资源ID"`
+	AttrDefID int64  `gorm:"column:attr_def_id;not null;index:idx_biz_entity,priority:4;comment:属性定义ID"`
+	Value     string `gorm:"column:value;type:text;not null;comment:属性值"`
+	ValidFrom int64  `gorm:"column:valid_from;not null;comment:开始时间，毫秒"`
+	ValidTo   int64  `gorm:"column:valid_to;not null;default:0;comment:结束时间，毫秒，0 表示至今"`
+}
+
+func (AttributeValueHistory) TableName() string {
+	return "attribute_value_histories"
+}
+
+type AttributeValueHistoryDAO interface {
+	// FindAsOf 返回实体在 asOf（毫秒）时刻的全部属性值
+	FindAsOf(ctx context.Context, bizID int64, entityType string, entityID, asOf int64) ([]AttributeValueHistory, error)
+}
+
+type attributeValueHistoryDAO struct {
+	db *egorm.Component
+}
+
+func NewAttributeValueHistoryDAO(db *egorm.Component) AttributeValueHistoryDAO {
+	return &attributeValueHistoryDAO{db: db}
+}
+
+func (a *attributeValueHistoryDAO) FindAsOf(ctx context.Context, bizID int64, entityType string, entityID, asOf int64) ([]AttributeValueHistory, error) {
+	var res []AttributeValueHistory
+	err := a.db.WithContext(ctx).
+		Where("biz_id = ? AND entity_type = ? AND entity_id = ? AND valid_from <= ? AND (valid_to = 0 OR valid_to > ?)",
+			bizID, entityType, entityID, asOf, asOf).
+		Find(&res).Error
+	return res, err
+}
+
+// recordAttrValue 在修改属性值的事务中调用，deleted 为 true 表示属性值被删除，now 为毫秒时间戳
+func recordAttrValue(tx *gorm.DB, bizID int64, entityType string, entityID, attrDefID int64, value string, deleted bool, now int64) error {
+	err := tx.Model(&AttributeValueHistory{}).
+		Where("biz_id = ? AND entity_type = ? AND entity_id = ? AND attr_def_id = ? AND valid_to = 0",
+			bizID, entityType, entityID, attrDefID).
+		Update("valid_to", now).Error
+	if err != nil || deleted {
+		return err
+	}
+	return tx.Create(&AttributeValueHistory{
+		BizID:      bizID,
+		EntityType: entityType,
+		EntityID:   entityID,
+		AttrDefID:  attrDefID,
+		Value:      value,
+		ValidFrom:  now,
+	}).Error
+}
+
+// backfillAttrValueHistories 为已有的属性值补上历史，属性值从最后一次修改时起一直是当前的值
+func backfillAttrValueHistories(db *egorm.Component) error {
+	var histories []AttributeValueHistory
+	var subjectValues []SubjectAttributeValue
+	if err := db.Find(&subjectValues).Error; err != nil {
+		return err
+	}
+	for _, src := range subjectValues {
+		histories = append(histories, AttributeValueHistory{BizID: src.BizID, EntityType: AttrEntitySubject, EntityID: src.SubjectID, AttrDefID: src.AttrDefID, Value: src.Value, ValidFrom: src.Utime})
+	}
+	var resourceValues []ResourceAttributeValue
+	if err := db.Find(&resourceValues).Error; err != nil {
+		return err
+	}
+	for _, src := range resourceValues {
+		histories = append(histories, AttributeValueHistory{BizID: src.BizID, EntityType: AttrEntityResource, EntityID: src.ResourceID, AttrDefID: src.AttrDefID, Value: src.Value, ValidFrom: src.Utime})
+	}
+	var envValues []EnvironmentAttributeValue
+	if err := db.Find(&envValues).Error; err != nil {
+		return err
+	}
+	for _, src := range envValues {
+		histories = append(histories, AttributeValueHistory{BizID: src.BizID, EntityType: AttrEntityEnvironment, AttrDefID: src.AttrDefID, Value: src.Value, ValidFrom: src.Utime})
+	}
+	if len(histories) == 0 {
+		return nil
+	}
+	return db.CreateInBatches(histories, 500).Error
+}
@@ -9,12 +9,15 @@ import (
 
 type AttributeDefinition struct {
 	ID             int64  `gorm:"column:id;primaryKey;;autoIncrement;"`
-	BizID          int64  `gorm:"column:biz_id;uniqueIndex:idx_biz_id_name;comment:和name组成唯一索引，比如说代表订单组的biz_id"`
-	Name           string `gorm:"column:name;size:100;not null;type:varchar(255);uniqueIndex:idx_biz_id_name;comment:属性名称"`
+	BizID          int64  `gorm:"column:biz_id;uniqueIndex:idx_biz_type_name,priority:1;comment:和resource_type、name组成唯一索引，比如说代表订单组的biz_id"`
+	Name           string `gorm:"column:name;size:100;not null;type:varchar(255);uniqueIndex:idx_biz_type_name,priority:3;comment:属性名称"`
 	Description    string `gorm:"column:description;type:text;comment:属性描述"`
 	DataType       string `gorm:"column:data_type;type:varchar(255);not null;comment:属性数据类型"`
 	EntityType     string `gorm:"column:entity_type;type:enum('subject','resource','environment');not null;comment:属性所属实体类型;index:idx_entity_type"`
 	ValidationRule string `gorm:"column:validation_rule;comment:验证规则，正则表达式"`
+	DefaultValue   string `gorm:"column:default_value;type:text;comment:属性缺失时使用的默认值，为空表示没有默认值"`
+	Constraints    string `gorm:"column:constraints;type:text;comment:结构化约束，JSON"`
+	ResourceType   string `gorm:"column:resource_type;type:varchar(255);not null;default:'';uniqueIndex:idx_biz_type_name,priority:2;comment:资源属性所属的资源类型，为空表示所有资源类型共用"`
 	Ctime          int64  `gorm:"column:ctime;comment:创建时间"` // 使用毫秒级时间戳
 	Utime          int64  `gorm:"column:utime;comment:更新时间"` // 使用毫秒级时间戳
 }
@@ -44,15 +47,15 @@ func (a *attributeDefinitionDAO) Create(ctx context.Context, definition Attribut
 	definition.Utime = now
 	definition.Ctime = now
 	err := a.db.WithContext(ctx).Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "biz_id"}, {Name: "name"}},
-		DoUpdates: clause.AssignmentColumns([]string{"description", "data_type", "entity_type", "validation_rule"}),
+		Columns:   []clause.Column{{Name: "biz_id"}, {Name: "resource_type"}, {Name: "name"}},
+		DoUpdates: clause.AssignmentColumns([]string{"description", "data_type", "entity_type", "validation_rule", "default_value", "constraints"}),
 	}).Create(&definition).Error
 	return definition.ID, err
 }
 
 func (a *attributeDefinitionDAO) FindByBizIdAndID(ctx context.Context, bizId, id int64) (AttributeDefinition, error) {
 	var definition AttributeDefinition
-	err := a.db.WithContext(ctx).Model(&AttributeDefinition{}).Where("biz_id=? AND id=?").First(&definition).Error
+	err := a.db.WithContext(ctx).Model(&AttributeDefinition{}).Where("biz_id=? AND id=?", bizId, id).First(&definition).Error
 	return definition, err
 }
 
@@ -67,6 +70,17 @@ func (a *attributeDefinitionDAO) FindByBizID(ctx context.Context, bizId int64) (
 }
 
 func (a *attributeDefinitionDAO) FindByIDs(ctx context.Context, ids []int64) (map[int64]AttributeDefinition, error) {
-	//TODO implement me
-	panic("implement me")
+	if len(ids) == 0 {
+		return map[int64]AttributeDefinition{}, nil
+	}
+	var definitions []AttributeDefinition
+	err := a.db.WithContext(ctx).Model(&AttributeDefinition{}).Where("id IN ?", ids).Find(&definitions).Error
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[int64]AttributeDefinition, len(definitions))
+	for idx := range definitions {
+		res[definitions[idx].ID] = definitions[idx]
+	}
+	return res, nil
 }
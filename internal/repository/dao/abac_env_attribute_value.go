@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"time"
 )
@@ -33,10 +34,16 @@ func (e *environmentAttributeValueDao) Create(ctx context.Context, value Environ
 	now := time.Now().UnixMilli()
 	value.Ctime = now
 	value.Utime = now
-	err := e.db.WithContext(ctx).Model(&EnvironmentAttributeValue{}).Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "biz_id"}, {Name: "attr_def_id"}},
-		DoUpdates: clause.AssignmentColumns([]string{"value", "utime"}),
-	}).Create(&value).Error
+	err := e.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&EnvironmentAttributeValue{}).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "biz_id"}, {Name: "attr_def_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "utime"}),
+		}).Create(&value).Error
+		if err != nil {
+			return err
+		}
+		return recordAttrValue(tx, value.BizID, AttrEntityEnvironment, 0, value.AttrDefID, value.Value, false, now)
+	})
 	return value.ID, err
 }
 
@@ -59,10 +66,17 @@ func (e *environmentAttributeValueDao) FindByBizID(ctx context.Context, bizId in
 }
 
 func (e *environmentAttributeValueDao) DeleteByID(ctx context.Context, id int64) error {
-	return e.db.WithContext(ctx).
-		Model(&EnvironmentAttributeValue{}).
-		Where("id = ?", id).
-		Delete(&EnvironmentAttributeValue{}).Error
+	return e.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var value EnvironmentAttributeValue
+		res := tx.Where("id = ?", id).Limit(1).Find(&value)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		if err := tx.Model(&EnvironmentAttributeValue{}).Where("id = ?", id).Delete(&EnvironmentAttributeValue{}).Error; err != nil {
+			return err
+		}
+		return recordAttrValue(tx, value.BizID, AttrEntityEnvironment, 0, value.AttrDefID, "", true, time.Now().UnixMilli())
+	})
 }
 
 func NewEnvironmentAttributeValueDAO(db *egorm.Component) EnvironmentAttributeValueDAO {
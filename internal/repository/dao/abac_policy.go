@@ -2,9 +2,12 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/ego-component/egorm"
 	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"time"
@@ -18,6 +21,9 @@ type Policy struct {
 	Description string `gorm:"column:description;type:text;comment:策略描述" json:"description"`
 	Status      string `gorm:"column:status;type:enum('active','inactive');not null;default:active;index:idx_status;comment:策略状态" json:"status"`
 	ExecuteType string `gorm:"column:execute_type;type:varchar(255);default:logic"`
+	Priority    int    `gorm:"column:priority;type:int;not null;default:0;comment:策略优先级，数值越大越优先"`
+	MissingAttr string `gorm:"column:missing_attr;type:varchar(32);not null;default:'';comment:属性缺失时的处理方式，为空时按false处理"`
+	Version     int64  `gorm:"column:version;not null;default:0;comment:生效的版本号，0表示还没有发布"`
 	Ctime       int64  `gorm:"column:ctime;comment:创建时间"`
 	Utime       int64  `gorm:"column:utime;comment:更新时间"`
 }
@@ -35,16 +41,17 @@ ABAC 模型允许在同一策略下对同一属性定义设置 多个规则 。
 */
 // PolicyRule 策略规则表模型
 type PolicyRule struct {
-	ID        int64  `gorm:"column:id;primaryKey;autoIncrement;"`
-	BizID     int64  `gorm:"column:biz_id;index:idx_biz_id;comment:业务ID"`
-	PolicyID  int64  `gorm:"column:policy_id;not null;index:idx_policy_id;comment:策略ID"`
-	AttrDefID int64  `gorm:"column:attr_def_id;not null;index:idx_attr_def_id;comment:属性定义ID"`
-	Value     string `gorm:"column:value;type:text;comment:比较值，取决于类型"`
-	Left      int64  `gorm:"column:left;comment:左规则ID"`
-	Right     int64  `gorm:"column:right;comment:右规则ID"`
-	Operator  string `gorm:"column:operator;type:varchar(255);not null;comment:操作符"`
-	Ctime     int64  `gorm:"column:ctime;comment:创建时间"`
-	Utime     int64  `gorm:"column:utime;comment:更新时间"`
+	ID             int64  `gorm:"column:id;primaryKey;autoIncrement;"`
+	BizID          int64  `gorm:"column:biz_id;index:idx_biz_id;comment:业务ID"`
+	PolicyID       int64  `gorm:"column:policy_id;not null;index:idx_policy_id;comment:策略ID"`
+	AttrDefID      int64  `gorm:"column:attr_def_id;not null;index:idx_attr_def_id;comment:属性定义ID"`
+	Value          string `gorm:"column:value;type:text;comment:比较值，取决于类型"`
+	ValueAttrDefID int64  `gorm:"column:value_attr_def_id;not null;default:0;comment:右侧引用的属性定义ID，不为0时和该属性的值比较"`
+	Left           int64  `gorm:"column:left;comment:左规则ID"`
+	Right          int64  `gorm:"column:right;comment:右规则ID"`
+	Operator       string `gorm:"column:operator;type:varchar(255);not null;comment:操作符"`
+	Ctime          int64  `gorm:"column:ctime;comment:创建时间"`
+	Utime          int64  `gorm:"column:utime;comment:更新时间"`
 }
 
 // TableName 指定表名
@@ -52,6 +59,13 @@ func (r PolicyRule) TableName() string {
 	return "policy_rules"
 }
 
+// PolicyRuleNode 规则树节点，写入时先写子节点，父节点才能记录子规则ID
+type PolicyRuleNode struct {
+	Rule  PolicyRule
+	Left  *PolicyRuleNode
+	Right *PolicyRuleNode
+}
+
 // PermissionPolicy 权限策略关联表模型
 type PermissionPolicy struct {
 	ID           int64  `gorm:"column:id;primaryKey;autoIncrement;"`
@@ -68,6 +82,37 @@ func (p PermissionPolicy) TableName() string {
 	return "permission_policies"
 }
 
+// PolicyVersion 策略发布的版本，保存发布时策略、规则以及与权限关联的快照，写入后不再修改。
+// 策略表、规则表以及权限策略关联表中的数据是草稿，只有发布后才会生效
+type PolicyVersion struct {
+	ID            int64  `gorm:"column:id;primaryKey;autoIncrement;"`
+	BizID         int64  `gorm:"column:biz_id;index:idx_biz_id;comment:业务ID"`
+	PolicyID      int64  `gorm:"column:policy_id;not null;uniqueIndex:idx_policy_version;comment:策略ID"`
+	Version       int64  `gorm:"column:version;not null;uniqueIndex:idx_policy_version;comment:版本号，从1开始递增"`
+	SourceVersion int64  `gorm:"column:source_version;not null;default:0;comment:回滚时复制的版本号"`
+	Snapshot      string `gorm:"column:snapshot;type:mediumtext;comment:策略、规则以及权限关联的快照，JSON"`
+	Comment       string `gorm:"column:comment;type:varchar(255);comment:发布说明"`
+	Ctime         int64  `gorm:"column:ctime;comment:创建时间"`
+	Utime         int64  `gorm:"column:utime;comment:更新时间"`
+}
+
+// TableName 指定表名
+func (p PolicyVersion) TableName() string {
+	return "policy_versions"
+}
+
+// PolicySnapshot 版本快照的内容，策略名称不属于版本
+type PolicySnapshot struct {
+	Description string       `json:"description"`
+	Status      string       `json:"status"`
+	ExecuteType string       `json:"executeType"`
+	Priority    int          `json:"priority"`
+	MissingAttr string       `json:"missingAttr"`
+	Rules       []PolicyRule `json:"rules"`
+	// Permissions 策略与权限的关联，决定策略用于哪些权限
+	Permissions []PermissionPolicy `json:"permissions"`
+}
+
 type PolicyDAO interface {
 	//policy
 	SavePolicy(ctx context.Context, policy Policy) (int64, error)
@@ -84,6 +129,8 @@ type PolicyDAO interface {
 	SavePolicyRule(ctx context.Context, rule PolicyRule) (int64, error)
 	DeletePolicyRule(ctx context.Context, bizID, id int64) error
 	DeletePolicyRuleCascade(ctx context.Context, bizID, id int64) error
+	// ReplacePolicyRules 删除策略已有的规则，写入新的规则树，返回根规则ID
+	ReplacePolicyRules(ctx context.Context, bizID, policyID int64, root PolicyRuleNode) (int64, error)
 	FindPolicyRule(ctx context.Context, id int64) (PolicyRule, error)
 	FindPolicyRulesByPolicyID(ctx context.Context, bizID, policyID int64) ([]PolicyRule, error)
 	FindPolicyRulesByPolicyIDs(ctx context.Context, policyIDs []int64) (map[int64][]PolicyRule, error)
@@ -95,7 +142,20 @@ type PolicyDAO interface {
 	DeletePermissionPolicy(ctx context.Context, bizID int64, permissionID int64, policyID int64) error
 	FindPoliciesByPermission(ctx context.Context, bizID int64, permissionIDs []int64) ([]PermissionPolicy, error)
 	FindPermissionPolicy(ctx context.Context, bizID int64) (map[int64][]PermissionPolicy, error)
+	FindPermissionPolicyByPolicyID(ctx context.Context, bizID, policyID int64) ([]PermissionPolicy, error)
 	FindPermissionPolicyByBizIDs(ctx context.Context, bizIDs []int64) (map[int64]map[int64][]PermissionPolicy, error)
+
+	//policy version方法
+	// PublishPolicy 把草稿保存成新版本并切换生效版本，返回新版本号
+	PublishPolicy(ctx context.Context, bizID, policyID int64, comment string) (int64, error)
+	// RollbackPolicy 复制历史版本发布成新版本，草稿也恢复成该版本，返回新版本号
+	RollbackPolicy(ctx context.Context, bizID, policyID, version int64, comment string) (int64, error)
+	FindPolicyVersions(ctx context.Context, bizID, policyID int64) ([]PolicyVersion, error)
+	FindPolicyVersion(ctx context.Context, bizID, policyID, version int64) (PolicyVersion, error)
+	// FindPolicyVersionsAsOf 返回每个策略在 asOf（毫秒）时刻生效的版本，包括已经删除的策略，key 为策略ID
+	FindPolicyVersionsAsOf(ctx context.Context, bizID, asOf int64) (map[int64]PolicyVersion, error)
+	// FindPublishedPolicyVersions 返回业务下所有策略当前生效的版本，key 为策略ID
+	FindPublishedPolicyVersions(ctx context.Context, bizID int64) (map[int64]PolicyVersion, error)
 }
 type policyDao struct {
 	db *egorm.Component
@@ -109,7 +169,7 @@ func (p *policyDao) SavePolicy(ctx context.Context, policy Policy) (int64, error
 	policy.Utime = now
 	err := p.db.WithContext(ctx).
 		Clauses(clause.OnConflict{
-			DoUpdates: clause.AssignmentColumns([]string{"description", "utime"}),
+			DoUpdates: clause.AssignmentColumns([]string{"description", "priority", "missing_attr", "utime"}),
 		}).Create(&policy).Error
 	return policy.ID, err
 
@@ -127,7 +187,7 @@ func (p *policyDao) DeletePolicy(ctx context.Context, bizID, id int64) error {
 			return err
 		}
 
-		// 3. 删除策略本身
+		// 3. 删除策略本身，策略的版本保留下来用于按时间点校验
 		return tx.Where("id = ? AND biz_id = ?", id, bizID).Delete(&Policy{}).Error
 	})
 }
@@ -146,6 +206,9 @@ func (p *policyDao) FindPolicyById(ctx context.Context, bizId, id int64) (Policy
 	err := p.db.WithContext(ctx).
 		Where("id = ? AND biz_id = ?", id, bizId).
 		First(&policy).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return policy, fmt.Errorf("%w: %d", errs.ErrPolicyNotFound, id)
+	}
 	return policy, err
 }
 
@@ -203,7 +266,7 @@ func (p *policyDao) SavePolicyRule(ctx context.Context, rule PolicyRule) (int64,
 	err := p.db.WithContext(ctx).
 		Clauses(clause.OnConflict{
 			Columns:   []clause.Column{{Name: "id"}},
-			DoUpdates: clause.AssignmentColumns([]string{"value", "left", "right", "operator", "utime"}),
+			DoUpdates: clause.AssignmentColumns([]string{"value", "value_attr_def_id", "left", "right", "operator", "utime"}),
 		}).Create(&rule).Error
 	return rule.ID, err
 }
@@ -223,6 +286,42 @@ func (p *policyDao) DeletePolicyRuleCascade(ctx context.Context, bizID, id int64
 		return tx.Where("id = ? AND biz_id = ?", id, bizID).Delete(&PolicyRule{}).Error
 	})
 }
+func (p *policyDao) ReplacePolicyRules(ctx context.Context, bizID, policyID int64, root PolicyRuleNode) (int64, error) {
+	var rootID int64
+	now := time.Now().UnixMilli()
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("biz_id = ? AND policy_id = ?", bizID, policyID).Delete(&PolicyRule{}).Error; err != nil {
+			return err
+		}
+		var err error
+		rootID, err = p.createRuleTree(tx, bizID, policyID, root, now)
+		return err
+	})
+	return rootID, err
+}
+
+func (p *policyDao) createRuleTree(tx *gorm.DB, bizID, policyID int64, node PolicyRuleNode, now int64) (int64, error) {
+	rule := node.Rule
+	var err error
+	if node.Left != nil {
+		if rule.Left, err = p.createRuleTree(tx, bizID, policyID, *node.Left, now); err != nil {
+			return 0, err
+		}
+	}
+	if node.Right != nil {
+		if rule.Right, err = p.createRuleTree(tx, bizID, policyID, *node.Right, now); err != nil {
+			return 0, err
+		}
+	}
+	rule.ID = 0
+	rule.BizID = bizID
+	rule.PolicyID = policyID
+	rule.Ctime = now
+	rule.Utime = now
+	err = tx.Create(&rule).Error
+	return rule.ID, err
+}
+
 func (p *policyDao) deleteChildRules(tx *gorm.DB, bizID, parentRuleID int64) error {
 	// 查询当前规则的子规则
 	var childRules []PolicyRule
@@ -348,6 +447,14 @@ func (p *policyDao) FindPermissionPolicy(ctx context.Context, bizID int64) (map[
 	return result, err
 }
 
+func (p *policyDao) FindPermissionPolicyByPolicyID(ctx context.Context, bizID, policyID int64) ([]PermissionPolicy, error) {
+	var relations []PermissionPolicy
+	err := p.db.WithContext(ctx).
+		Where("biz_id = ? AND policy_id = ?", bizID, policyID).
+		Find(&relations).Error
+	return relations, err
+}
+
 func (p *policyDao) FindPermissionPolicyByBizIDs(ctx context.Context, bizIDs []int64) (map[int64]map[int64][]PermissionPolicy, error) {
 	var policies []PermissionPolicy
 	err := p.db.WithContext(ctx).
@@ -369,6 +476,226 @@ func (p *policyDao) FindPermissionPolicyByBizIDs(ctx context.Context, bizIDs []i
 	return result, nil
 }
 
+func (p *policyDao) PublishPolicy(ctx context.Context, bizID, policyID int64, comment string) (int64, error) {
+	var version int64
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		policy, err := p.lockPolicy(tx, bizID, policyID)
+		if err != nil {
+			return err
+		}
+		var rules []PolicyRule
+		if err = tx.Where("biz_id = ? AND policy_id = ?", bizID, policyID).Find(&rules).Error; err != nil {
+			return err
+		}
+		var permissions []PermissionPolicy
+		if err = tx.Where("biz_id = ? AND policy_id = ?", bizID, policyID).Find(&permissions).Error; err != nil {
+			return err
+		}
+		snapshot, err := json.Marshal(PolicySnapshot{
+			Description: policy.Description,
+			Status:      policy.Status,
+			ExecuteType: policy.ExecuteType,
+			Priority:    policy.Priority,
+			MissingAttr: policy.MissingAttr,
+			Rules:       rules,
+			Permissions: permissions,
+		})
+		if err != nil {
+			return err
+		}
+		version, err = p.createVersion(tx, policy, PolicyVersion{Snapshot: string(snapshot), Comment: comment})
+		return err
+	})
+	return version, err
+}
+
+func (p *policyDao) RollbackPolicy(ctx context.Context, bizID, policyID, version int64, comment string) (int64, error) {
+	var newVersion int64
+	now := time.Now().UnixMilli()
+	err := p.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		policy, err := p.lockPolicy(tx, bizID, policyID)
+		if err != nil {
+			return err
+		}
+		var target PolicyVersion
+		err = tx.Where("biz_id = ? AND policy_id = ? AND version = ?", bizID, policyID, version).First(&target).Error
+		if err != nil {
+			return err
+		}
+		var snapshot PolicySnapshot
+		if err = json.Unmarshal([]byte(target.Snapshot), &snapshot); err != nil {
+			return err
+		}
+		// 草稿恢复成目标版本，之后的修改在此基础上进行
+		err = tx.Model(&Policy{}).Where("id = ? AND biz_id = ?", policyID, bizID).Updates(map[string]any{
+			"description":  snapshot.Description,
+			"status":       snapshot.Status,
+			"execute_type": snapshot.ExecuteType,
+			"priority":     snapshot.Priority,
+			"missing_attr": snapshot.MissingAttr,
+			"utime":        now,
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err = tx.Where("biz_id = ? AND policy_id = ?", bizID, policyID).Delete(&PolicyRule{}).Error; err != nil {
+			return err
+		}
+		for _, root := range policyRuleNodes(snapshot.Rules) {
+			if _, err = p.createRuleTree(tx, bizID, policyID, root, now); err != nil {
+				return err
+			}
+		}
+		if err = tx.Where("biz_id = ? AND policy_id = ?", bizID, policyID).Delete(&PermissionPolicy{}).Error; err != nil {
+			return err
+		}
+		for _, permission := range snapshot.Permissions {
+			permission.ID = 0
+			permission.Ctime = now
+			permission.Utime = now
+			if err = tx.Create(&permission).Error; err != nil {
+				return err
+			}
+		}
+		newVersion, err = p.createVersion(tx, policy, PolicyVersion{
+			SourceVersion: version,
+			Snapshot:      target.Snapshot,
+			Comment:       comment,
+		})
+		return err
+	})
+	return newVersion, err
+}
+
+// lockPolicy 锁住策略，同一个策略的发布和回滚串行执行
+func (p *policyDao) lockPolicy(tx *gorm.DB, bizID, policyID int64) (Policy, error) {
+	var policy Policy
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND biz_id = ?", policyID, bizID).
+		First(&policy).Error
+	return policy, err
+}
+
+// createVersion 写入新版本并切换策略的生效版本，需要先锁住策略
+func (p *policyDao) createVersion(tx *gorm.DB, policy Policy, version PolicyVersion) (int64, error) {
+	var latest int64
+	err := tx.Model(&PolicyVersion{}).
+		Where("policy_id = ?", policy.ID).
+		Select("COALESCE(MAX(version), 0)").Scan(&latest).Error
+	if err != nil {
+		return 0, err
+	}
+	now := time.Now().UnixMilli()
+	version.BizID = policy.BizID
+	version.PolicyID = policy.ID
+	version.Version = latest + 1
+	version.Ctime = now
+	version.Utime = now
+	if err = tx.Create(&version).Error; err != nil {
+		return 0, err
+	}
+	err = tx.Model(&Policy{}).Where("id = ?", policy.ID).
+		Updates(map[string]any{"version": version.Version, "utime": now}).Error
+	return version.Version, err
+}
+
+// policyRuleNodes 把快照中的规则还原成规则树
+func policyRuleNodes(rules []PolicyRule) []PolicyRuleNode {
+	ruleMap := make(map[int64]PolicyRule, len(rules))
+	children := make(map[int64]struct{}, len(rules))
+	for _, rule := range rules {
+		ruleMap[rule.ID] = rule
+		children[rule.Left] = struct{}{}
+		children[rule.Right] = struct{}{}
+	}
+	var build func(id int64) *PolicyRuleNode
+	build = func(id int64) *PolicyRuleNode {
+		rule, ok := ruleMap[id]
+		if !ok {
+			return nil
+		}
+		node := &PolicyRuleNode{Rule: rule, Left: build(rule.Left), Right: build(rule.Right)}
+		// 子规则写入后才有新的ID，写入时再回填
+		node.Rule.Left, node.Rule.Right = 0, 0
+		return node
+	}
+	res := make([]PolicyRuleNode, 0, len(rules))
+	for _, rule := range rules {
+		if _, ok := children[rule.ID]; !ok {
+			res = append(res, *build(rule.ID))
+		}
+	}
+	return res
+}
+
+func (p *policyDao) FindPolicyVersions(ctx context.Context, bizID, policyID int64) ([]PolicyVersion, error) {
+	var versions []PolicyVersion
+	err := p.db.WithContext(ctx).
+		Where("biz_id = ? AND policy_id = ?", bizID, policyID).
+		Order("version DESC").
+		Find(&versions).Error
+	return versions, err
+}
+
+func (p *policyDao) FindPolicyVersion(ctx context.Context, bizID, policyID, version int64) (PolicyVersion, error) {
+	var res PolicyVersion
+	err := p.db.WithContext(ctx).
+		Where("biz_id = ? AND policy_id = ? AND version = ?", bizID, policyID, version).
+		First(&res).Error
+	return res, err
+}
+
+func (p *policyDao) FindPublishedPolicyVersions(ctx context.Context, bizID int64) (map[int64]PolicyVersion, error) {
+	var versions []PolicyVersion
+	err := p.db.WithContext(ctx).
+		Table("policy_versions AS pv").
+		Select("pv.*").
+		Joins("JOIN policies AS p ON p.id = pv.policy_id AND p.version = pv.version").
+		Where("p.biz_id = ?", bizID).
+		Find(&versions).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]PolicyVersion, len(versions))
+	for _, version := range versions {
+		result[version.PolicyID] = version
+	}
+	return result, nil
+}
+
+func (p *policyDao) FindPolicyVersionsAsOf(ctx context.Context, bizID, asOf int64) (map[int64]PolicyVersion, error) {
+	var versions []PolicyVersion
+	// 发布和回滚都会写入新版本并切换过去，所以 asOf 之前最新的版本就是当时生效的版本
+	err := p.db.WithContext(ctx).
+		Table("policy_versions AS pv").
+		Select("pv.*").
+		Joins("JOIN (SELECT policy_id, MAX(version) AS version FROM policy_versions WHERE biz_id = ? AND ctime <= ? GROUP BY policy_id) AS latest ON latest.policy_id = pv.policy_id AND latest.version = pv.version", bizID, asOf).
+		Find(&versions).Error
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]PolicyVersion, len(versions))
+	for _, version := range versions {
+		result[version.PolicyID] = version
+	}
+	return result, nil
+}
+
+// backfillPolicyVersions 把已有策略的草稿发布成第一个版本，升级前生效的策略升级后继续生效
+func backfillPolicyVersions(db *egorm.Component) error {
+	var policies []Policy
+	if err := db.Where("version = ?", 0).Find(&policies).Error; err != nil {
+		return err
+	}
+	dao := &policyDao{db: db}
+	for _, policy := range policies {
+		if _, err := dao.PublishPolicy(context.Background(), policy.BizID, policy.ID, "初始版本"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func NewPolicyDAO(db *egorm.Component) PolicyDAO {
 	return &policyDao{db: db}
 }
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"time"
 )
@@ -28,6 +29,10 @@ type ResourceAttributeValueDAO interface {
 	FindByBizIdAndResourceId(ctx context.Context, bizId, resourceId int64) ([]ResourceAttributeValue, error)
 	FindByBizIdAndAttrId(ctx context.Context, bizId, attrId int64) ([]ResourceAttributeValue, error)
 	FindByResourceId(ctx context.Context, resourceId []int64) (map[int64][]ResourceAttributeValue, error)
+	// BatchCreate 在一个事务中批量写入，已经存在的值会被覆盖
+	BatchCreate(ctx context.Context, values []ResourceAttributeValue) error
+	// FindByBizID 按 ID 升序分页，返回 ID 大于 afterId 的最多 limit 条
+	FindByBizID(ctx context.Context, bizId, afterId int64, limit int) ([]ResourceAttributeValue, error)
 }
 type resourceAttributeValueDao struct {
 	db *egorm.Component
@@ -40,15 +45,31 @@ func (r *resourceAttributeValueDao) Create(ctx context.Context, value ResourceAt
 	now := time.Now().UnixMilli()
 	value.Utime = now
 	value.Ctime = now
-	err := r.db.WithContext(ctx).Model(&ResourceAttributeValue{}).Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "biz_id"}, {Name: "resource_id"}, {Name: "attr_def_id"}},
-		DoUpdates: clause.AssignmentColumns([]string{"utime", "value"}),
-	}).Create(&value).Error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&ResourceAttributeValue{}).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "biz_id"}, {Name: "resource_id"}, {Name: "attr_def_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"utime", "value"}),
+		}).Create(&value).Error
+		if err != nil {
+			return err
+		}
+		return recordAttrValue(tx, value.BizID, AttrEntityResource, value.ResourceID, value.AttrDefID, value.Value, false, now)
+	})
 	return value.ID, err
 }
 
 func (r *resourceAttributeValueDao) DeleteByID(ctx context.Context, id int64) error {
-	return r.db.WithContext(ctx).Model(&ResourceAttributeValue{}).Where("id=?", id).Delete(&ResourceAttributeValue{}).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var value ResourceAttributeValue
+		res := tx.Where("id=?", id).Limit(1).Find(&value)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		if err := tx.Model(&ResourceAttributeValue{}).Where("id=?", id).Delete(&ResourceAttributeValue{}).Error; err != nil {
+			return err
+		}
+		return recordAttrValue(tx, value.BizID, AttrEntityResource, value.ResourceID, value.AttrDefID, "", true, time.Now().UnixMilli())
+	})
 }
 
 func (r *resourceAttributeValueDao) FindByBizIdAndResourceId(ctx context.Context, bizId, resourceId int64) ([]ResourceAttributeValue, error) {
@@ -75,3 +96,37 @@ func (r *resourceAttributeValueDao) FindByResourceId(ctx context.Context, resour
 	}
 	return result, nil
 }
+
+func (r *resourceAttributeValueDao) BatchCreate(ctx context.Context, values []ResourceAttributeValue) error {
+	if len(values) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	for idx := range values {
+		values[idx].Ctime = now
+		values[idx].Utime = now
+	}
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&ResourceAttributeValue{}).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "biz_id"}, {Name: "resource_id"}, {Name: "attr_def_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"utime", "value"}),
+		}).Create(&values).Error
+		if err != nil {
+			return err
+		}
+		for _, value := range values {
+			err = recordAttrValue(tx, value.BizID, AttrEntityResource, value.ResourceID, value.AttrDefID, value.Value, false, now)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (r *resourceAttributeValueDao) FindByBizID(ctx context.Context, bizId, afterId int64, limit int) ([]ResourceAttributeValue, error) {
+	var values []ResourceAttributeValue
+	err := r.db.WithContext(ctx).Model(&ResourceAttributeValue{}).Where("biz_id=? AND id>?", bizId, afterId).
+		Order("id").Limit(limit).Find(&values).Error
+	return values, err
+}
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 	"time"
 )
@@ -31,6 +32,10 @@ type SubjectAttributeValueDAO interface {
 	FindByID(ctx context.Context, id int64) (SubjectAttributeValue, error)
 	DeleteByID(ctx context.Context, id int64) error
 	FindByBizIdAndSubjectID(ctx context.Context, bizId, subjectId int64) ([]SubjectAttributeValue, error)
+	// BatchCreate 在一个事务中批量写入，已经存在的值会被覆盖
+	BatchCreate(ctx context.Context, values []SubjectAttributeValue) error
+	// FindByBizID 按 ID 升序分页，返回 ID 大于 afterId 的最多 limit 条
+	FindByBizID(ctx context.Context, bizId, afterId int64, limit int) ([]SubjectAttributeValue, error)
 }
 
 type subjectAttributeValueDao struct {
@@ -44,10 +49,16 @@ func (s *subjectAttributeValueDao) Create(ctx context.Context, value SubjectAttr
 	now := time.Now().UnixMilli()
 	value.Ctime = now
 	value.Utime = now
-	err := s.db.WithContext(ctx).Model(&SubjectAttributeValue{}).Clauses(clause.OnConflict{
-		Columns:   []clause.Column{{Name: "biz_id"}, {Name: "subject_id"}, {Name: "attr_def_id"}},
-		DoUpdates: clause.AssignmentColumns([]string{"value", "utime"}),
-	}).Create(&value).Error
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&SubjectAttributeValue{}).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "biz_id"}, {Name: "subject_id"}, {Name: "attr_def_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "utime"}),
+		}).Create(&value).Error
+		if err != nil {
+			return err
+		}
+		return recordAttrValue(tx, value.BizID, AttrEntitySubject, value.SubjectID, value.AttrDefID, value.Value, false, now)
+	})
 	return value.ID, err
 }
 
@@ -58,7 +69,17 @@ func (s *subjectAttributeValueDao) FindByID(ctx context.Context, id int64) (Subj
 }
 
 func (s *subjectAttributeValueDao) DeleteByID(ctx context.Context, id int64) error {
-	return s.db.WithContext(ctx).Model(&SubjectAttributeValue{}).Where("id = ?", id).Delete(&SubjectAttributeValue{}).Error
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var value SubjectAttributeValue
+		res := tx.Where("id = ?", id).Limit(1).Find(&value)
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		if err := tx.Model(&SubjectAttributeValue{}).Where("id = ?", id).Delete(&SubjectAttributeValue{}).Error; err != nil {
+			return err
+		}
+		return recordAttrValue(tx, value.BizID, AttrEntitySubject, value.SubjectID, value.AttrDefID, "", true, time.Now().UnixMilli())
+	})
 }
 
 func (s *subjectAttributeValueDao) FindByBizIdAndSubjectID(ctx context.Context, bizId, subjectId int64) ([]SubjectAttributeValue, error) {
@@ -66,3 +87,37 @@ func (s *subjectAttributeValueDao) FindByBizIdAndSubjectID(ctx context.Context,
 	err := s.db.WithContext(ctx).Model(&SubjectAttributeValue{}).Where("biz_id=? AND subject_id=?", bizId, subjectId).Find(&subjectAttributeValues).Error
 	return subjectAttributeValues, err
 }
+
+func (s *subjectAttributeValueDao) BatchCreate(ctx context.Context, values []SubjectAttributeValue) error {
+	if len(values) == 0 {
+		return nil
+	}
+	now := time.Now().UnixMilli()
+	for idx := range values {
+		values[idx].Ctime = now
+		values[idx].Utime = now
+	}
+	return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&SubjectAttributeValue{}).Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "biz_id"}, {Name: "subject_id"}, {Name: "attr_def_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"value", "utime"}),
+		}).Create(&values).Error
+		if err != nil {
+			return err
+		}
+		for _, value := range values {
+			err = recordAttrValue(tx, value.BizID, AttrEntitySubject, value.SubjectID, value.AttrDefID, value.Value, false, now)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *subjectAttributeValueDao) FindByBizID(ctx context.Context, bizId, afterId int64, limit int) ([]SubjectAttributeValue, error) {
+	var subjectAttributeValues []SubjectAttributeValue
+	err := s.db.WithContext(ctx).Model(&SubjectAttributeValue{}).Where("biz_id=? AND id>?", bizId, afterId).
+		Order("id").Limit(limit).Find(&subjectAttributeValues).Error
+	return subjectAttributeValues, err
+}
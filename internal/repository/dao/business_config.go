@@ -7,14 +7,17 @@ import (
 )
 
 type BusinessConfig struct {
-	ID        int64  `gorm:"primaryKey;autoIncrement;comment:'业务ID'"`
-	OwnerID   int64  `gorm:"type:BIGINT;comment:'业务方ID'"`
-	OwnerType string `gorm:"type:ENUM('person', 'organization');comment:'业务方类型：person-个人,organization-组织'"`
-	Name      string `gorm:"type:VARCHAR(255);NOT NULL;comment:'业务名称'"`
-	RateLimit int    `gorm:"type:INT;DEFAULT:1000;comment:'每秒最大请求数'"`
-	Token     string `gorm:"type:TEXT;NOT NULL;comment:'业务方Token，内部包含bizID'"`
-	Ctime     int64
-	Utime     int64
+	ID                    int64  `gorm:"primaryKey;autoIncrement;comment:'业务ID'"`
+	OwnerID               int64  `gorm:"type:BIGINT;comment:'业务方ID'"`
+	OwnerType             string `gorm:"type:ENUM('person', 'organization');comment:'业务方类型：person-个人,organization-组织'"`
+	Name                  string `gorm:"type:VARCHAR(255);NOT NULL;comment:'业务名称'"`
+	RateLimit             int    `gorm:"type:INT;DEFAULT:1000;comment:'每秒最大请求数'"`
+	Token                 string `gorm:"type:TEXT;NOT NULL;comment:'业务方Token，内部包含bizID'"`
+	CheckMode             string `gorm:"type:VARCHAR(32);NOT NULL;DEFAULT:'rbac';comment:'权限校验模式：rbac,abac,rbac_and_abac,rbac_or_abac'"`
+	CombiningAlgorithm    string `gorm:"type:VARCHAR(32);NOT NULL;DEFAULT:'deny_overrides';comment:'ABAC 策略合并算法'"`
+	NotApplicableDecision string `gorm:"type:VARCHAR(16);NOT NULL;DEFAULT:'deny';comment:'ABAC 没有适用策略时的结果：allow,deny'"`
+	Ctime                 int64
+	Utime                 int64
 }
 
 func (BusinessConfig) TableName() string {
@@ -82,11 +85,14 @@ func (b *businessConfigDao) UpdateToken(ctx context.Context, id int64, token str
 
 func (b *businessConfigDao) Update(ctx context.Context, config BusinessConfig) error {
 	return b.db.WithContext(ctx).Model(&BusinessConfig{}).Where("id=?", config.ID).Updates(map[string]any{
-		"owner_id":   config.OwnerID,
-		"owner_type": config.OwnerType,
-		"name":       config.Name,
-		"rate_limit": config.RateLimit,
-		"utime":      config.Utime,
+		"owner_id":                config.OwnerID,
+		"owner_type":              config.OwnerType,
+		"name":                    config.Name,
+		"rate_limit":              config.RateLimit,
+		"check_mode":              config.CheckMode,
+		"combining_algorithm":     config.CombiningAlgorithm,
+		"not_applicable_decision": config.NotApplicableDecision,
+		"utime":                   config.Utime,
 	}).Error
 }
 
@@ -8,7 +8,14 @@ import (
 )
 
 func InitTable(db *egorm.Component) error {
-	return db.AutoMigrate(
+	// 策略版本表第一次创建时，已有的策略需要发布成第一个版本
+	backfill := !db.Migrator().HasTable(&PolicyVersion{})
+	// 历史表第一次创建时，已有的授权以及属性值需要补上历史
+	backfillGrants := !db.Migrator().HasTable(&GrantHistory{})
+	backfillAttrValues := !db.Migrator().HasTable(&AttributeValueHistory{})
+	// 权限加上 key_pattern 列时，已有的带通配符的权限需要补上标记
+	backfillKeyPattern := !db.Migrator().HasColumn(&Permission{}, "key_pattern")
+	err := db.AutoMigrate(
 		&Role{},
 		&Resource{},
 		&Permission{},
@@ -25,10 +32,45 @@ func InitTable(db *egorm.Component) error {
 		&Policy{},
 		&PolicyRule{},
 		&PermissionPolicy{},
+		&PolicyVersion{},
+
+		&GrantHistory{},
+		&AttributeValueHistory{},
 
 		&audit.OperationLog{},
 		&audit.UserRoleLog{},
 	)
+	if err != nil {
+		return err
+	}
+	// 属性定义的唯一索引加上了资源类型，不同资源类型可以定义同名的属性，旧的索引需要删掉
+	if db.Migrator().HasIndex(&AttributeDefinition{}, "idx_biz_id_name") {
+		if err = db.Migrator().DropIndex(&AttributeDefinition{}, "idx_biz_id_name"); err != nil {
+			return err
+		}
+	}
+	if backfillKeyPattern {
+		err = db.Model(&Permission{}).
+			Where("resource_key LIKE ? OR resource_key LIKE ? OR resource_key LIKE ?", "%*%", "%?%", "%[%").
+			Update("key_pattern", true).Error
+		if err != nil {
+			return err
+		}
+	}
+	if backfill {
+		if err = backfillPolicyVersions(db); err != nil {
+			return err
+		}
+	}
+	if backfillGrants {
+		if err = backfillGrantHistories(db); err != nil {
+			return err
+		}
+	}
+	if backfillAttrValues {
+		return backfillAttrValueHistories(db)
+	}
+	return nil
 }
 
 func isUniqueConstraintError(err error) bool {
@@ -17,17 +17,19 @@ import (
 - idx_resource_id : 单字段索引，加速按资源 ID 查询
 */
 type Permission struct {
-	ID           int64  `gorm:"primaryKey;autoIncrement;comment:'权限ID'"`
-	BizID        int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_resource_action,priority:1;index:idx_biz_action,priority:1;index:idx_biz_resource_type,priority:1;index:idx_biz_resource_key,priority:1;comment:'业务ID'"`
-	Name         string `gorm:"type:VARCHAR(255);NOT NULL;comment:'权限名称'"`
-	Description  string `gorm:"type:TEXT;comment:'权限描述'"`
-	ResourceID   int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_resource_action,priority:2;index:idx_resource_id;comment:'关联的资源ID，创建后不可修改'"`
-	ResourceType string `gorm:"type:VARCHAR(255);NOT NULL;index:idx_biz_resource_type,priority:2;comment:'资源类型，冗余字段，加速查询'"`
-	ResourceKey  string `gorm:"type:VARCHAR(255);NOT NULL;index:idx_biz_resource_key,priority:2;comment:'资源业务标识符 (如 用户ID, 文档路径)，冗余字段，加速查询'"`
-	Action       string `gorm:"type:VARCHAR(255);NOT NULL;NOT NULL;uniqueIndex:uk_biz_resource_action,priority:3;index:idx_biz_action,priority:2;comment:'操作类型'"`
-	Metadata     string `gorm:"type:TEXT;comment:'权限元数据，可扩展字段'"`
-	Ctime        int64
-	Utime        int64
+	ID                 int64  `gorm:"primaryKey;autoIncrement;comment:'权限ID'"`
+	BizID              int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_resource_action,priority:1;index:idx_biz_action,priority:1;index:idx_biz_resource_type,priority:1;index:idx_biz_resource_key,priority:1;index:idx_biz_type_pattern,priority:1;comment:'业务ID'"`
+	Name               string `gorm:"type:VARCHAR(255);NOT NULL;comment:'权限名称'"`
+	Description        string `gorm:"type:TEXT;comment:'权限描述'"`
+	ResourceID         int64  `gorm:"type:BIGINT;NOT NULL;uniqueIndex:uk_biz_resource_action,priority:2;index:idx_resource_id;comment:'关联的资源ID，创建后不可修改'"`
+	ResourceType       string `gorm:"type:VARCHAR(255);NOT NULL;index:idx_biz_resource_type,priority:2;index:idx_biz_type_pattern,priority:2;comment:'资源类型，冗余字段，加速查询'"`
+	ResourceKey        string `gorm:"type:VARCHAR(255);NOT NULL;index:idx_biz_resource_key,priority:2;comment:'资源业务标识符 (如 用户ID, 文档路径)，冗余字段，加速查询'"`
+	KeyPattern         bool   `gorm:"NOT NULL;DEFAULT:false;index:idx_biz_type_pattern,priority:3;comment:'资源标识符是否带通配符，校验时带通配符的权限需要和资源标识符逐个匹配'"`
+	Action             string `gorm:"type:VARCHAR(255);NOT NULL;NOT NULL;uniqueIndex:uk_biz_resource_action,priority:3;index:idx_biz_action,priority:2;comment:'操作类型'"`
+	Metadata           string `gorm:"type:TEXT;comment:'权限元数据，可扩展字段'"`
+	CombiningAlgorithm string `gorm:"type:VARCHAR(32);NOT NULL;DEFAULT:'';comment:'ABAC 策略合并算法，为空时使用业务配置'"`
+	Ctime              int64
+	Utime              int64
 }
 
 func (Permission) TableName() string {
@@ -40,6 +42,7 @@ type PermissionDAO interface {
 	FindByBizIDAndID(ctx context.Context, bizId, id int64) (Permission, error)
 	UpdateByBizIDAndID(ctx context.Context, permission Permission) error
 	DeleteByBizIDAndID(ctx context.Context, bizId, id int64) error
+	// FindPermissions 返回 key 完全相同以及 key 带通配符的权限
 	FindPermissions(ctx context.Context, bizId int64, resourceType, resourceKey string, action []string) ([]Permission, error)
 }
 type permissionDao struct {
@@ -82,11 +85,12 @@ func (p *permissionDao) UpdateByBizIDAndID(ctx context.Context, permission Permi
 	permission.Utime = now
 	return p.db.Where(ctx).Model(&Permission{}).Where("biz_id=? AND id=?", permission.BizID, permission.ID).
 		Updates(map[string]interface{}{
-			"description": permission.Description,
-			"metadata":    permission.Metadata,
-			"name":        permission.Name,
-			"utime":       permission.Utime,
-			"action":      permission.Action,
+			"description":         permission.Description,
+			"metadata":            permission.Metadata,
+			"name":                permission.Name,
+			"utime":               permission.Utime,
+			"action":              permission.Action,
+			"combining_algorithm": permission.CombiningAlgorithm,
 		}).Error
 
 }
@@ -99,7 +103,9 @@ func (p *permissionDao) FindPermissions(ctx context.Context, bizId int64, resour
 	permissions := make([]Permission, 0)
 	err := p.db.WithContext(ctx).
 		Model(&Permission{}).
-		Where("biz_id=? AND resource_type=? AND resource_key=? AND action IN ?", bizId, resourceType, resourceKey, action).
+		Where("biz_id=? AND resource_type=? AND action IN ?", bizId, resourceType, action).
+		// 带通配符的权限只限定在同一个业务、资源类型和操作内，由上层再逐个匹配
+		Where("resource_key=? OR key_pattern=?", resourceKey, true).
 		Find(&permissions).
 		Error
 	return permissions, err
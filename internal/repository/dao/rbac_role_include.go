@@ -3,6 +3,8 @@ package dao
 import (
 	"context"
 	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 	"time"
 )
 
@@ -30,6 +32,9 @@ func (RoleInclusion) TableName() string {
 
 type RoleInclusionDAO interface {
 	Create(ctx context.Context, inclusion RoleInclusion) (RoleInclusion, error)
+	// CreateWithCheck 锁住业务下的角色后在同一个事务中执行 check 并写入，同一个业务下新增包含关系串行执行。
+	// check 需要通过传入的 tx 查询，才能读到加锁之后的数据
+	CreateWithCheck(ctx context.Context, inclusion RoleInclusion, check func(ctx context.Context, tx RoleInclusionDAO) error) (RoleInclusion, error)
 	FindByBizID(ctx context.Context, bizId int64, offset, limit int) ([]RoleInclusion, error)
 	FindByBizIdAndIncludingIds(ctx context.Context, bizId int64, IncludingIds []int64) ([]RoleInclusion, error)
 	FindByBizIdAndIncludedIds(ctx context.Context, bizId int64, IncludedIds []int64) ([]RoleInclusion, error)
@@ -51,10 +56,34 @@ func (r *roleInclusionDao) Create(ctx context.Context, inclusion RoleInclusion)
 	now := time.Now().Unix()
 	inclusion.Utime = now
 	inclusion.Ctime = now
-	err := r.db.WithContext(ctx).Model(&RoleInclusion{}).Create(&inclusion).Error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&RoleInclusion{}).Create(&inclusion).Error; err != nil {
+			return err
+		}
+		return openGrantHistory(tx, GrantTypeRoleInclusion, inclusion.BizID, inclusion.IncludingRoleID, inclusion.ID, inclusion, time.Now().UnixMilli())
+	})
 	return inclusion, err
 }
 
+func (r *roleInclusionDao) CreateWithCheck(ctx context.Context, inclusion RoleInclusion, check func(ctx context.Context, tx RoleInclusionDAO) error) (RoleInclusion, error) {
+	var created RoleInclusion
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var roleIDs []int64
+		err := tx.Model(&Role{}).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("biz_id = ?", inclusion.BizID).Pluck("id", &roleIDs).Error
+		if err != nil {
+			return err
+		}
+		txDao := &roleInclusionDao{db: tx}
+		if err = check(ctx, txDao); err != nil {
+			return err
+		}
+		created, err = txDao.Create(ctx, inclusion)
+		return err
+	})
+	return created, err
+}
+
 func (r *roleInclusionDao) FindByBizID(ctx context.Context, bizId int64, offset, limit int) ([]RoleInclusion, error) {
 	ri := make([]RoleInclusion, 0)
 	err := r.db.WithContext(ctx).Model(&RoleInclusion{}).Where("biz_id=?", bizId).Offset(offset).Limit(limit).Find(&ri).Error
@@ -74,7 +103,13 @@ func (r *roleInclusionDao) FindByBizIdAndIncludedIds(ctx context.Context, bizId
 }
 
 func (r *roleInclusionDao) DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error {
-	return r.db.WithContext(ctx).Model(&RoleInclusion{}).Where("biz_id=? AND id=?", bizID, id).Delete(&RoleInclusion{}).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&RoleInclusion{}).Where("biz_id=? AND id=?", bizID, id).Delete(&RoleInclusion{})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		return closeGrantHistory(tx, GrantTypeRoleInclusion, []int64{id}, time.Now().UnixMilli())
+	})
 }
 
 func NewRoleInclusionDAO(db *egorm.Component) RoleInclusionDAO {
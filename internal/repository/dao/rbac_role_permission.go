@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/ego-component/egorm"
 	"github.com/permission-dev/internal/errs"
+	"gorm.io/gorm"
 	"time"
 )
 
@@ -42,6 +43,7 @@ type RolePermissionDAO interface {
 	FindByBizIdAndID(ctx context.Context, bizId, id int64) (RolePermission, error)
 	FindByBizIdAndResourceType(ctx context.Context, bizId, resourceType string, offset, limit int) ([]RolePermission, error)
 	FindByBizIDAndRoleIds(ctx context.Context, bizId int64, roleIds []int64) ([]RolePermission, error)
+	FindByBizIDAndPermissionIds(ctx context.Context, bizId int64, permissionIds []int64) ([]RolePermission, error)
 	DeleteByBizIDAndID(ctx context.Context, bizId, id int64) error
 }
 type rolePermissionDAO struct {
@@ -58,7 +60,12 @@ func (r *rolePermissionDAO) Create(ctx context.Context, rp RolePermission) (Role
 	now := time.Now().Unix()
 	rp.Utime = now
 	rp.Ctime = now
-	err := r.db.WithContext(ctx).Model(&RolePermission{}).Create(&rp).Error
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&RolePermission{}).Create(&rp).Error; err != nil {
+			return err
+		}
+		return openGrantHistory(tx, GrantTypeRolePermission, rp.BizID, rp.RoleID, rp.ID, rp, time.Now().UnixMilli())
+	})
 	if err != nil {
 		if isUniqueConstraintError(err) {
 			return RolePermission{}, errs.ErrRolePermissionDuplicate
@@ -86,8 +93,20 @@ func (r *rolePermissionDAO) FindByBizIDAndRoleIds(ctx context.Context, bizId int
 	return rolePermissions, err
 }
 
+func (r *rolePermissionDAO) FindByBizIDAndPermissionIds(ctx context.Context, bizId int64, permissionIds []int64) ([]RolePermission, error) {
+	rolePermissions := make([]RolePermission, 0)
+	err := r.db.WithContext(ctx).Model(&RolePermission{}).Where("biz_id=? AND permission_id in (?)", bizId, permissionIds).Find(&rolePermissions).Error
+	return rolePermissions, err
+}
+
 func (r *rolePermissionDAO) DeleteByBizIDAndID(ctx context.Context, bizId, id int64) error {
-	return r.db.WithContext(ctx).Model(&RolePermission{}).Where("biz_id=? AND id=?", bizId, id).Delete(&RolePermission{}).Error
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&RolePermission{}).Where("biz_id=? AND id=?", bizId, id).Delete(&RolePermission{})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		return closeGrantHistory(tx, GrantTypeRolePermission, []int64{id}, time.Now().UnixMilli())
+	})
 }
 
 func NewRolePermissionDAO(db *egorm.Component) RolePermissionDAO {
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
 	"time"
 )
 
@@ -41,6 +42,12 @@ type UserPermissionDAO interface {
 	Create(ctx context.Context, up UserPermission) (UserPermission, error)
 	FindByBizID(ctx context.Context, bizId int64, offset, limit int) ([]UserPermission, error)
 	FindByBizIdAndUserId(ctx context.Context, bizId, userId int64) ([]UserPermission, error)
+	// FindByBizIdAndPermissionIds 最多返回 limit 条
+	FindByBizIdAndPermissionIds(ctx context.Context, bizId int64, permissionIds []int64, limit int) ([]UserPermission, error)
+	// FindUnexpiredByBizIdAndUserId 查找未过期的用户权限，包括尚未生效的
+	FindUnexpiredByBizIdAndUserId(ctx context.Context, bizId, userId, now int64) ([]UserPermission, error)
+	// FindByValidityBoundary 查找在 (from, to] 内生效或者在 [from, to) 内失效的用户权限
+	FindByValidityBoundary(ctx context.Context, from, to int64) ([]UserPermission, error)
 	FindByBizIdAndID(ctx context.Context, bizId, id int64) (UserPermission, error)
 	DeleteBizIdAndId(ctx context.Context, bizId, id int64) error
 	DeleteBizIdAndUserIdAndPermissionId(ctx context.Context, bizId, userId, permissionId int64) error
@@ -50,14 +57,29 @@ type userPermissionDao struct {
 }
 
 func (u *userPermissionDao) DeleteBizIdAndUserIdAndPermissionId(ctx context.Context, bizId, userId, permissionId int64) error {
-	return u.db.WithContext(ctx).Model(&UserPermission{}).Where("biz_id=? AND user_id=? AND permission_id = ?", bizId, userId, permissionId).Delete(&userPermissionDao{}).Error
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var ids []int64
+		err := tx.Model(&UserPermission{}).Where("biz_id=? AND user_id=? AND permission_id = ?", bizId, userId, permissionId).Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return err
+		}
+		if err = tx.Model(&UserPermission{}).Where("id IN ?", ids).Delete(&UserPermission{}).Error; err != nil {
+			return err
+		}
+		return closeGrantHistory(tx, GrantTypeUserPermission, ids, time.Now().UnixMilli())
+	})
 }
 
 func (u *userPermissionDao) Create(ctx context.Context, up UserPermission) (UserPermission, error) {
 	now := time.Now().Unix()
 	up.Ctime = now
 	up.Utime = now
-	err := u.db.WithContext(ctx).Model(&UserPermission{}).Create(&up).Error
+	err := u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&UserPermission{}).Create(&up).Error; err != nil {
+			return err
+		}
+		return openGrantHistory(tx, GrantTypeUserPermission, up.BizID, up.UserID, up.ID, up, time.Now().UnixMilli())
+	})
 	return up, err
 }
 
@@ -74,6 +96,26 @@ func (u *userPermissionDao) FindByBizIdAndUserId(ctx context.Context, bizId, use
 	return ups, err
 }
 
+func (u *userPermissionDao) FindByBizIdAndPermissionIds(ctx context.Context, bizId int64, permissionIds []int64, limit int) ([]UserPermission, error) {
+	ups := make([]UserPermission, 0)
+	err := u.db.WithContext(ctx).Model(&UserPermission{}).Where("biz_id=? AND permission_id IN ?", bizId, permissionIds).Limit(limit).Find(&ups).Error
+	return ups, err
+}
+
+func (u *userPermissionDao) FindUnexpiredByBizIdAndUserId(ctx context.Context, bizId, userId, now int64) ([]UserPermission, error) {
+	ups := make([]UserPermission, 0)
+	err := u.db.WithContext(ctx).Model(&UserPermission{}).Where("biz_id=? AND user_id=? AND end_time>=?", bizId, userId, now).Find(&ups).Error
+	return ups, err
+}
+
+func (u *userPermissionDao) FindByValidityBoundary(ctx context.Context, from, to int64) ([]UserPermission, error) {
+	ups := make([]UserPermission, 0)
+	err := u.db.WithContext(ctx).Model(&UserPermission{}).
+		Where("(start_time>? AND start_time<=?) OR (end_time>=? AND end_time<?)", from, to, from, to).
+		Find(&ups).Error
+	return ups, err
+}
+
 func (u *userPermissionDao) FindByBizIdAndID(ctx context.Context, bizId, id int64) (UserPermission, error) {
 	up := UserPermission{}
 	err := u.db.WithContext(ctx).Model(&UserPermission{}).Where("biz_id=? AND id=?", bizId, id).First(&up).Error
@@ -81,7 +123,13 @@ func (u *userPermissionDao) FindByBizIdAndID(ctx context.Context, bizId, id int6
 }
 
 func (u *userPermissionDao) DeleteBizIdAndId(ctx context.Context, bizId, id int64) error {
-	return u.db.WithContext(ctx).Model(&UserPermission{}).Where("biz_id=? AND id=?", bizId, id).Delete(&userPermissionDao{}).Error
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&UserPermission{}).Where("biz_id=? AND id=?", bizId, id).Delete(&UserPermission{})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		return closeGrantHistory(tx, GrantTypeUserPermission, []int64{id}, time.Now().UnixMilli())
+	})
 }
 
 func NewUserPermissionDAO(db *egorm.Component) UserPermissionDAO {
@@ -3,6 +3,7 @@ package dao
 import (
 	"context"
 	"github.com/ego-component/egorm"
+	"gorm.io/gorm"
 	"time"
 )
 
@@ -36,6 +37,10 @@ type UserRoleDAO interface {
 	FindByBizIDAndID(ctx context.Context, bizId, id int64) (UserRole, error)
 	FindByBizIDAndUserID(ctx context.Context, bizId, userId int64) ([]UserRole, error)
 	FindByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIds []int64) ([]UserRole, error)
+	// FindByValidityBoundary 查找在 (from, to] 内生效或者在 [from, to) 内失效的用户角色
+	FindByValidityBoundary(ctx context.Context, from, to int64) ([]UserRole, error)
+	// FindByBizIDAndRoleIDsWithLimit 同 FindByBizIDAndRoleIDs，最多返回 limit 条
+	FindByBizIDAndRoleIDsWithLimit(ctx context.Context, bizID int64, roleIds []int64, limit int) ([]UserRole, error)
 	DeleteByBizIDAndID(ctx context.Context, bizId, id int64) error
 }
 
@@ -47,7 +52,12 @@ func (u *userRoleDao) Create(ctx context.Context, role UserRole) (UserRole, erro
 	now := time.Now().Unix()
 	role.Utime = now
 	role.Ctime = now
-	err := u.db.WithContext(ctx).Model(&UserRole{}).Create(&role).Error
+	err := u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&UserRole{}).Create(&role).Error; err != nil {
+			return err
+		}
+		return openGrantHistory(tx, GrantTypeUserRole, role.BizID, role.UserID, role.ID, role, time.Now().UnixMilli())
+	})
 	return role, err
 }
 
@@ -71,13 +81,38 @@ func (u *userRoleDao) FindByBizIDAndUserID(ctx context.Context, bizId, userId in
 
 func (u *userRoleDao) FindByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIds []int64) ([]UserRole, error) {
 	userRoles := make([]UserRole, 0)
-	now := time.Now().Unix()
-	err := u.db.WithContext(ctx).Model(&UserRole{}).Where("biz_id=? AND role_id in (?) AND start_time<=? AND end_time>=?", bizID, roleIds, now, now).Find(&userRoles).Error
+	err := u.validByRoleIDs(ctx, bizID, roleIds).Find(&userRoles).Error
+	return userRoles, err
+}
+
+func (u *userRoleDao) FindByValidityBoundary(ctx context.Context, from, to int64) ([]UserRole, error) {
+	userRoles := make([]UserRole, 0)
+	err := u.db.WithContext(ctx).Model(&UserRole{}).
+		Where("(start_time>? AND start_time<=?) OR (end_time>=? AND end_time<?)", from, to, from, to).
+		Find(&userRoles).Error
+	return userRoles, err
+}
+
+func (u *userRoleDao) FindByBizIDAndRoleIDsWithLimit(ctx context.Context, bizID int64, roleIds []int64, limit int) ([]UserRole, error) {
+	userRoles := make([]UserRole, 0)
+	err := u.validByRoleIDs(ctx, bizID, roleIds).Limit(limit).Find(&userRoles).Error
 	return userRoles, err
 }
 
+// validByRoleIDs 当前有效的拥有这些角色的用户角色
+func (u *userRoleDao) validByRoleIDs(ctx context.Context, bizID int64, roleIds []int64) *gorm.DB {
+	now := time.Now().Unix()
+	return u.db.WithContext(ctx).Model(&UserRole{}).Where("biz_id=? AND role_id in (?) AND start_time<=? AND end_time>=?", bizID, roleIds, now, now)
+}
+
 func (u *userRoleDao) DeleteByBizIDAndID(ctx context.Context, bizId, id int64) error {
-	return u.db.WithContext(ctx).Model(&UserRole{}).Where("biz_id=? AND id=?", bizId, id).Delete(&UserRole{}).Error
+	return u.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		res := tx.Model(&UserRole{}).Where("biz_id=? AND id=?", bizId, id).Delete(&UserRole{})
+		if res.Error != nil || res.RowsAffected == 0 {
+			return res.Error
+		}
+		return closeGrantHistory(tx, GrantTypeUserRole, []int64{id}, time.Now().UnixMilli())
+	})
 }
 
 func NewUserDaoDAO(db *egorm.Component) UserRoleDAO {
@@ -9,6 +9,7 @@ import (
 
 type PermissionRepository interface {
 	Create(ctx context.Context, permission domain.Permission) (domain.Permission, error)
+	// FindPermissions 返回覆盖该资源的权限，包括 key 带通配符的权限
 	FindPermissions(ctx context.Context, bizId int64, resourceType, resourceKey string, action []string) ([]domain.Permission, error)
 	FindByBizID(ctx context.Context, bizId int64, offset, limit int) ([]domain.Permission, error)
 	FindByBizIDANdID(ctx context.Context, bizId, id int64) (domain.Permission, error)
@@ -45,8 +46,8 @@ func (p *permissionRepository) FindPermissions(ctx context.Context, bizId int64,
 	if err != nil {
 		return nil, err
 	}
-	list := slice.Map(permissions, func(idx int, src dao.Permission) domain.Permission {
-		return p.toDomain(src)
+	list := slice.FilterMap(permissions, func(idx int, src dao.Permission) (domain.Permission, bool) {
+		return p.toDomain(src), domain.MatchResourceKey(src.ResourceKey, resourceKey)
 	})
 	return list, nil
 }
@@ -76,17 +77,19 @@ func (p *permissionRepository) DeleteByBizIDAndID(ctx context.Context, bizId, id
 }
 func (r *permissionRepository) toEntity(p domain.Permission) dao.Permission {
 	return dao.Permission{
-		ID:           p.ID,
-		BizID:        p.BizID,
-		Name:         p.Name,
-		Description:  p.Description,
-		ResourceID:   p.Resource.ID,
-		ResourceType: p.Resource.Type,
-		ResourceKey:  p.Resource.Key,
-		Action:       p.Action,
-		Metadata:     p.Metadata,
-		Ctime:        p.Ctime,
-		Utime:        p.Utime,
+		ID:                 p.ID,
+		BizID:              p.BizID,
+		Name:               p.Name,
+		Description:        p.Description,
+		ResourceID:         p.Resource.ID,
+		ResourceType:       p.Resource.Type,
+		ResourceKey:        p.Resource.Key,
+		KeyPattern:         domain.IsResourceKeyPattern(p.Resource.Key),
+		Action:             p.Action,
+		Metadata:           p.Metadata,
+		CombiningAlgorithm: p.CombiningAlgorithm.String(),
+		Ctime:              p.Ctime,
+		Utime:              p.Utime,
 	}
 }
 
@@ -101,9 +104,10 @@ func (r *permissionRepository) toDomain(p dao.Permission) domain.Permission {
 			Type: p.ResourceType,
 			Key:  p.ResourceKey,
 		},
-		Action:   p.Action,
-		Metadata: p.Metadata,
-		Ctime:    p.Ctime,
-		Utime:    p.Utime,
+		Action:             p.Action,
+		Metadata:           p.Metadata,
+		CombiningAlgorithm: domain.CombiningAlgorithm(p.CombiningAlgorithm),
+		Ctime:              p.Ctime,
+		Utime:              p.Utime,
 	}
 }
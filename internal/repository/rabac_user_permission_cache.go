@@ -28,6 +28,15 @@ func (u *UserPermissionCachedRepository) FindByBizIDAndID(ctx context.Context, b
 	return domain.UserPermission{}, nil
 }
 
+// GetALLUserPermissionAsOf 历史授权不缓存
+func (u *UserPermissionCachedRepository) GetALLUserPermissionAsOf(ctx context.Context, bizId, userId, asOf int64) ([]domain.UserPermission, error) {
+	return u.repo.GetALLUserPermissionAsOf(ctx, bizId, userId, asOf)
+}
+
+func (u *UserPermissionCachedRepository) FindUsersWithValidityChange(ctx context.Context, from, to int64) ([]domain.User, error) {
+	return u.repo.FindUsersWithValidityChange(ctx, from, to)
+}
+
 func (u *UserPermissionCachedRepository) Reload(ctx context.Context, user []domain.User) error {
 	var evt permission.UserPermissionEvent
 	evt.Permissions = make(map[int64]permission.UserPermission)
@@ -53,15 +62,17 @@ func (u *UserPermissionCachedRepository) Reload(ctx context.Context, user []doma
 							Key:  src.Permission.Resource.Key,
 							Type: src.Permission.Resource.Type,
 						},
-						Action: src.Permission.Action,
-						Effect: src.Effect.String(),
+						Action:    src.Permission.Action,
+						Effect:    src.Effect.String(),
+						StartTime: src.StartTime,
+						EndTime:   src.EndTime,
 					}
 				}),
 			}
 		}
 
 	}
-	if len(evt.Permissions) > 0 {
+	if len(evt.Permissions) > 0 && u.producer != nil {
 		if err := u.producer.Produce(ctx, evt); err != nil {
 			u.logger.Warn("发送用户权限事件失败",
 				elog.FieldErr(err),
@@ -90,6 +101,10 @@ func (u *UserPermissionCachedRepository) FindByBizID(ctx context.Context, bizId
 	return u.repo.FindByBizID(ctx, bizId, offset, limit)
 }
 
+func (u *UserPermissionCachedRepository) FindByBizIDAndPermissionIDs(ctx context.Context, bizId int64, permissionIds []int64, limit int) ([]domain.UserPermission, error) {
+	return u.repo.FindByBizIDAndPermissionIDs(ctx, bizId, permissionIds, limit)
+}
+
 func (u *UserPermissionCachedRepository) FindByBizIdAndUserID(ctx context.Context, bizId, userId int64) ([]domain.UserPermission, error) {
 	perms, err := u.cache.Get(ctx, bizId, userId)
 	if err == nil {
@@ -11,6 +11,8 @@ var _ RoleIncludeRepository = (*roleIncludeRepository)(nil)
 
 type RoleIncludeRepository interface {
 	Create(ctx context.Context, inclusion domain.RoleInclusion) (domain.RoleInclusion, error)
+	// CreateWithCheck 同一个业务下串行执行 check 和创建，check 通过传入的 repo 查询已有的包含关系
+	CreateWithCheck(ctx context.Context, inclusion domain.RoleInclusion, check func(ctx context.Context, repo RoleIncludeRepository) error) (domain.RoleInclusion, error)
 	FindByBizID(ctx context.Context, bizId int64, offset, limit int) ([]domain.RoleInclusion, error)
 	FindByBizIdAndIncludingIds(ctx context.Context, bizId int64, IncludingIds []int64) ([]domain.RoleInclusion, error)
 	FindByBizIdAndIncludedIds(ctx context.Context, bizId int64, IncludedIds []int64) ([]domain.RoleInclusion, error)
@@ -38,6 +40,17 @@ func (r *roleIncludeRepository) Create(ctx context.Context, inclusion domain.Rol
 	return r.toDomain(create), nil
 }
 
+func (r *roleIncludeRepository) CreateWithCheck(ctx context.Context, inclusion domain.RoleInclusion,
+	check func(ctx context.Context, repo RoleIncludeRepository) error) (domain.RoleInclusion, error) {
+	create, err := r.roleInclusionDao.CreateWithCheck(ctx, r.toEntity(inclusion), func(ctx context.Context, tx dao.RoleInclusionDAO) error {
+		return check(ctx, &roleIncludeRepository{roleInclusionDao: tx})
+	})
+	if err != nil {
+		return domain.RoleInclusion{}, err
+	}
+	return r.toDomain(create), nil
+}
+
 func (r *roleIncludeRepository) FindByBizID(ctx context.Context, bizId int64, offset, limit int) ([]domain.RoleInclusion, error) {
 	roleInclusions, err := r.roleInclusionDao.FindByBizID(ctx, bizId, offset, limit)
 	if err != nil {
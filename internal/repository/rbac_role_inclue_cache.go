@@ -64,17 +64,18 @@ func (r *RoleInclusionReloadCacheRepository) getAffectedRoleIDs(ctx context.Cont
 	allRoleIDs[includeRoleID] = struct{}{}
 
 	inlcudedIDs := []int64{includeRoleID}
-	for {
+	for len(inlcudedIDs) > 0 {
 		inclusions, err := r.repo.FindByBizIdAndIncludedIds(ctx, bizID, inlcudedIDs)
 		if err != nil {
 			return nil, err
 		}
-		if len(inclusions) == 0 {
-			break
-		}
-		inlcudedIDs = slice.Map(inclusions, func(idx int, src domain.RoleInclusion) int64 {
+		// 只继续展开没有访问过的角色，避免已有数据中的环导致死循环
+		inlcudedIDs = slice.FilterMap(inclusions, func(idx int, src domain.RoleInclusion) (int64, bool) {
+			if _, ok := allRoleIDs[src.IncludingRole.ID]; ok {
+				return 0, false
+			}
 			allRoleIDs[src.IncludingRole.ID] = struct{}{}
-			return src.IncludingRole.ID
+			return src.IncludingRole.ID, true
 		})
 	}
 	return mapx.Keys(allRoleIDs), nil
@@ -15,6 +15,7 @@ type RolePermissionRepository interface {
 	Create(ctx context.Context, permission domain.RolePermission) (domain.RolePermission, error)
 	FindByBizID(ctx context.Context, bizID int64) ([]domain.RolePermission, error)
 	FindByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) ([]domain.RolePermission, error)
+	FindByBizIDAndPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64) ([]domain.RolePermission, error)
 
 	DeleteByBizIDAndID(ctx context.Context, bizID, id int64) error
 }
@@ -68,6 +69,15 @@ func (r *rolePermissionRepository) FindByBizIDAndRoleIDs(ctx context.Context, bi
 		return r.toDomain(src)
 	}), nil
 }
+func (r *rolePermissionRepository) FindByBizIDAndPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64) ([]domain.RolePermission, error) {
+	rolePermissions, err := r.rolePermissionDao.FindByBizIDAndPermissionIds(ctx, bizID, permissionIDs)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(rolePermissions, func(idx int, src dao.RolePermission) domain.RolePermission {
+		return r.toDomain(src)
+	}), nil
+}
 func (r *rolePermissionRepository) FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.RolePermission, error) {
 	rp, err := r.rolePermissionDao.FindByBizIdAndID(ctx, bizID, id)
 	if err != nil {
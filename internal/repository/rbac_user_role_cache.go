@@ -59,3 +59,11 @@ func (r *UserRoleReloadCacheRepository) DeleteByBizIDAndID(ctx context.Context,
 func (r *UserRoleReloadCacheRepository) FindByBizID(ctx context.Context, bizID int64) ([]domain.UserRole, error) {
 	return r.repo.FindByBizID(ctx, bizID)
 }
+
+func (r *UserRoleReloadCacheRepository) FindByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) ([]domain.UserRole, error) {
+	return r.repo.FindByBizIDAndRoleIDs(ctx, bizID, roleIDs)
+}
+
+func (r *UserRoleReloadCacheRepository) FindByBizIDAndRoleIDsWithLimit(ctx context.Context, bizID int64, roleIDs []int64, limit int) ([]domain.UserRole, error) {
+	return r.repo.FindByBizIDAndRoleIDsWithLimit(ctx, bizID, roleIDs, limit)
+}
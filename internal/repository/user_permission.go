@@ -6,6 +6,7 @@ import (
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/permission-dev/internal/domain"
 	"github.com/permission-dev/internal/repository/dao"
+	"golang.org/x/sync/errgroup"
 	"time"
 )
 
@@ -15,11 +16,17 @@ type UserPermissionRepository interface {
 	Create(ctx context.Context, permission domain.UserPermission) (domain.UserPermission, error)
 	FindByBizID(ctx context.Context, bizId int64, offset, limit int) ([]domain.UserPermission, error)
 	FindByBizIdAndUserID(ctx context.Context, bizId, userId int64) ([]domain.UserPermission, error)
+	// FindByBizIDAndPermissionIDs 返回直接授予用户的这些权限，最多 limit 条
+	FindByBizIDAndPermissionIDs(ctx context.Context, bizId int64, permissionIds []int64, limit int) ([]domain.UserPermission, error)
 	DeleteByBizIdAndID(ctx context.Context, bizId, id int64) error
 	//返回用户的个人权限，个人角色以及包含角色的权限
 	GetALLUserPermission(ctx context.Context, bizId, userId int64) ([]domain.UserPermission, error)
+	// GetALLUserPermissionAsOf 与 GetALLUserPermission 相同，但是使用 asOf（毫秒）时刻的授权
+	GetALLUserPermissionAsOf(ctx context.Context, bizId, userId, asOf int64) ([]domain.UserPermission, error)
 
 	FindByBizIDAndID(ctx context.Context, bizId, id int64) (domain.UserPermission, error)
+	// FindUsersWithValidityChange 返回个人权限或者角色在 (from, to] 内生效、失效的用户
+	FindUsersWithValidityChange(ctx context.Context, from, to int64) ([]domain.User, error)
 }
 
 type userPermissionRepository struct {
@@ -27,6 +34,8 @@ type userPermissionRepository struct {
 	roleInclusionDao  dao.RoleInclusionDAO
 	rolePermissionDao dao.RolePermissionDAO
 	userPermissionDao dao.UserPermissionDAO
+	grantHistoryDao   dao.GrantHistoryDAO
+	roleInclusionCfg  domain.RoleInclusionConfig
 }
 
 func (u *userPermissionRepository) Create(ctx context.Context, permission domain.UserPermission) (domain.UserPermission, error) {
@@ -53,6 +62,16 @@ func (u *userPermissionRepository) FindByBizID(ctx context.Context, bizId int64,
 	}), nil
 }
 
+func (u *userPermissionRepository) FindByBizIDAndPermissionIDs(ctx context.Context, bizId int64, permissionIds []int64, limit int) ([]domain.UserPermission, error) {
+	ups, err := u.userPermissionDao.FindByBizIdAndPermissionIds(ctx, bizId, permissionIds, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(ups, func(idx int, src dao.UserPermission) domain.UserPermission {
+		return u.toDomain(src)
+	}), nil
+}
+
 func (u *userPermissionRepository) FindByBizIdAndUserID(ctx context.Context, bizId, userId int64) ([]domain.UserPermission, error) {
 	ups, err := u.userPermissionDao.FindByBizIdAndUserId(ctx, bizId, userId)
 	if err != nil {
@@ -67,9 +86,24 @@ func (u *userPermissionRepository) DeleteByBizIdAndID(ctx context.Context, bizId
 	return u.userPermissionDao.DeleteBizIdAndId(ctx, bizId, id)
 }
 
+// GetALLUserPermission 返回未过期（包括尚未生效）的全部权限，是否生效由调用方根据 StartTime、EndTime 判断，
+// 这样缓存的结果在有效期变化前后都是正确的
 func (u *userPermissionRepository) GetALLUserPermission(ctx context.Context, bizId, userId int64) ([]domain.UserPermission, error) {
+	return u.getAllUserPermission(ctx, bizId, userId, 0)
+}
+
+func (u *userPermissionRepository) GetALLUserPermissionAsOf(ctx context.Context, bizId, userId, asOf int64) ([]domain.UserPermission, error) {
+	return u.getAllUserPermission(ctx, bizId, userId, asOf)
+}
+
+// getAllUserPermission asOf 为 0 时使用当前的授权，否则使用授权历史
+func (u *userPermissionRepository) getAllUserPermission(ctx context.Context, bizId, userId, asOf int64) ([]domain.UserPermission, error) {
+	now := time.Now().Unix()
+	if asOf > 0 {
+		now = asOf / 1000
+	}
 	//获取个人权限
-	userPermissions, err := u.userPermissionDao.FindByBizIdAndUserId(ctx, bizId, userId)
+	userPermissions, err := u.findUnexpiredUserPermissions(ctx, bizId, userId, now, asOf)
 	if err != nil {
 		return nil, err
 	}
@@ -77,81 +111,215 @@ func (u *userPermissionRepository) GetALLUserPermission(ctx context.Context, biz
 		return u.toDomain(src)
 	})
 	//获取角色以及包含的角色
-	roleIds, err := u.GetAllRoleIds(ctx, bizId, userId)
+	roleGrants, err := u.getRoleGrants(ctx, bizId, userId, now, asOf)
 	if err != nil {
 		return nil, err
 	}
 	//获取所有角色的权限
-	allRoleUserPermissions, err := u.GetAllRolePermissions(ctx, bizId, userId, roleIds)
+	allRoleUserPermissions, err := u.getAllRolePermissions(ctx, bizId, userId, roleGrants, asOf)
 	if err != nil {
 		return nil, err
 	}
 	perms = append(perms, allRoleUserPermissions...)
 	return perms, nil
 }
-func (u *userPermissionRepository) GetAllRolePermissions(ctx context.Context, bizId, userId int64, roleIds []int64) ([]domain.UserPermission, error) {
-	if len(roleIds) == 0 {
+
+func (u *userPermissionRepository) findUnexpiredUserPermissions(ctx context.Context, bizId, userId, now, asOf int64) ([]dao.UserPermission, error) {
+	if asOf == 0 {
+		return u.userPermissionDao.FindUnexpiredByBizIdAndUserId(ctx, bizId, userId, now)
+	}
+	ups, err := u.grantHistoryDao.FindUserPermissionsAsOf(ctx, bizId, userId, asOf)
+	return slice.FilterMap(ups, func(_ int, src dao.UserPermission) (dao.UserPermission, bool) {
+		return src, src.EndTime >= now
+	}), err
+}
+
+func (u *userPermissionRepository) findUserRoles(ctx context.Context, bizId, userId, asOf int64) ([]dao.UserRole, error) {
+	if asOf == 0 {
+		return u.userRoleDao.FindByBizIDAndUserID(ctx, bizId, userId)
+	}
+	return u.grantHistoryDao.FindUserRolesAsOf(ctx, bizId, userId, asOf)
+}
+
+func (u *userPermissionRepository) findRoleInclusions(ctx context.Context, bizId int64, includingIds []int64, asOf int64) ([]dao.RoleInclusion, error) {
+	if asOf == 0 {
+		return u.roleInclusionDao.FindByBizIdAndIncludingIds(ctx, bizId, includingIds)
+	}
+	return u.grantHistoryDao.FindRoleInclusionsAsOf(ctx, bizId, includingIds, asOf)
+}
+
+func (u *userPermissionRepository) findRolePermissions(ctx context.Context, bizId int64, roleIds []int64, asOf int64) ([]dao.RolePermission, error) {
+	if asOf == 0 {
+		return u.rolePermissionDao.FindByBizIDAndRoleIds(ctx, bizId, roleIds)
+	}
+	return u.grantHistoryDao.FindRolePermissionsAsOf(ctx, bizId, roleIds, asOf)
+}
+
+func (u *userPermissionRepository) FindUsersWithValidityChange(ctx context.Context, from, to int64) ([]domain.User, error) {
+	var (
+		eg        errgroup.Group
+		ups       []dao.UserPermission
+		userRoles []dao.UserRole
+	)
+	eg.Go(func() error {
+		var err error
+		ups, err = u.userPermissionDao.FindByValidityBoundary(ctx, from, to)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		userRoles, err = u.userRoleDao.FindByValidityBoundary(ctx, from, to)
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	users := make(map[domain.User]struct{}, len(ups)+len(userRoles))
+	for _, src := range ups {
+		users[domain.User{ID: src.UserID, BizID: src.BizID}] = struct{}{}
+	}
+	for _, src := range userRoles {
+		users[domain.User{ID: src.UserID, BizID: src.BizID}] = struct{}{}
+	}
+	return mapx.Keys(users), nil
+}
+
+// roleGrant 用户通过某个直接拥有的角色获得另一个角色，
+// path 为从直接拥有的角色到该角色的路径，有效期取自直接拥有的角色
+type roleGrant struct {
+	path      []int64
+	startTime int64
+	endTime   int64
+}
+
+func (r roleGrant) roleID() int64 {
+	return r.path[len(r.path)-1]
+}
+
+// getAllRolePermissions 获取角色的权限，同一个角色有多个来源时每个来源都会生成一条权限
+func (u *userPermissionRepository) getAllRolePermissions(ctx context.Context, bizId, userId int64, roleGrants map[int64][]roleGrant, asOf int64) ([]domain.UserPermission, error) {
+	if len(roleGrants) == 0 {
 		return []domain.UserPermission{}, nil
 	}
-	rolePermissions, err := u.rolePermissionDao.FindByBizIDAndRoleIds(ctx, bizId, roleIds)
+	rolePermissions, err := u.findRolePermissions(ctx, bizId, mapx.Keys(roleGrants), asOf)
 	if err != nil {
 		return []domain.UserPermission{}, err
 	}
-	return slice.Map(rolePermissions, func(idx int, src dao.RolePermission) domain.UserPermission {
-		return domain.UserPermission{
-			ID:     0,
-			BizID:  bizId,
-			UserID: userId,
-			Permission: domain.Permission{
-				ID:    src.PermissionID,
-				BizID: bizId,
-				Resource: domain.Resource{
+	res := make([]domain.UserPermission, 0, len(rolePermissions))
+	for _, src := range rolePermissions {
+		for _, grant := range roleGrants[src.RoleID] {
+			res = append(res, domain.UserPermission{
+				ID:     0,
+				BizID:  bizId,
+				UserID: userId,
+				Permission: domain.Permission{
+					ID:    src.PermissionID,
 					BizID: bizId,
-					Type:  src.ResourceType,
-					Key:   src.ResourceKey,
+					Resource: domain.Resource{
+						BizID: bizId,
+						Type:  src.ResourceType,
+						Key:   src.ResourceKey,
+					},
+					Action: src.PermissionAction,
 				},
-				Action: src.PermissionAction,
-			},
-			StartTime: time.Now().Unix(),
-			EndTime:   time.Now().AddDate(100, 0, 0).Unix(),
-			Effect:    domain.EffectAllow,
-			Ctime:     src.Ctime,
-			Utime:     src.Utime,
+				StartTime: grant.startTime,
+				EndTime:   grant.endTime,
+				Effect:    domain.EffectAllow,
+				Ctime:     src.Ctime,
+				Utime:     src.Utime,
+				RolePath:  grant.path,
+			})
 		}
-	}), nil
-
+	}
+	return res, nil
 }
+
+// GetAllRoleIds 返回当前有效的全部角色（包括被包含的角色）
 func (u *userPermissionRepository) GetAllRoleIds(ctx context.Context, bizId, userId int64) ([]int64, error) {
+	now := time.Now().Unix()
+	roleGrants, err := u.getRoleGrants(ctx, bizId, userId, now, 0)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]int64, 0, len(roleGrants))
+	for roleID, grants := range roleGrants {
+		for _, grant := range grants {
+			if grant.startTime <= now && now <= grant.endTime {
+				res = append(res, roleID)
+				break
+			}
+		}
+	}
+	return res, nil
+}
+
+// getRoleGrants 展开用户未过期的角色以及其包含的角色，超过最大深度的包含关系不再展开
+func (u *userPermissionRepository) getRoleGrants(ctx context.Context, bizId, userId, now, asOf int64) (map[int64][]roleGrant, error) {
 	//直接关联的角色
-	directUserRoles, err := u.userRoleDao.FindByBizIDAndUserID(ctx, bizId, userId)
+	directUserRoles, err := u.findUserRoles(ctx, bizId, userId, asOf)
 	if err != nil {
 		return nil, err
 	}
-	allRoleIds := make(map[int64]any, len(directUserRoles))
-	directUserRoleIds := slice.Map(directUserRoles, func(idx int, src dao.UserRole) int64 {
-		allRoleIds[src.RoleID] = struct{}{}
-		return src.RoleID
-	})
-	includeIds := directUserRoleIds
-	for {
-		roleInclusions, err := u.roleInclusionDao.FindByBizIdAndIncludingIds(ctx, bizId, includeIds)
+	roleGrants := make(map[int64][]roleGrant, len(directUserRoles))
+	// key 为 (直接拥有的角色, 角色)，同一个来源下已经访问过的角色不再展开，避免重复以及环
+	visited := make(map[[2]int64]struct{}, len(directUserRoles))
+	current := make([]roleGrant, 0, len(directUserRoles))
+	for _, src := range directUserRoles {
+		if src.EndTime < now {
+			continue
+		}
+		grant := roleGrant{path: []int64{src.RoleID}, startTime: src.StartTime, endTime: src.EndTime}
+		visited[[2]int64{src.RoleID, src.RoleID}] = struct{}{}
+		roleGrants[src.RoleID] = append(roleGrants[src.RoleID], grant)
+		current = append(current, grant)
+	}
+	for depth := 0; len(current) > 0 && depth < u.roleInclusionCfg.MaxDepth; depth++ {
+		includingIds := slice.Map(current, func(idx int, src roleGrant) int64 {
+			return src.roleID()
+		})
+		roleInclusions, err := u.findRoleInclusions(ctx, bizId, includingIds, asOf)
 		if err != nil {
 			return nil, err
 		}
-		if len(roleInclusions) == 0 {
-			break
+		includedMap := make(map[int64][]int64, len(roleInclusions))
+		for _, src := range roleInclusions {
+			includedMap[src.IncludingRoleID] = append(includedMap[src.IncludingRoleID], src.IncludedRoleID)
 		}
-		includeIds = slice.Map(roleInclusions, func(idx int, src dao.RoleInclusion) int64 {
-			allRoleIds[src.IncludedRoleID] = struct{}{}
-			return src.IncludedRoleID
-		})
+		next := make([]roleGrant, 0, len(roleInclusions))
+		for _, grant := range current {
+			for _, includedID := range includedMap[grant.roleID()] {
+				key := [2]int64{grant.path[0], includedID}
+				if _, ok := visited[key]; ok {
+					continue
+				}
+				visited[key] = struct{}{}
+				path := make([]int64, 0, len(grant.path)+1)
+				path = append(path, grant.path...)
+				newGrant := roleGrant{path: append(path, includedID), startTime: grant.startTime, endTime: grant.endTime}
+				roleGrants[includedID] = append(roleGrants[includedID], newGrant)
+				next = append(next, newGrant)
+			}
+		}
+		current = next
 	}
-	return mapx.Keys(allRoleIds), nil
+	return roleGrants, nil
 }
 
-func NewUserPermissionRepository(userPermissionDao dao.UserPermissionDAO) UserPermissionRepository {
+func NewUserPermissionRepository(
+	userRoleDao dao.UserRoleDAO,
+	roleInclusionDao dao.RoleInclusionDAO,
+	rolePermissionDao dao.RolePermissionDAO,
+	userPermissionDao dao.UserPermissionDAO,
+	grantHistoryDao dao.GrantHistoryDAO,
+	roleInclusionCfg domain.RoleInclusionConfig,
+) UserPermissionRepository {
 	return &userPermissionRepository{
+		userRoleDao:       userRoleDao,
+		roleInclusionDao:  roleInclusionDao,
+		rolePermissionDao: rolePermissionDao,
 		userPermissionDao: userPermissionDao,
+		grantHistoryDao:   grantHistoryDao,
+		roleInclusionCfg:  roleInclusionCfg,
 	}
 }
 
@@ -14,6 +14,9 @@ type UserRoleRepository interface {
 	Create(ctx context.Context, userRole domain.UserRole) (domain.UserRole, error)
 	FindByBizID(ctx context.Context, bizId int64) ([]domain.UserRole, error)
 	FindByBizIDAndUserID(ctx context.Context, bizId, userId int64) ([]domain.UserRole, error)
+	FindByBizIDAndRoleIDs(ctx context.Context, bizID int64, roleIDs []int64) ([]domain.UserRole, error)
+	// FindByBizIDAndRoleIDsWithLimit 同 FindByBizIDAndRoleIDs，最多返回 limit 条
+	FindByBizIDAndRoleIDsWithLimit(ctx context.Context, bizID int64, roleIDs []int64, limit int) ([]domain.UserRole, error)
 	DeleteByBizIDAndID(ctx context.Context, bizId, id int64) error
 }
 
@@ -31,6 +34,16 @@ func (r *userRoleRepository) FindByBizIDAndRoleIDs(ctx context.Context, bizID in
 		return r.toDomain(src)
 	}), nil
 }
+func (r *userRoleRepository) FindByBizIDAndRoleIDsWithLimit(ctx context.Context, bizID int64, roleIDs []int64, limit int) ([]domain.UserRole, error) {
+	userRoles, err := r.userRoleDao.FindByBizIDAndRoleIDsWithLimit(ctx, bizID, roleIDs, limit)
+	if err != nil {
+		return nil, err
+	}
+	return slice.Map(userRoles, func(_ int, src dao.UserRole) domain.UserRole {
+		return r.toDomain(src)
+	}), nil
+}
+
 func (u *userRoleRepository) FindByBizIDAndID(ctx context.Context, bizID, id int64) (domain.UserRole, error) {
 	ur, err := u.userRoleDao.FindByBizIDAndID(ctx, bizID, id)
 	if err != nil {
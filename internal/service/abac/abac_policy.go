@@ -2,29 +2,392 @@ package abac
 
 import (
 	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
 	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
 	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/expression"
+	"golang.org/x/sync/errgroup"
 )
 
+// PolicySvc 策略、规则以及与权限关联的修改都只修改草稿，发布后才会在鉴权中生效
 type PolicySvc interface {
 	//policy相关
 	Save(ctx context.Context, policy domain.Policy) (int64, error)
 	Delete(ctx context.Context, bizID, id int64) error
-	First(ctx context.Context, bizID, id int64) (domain.Policy, error) // 包含规则
+	First(ctx context.Context, bizID, id int64) (domain.Policy, error) // 包含规则以及关联的权限，权限只有ID
 	//policy rule相关
 	SaveRule(ctx context.Context, bizID, policyId int64, rule domain.PolicyRule) (int64, error)
+	// ValidatePolicy 校验策略的全部规则，不保存，用于模拟候选策略
+	ValidatePolicy(ctx context.Context, bizID int64, policy domain.Policy) error
 	DeleteRule(ctx context.Context, bizID, ruleID int64, cascade bool) error
 	//policy permission相关
 	FindPoliciesByPermissionIDs(ctx context.Context, bizID int64, permissionIDs []int64) ([]domain.Policy, error)
 	SavePermissionPolicy(ctx context.Context, bizID, policyID, permissionID int64, effect domain.Effect) error
 	FindPolicies(ctx context.Context, bizID int64, offset, limit int) (int64, []domain.Policy, error)
 	FindBizPolicies(ctx context.Context, bizID int64) ([]domain.Policy, error)
+	//policy expression相关
+	// SaveExpression 把表达式编译成规则树并替换策略已有的全部规则，返回根规则ID
+	SaveExpression(ctx context.Context, bizID, policyID int64, expr string) (int64, error)
+	// Expression 把策略已有的规则渲染成表达式
+	Expression(ctx context.Context, bizID, policyID int64) (string, error)
+	//policy version相关
+	// Publish 把草稿发布成新版本并切换生效版本，返回新版本号
+	Publish(ctx context.Context, bizID, policyID int64, comment string) (int64, error)
+	// Rollback 复制历史版本发布成新版本，草稿也恢复成该版本，返回新版本号
+	Rollback(ctx context.Context, bizID, policyID, version int64, comment string) (int64, error)
+	// FindVersions 返回策略的全部版本，新版本在前，不包含规则
+	FindVersions(ctx context.Context, bizID, policyID int64) ([]domain.PolicyVersion, error)
+	// FindVersion 返回版本的快照，version 为 domain.PolicyDraftVersion 时返回草稿
+	FindVersion(ctx context.Context, bizID, policyID, version int64) (domain.PolicyVersion, error)
+	// DiffVersions 比较两个版本，version 为 domain.PolicyDraftVersion 时表示草稿
+	DiffVersions(ctx context.Context, bizID, policyID, from, to int64) (domain.PolicyVersionDiff, error)
 }
 
 type policySvc struct {
 	repository.AttributePolicyRepository
+	attrRepo       repository.AttributeDefinitionRepository
+	permissionRepo repository.PermissionRepository
+	selector       evaluator.Selector
+	cache          PolicyCache
+}
+
+func NewPolicySvc(
+	repo repository.AttributePolicyRepository,
+	attrRepo repository.AttributeDefinitionRepository,
+	permissionRepo repository.PermissionRepository,
+	selector evaluator.Selector,
+	cache PolicyCache,
+) PolicySvc {
+	return &policySvc{
+		AttributePolicyRepository: repo,
+		attrRepo:                  attrRepo,
+		permissionRepo:            permissionRepo,
+		selector:                  selector,
+		cache:                     cache,
+	}
+}
+
+// 以下写操作会改变生效的策略，成功后让业务的策略缓存失效，只修改草稿的操作不需要
+
+func (p *policySvc) Delete(ctx context.Context, bizID, id int64) error {
+	err := p.AttributePolicyRepository.Delete(ctx, bizID, id)
+	if err == nil {
+		p.cache.Invalidate(bizID)
+	}
+	return err
+}
+
+func (p *policySvc) Publish(ctx context.Context, bizID, policyID int64, comment string) (int64, error) {
+	version, err := p.AttributePolicyRepository.Publish(ctx, bizID, policyID, comment)
+	if err == nil {
+		p.cache.Invalidate(bizID)
+	}
+	return version, err
+}
+
+func (p *policySvc) Rollback(ctx context.Context, bizID, policyID, version int64, comment string) (int64, error) {
+	newVersion, err := p.AttributePolicyRepository.Rollback(ctx, bizID, policyID, version, comment)
+	if err == nil {
+		p.cache.Invalidate(bizID)
+	}
+	return newVersion, err
+}
+
+// SavePermissionPolicy 关联权限后策略也要用于该权限的资源类型，草稿中的规则引用的资源属性需要适用于该资源类型
+func (p *policySvc) SavePermissionPolicy(ctx context.Context, bizID, policyID, permissionID int64, effect domain.Effect) error {
+	policy, err := p.AttributePolicyRepository.First(ctx, bizID, policyID)
+	if err != nil {
+		return err
+	}
+	policy.Permissions = append(policy.Permissions, domain.UserPermission{Permission: domain.Permission{ID: permissionID}})
+	defs, resourceTypes, err := p.policyDefs(ctx, bizID, policy)
+	if err != nil {
+		return err
+	}
+	for _, rule := range policy.Rules {
+		if err = checkResourceTypes(rule, defs, resourceTypes); err != nil {
+			return err
+		}
+	}
+	return p.AttributePolicyRepository.SavePermissionPolicy(ctx, bizID, policyID, permissionID, effect)
+}
+
+// SaveRule 保存前按属性定义校验运算符以及比较值，包括注册的自定义运算符，
+// 比较值还需要满足属性定义的类型以及约束，引用的资源属性需要适用于策略关联的全部资源类型
+func (p *policySvc) SaveRule(ctx context.Context, bizID, policyId int64, rule domain.PolicyRule) (int64, error) {
+	policy, err := p.AttributePolicyRepository.First(ctx, bizID, policyId)
+	if err != nil {
+		return 0, err
+	}
+	defs, resourceTypes, err := p.policyDefs(ctx, bizID, policy)
+	if err != nil {
+		return 0, err
+	}
+	if err = p.validateRule(rule, defs); err != nil {
+		return 0, err
+	}
+	if err = checkResourceTypes(rule, defs, resourceTypes); err != nil {
+		return 0, err
+	}
+	return p.AttributePolicyRepository.SaveRule(ctx, bizID, policyId, rule)
+}
+
+func (p *policySvc) ValidatePolicy(ctx context.Context, bizID int64, policy domain.Policy) error {
+	defs, resourceTypes, err := p.policyDefs(ctx, bizID, policy)
+	if err != nil {
+		return err
+	}
+	for _, rule := range policy.Rules {
+		if err = p.validateRule(rule, defs); err != nil {
+			return err
+		}
+		if err = checkResourceTypes(rule, defs, resourceTypes); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// policyDefs 返回业务的属性定义以及策略关联的权限的资源类型，资源类型已经去重
+func (p *policySvc) policyDefs(ctx context.Context, bizID int64, policy domain.Policy) (domain.BizAttrDefinition, []string, error) {
+	defs, err := p.attrRepo.FindByBizID(ctx, bizID)
+	if err != nil {
+		return domain.BizAttrDefinition{}, nil, err
+	}
+	// 没有资源类型专属的属性时不会有限制，不需要查询权限
+	if !slices.ContainsFunc(defs.ResourceAttrDefs, func(def domain.AttributeDefinition) bool {
+		return def.ResourceType != ""
+	}) {
+		return defs, nil, nil
+	}
+	var eg errgroup.Group
+	resourceTypes := make([]string, len(policy.Permissions))
+	for idx := range policy.Permissions {
+		eg.Go(func() error {
+			permission, err := p.permissionRepo.FindByBizIDANdID(ctx, bizID, policy.Permissions[idx].Permission.ID)
+			resourceTypes[idx] = permission.Resource.Type
+			return err
+		})
+	}
+	if err = eg.Wait(); err != nil {
+		return domain.BizAttrDefinition{}, nil, err
+	}
+	slices.Sort(resourceTypes)
+	return defs, slices.Compact(resourceTypes), nil
+}
+
+// checkResourceTypes 规则以及子规则引用的资源属性需要适用于 resourceTypes 中的每一种资源
+func checkResourceTypes(rule domain.PolicyRule, defs domain.BizAttrDefinition, resourceTypes []string) error {
+	for _, child := range []*domain.PolicyRule{rule.LeftRule, rule.RightRule} {
+		if child == nil {
+			continue
+		}
+		if err := checkResourceTypes(*child, defs, resourceTypes); err != nil {
+			return err
+		}
+	}
+	for _, id := range []int64{rule.AttrDef.ID, rule.ValueAttrDef.ID} {
+		if def, ok := defs.GetByDefId(id); ok && !def.AvailableFor(resourceTypes) {
+			return fmt.Errorf("%w: 资源属性 %s 只属于资源类型 %s，策略关联的资源类型为 %v", errs.ErrInvalidPolicyRule,
+				def.Name, def.ResourceType, resourceTypes)
+		}
+	}
+	return nil
+}
+
+// validateRule 规则引用的属性需要已经定义，并且属性的类型可以比较，逻辑运算的子规则同样校验
+func (p *policySvc) validateRule(rule domain.PolicyRule, defs domain.BizAttrDefinition) error {
+	if rule.LeftRule != nil || rule.RightRule != nil {
+		switch rule.Operator {
+		case domain.AND, domain.OR, domain.NOT:
+		default:
+			return fmt.Errorf("%w: %s 不是逻辑运算符", errs.ErrInvalidPolicyRule, rule.Operator)
+		}
+		for _, child := range []*domain.PolicyRule{rule.LeftRule, rule.RightRule} {
+			if child == nil {
+				continue
+			}
+			if err := p.validateRule(*child, defs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	def, ok := defs.GetByDefId(rule.AttrDef.ID)
+	if !ok {
+		return fmt.Errorf("%w: 属性 %d 未定义", errs.ErrInvalidPolicyRule, rule.AttrDef.ID)
+	}
+	if !rule.ReferencesAttr() {
+		if err := p.selector.Validate(def.DataType, rule.Operator, rule.Value); err != nil {
+			return err
+		}
+		return validateRuleLiteral(def, rule.Operator, rule.Value)
+	}
+	ref, ok := defs.GetByDefId(rule.ValueAttrDef.ID)
+	if !ok {
+		return fmt.Errorf("%w: 引用的属性 %d 未定义", errs.ErrInvalidPolicyRule, rule.ValueAttrDef.ID)
+	}
+	if !p.selector.Supports(def.DataType, rule.Operator) || !domain.AttrRefComparable(rule.Operator, def.DataType, ref.DataType) {
+		return fmt.Errorf("%w: 属性 %s(%s) 与 %s(%s) 不能使用 %s 比较", errs.ErrInvalidPolicyRule,
+			def.Name, def.DataType, ref.Name, ref.DataType, rule.Operator)
+	}
+	return nil
+}
+
+// SaveExpression 资源属性按策略关联的资源类型解析，同名时使用该资源类型专属的属性
+func (p *policySvc) SaveExpression(ctx context.Context, bizID, policyID int64, expr string) (int64, error) {
+	// 确认策略属于该业务
+	policy, err := p.AttributePolicyRepository.First(ctx, bizID, policyID)
+	if err != nil {
+		return 0, err
+	}
+	defs, resourceTypes, err := p.policyDefs(ctx, bizID, policy)
+	if err != nil {
+		return 0, err
+	}
+	rule, err := expression.Parse(expr, defs.ForResourceTypes(resourceTypes), p.selector)
+	if err != nil {
+		return 0, err
+	}
+	if err = p.validateRule(rule, defs); err != nil {
+		return 0, err
+	}
+	if err = checkResourceTypes(rule, defs, resourceTypes); err != nil {
+		return 0, err
+	}
+	return p.AttributePolicyRepository.ReplaceRules(ctx, bizID, policyID, rule)
+}
+
+func (p *policySvc) Expression(ctx context.Context, bizID, policyID int64) (string, error) {
+	var (
+		eg     errgroup.Group
+		policy domain.Policy
+		defs   domain.BizAttrDefinition
+	)
+	eg.Go(func() error {
+		var err error
+		policy, err = p.AttributePolicyRepository.First(ctx, bizID, policyID)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		defs, err = p.attrRepo.FindByBizID(ctx, bizID)
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return "", err
+	}
+	return expression.Format(policy.Rules, defs)
+}
+
+func (p *policySvc) FindVersion(ctx context.Context, bizID, policyID, version int64) (domain.PolicyVersion, error) {
+	if version != domain.PolicyDraftVersion {
+		return p.AttributePolicyRepository.FindVersion(ctx, bizID, policyID, version)
+	}
+	policy, err := p.AttributePolicyRepository.First(ctx, bizID, policyID)
+	if err != nil {
+		return domain.PolicyVersion{}, err
+	}
+	return domain.PolicyVersion{Version: domain.PolicyDraftVersion, Policy: policy, Ctime: policy.Utime}, nil
+}
+
+func (p *policySvc) DiffVersions(ctx context.Context, bizID, policyID, from, to int64) (domain.PolicyVersionDiff, error) {
+	var (
+		eg                     errgroup.Group
+		fromVersion, toVersion domain.PolicyVersion
+		defs                   domain.BizAttrDefinition
+	)
+	eg.Go(func() error {
+		var err error
+		fromVersion, err = p.FindVersion(ctx, bizID, policyID, from)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		toVersion, err = p.FindVersion(ctx, bizID, policyID, to)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		defs, err = p.attrRepo.FindByBizID(ctx, bizID)
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return domain.PolicyVersionDiff{}, err
+	}
+	res, err := diffPolicies(fromVersion.Policy, toVersion.Policy, defs)
+	res.From, res.To = from, to
+	return res, err
+}
+
+// diffPolicies 规则按表达式比较，规则ID在不同版本之间没有意义
+func diffPolicies(from, to domain.Policy, defs domain.BizAttrDefinition) (domain.PolicyVersionDiff, error) {
+	var (
+		res domain.PolicyVersionDiff
+		err error
+	)
+	if res.FromExpression, err = expression.Format(from.Rules, defs); err != nil {
+		return res, err
+	}
+	if res.ToExpression, err = expression.Format(to.Rules, defs); err != nil {
+		return res, err
+	}
+	fromRules, err := formatRules(from.Rules, defs)
+	if err != nil {
+		return res, err
+	}
+	toRules, err := formatRules(to.Rules, defs)
+	if err != nil {
+		return res, err
+	}
+	res.AddedRules = subtractRules(toRules, fromRules)
+	res.RemovedRules = subtractRules(fromRules, toRules)
+	fields := []domain.PolicyFieldChange{
+		{Field: "description", From: from.Description, To: to.Description},
+		{Field: "status", From: string(from.Status), To: string(to.Status)},
+		{Field: "priority", From: strconv.Itoa(from.Priority), To: strconv.Itoa(to.Priority)},
+		{Field: "execute_type", From: string(from.ExecuteType), To: string(to.ExecuteType)},
+		{Field: "missing_attr", From: string(from.MissingAttr.Normalize()), To: string(to.MissingAttr.Normalize())},
+	}
+	for _, field := range fields {
+		if field.From != field.To {
+			res.Changes = append(res.Changes, field)
+		}
+	}
+	return res, nil
+}
+
+// formatRules 把每条顶层规则分别渲染成表达式
+func formatRules(rules []domain.PolicyRule, defs domain.BizAttrDefinition) ([]string, error) {
+	res := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		expr, err := expression.Format([]domain.PolicyRule{rule}, defs)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, expr)
+	}
+	return res, nil
 }
 
-func NewPolicySvc(repo repository.AttributePolicyRepository) PolicySvc {
-	return &policySvc{repo}
+// subtractRules 返回 src 中去掉 dst 之后剩下的规则，相同的规则出现多次时按次数抵消
+func subtractRules(src, dst []string) []string {
+	counts := make(map[string]int, len(dst))
+	for _, rule := range dst {
+		counts[rule]++
+	}
+	var res []string
+	for _, rule := range src {
+		if counts[rule] > 0 {
+			counts[rule]--
+			continue
+		}
+		res = append(res, rule)
+	}
+	return res
 }
@@ -1,14 +1,50 @@
 package abac
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/ecodeclub/ekit/mapx"
 	"github.com/gotomicro/ego/core/elog"
 	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
 	"github.com/permission-dev/internal/service/abac/evaluator"
 )
 
 type PolicyExecutor interface {
+	// Check 规则满足时返回 true，不满足以及无法判定时返回 false
 	Check(policy domain.Policy, subject domain.ABACObject, resource domain.ABACObject, enviroment domain.ABACObject) bool
+	// Explain 执行策略并返回每个规则节点的执行过程
+	Explain(policy domain.Policy, subject domain.ABACObject, resource domain.ABACObject, enviroment domain.ABACObject) domain.PolicyTrace
+	// Compile 预先解析策略规则的比较值，规则中的属性定义按 defs 补全，defs 中没有的保持原样
+	Compile(policy domain.Policy, defs domain.BizAttrDefinition) *CompiledPolicy
+	CheckCompiled(policy *CompiledPolicy, subject domain.ABACObject, resource domain.ABACObject, enviroment domain.ABACObject) bool
+	// EvaluateCompiled 与 CheckCompiled 相同，但是区分规则不满足和无法判定
+	EvaluateCompiled(policy *CompiledPolicy, subject domain.ABACObject, resource domain.ABACObject, enviroment domain.ABACObject) domain.EvalResult
+	ExplainCompiled(policy *CompiledPolicy, subject domain.ABACObject, resource domain.ABACObject, enviroment domain.ABACObject) domain.PolicyTrace
+}
+
+// CompiledPolicy 编译后的策略，创建后不再修改，可以并发使用
+type CompiledPolicy struct {
+	domain.Policy
+	rules []*compiledRule
+	// attrs 规则中引用到的属性，按 ID 去重
+	attrs []domain.AttributeDefinition
+}
+
+// Attributes 返回规则中引用到的属性，包括右侧引用的属性
+func (c *CompiledPolicy) Attributes() []domain.AttributeDefinition {
+	return c.attrs
+}
+
+type compiledRule struct {
+	// rule 中的属性定义已经按业务的属性定义补全
+	rule        domain.PolicyRule
+	left, right *compiledRule
+	// matcher 叶子规则预先解析好的比较值，右侧引用属性或者属性类型未知时为 nil，执行时再解析
+	matcher evaluator.Matcher
+	// err 比较值解析失败的原因，执行结果为 false
+	err error
 }
 
 // 基于逻辑运算符的方法
@@ -18,18 +54,110 @@ type logicOperatorExecutor struct {
 }
 
 func (l *logicOperatorExecutor) Check(policy domain.Policy, subject domain.ABACObject, resource domain.ABACObject, enviroment domain.ABACObject) bool {
+	return l.CheckCompiled(l.Compile(policy, domain.BizAttrDefinition{}), subject, resource, enviroment)
+}
+
+func (l *logicOperatorExecutor) Explain(policy domain.Policy, subject domain.ABACObject, resource domain.ABACObject, enviroment domain.ABACObject) domain.PolicyTrace {
+	return l.ExplainCompiled(l.Compile(policy, domain.BizAttrDefinition{}), subject, resource, enviroment)
+}
+
+func (l *logicOperatorExecutor) Compile(policy domain.Policy, defs domain.BizAttrDefinition) *CompiledPolicy {
+	res := &CompiledPolicy{
+		Policy: policy,
+		rules:  make([]*compiledRule, 0, len(policy.Rules)),
+	}
+	for index := range policy.Rules {
+		res.rules = append(res.rules, l.compileRule(policy.Rules[index], defs))
+	}
+	seen := make(map[int64]struct{})
+	for index := range res.rules {
+		res.attrs = collectAttrs(res.rules[index], seen, res.attrs)
+	}
+	return res
+}
+
+func collectAttrs(compiled *compiledRule, seen map[int64]struct{}, attrs []domain.AttributeDefinition) []domain.AttributeDefinition {
+	if compiled == nil {
+		return attrs
+	}
+	if compiled.left != nil || compiled.right != nil {
+		attrs = collectAttrs(compiled.left, seen, attrs)
+		return collectAttrs(compiled.right, seen, attrs)
+	}
+	defs := []domain.AttributeDefinition{compiled.rule.AttrDef}
+	if compiled.rule.ReferencesAttr() {
+		defs = append(defs, compiled.rule.ValueAttrDef)
+	}
+	for _, def := range defs {
+		if _, ok := seen[def.ID]; ok || def.ID == 0 {
+			continue
+		}
+		seen[def.ID] = struct{}{}
+		attrs = append(attrs, def)
+	}
+	return attrs
+}
+
+func (l *logicOperatorExecutor) compileRule(rule domain.PolicyRule, defs domain.BizAttrDefinition) *compiledRule {
+	if def, ok := defs.GetByDefId(rule.AttrDef.ID); ok {
+		rule.AttrDef = def
+	}
+	if def, ok := defs.GetByDefId(rule.ValueAttrDef.ID); ok && rule.ReferencesAttr() {
+		rule.ValueAttrDef = def
+	}
+	res := &compiledRule{rule: rule}
+	if rule.LeftRule != nil {
+		res.left = l.compileRule(*rule.LeftRule, defs)
+	}
+	if rule.RightRule != nil {
+		res.right = l.compileRule(*rule.RightRule, defs)
+	}
+	if res.left == nil && res.right == nil && !rule.ReferencesAttr() && rule.AttrDef.DataType != "" {
+		res.matcher, res.err = l.selector.Compile(rule.AttrDef.DataType, rule.Operator, rule.Value)
+	}
+	return res
+}
+
+func (l *logicOperatorExecutor) CheckCompiled(policy *CompiledPolicy, subject domain.ABACObject, resource domain.ABACObject, enviroment domain.ABACObject) bool {
+	return l.EvaluateCompiled(policy, subject, resource, enviroment) == domain.EvalTrue
+}
+
+func (l *logicOperatorExecutor) EvaluateCompiled(policy *CompiledPolicy, subject domain.ABACObject, resource domain.ABACObject, enviroment domain.ABACObject) domain.EvalResult {
 	subjectMap := subject.ValuesMap()
 	resourceMap := resource.ValuesMap()
 	enviromentMap := enviroment.ValuesMap()
 	allAttributeMap := mapx.Merge(subjectMap, resourceMap, enviromentMap)
-	res := true
-	for index := range policy.Rules {
-		rule := policy.Rules[index]
-		res = res && l.checkOneRule(rule, allAttributeMap)
+	res := domain.EvalTrue
+	for index := range policy.rules {
+		rule := policy.rules[index]
+		res = res.And(l.checkOneRule(policy, rule, allAttributeMap, nil))
+		if res == domain.EvalFalse {
+			break
+		}
 	}
 	return res
 }
 
+func (l *logicOperatorExecutor) ExplainCompiled(policy *CompiledPolicy, subject domain.ABACObject, resource domain.ABACObject, enviroment domain.ABACObject) domain.PolicyTrace {
+	subjectMap := subject.ValuesMap()
+	resourceMap := resource.ValuesMap()
+	enviromentMap := enviroment.ValuesMap()
+	allAttributeMap := mapx.Merge(subjectMap, resourceMap, enviromentMap)
+	res := domain.PolicyTrace{
+		Policy: policy.Policy,
+		Rules:  make([]domain.RuleTrace, len(policy.rules)),
+	}
+	// 与 Check 不同，这里不短路，每条规则都会执行以便给出完整的过程
+	result := domain.EvalTrue
+	for index := range policy.rules {
+		rule := policy.rules[index]
+		result = result.And(l.checkOneRule(policy, rule, allAttributeMap, &res.Rules[index]))
+	}
+	res.Result = result == domain.EvalTrue
+	res.Indeterminate = result == domain.EvalIndeterminate
+	return res
+}
+
 func NewPolicyExecutor(selector evaluator.Selector) PolicyExecutor {
 	return &logicOperatorExecutor{
 		selector: selector,
@@ -37,35 +165,152 @@ func NewPolicyExecutor(selector evaluator.Selector) PolicyExecutor {
 	}
 }
 
-func (l *logicOperatorExecutor) checkOneRule(rule domain.PolicyRule, values map[int64]domain.AttributeValue) bool {
-	if rule.LeftRule == nil && rule.RightRule == nil {
-		val := values[rule.AttrDef.ID]
-		actualVal := val.Value
-		checker, err := l.selector.Select(val.AttrDef.DataType)
-		if err != nil {
-			return false
-		}
-		ok, err := checker.Evaluator(rule.Value, actualVal, rule.Operator)
+// checkOneRule 执行单个规则节点，trace 不为 nil 时记录执行过程
+func (l *logicOperatorExecutor) checkOneRule(policy *CompiledPolicy, compiled *compiledRule, values map[int64]domain.AttributeValue, trace *domain.RuleTrace) domain.EvalResult {
+	rule := compiled.rule
+	if trace != nil {
+		trace.RuleID = rule.ID
+		trace.AttrDef = rule.AttrDef
+		trace.Operator = rule.Operator
+		trace.WantVal = rule.Value
+	}
+	var (
+		res domain.EvalResult
+		err error
+	)
+	if compiled.left == nil && compiled.right == nil {
+		res, err = l.checkLeaf(policy.MissingAttr.Normalize(), compiled, values, trace)
+	} else {
+		res, err = l.checkLogic(policy, compiled, values, trace)
+	}
+	// 属性缺失是按策略配置处理的结果，其他错误说明策略或者属性值有问题，需要记录下来
+	if err != nil && !errors.Is(err, errs.ErrAttributeMissing) {
+		l.logger.Warn("执行策略规则失败",
+			elog.FieldErr(err),
+			elog.Int64("policyID", policy.ID),
+			elog.Int64("ruleID", rule.ID))
+	}
+	if trace != nil {
+		trace.Result = res == domain.EvalTrue
+		trace.Indeterminate = res == domain.EvalIndeterminate
 		if err != nil {
-			return false
+			trace.Err = err.Error()
 		}
-		return ok
 	}
-	left, right := true, true
-	if rule.LeftRule != nil {
-		left = l.checkOneRule(*rule.LeftRule, values)
+	return res
+}
+
+func (l *logicOperatorExecutor) checkLogic(policy *CompiledPolicy, compiled *compiledRule, values map[int64]domain.AttributeValue, trace *domain.RuleTrace) (domain.EvalResult, error) {
+	left, right := domain.EvalTrue, domain.EvalTrue
+	var leftTrace, rightTrace *domain.RuleTrace
+	if trace != nil {
+		leftTrace, rightTrace = &domain.RuleTrace{}, &domain.RuleTrace{}
 	}
-	if rule.RightRule != nil {
-		right = l.checkOneRule(*rule.RightRule, values)
+	if compiled.left != nil {
+		left = l.checkOneRule(policy, compiled.left, values, leftTrace)
+		if trace != nil {
+			trace.Left = leftTrace
+		}
+	}
+	if compiled.right != nil {
+		right = l.checkOneRule(policy, compiled.right, values, rightTrace)
+		if trace != nil {
+			trace.Right = rightTrace
+		}
 	}
-	switch rule.Operator {
+	switch compiled.rule.Operator {
 	case domain.AND:
-		return left && right
+		return left.And(right), nil
 	case domain.OR:
-		return left || right
+		return left.Or(right), nil
 	case domain.NOT:
-		return !right
+		return right.Not(), nil
 	default:
-		return false
+		return domain.EvalIndeterminate, fmt.Errorf("%w: %s 不是逻辑运算符", errs.ErrInvalidPolicyRule, compiled.rule.Operator)
+	}
+}
+
+// checkLeaf 执行比较规则，属性缺失时按 mode 处理，执行出错时无法判定
+func (l *logicOperatorExecutor) checkLeaf(mode domain.MissingAttrMode, compiled *compiledRule, values map[int64]domain.AttributeValue, trace *domain.RuleTrace) (domain.EvalResult, error) {
+	rule := compiled.rule
+	val, ok := lookupAttr(mode, rule.AttrDef, values)
+	if !ok {
+		return missingAttrResult(mode), fmt.Errorf("%w: 属性 %d", errs.ErrAttributeMissing, rule.AttrDef.ID)
+	}
+	wantVal := rule.Value
+	if rule.ReferencesAttr() {
+		right, ok := lookupAttr(mode, rule.ValueAttrDef, values)
+		if !ok {
+			return missingAttrResult(mode), fmt.Errorf("%w: 规则引用的属性 %d", errs.ErrAttributeMissing, rule.ValueAttrDef.ID)
+		}
+		if trace != nil {
+			trace.ValueAttrDef = right.AttrDef
+		}
+		var err error
+		if wantVal, err = l.refWantVal(rule, val, right); err != nil {
+			return domain.EvalIndeterminate, err
+		}
+	}
+	if trace != nil {
+		trace.WantVal = wantVal
+		trace.ActualVal = val.Value
+	}
+	var (
+		matched bool
+		err     error
+	)
+	switch {
+	case compiled.err != nil:
+		err = compiled.err
+	case compiled.matcher != nil:
+		matched, err = compiled.matcher.Match(val.Value)
+	default:
+		matched, err = l.evaluate(val, wantVal, rule.Operator)
+	}
+	if err != nil {
+		return domain.EvalIndeterminate, err
+	}
+	if matched {
+		return domain.EvalTrue, nil
+	}
+	return domain.EvalFalse, nil
+}
+
+// lookupAttr 返回属性值，属性缺失时如果策略允许则使用属性定义上的默认值
+func lookupAttr(mode domain.MissingAttrMode, def domain.AttributeDefinition, values map[int64]domain.AttributeValue) (domain.AttributeValue, bool) {
+	if val, ok := values[def.ID]; ok {
+		return val, true
+	}
+	if mode == domain.MissingAttrDefault && def.DefaultValue != "" {
+		return domain.AttributeValue{AttrDef: def, Value: def.DefaultValue}, true
+	}
+	return domain.AttributeValue{}, false
+}
+
+func missingAttrResult(mode domain.MissingAttrMode) domain.EvalResult {
+	if mode == domain.MissingAttrFalse {
+		return domain.EvalFalse
+	}
+	return domain.EvalIndeterminate
+}
+
+// refWantVal 右侧引用属性时用该属性的值作为比较值，并校验两侧的类型
+func (l *logicOperatorExecutor) refWantVal(rule domain.PolicyRule, left, right domain.AttributeValue) (string, error) {
+	if !domain.AttrRefComparable(rule.Operator, left.AttrDef.DataType, right.AttrDef.DataType) {
+		return right.Value, fmt.Errorf("%w: 属性 %s(%s) 与 %s(%s) 不能使用 %s 比较", errs.ErrRuleAttrRefInvalid,
+			left.AttrDef.Name, left.AttrDef.DataType, right.AttrDef.Name, right.AttrDef.DataType, rule.Operator)
+	}
+	// 时间属性的值是毫秒时间戳，转换成 TimeEvaluator 的精确时间规则
+	if right.AttrDef.DataType == domain.DataTypeDatetime {
+		return "@time(" + right.Value + ")", nil
+	}
+	return right.Value, nil
+}
+
+func (l *logicOperatorExecutor) evaluate(val domain.AttributeValue, wantVal string, op domain.RuleOperator) (bool, error) {
+	checker, err := l.selector.Select(val.AttrDef.DataType)
+	if err != nil {
+		return false, err
 	}
+	return checker.Evaluator(wantVal, val.Value, op)
 }
@@ -15,25 +15,38 @@ type AttributeDefinitionSvc interface {
 }
 
 type attributeDefinitionSvc struct {
-	repo repository.AttributeDefinitionRepository
+	repo  repository.AttributeDefinitionRepository
+	cache PolicyCache
 }
 
+// Create 编译后的策略依赖属性定义，变更后让业务的策略缓存失效
 func (a *attributeDefinitionSvc) Create(ctx context.Context, bizId int64, definition domain.AttributeDefinition) (int64, error) {
-	return a.Create(ctx, bizId, definition)
+	if err := ValidateAttrDefinition(definition); err != nil {
+		return 0, err
+	}
+	id, err := a.repo.Create(ctx, bizId, definition)
+	if err == nil {
+		a.cache.Invalidate(bizId)
+	}
+	return id, err
 }
 
 func (a *attributeDefinitionSvc) Delete(ctx context.Context, bizId, id int64) error {
-	return a.Delete(ctx, bizId, id)
+	err := a.repo.Delete(ctx, bizId, id)
+	if err == nil {
+		a.cache.Invalidate(bizId)
+	}
+	return err
 }
 
 func (a *attributeDefinitionSvc) FindByBizID(ctx context.Context, bizId int64) (domain.BizAttrDefinition, error) {
-	return a.FindByBizID(ctx, bizId)
+	return a.repo.FindByBizID(ctx, bizId)
 }
 
 func (a *attributeDefinitionSvc) FindByBizIdAndId(ctx context.Context, bizId, id int64) (domain.AttributeDefinition, error) {
 	return a.repo.FindByBizIdAndId(ctx, bizId, id)
 }
 
-func NewAttributeDefinitionSvc(repo repository.AttributeDefinitionRepository) AttributeDefinitionSvc {
-	return &attributeDefinitionSvc{repo: repo}
+func NewAttributeDefinitionSvc(repo repository.AttributeDefinitionRepository, cache PolicyCache) AttributeDefinitionSvc {
+	return &attributeDefinitionSvc{repo: repo, cache: cache}
 }
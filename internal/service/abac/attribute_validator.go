@@ -0,0 +1,203 @@
+package abac
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/service/abac/converter"
+)
+
+// ValidateAttrValue 按属性定义校验属性值：正则、数据类型以及结构化约束。
+// array 的 Enum、Min、Max 等约束作用于每个元素；自定义数据类型不校验类型
+func ValidateAttrValue(def domain.AttributeDefinition, value string) error {
+	if def.ValidationRule != "" {
+		matched, err := regexp.MatchString(def.ValidationRule, value)
+		if err != nil {
+			return fmt.Errorf("%w: 属性 %s 的正则表达式语法错误: %w", errs.ErrInvalidAttrConstraint, def.Name, err)
+		}
+		if !matched {
+			return fmt.Errorf("%w: 属性 %s 的值 %s 不符合 %s", errs.ErrInvalidAttrValue, def.Name, value, def.ValidationRule)
+		}
+	}
+	if err := validateTypedValue(def.DataType, def.Constraints, value); err != nil {
+		return fmt.Errorf("%w: 属性 %s(%s) 的值 %s %s", errs.ErrInvalidAttrValue, def.Name, def.DataType, value, err.Error())
+	}
+	return nil
+}
+
+// ValidateAttrDefinition 保存属性定义前校验约束是否与数据类型匹配，以及默认值是否满足约束
+func ValidateAttrDefinition(def domain.AttributeDefinition) error {
+	if def.ResourceType != "" && def.EntityType != domain.ResourceTypeEntity {
+		return fmt.Errorf("%w: 只有资源属性可以指定资源类型", errs.ErrInvalidAttrConstraint)
+	}
+	if dataType, ok := domain.BuiltinEnvAttrs[def.Name]; ok && def.EntityType == domain.EnvironmentTypeEntity && def.DataType != dataType {
+		return fmt.Errorf("%w: 内置环境属性 %s 的数据类型必须是 %s", errs.ErrInvalidAttrConstraint, def.Name, dataType)
+	}
+	if def.ValidationRule != "" {
+		if _, err := regexp.Compile(def.ValidationRule); err != nil {
+			return fmt.Errorf("%w: 正则表达式语法错误: %w", errs.ErrInvalidAttrConstraint, err)
+		}
+	}
+	c := def.Constraints
+	elemType := def.DataType
+	if def.DataType == domain.DataTypeArray {
+		elemType = c.ElemType
+		if c.MaxLen < 0 || c.ElemType == domain.DataTypeArray {
+			return fmt.Errorf("%w: array 的最大长度不能小于 0，元素不能是 array", errs.ErrInvalidAttrConstraint)
+		}
+	} else if c.ElemType != "" || c.MaxLen != 0 {
+		return fmt.Errorf("%w: 只有 array 可以限制元素类型以及长度", errs.ErrInvalidAttrConstraint)
+	}
+	if c.Min != nil || c.Max != nil {
+		if elemType != domain.DataTypeNumber && elemType != domain.DataTypeFloat {
+			return fmt.Errorf("%w: 只有 number、float 可以限制取值范围", errs.ErrInvalidAttrConstraint)
+		}
+		if c.Min != nil && c.Max != nil && *c.Min > *c.Max {
+			return fmt.Errorf("%w: 最小值大于最大值", errs.ErrInvalidAttrConstraint)
+		}
+	}
+	if c.NotBefore != 0 || c.NotAfter != 0 {
+		if elemType != domain.DataTypeDatetime {
+			return fmt.Errorf("%w: 只有 datetime 可以限制时间范围", errs.ErrInvalidAttrConstraint)
+		}
+		if c.NotBefore != 0 && c.NotAfter != 0 && c.NotBefore > c.NotAfter {
+			return fmt.Errorf("%w: 开始时间晚于结束时间", errs.ErrInvalidAttrConstraint)
+		}
+	}
+	// 枚举值本身也要满足类型以及其他约束
+	enumConstraints := c
+	enumConstraints.Enum = nil
+	for _, val := range c.Enum {
+		if err := validateTypedValue(elemType, elemConstraints(enumConstraints), val); err != nil {
+			return fmt.Errorf("%w: 枚举值 %s %s", errs.ErrInvalidAttrConstraint, val, err.Error())
+		}
+	}
+	if def.DefaultValue != "" {
+		return ValidateAttrValue(def, def.DefaultValue)
+	}
+	return nil
+}
+
+// validateRuleLiteral 规则中的比较值会与属性值比较，也需要是合法的属性值。
+// 只校验能够对应到属性值的比较值：=、!= 的比较值，IN、NOT IN 的每一项，
+// ANY_MATCH、ALL_MATCH 的每个元素；datetime 只校验时间戳，时间规则由 evaluator 校验
+func validateRuleLiteral(def domain.AttributeDefinition, op domain.RuleOperator, wantVal string) error {
+	var literals []string
+	switch op {
+	case domain.Equals, domain.NotEquals:
+		literals = []string{wantVal}
+	case domain.IN, domain.NotIn:
+		var list []json.RawMessage
+		if err := json.Unmarshal([]byte(wantVal), &list); err != nil {
+			return fmt.Errorf("%w: %s 不是列表", errs.ErrInvalidPolicyRule, wantVal)
+		}
+		for _, raw := range list {
+			var str string
+			if json.Unmarshal(raw, &str) != nil {
+				str = string(raw)
+			}
+			literals = append(literals, str)
+		}
+	case domain.AnyMatch, domain.AllMatch:
+		elems, err := converter.NewArrayConverter().Decode(wantVal)
+		if err != nil {
+			return fmt.Errorf("%w: %s 不是数组", errs.ErrInvalidPolicyRule, wantVal)
+		}
+		elemDef := def
+		elemDef.DataType, elemDef.Constraints = def.Constraints.ElemType, elemConstraints(def.Constraints)
+		elemDef.ValidationRule = ""
+		def, literals = elemDef, elems
+	default:
+		return nil
+	}
+	for _, literal := range literals {
+		if def.DataType == domain.DataTypeDatetime {
+			if _, err := converter.NewTimeConverter().Decode(literal); err != nil {
+				continue
+			}
+		}
+		if err := ValidateAttrValue(def, literal); err != nil {
+			return fmt.Errorf("%w: %w", errs.ErrInvalidPolicyRule, err)
+		}
+	}
+	return nil
+}
+
+// elemConstraints array 元素使用的约束
+func elemConstraints(c domain.AttrConstraints) domain.AttrConstraints {
+	c.ElemType, c.MaxLen = "", 0
+	return c
+}
+
+func validateTypedValue(dataType domain.DataType, c domain.AttrConstraints, value string) error {
+	switch dataType {
+	case domain.DataTypeNumber:
+		val, err := converter.NewNumberConverter().Decode(value)
+		if err != nil {
+			return errors.New("不是整数")
+		}
+		if err = checkRange(float64(val), c); err != nil {
+			return err
+		}
+	case domain.DataTypeFloat:
+		val, err := converter.NewFloatConverter().Decode(value)
+		if err != nil {
+			return errors.New("不是数字")
+		}
+		if err = checkRange(val, c); err != nil {
+			return err
+		}
+	case domain.DataTypeBoolean:
+		if _, err := converter.NewBoolConverter().Decode(value); err != nil {
+			return errors.New("不是布尔值")
+		}
+	case domain.DataTypeIP:
+		if _, err := converter.NewIPConverter().Decode(value); err != nil {
+			return errors.New("不是 IP 地址")
+		}
+	case domain.DataTypeDatetime:
+		val, err := converter.NewTimeConverter().Decode(value)
+		if err != nil {
+			return errors.New("不是毫秒时间戳")
+		}
+		if c.NotBefore != 0 && val.UnixMilli() < c.NotBefore {
+			return fmt.Errorf("早于 %d", c.NotBefore)
+		}
+		if c.NotAfter != 0 && val.UnixMilli() > c.NotAfter {
+			return fmt.Errorf("晚于 %d", c.NotAfter)
+		}
+	case domain.DataTypeArray:
+		elems, err := converter.NewArrayConverter().Decode(value)
+		if err != nil {
+			return errors.New("不是字符串数组")
+		}
+		if c.MaxLen > 0 && len(elems) > c.MaxLen {
+			return fmt.Errorf("超过最大长度 %d", c.MaxLen)
+		}
+		for _, elem := range elems {
+			if err = validateTypedValue(c.ElemType, elemConstraints(c), elem); err != nil {
+				return fmt.Errorf("中的元素 %s %s", elem, err.Error())
+			}
+		}
+		return nil
+	}
+	if len(c.Enum) > 0 && !slices.Contains(c.Enum, value) {
+		return fmt.Errorf("不在 %v 中", c.Enum)
+	}
+	return nil
+}
+
+func checkRange(val float64, c domain.AttrConstraints) error {
+	if c.Min != nil && val < *c.Min {
+		return fmt.Errorf("小于最小值 %v", *c.Min)
+	}
+	if c.Max != nil && val > *c.Max {
+		return fmt.Errorf("大于最大值 %v", *c.Max)
+	}
+	return nil
+}
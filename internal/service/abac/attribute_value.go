@@ -2,6 +2,8 @@ package abac
 
 import (
 	"context"
+	"io"
+
 	"github.com/permission-dev/internal/domain"
 	"github.com/permission-dev/internal/repository"
 )
@@ -21,11 +23,49 @@ type AttributeValueSvc interface {
 	DeleteEnvironmentValue(ctx context.Context, bizID, id int64) error
 	FindEnvironmentValue(ctx context.Context, bizID int64) (domain.ABACObject, error)
 	FindEnvironmentValueWithDefinition(ctx context.Context, bizID int64) (domain.ABACObject, error)
+
+	// ImportValues 从 r 读取 CSV 或者 NDJSON，批量写入主体或者资源的属性值。按属性名匹配属性定义，
+	// 每一行单独校验，不合法的行记录在结果中，其余行按批在事务中写入
+	ImportValues(ctx context.Context, bizID int64, entityType domain.EntityType, format domain.AttrValueFormat, r io.Reader) (domain.AttrValueImportResult, error)
+	// ExportValues 把主体或者资源的全部属性值按 format 写入 w，格式与 ImportValues 相同
+	ExportValues(ctx context.Context, bizID int64, entityType domain.EntityType, format domain.AttrValueFormat, w io.Writer) error
 }
 type attributeValueSvc struct {
 	repository.AttributeValueRepository
+	attrRepo repository.AttributeDefinitionRepository
+}
+
+func NewAttributeValueSvc(repository repository.AttributeValueRepository, attrRepo repository.AttributeDefinitionRepository) AttributeValueSvc {
+	return &attributeValueSvc{AttributeValueRepository: repository, attrRepo: attrRepo}
+}
+
+// 以下保存操作先按属性定义校验属性值
+
+func (a *attributeValueSvc) SaveSubjectValue(ctx context.Context, bizID, subjectID int64, val domain.AttributeValue) (int64, error) {
+	if err := a.validate(ctx, bizID, val); err != nil {
+		return 0, err
+	}
+	return a.AttributeValueRepository.SaveSubjectValue(ctx, bizID, subjectID, val)
+}
+
+func (a *attributeValueSvc) SaveResourceValue(ctx context.Context, bizID, resourceID int64, val domain.AttributeValue) (int64, error) {
+	if err := a.validate(ctx, bizID, val); err != nil {
+		return 0, err
+	}
+	return a.AttributeValueRepository.SaveResourceValue(ctx, bizID, resourceID, val)
+}
+
+func (a *attributeValueSvc) SaveEnvironmentValue(ctx context.Context, bizID int64, val domain.AttributeValue) (int64, error) {
+	if err := a.validate(ctx, bizID, val); err != nil {
+		return 0, err
+	}
+	return a.AttributeValueRepository.SaveEnvironmentValue(ctx, bizID, val)
 }
 
-func NewAttributeValueSvc(repository repository.AttributeValueRepository) AttributeValueSvc {
-	return &attributeValueSvc{repository}
+func (a *attributeValueSvc) validate(ctx context.Context, bizID int64, val domain.AttributeValue) error {
+	def, err := a.attrRepo.FindByBizIdAndId(ctx, bizID, val.AttrDef.ID)
+	if err != nil {
+		return err
+	}
+	return ValidateAttrValue(def, val.Value)
 }
@@ -0,0 +1,381 @@
+package abac
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+)
+
+const (
+	// importBatchSize 导入时每个事务写入的行数
+	importBatchSize = 500
+	// exportPageSize 导出时每次查询的行数
+	exportPageSize = 1000
+	// maxNDJSONLineSize NDJSON 单行的最大长度
+	maxNDJSONLineSize = 1 << 20
+)
+
+var attrValueColumns = []string{"entity_id", "attribute", "value"}
+
+// resourceTypeColumn 可选的列，资源属性的所属资源类型，用于区分不同资源类型的同名属性
+const resourceTypeColumn = "resource_type"
+
+func (a *attributeValueSvc) ImportValues(ctx context.Context, bizID int64, entityType domain.EntityType, format domain.AttrValueFormat, r io.Reader) (domain.AttrValueImportResult, error) {
+	var res domain.AttrValueImportResult
+	if entityType != domain.SubjectTypeEntity && entityType != domain.ResourceTypeEntity {
+		return res, errs.ErrUnsupportedBulkEntity
+	}
+	reader, err := newAttrValueReader(format, r)
+	if err != nil {
+		return res, err
+	}
+	defs, err := a.attrRepo.FindByBizID(ctx, bizID)
+	if err != nil {
+		return res, err
+	}
+	attrDefs := defs.SubjectAttrDefs
+	if entityType == domain.ResourceTypeEntity {
+		attrDefs = defs.ResourceAttrDefs
+	}
+	byName := make(map[string][]domain.AttributeDefinition, len(attrDefs))
+	for _, def := range attrDefs {
+		byName[def.Name] = append(byName[def.Name], def)
+	}
+
+	batch := make([]domain.EntityAttributeValue, 0, importBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		// 写入失败时中止导入，之前的批次已经提交，不会回滚
+		if err := a.AttributeValueRepository.BatchSaveValues(ctx, bizID, entityType, batch); err != nil {
+			return err
+		}
+		res.Succeeded += int64(len(batch))
+		batch = batch[:0]
+		return nil
+	}
+	for {
+		row, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return res, err
+		}
+		res.Total++
+		val, err := row.toValue(byName)
+		if err != nil {
+			res.Failed++
+			if len(res.Errors) < domain.MaxAttrValueImportErrors {
+				res.Errors = append(res.Errors, domain.AttrValueRowError{Line: row.line, Message: err.Error()})
+			}
+			continue
+		}
+		batch = append(batch, val)
+		if len(batch) == importBatchSize {
+			if err = flush(); err != nil {
+				return res, err
+			}
+		}
+	}
+	return res, flush()
+}
+
+func (a *attributeValueSvc) ExportValues(ctx context.Context, bizID int64, entityType domain.EntityType, format domain.AttrValueFormat, w io.Writer) error {
+	if entityType != domain.SubjectTypeEntity && entityType != domain.ResourceTypeEntity {
+		return errs.ErrUnsupportedBulkEntity
+	}
+	writer, err := newAttrValueWriter(format, entityType, w)
+	if err != nil {
+		return err
+	}
+	defs, err := a.attrRepo.FindByBizID(ctx, bizID)
+	if err != nil {
+		return err
+	}
+	var afterID int64
+	for {
+		values, err := a.AttributeValueRepository.FindValuesByBizID(ctx, bizID, entityType, afterID, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, val := range values {
+			// 属性定义已经删除的值不导出
+			def, ok := defs.GetByDefId(val.Value.AttrDef.ID)
+			if !ok {
+				continue
+			}
+			row := attrValueRow{entityID: strconv.FormatInt(val.EntityID, 10), attribute: def.Name, value: val.Value.Value, resourceType: def.ResourceType}
+			if err = writer.Write(row); err != nil {
+				return err
+			}
+		}
+		if len(values) < exportPageSize {
+			return writer.Flush()
+		}
+		afterID = values[len(values)-1].Value.ID
+	}
+}
+
+// attrValueRow 导入、导出的一行，entityID 保留原始文本，校验时再解析
+type attrValueRow struct {
+	line      int64
+	entityID  string
+	attribute string
+	value     string
+	// resourceType 属性所属的资源类型，为空表示共用的属性或者没有指定
+	resourceType string
+	// err 这一行的格式错误，只影响这一行
+	err error
+}
+
+func (r attrValueRow) toValue(byName map[string][]domain.AttributeDefinition) (domain.EntityAttributeValue, error) {
+	if r.err != nil {
+		return domain.EntityAttributeValue{}, r.err
+	}
+	entityID, err := strconv.ParseInt(r.entityID, 10, 64)
+	if err != nil || entityID <= 0 {
+		return domain.EntityAttributeValue{}, fmt.Errorf("entity_id %q 无效", r.entityID)
+	}
+	def, err := r.attrDef(byName[r.attribute])
+	if err != nil {
+		return domain.EntityAttributeValue{}, err
+	}
+	if err = ValidateAttrValue(def, r.value); err != nil {
+		return domain.EntityAttributeValue{}, err
+	}
+	return domain.EntityAttributeValue{
+		EntityID: entityID,
+		Value:    domain.AttributeValue{AttrDef: def, Value: r.value},
+	}, nil
+}
+
+// attrDef 从同名的属性定义中选出这一行的属性：指定了资源类型时优先使用该资源类型专属的属性，
+// 其次是共用的属性；没有指定时使用共用的属性，只有一个资源类型定义了该属性时也可以省略
+func (r attrValueRow) attrDef(defs []domain.AttributeDefinition) (domain.AttributeDefinition, error) {
+	if len(defs) == 0 {
+		return domain.AttributeDefinition{}, fmt.Errorf("属性 %s 未定义", r.attribute)
+	}
+	if r.resourceType != "" {
+		if idx := slices.IndexFunc(defs, func(def domain.AttributeDefinition) bool {
+			return def.ResourceType == r.resourceType
+		}); idx >= 0 {
+			return defs[idx], nil
+		}
+	}
+	if idx := slices.IndexFunc(defs, func(def domain.AttributeDefinition) bool {
+		return def.ResourceType == ""
+	}); idx >= 0 {
+		return defs[idx], nil
+	}
+	if r.resourceType != "" {
+		return domain.AttributeDefinition{}, fmt.Errorf("资源类型 %s 没有属性 %s", r.resourceType, r.attribute)
+	}
+	if len(defs) > 1 {
+		return domain.AttributeDefinition{}, fmt.Errorf("属性 %s 在多个资源类型中定义，需要指定 %s", r.attribute, resourceTypeColumn)
+	}
+	return defs[0], nil
+}
+
+// attrValueReader Read 返回下一个数据行，格式错误的行也会返回，由校验记录错误；结束时返回 io.EOF
+type attrValueReader interface {
+	Read() (attrValueRow, error)
+}
+
+type attrValueWriter interface {
+	Write(row attrValueRow) error
+	Flush() error
+}
+
+func newAttrValueReader(format domain.AttrValueFormat, r io.Reader) (attrValueReader, error) {
+	switch format {
+	case domain.AttrValueFormatCSV:
+		reader := csv.NewReader(r)
+		reader.FieldsPerRecord = -1
+		reader.ReuseRecord = true
+		return &csvAttrValueReader{reader: reader}, nil
+	case domain.AttrValueFormatNDJSON:
+		scanner := bufio.NewScanner(r)
+		scanner.Buffer(make([]byte, 0, 64*1024), maxNDJSONLineSize)
+		return &ndjsonAttrValueReader{scanner: scanner}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errs.ErrUnsupportedValueFormat, format)
+	}
+}
+
+// newAttrValueWriter 导出资源属性值时 CSV 多一列 resource_type
+func newAttrValueWriter(format domain.AttrValueFormat, entityType domain.EntityType, w io.Writer) (attrValueWriter, error) {
+	switch format {
+	case domain.AttrValueFormatCSV:
+		return &csvAttrValueWriter{writer: csv.NewWriter(w), resourceType: entityType == domain.ResourceTypeEntity}, nil
+	case domain.AttrValueFormatNDJSON:
+		return &ndjsonAttrValueWriter{encoder: json.NewEncoder(w)}, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errs.ErrUnsupportedValueFormat, format)
+	}
+}
+
+type csvAttrValueReader struct {
+	reader *csv.Reader
+	// columns entity_id、attribute、value 以及 resource_type 在记录中的下标，读取表头后设置，
+	// 没有 resource_type 列时为 -1
+	columns []int
+}
+
+func (c *csvAttrValueReader) Read() (attrValueRow, error) {
+	if c.columns == nil {
+		if err := c.readHeader(); err != nil {
+			return attrValueRow{}, err
+		}
+	}
+	record, err := c.reader.Read()
+	var parseErr *csv.ParseError
+	if errors.As(err, &parseErr) {
+		return attrValueRow{line: int64(parseErr.StartLine), err: fmt.Errorf("CSV 格式错误: %w", parseErr.Err)}, nil
+	}
+	if err != nil {
+		return attrValueRow{}, err
+	}
+	line, _ := c.reader.FieldPos(0)
+	row := attrValueRow{line: int64(line)}
+	fields := []*string{&row.entityID, &row.attribute, &row.value, &row.resourceType}
+	for idx, column := range c.columns {
+		if column >= 0 && column < len(record) {
+			*fields[idx] = record[column]
+		}
+	}
+	return row, nil
+}
+
+func (c *csvAttrValueReader) readHeader() error {
+	header, err := c.reader.Read()
+	if errors.Is(err, io.EOF) {
+		return err
+	}
+	if err != nil {
+		return fmt.Errorf("%w: CSV 表头无效: %w", errs.ErrUnsupportedValueFormat, err)
+	}
+	index := make(map[string]int, len(header))
+	for idx, name := range header {
+		// Excel 导出的 CSV 以 BOM 开头
+		index[strings.TrimSpace(strings.TrimPrefix(name, "\ufeff"))] = idx
+	}
+	c.columns = make([]int, 0, len(attrValueColumns)+1)
+	for _, name := range attrValueColumns {
+		idx, ok := index[name]
+		if !ok {
+			return fmt.Errorf("%w: CSV 表头缺少 %s", errs.ErrUnsupportedValueFormat, name)
+		}
+		c.columns = append(c.columns, idx)
+	}
+	if idx, ok := index[resourceTypeColumn]; ok {
+		c.columns = append(c.columns, idx)
+	} else {
+		c.columns = append(c.columns, -1)
+	}
+	return nil
+}
+
+type csvAttrValueWriter struct {
+	writer *csv.Writer
+	header bool
+	// resourceType 是否输出 resource_type 列
+	resourceType bool
+}
+
+func (c *csvAttrValueWriter) Write(row attrValueRow) error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	record := []string{row.entityID, row.attribute, row.value}
+	if c.resourceType {
+		record = append(record, row.resourceType)
+	}
+	return c.writer.Write(record)
+}
+
+// Flush 没有数据时也输出表头
+func (c *csvAttrValueWriter) Flush() error {
+	if err := c.writeHeader(); err != nil {
+		return err
+	}
+	c.writer.Flush()
+	return c.writer.Error()
+}
+
+func (c *csvAttrValueWriter) writeHeader() error {
+	if c.header {
+		return nil
+	}
+	c.header = true
+	if c.resourceType {
+		return c.writer.Write(append(slices.Clone(attrValueColumns), resourceTypeColumn))
+	}
+	return c.writer.Write(attrValueColumns)
+}
+
+// ndjsonAttrValue NDJSON 的一行，导入时 value 可以是任意 JSON 值
+type ndjsonAttrValue struct {
+	EntityID     json.Number     `json:"entity_id"`
+	Attribute    string          `json:"attribute"`
+	Value        json.RawMessage `json:"value"`
+	ResourceType string          `json:"resource_type,omitempty"`
+}
+
+type ndjsonAttrValueReader struct {
+	scanner *bufio.Scanner
+	line    int64
+}
+
+func (n *ndjsonAttrValueReader) Read() (attrValueRow, error) {
+	for n.scanner.Scan() {
+		n.line++
+		data := bytes.TrimSpace(n.scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		row := attrValueRow{line: n.line}
+		var val ndjsonAttrValue
+		if err := json.Unmarshal(data, &val); err != nil {
+			row.err = fmt.Errorf("JSON 格式错误: %w", err)
+			return row, nil
+		}
+		row.entityID, row.attribute, row.resourceType = val.EntityID.String(), val.Attribute, val.ResourceType
+		if json.Unmarshal(val.Value, &row.value) != nil {
+			row.value = string(val.Value)
+		}
+		return row, nil
+	}
+	if err := n.scanner.Err(); err != nil {
+		return attrValueRow{}, err
+	}
+	return attrValueRow{}, io.EOF
+}
+
+type ndjsonAttrValueWriter struct {
+	encoder *json.Encoder
+}
+
+func (n *ndjsonAttrValueWriter) Write(row attrValueRow) error {
+	value, err := json.Marshal(row.value)
+	if err != nil {
+		return err
+	}
+	return n.encoder.Encode(ndjsonAttrValue{EntityID: json.Number(row.entityID), Attribute: row.attribute, Value: value, ResourceType: row.resourceType})
+}
+
+func (n *ndjsonAttrValueWriter) Flush() error {
+	return nil
+}
@@ -0,0 +1,55 @@
+package converter
+
+import "net/netip"
+
+// IPConverter IPv4 或者 IPv6 地址，IPv4 映射的 IPv6 地址（::ffff:1.2.3.4）按 IPv4 处理
+type IPConverter struct {
+}
+
+func (i *IPConverter) Decode(str string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(str)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+	return addr.Unmap(), nil
+}
+
+func (i *IPConverter) Encode(t netip.Addr) (string, error) {
+	return t.Unmap().String(), nil
+}
+
+func NewIPConverter() *IPConverter {
+	return &IPConverter{}
+}
+
+// CIDRConverter 网段，例如 10.0.0.0/8、fd00::/8，单个地址按 /32 或者 /128 处理
+type CIDRConverter struct {
+}
+
+func (c *CIDRConverter) Decode(str string) (netip.Prefix, error) {
+	if addr, err := netip.ParseAddr(str); err == nil {
+		addr = addr.Unmap()
+		return netip.PrefixFrom(addr, addr.BitLen()), nil
+	}
+	prefix, err := netip.ParsePrefix(str)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	if prefix.Addr().Is4In6() {
+		// ::ffff:10.0.0.0/104 转成 10.0.0.0/8
+		bits := prefix.Bits() - 96
+		if bits < 0 {
+			bits = 0
+		}
+		prefix = netip.PrefixFrom(prefix.Addr().Unmap(), bits)
+	}
+	return prefix.Masked(), nil
+}
+
+func (c *CIDRConverter) Encode(t netip.Prefix) (string, error) {
+	return t.String(), nil
+}
+
+func NewCIDRConverter() *CIDRConverter {
+	return &CIDRConverter{}
+}
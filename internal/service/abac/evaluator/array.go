@@ -62,3 +62,34 @@ func (a *ArrayEvaluator) CheckAllMatch(wantArray, actualArray []string) bool {
 	}
 	return true
 }
+
+// Compile 比较值预先解析成集合
+func (a *ArrayEvaluator) Compile(wantVal string, op domain.RuleOperator) (Matcher, error) {
+	if op != domain.AnyMatch && op != domain.AllMatch {
+		return nil, errs.ErrUnkonwOperator
+	}
+	wantArray, err := a.converter.Decode(wantVal)
+	if err != nil {
+		return nil, err
+	}
+	wantMap := make(map[string]struct{}, len(wantArray))
+	for index := range wantArray {
+		wantMap[wantArray[index]] = struct{}{}
+	}
+	return MatcherFunc(func(actualVal string) (bool, error) {
+		actualArray, err := a.converter.Decode(actualVal)
+		if err != nil || len(actualArray) == 0 {
+			return false, err
+		}
+		for index := range actualArray {
+			_, ok := wantMap[actualArray[index]]
+			if op == domain.AnyMatch && ok {
+				return true, nil
+			}
+			if op == domain.AllMatch && !ok {
+				return false, nil
+			}
+		}
+		return op == domain.AllMatch, nil
+	}), nil
+}
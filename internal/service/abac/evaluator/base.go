@@ -36,6 +36,25 @@ func isSlice(op domain.RuleOperator) bool {
 	return op == domain.IN || op == domain.NotIn
 }
 
+// sliceMatcher IN、NOT IN 预先把列表转换成集合，decode 把属性值转换成列表元素的类型
+func sliceMatcher[T comparable](list []T, op domain.RuleOperator, decode func(str string) (T, error)) (Matcher, error) {
+	if !isSlice(op) {
+		return nil, errs.ErrUnkonwOperator
+	}
+	set := make(map[T]struct{}, len(list))
+	for index := range list {
+		set[list[index]] = struct{}{}
+	}
+	return MatcherFunc(func(actualVal string) (bool, error) {
+		val, err := decode(actualVal)
+		if err != nil {
+			return false, err
+		}
+		_, ok := set[val]
+		return ok == (op == domain.IN), nil
+	}), nil
+}
+
 func sliceEvaluator[T comparable](wantedVal []T, actualVal T, op domain.RuleOperator) (bool, error) {
 	switch op {
 	case domain.IN:
@@ -36,3 +36,21 @@ func (b *BoolEvaluator) getData(wantVal, actualVal string) (convWantVal, convAct
 	convActualVal, err = b.converter.Decode(actualVal)
 	return convWantVal, convActualVal, err
 }
+
+// Compile 预先解析比较值
+func (b *BoolEvaluator) Compile(wantVal string, op domain.RuleOperator) (Matcher, error) {
+	if op != domain.Equals && op != domain.NotEquals {
+		return nil, errs.ErrUnkonwOperator
+	}
+	boolWantVal, err := b.converter.Decode(wantVal)
+	if err != nil {
+		return nil, err
+	}
+	return MatcherFunc(func(actualVal string) (bool, error) {
+		boolActualVal, err := b.converter.Decode(actualVal)
+		if err != nil {
+			return false, err
+		}
+		return (boolWantVal == boolActualVal) == (op == domain.Equals), nil
+	}), nil
+}
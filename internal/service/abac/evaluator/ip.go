@@ -0,0 +1,94 @@
+package evaluator
+
+import (
+	"encoding/json"
+	"net/netip"
+	"strings"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/service/abac/converter"
+)
+
+// IPEvaluator IP 类型，支持 =、!=、IN CIDR、NOT IN CIDR，IPv4 和 IPv6 都可以使用。
+// IN CIDR 的比较值为网段列表，例如 ["10.0.0.0/8","fd00::/8"]，也可以是单个网段
+type IPEvaluator struct {
+	converter     converter.Converter[netip.Addr]
+	cidrConverter converter.Converter[netip.Prefix]
+}
+
+func NewIPEvaluator() *IPEvaluator {
+	return &IPEvaluator{
+		converter:     converter.NewIPConverter(),
+		cidrConverter: converter.NewCIDRConverter(),
+	}
+}
+
+func (i *IPEvaluator) Evaluator(wantVal, actualVal string, op domain.RuleOperator) (bool, error) {
+	matcher, err := i.Compile(wantVal, op)
+	if err != nil {
+		return false, err
+	}
+	return matcher.Match(actualVal)
+}
+
+// Validate 保存规则时校验地址以及网段的格式
+func (i *IPEvaluator) Validate(wantVal string, op domain.RuleOperator) error {
+	_, err := i.Compile(wantVal, op)
+	return err
+}
+
+// Compile 预先解析比较的地址或者网段
+func (i *IPEvaluator) Compile(wantVal string, op domain.RuleOperator) (Matcher, error) {
+	var match func(addr netip.Addr) bool
+	switch op {
+	case domain.Equals, domain.NotEquals:
+		want, err := i.converter.Decode(wantVal)
+		if err != nil {
+			return nil, err
+		}
+		match = func(addr netip.Addr) bool {
+			return (want == addr) == (op == domain.Equals)
+		}
+	case domain.InCIDR, domain.NotInCIDR:
+		prefixes, err := i.getPrefixes(wantVal)
+		if err != nil {
+			return nil, err
+		}
+		match = func(addr netip.Addr) bool {
+			contains := slice.ContainsFunc(prefixes, func(src netip.Prefix) bool {
+				return src.Contains(addr)
+			})
+			return contains == (op == domain.InCIDR)
+		}
+	default:
+		return nil, errs.ErrUnkonwOperator
+	}
+	return MatcherFunc(func(actualVal string) (bool, error) {
+		addr, err := i.converter.Decode(actualVal)
+		if err != nil {
+			return false, err
+		}
+		return match(addr), nil
+	}), nil
+}
+
+func (i *IPEvaluator) getPrefixes(wantVal string) ([]netip.Prefix, error) {
+	list := []string{wantVal}
+	if strings.HasPrefix(strings.TrimSpace(wantVal), "[") {
+		list = nil
+		if err := json.Unmarshal([]byte(wantVal), &list); err != nil {
+			return nil, err
+		}
+	}
+	res := make([]netip.Prefix, 0, len(list))
+	for _, item := range list {
+		prefix, err := i.cidrConverter.Decode(strings.TrimSpace(item))
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, prefix)
+	}
+	return res, nil
+}
@@ -0,0 +1,27 @@
+package evaluator
+
+import "github.com/permission-dev/internal/domain"
+
+// Matcher 已经解析好比较值的规则，只需要传入属性值，创建后不再修改，可以并发使用
+type Matcher interface {
+	Match(actualVal string) (bool, error)
+}
+
+type MatcherFunc func(actualVal string) (bool, error)
+
+func (f MatcherFunc) Match(actualVal string) (bool, error) {
+	return f(actualVal)
+}
+
+// Compiler 可选接口，PolicyRuleEvaluator 实现后编译策略时预先解析比较值，
+// 例如把 IN 的列表解析成集合，避免每次执行都重新解析
+type Compiler interface {
+	Compile(wantVal string, op domain.RuleOperator) (Matcher, error)
+}
+
+// evaluatorMatcher 没有实现 Compiler 的 evaluator 每次执行时再解析比较值
+func evaluatorMatcher(evaluator PolicyRuleEvaluator, wantVal string, op domain.RuleOperator) Matcher {
+	return MatcherFunc(func(actualVal string) (bool, error) {
+		return evaluator.Evaluator(wantVal, actualVal, op)
+	})
+}
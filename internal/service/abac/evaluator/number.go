@@ -45,3 +45,25 @@ func (n *NumberEvaluator) Evaluator(wantVal, actualVal string, op domain.RuleOpe
 	}
 	return baseEvaluator[int64](convWantVal, convActualVal, op)
 }
+
+// Compile 预先解析比较值，IN、NOT IN 的列表解析成集合
+func (n *NumberEvaluator) Compile(wantVal string, op domain.RuleOperator) (Matcher, error) {
+	if isSlice(op) {
+		var list []int64
+		if err := json.Unmarshal([]byte(wantVal), &list); err != nil {
+			return nil, err
+		}
+		return sliceMatcher[int64](list, op, n.converter.Decode)
+	}
+	convWantVal, err := n.converter.Decode(wantVal)
+	if err != nil {
+		return nil, err
+	}
+	return MatcherFunc(func(actualVal string) (bool, error) {
+		convActualVal, err := n.converter.Decode(actualVal)
+		if err != nil {
+			return false, err
+		}
+		return baseEvaluator[int64](convWantVal, convActualVal, op)
+	}), nil
+}
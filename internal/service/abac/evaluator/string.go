@@ -22,14 +22,33 @@ func (s *StringEvaluator) Evaluator(wantVal, actualVal string, op domain.RuleOpe
 	}
 	switch op {
 	case domain.Equals:
-		return wantVal == wantVal, nil
+		return wantVal == actualVal, nil
 	case domain.NotEquals:
-		return wantVal != wantVal, nil
+		return wantVal != actualVal, nil
 	default:
 		return false, errs.ErrUnkonwOperator
 	}
 }
 
+// Compile IN、NOT IN 的列表预先解析成集合
+func (s *StringEvaluator) Compile(wantVal string, op domain.RuleOperator) (Matcher, error) {
+	if isSlice(op) {
+		list, err := s.GetSliceData(wantVal)
+		if err != nil {
+			return nil, err
+		}
+		return sliceMatcher[string](list, op, func(str string) (string, error) {
+			return str, nil
+		})
+	}
+	if op != domain.Equals && op != domain.NotEquals {
+		return nil, errs.ErrUnkonwOperator
+	}
+	return MatcherFunc(func(actualVal string) (bool, error) {
+		return (wantVal == actualVal) == (op == domain.Equals), nil
+	}), nil
+}
+
 func (s *StringEvaluator) GetSliceData(wantVal string) (res []string, err error) {
 	err = json.Unmarshal([]byte(wantVal), &res)
 	if err != nil {
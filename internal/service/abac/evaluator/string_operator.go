@@ -0,0 +1,42 @@
+package evaluator
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/permission-dev/internal/domain"
+)
+
+// 通过 Selector.RegisterOperator 注册到字符串类型上的运算符，也是自定义运算符的示例
+const (
+	OperatorMatches    domain.RuleOperator = "MATCHES"     // 正则匹配
+	OperatorStartsWith domain.RuleOperator = "STARTS_WITH" // 前缀
+	OperatorEndsWith   domain.RuleOperator = "ENDS_WITH"   // 后缀
+	OperatorContains   domain.RuleOperator = "CONTAINS"    // 包含子串
+)
+
+func stringOperators() map[domain.RuleOperator]OperatorEvaluator {
+	return map[domain.RuleOperator]OperatorEvaluator{
+		OperatorMatches: regexOperator{},
+		OperatorStartsWith: OperatorFunc(func(wantVal, actualVal string) (bool, error) {
+			return strings.HasPrefix(actualVal, wantVal), nil
+		}),
+		OperatorEndsWith: OperatorFunc(func(wantVal, actualVal string) (bool, error) {
+			return strings.HasSuffix(actualVal, wantVal), nil
+		}),
+		OperatorContains: OperatorFunc(func(wantVal, actualVal string) (bool, error) {
+			return strings.Contains(actualVal, wantVal), nil
+		}),
+	}
+}
+
+type regexOperator struct{}
+
+func (regexOperator) Evaluate(wantVal, actualVal string) (bool, error) {
+	return regexp.MatchString(wantVal, actualVal)
+}
+
+func (regexOperator) Validate(wantVal string) error {
+	_, err := regexp.Compile(wantVal)
+	return err
+}
@@ -2,22 +2,50 @@ package evaluator
 
 import (
 	"fmt"
-	"github.com/ecodeclub/ekit/slice"
-	"github.com/permission-dev/internal/domain"
-	"github.com/permission-dev/internal/service/abac/converter"
 	"strconv"
 	"strings"
 	"time"
+	// 内嵌时区数据，运行环境没有安装 tzdata 时规则中的时区同样可以使用
+	_ "time/tzdata"
+	"unicode"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/service/abac/converter"
 )
 
+// 时间规则的格式为 @类型(参数[,时区][,exclude=日期列表])：
+//
+//	@time(1700000000000)                          毫秒时间戳，也可以直接写时间戳
+//	@day(09:00)、@day(09:00-18:00)                 每天的时间点或者时间段，时间段可以跨过零点，例如 22:00-06:00
+//	@week(1,09:30)、@week(1-5,09:00-18:00)         星期（0 为周日），可以是集合，例如 1-5、1|3|5、5-1
+//	@month(1,09:00)、@month(25-L)                  每月几号，L 表示月末，超过当月天数时按月末处理
+//	@date(2024-10-01)、@date(2024-10-01~2024-10-07|2024-12-25)  日期或者日期范围
+//
+// 时区使用 IANA 名称，例如 @day(09:00-18:00,Asia/Shanghai)，不指定时使用属性值的时区。
+// exclude 为排除的日期，例如节假日，格式与 @date 相同。
+//
+// >、<、>=、<= 与单个时间点比较：@week、@month 分别与本周、本月对应的时间点比较，@date 与当天零点比较。
+// =、!= 判断是否落在规则描述的时间段内：星期、日期以及每天的时间段同时满足，并且不在排除的日期中；
+// @time 使用 =、!= 时判断是否为同一时刻。
 const (
 	timeType  string = "time"
 	dayType          = "day"
 	monthType        = "month"
 	weekType         = "week"
+	dateType         = "date"
+
+	excludePrefix  = "exclude="
+	lastDayOfMonth = "L"
+	dateLayout     = "2006-01-02"
+	setSeparator   = "|"
+	rangeSeparator = "-"
+	// dateRangeSeparator 日期本身包含 -，日期范围使用 ~
+	dateRangeSeparator = "~"
 
 	hoursInDay    = 24
 	minutesInHour = 60
+	minutesInDay  = hoursInDay * minutesInHour
 	daysInWeek    = 7
 	minWeekday    = 0
 	maxWeekday    = 6
@@ -27,27 +55,57 @@ const (
 )
 
 type timeRule struct {
-	Type     string
-	Value    string
-	Operator string
+	Type string
+	// Args 去掉时区以及排除日期之后的参数
+	Args []string
+	// Location 为 nil 时使用属性值的时区
+	Location *time.Location
+	Exclude  []dateRange
 }
 
 func parseTimeRule(rule string) (*timeRule, error) {
+	if _, err := strconv.ParseInt(rule, 10, 64); err == nil {
+		return &timeRule{Type: timeType, Args: []string{rule}}, nil
+	}
 	//@day(9:30)
-	rule = strings.TrimPrefix(rule, "@")
-	parts := strings.SplitN(rule, "(", number2)
-	if len(parts) != 2 {
+	ruleType, value, ok := strings.Cut(strings.TrimPrefix(rule, "@"), "(")
+	if !ok || !strings.HasSuffix(value, ")") {
 		return nil, fmt.Errorf("invalid rule format %s", rule)
 	}
-	ruleType := parts[0]
-	if !slice.Contains[string]([]string{timeType, dayType, monthType, timeType}, ruleType) {
+	if !slice.Contains[string]([]string{timeType, dayType, weekType, monthType, dateType}, ruleType) {
 		return nil, fmt.Errorf("invalid %s rule type  %s", rule, ruleType)
 	}
-	value := strings.TrimSuffix(parts[1], ")")
-	return &timeRule{
-		Type:  ruleType,
-		Value: value,
-	}, nil
+	res := &timeRule{Type: ruleType}
+	for _, arg := range strings.Split(strings.TrimSuffix(value, ")"), ",") {
+		arg = strings.TrimSpace(arg)
+		switch {
+		case strings.HasPrefix(arg, excludePrefix):
+			exclude, err := parseDateRanges(strings.TrimPrefix(arg, excludePrefix))
+			if err != nil {
+				return nil, err
+			}
+			res.Exclude = append(res.Exclude, exclude...)
+		case isLocation(arg):
+			if res.Location != nil {
+				return nil, fmt.Errorf("duplicate time zone %s in rule %s", arg, rule)
+			}
+			loc, err := time.LoadLocation(arg)
+			if err != nil {
+				return nil, fmt.Errorf("invalid time zone %s: %w", arg, err)
+			}
+			res.Location = loc
+		default:
+			res.Args = append(res.Args, arg)
+		}
+	}
+	return res, nil
+}
+
+// isLocation 参数中除了月末的 L 之外还有字母时认为是时区，例如 Asia/Shanghai、UTC
+func isLocation(arg string) bool {
+	return strings.ContainsFunc(arg, func(r rune) bool {
+		return unicode.IsLetter(r) && string(r) != lastDayOfMonth
+	})
 }
 
 type TimeEvaluator struct {
@@ -59,27 +117,62 @@ func NewTimeEvaluator() *TimeEvaluator {
 }
 
 func (t *TimeEvaluator) Evaluator(wantVal, actualVal string, op domain.RuleOperator) (bool, error) {
-	rule, err := parseTimeRule(wantVal)
+	matcher, err := t.Compile(wantVal, op)
 	if err != nil {
 		return false, err
 	}
-	actualTime, err := t.converter.Decode(actualVal)
+	return matcher.Match(actualVal)
+}
+
+// Validate 保存规则时校验时间规则的格式以及是否支持运算符
+func (t *TimeEvaluator) Validate(wantVal string, op domain.RuleOperator) error {
+	_, err := t.Compile(wantVal, op)
+	return err
+}
+
+// Compile 预先解析时间规则
+func (t *TimeEvaluator) Compile(wantVal string, op domain.RuleOperator) (Matcher, error) {
+	rule, err := parseTimeRule(wantVal)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
-	switch rule.Type {
-	case timeType:
-		return checkExactTime(actualTime, rule, op)
-	case dayType:
-		return checkDailyTime(actualTime, rule, op)
-	case weekType:
-		return checkWeeklyTime(actualTime, rule, op)
-	case monthType:
-		return checkMonthlyTime(actualTime, rule, op)
+	var match func(actualTime time.Time) (bool, error)
+	switch op {
+	case domain.Equals, domain.NotEquals:
+		period, err := rule.period()
+		if err != nil {
+			return nil, err
+		}
+		match = func(actualTime time.Time) (bool, error) {
+			return period.contains(actualTime) == (op == domain.Equals), nil
+		}
+	case domain.Greater, domain.Less, domain.GreaterOrEqual, domain.LessOrEqual:
+		// @day、@week、@month 的时间点依赖属性值所在的日期，这里只校验格式
+		if _, err = rule.target(time.UnixMilli(0)); err != nil {
+			return nil, err
+		}
+		match = func(actualTime time.Time) (bool, error) {
+			target, err := rule.target(actualTime)
+			if err != nil {
+				return false, err
+			}
+			return compareTimes(actualTime, target, op)
+		}
 	default:
-		return false, fmt.Errorf("unkown rule type %s", rule.Type)
+		return nil, fmt.Errorf("unkonw operator %s", op)
 	}
+	return MatcherFunc(func(actualVal string) (bool, error) {
+		actualTime, err := t.converter.Decode(actualVal)
+		if err != nil {
+			return false, err
+		}
+		if rule.Location != nil {
+			actualTime = actualTime.In(rule.Location)
+		}
+		return match(actualTime)
+	}), nil
 }
+
 func compareTimes(actualTime, targetTime time.Time, op domain.RuleOperator) (bool, error) {
 	switch op {
 	case domain.Greater:
@@ -94,75 +187,350 @@ func compareTimes(actualTime, targetTime time.Time, op domain.RuleOperator) (boo
 		return false, fmt.Errorf("unkonw operator %s", op)
 	}
 }
-func checkExactTime(actualTime time.Time, wantTimeRule *timeRule, op domain.RuleOperator) (bool, error) {
-	targetTime, err := strconv.ParseInt(wantTimeRule.Value, 10, 64)
+
+// target 比较运算使用的时间点，actualTime 已经转换到规则的时区
+func (r *timeRule) target(actualTime time.Time) (time.Time, error) {
+	if len(r.Exclude) > 0 {
+		return time.Time{}, fmt.Errorf("exclude only works with = and !=, rule %s", r.Type)
+	}
+	switch r.Type {
+	case timeType:
+		return checkExactTime(actualTime, r)
+	case dayType:
+		return checkDailyTime(actualTime, r)
+	case weekType:
+		return checkWeeklyTime(actualTime, r)
+	case monthType:
+		return checkMonthlyTime(actualTime, r)
+	default:
+		return checkDate(actualTime, r)
+	}
+}
+
+func checkExactTime(actualTime time.Time, rule *timeRule) (time.Time, error) {
+	if len(rule.Args) != 1 {
+		return time.Time{}, fmt.Errorf("invalid time format: %v", rule.Args)
+	}
+	targetTime, err := strconv.ParseInt(rule.Args[0], 10, 64)
 	if err != nil {
-		return false, fmt.Errorf("invalid time value: %s", wantTimeRule.Value)
+		return time.Time{}, fmt.Errorf("invalid time value: %s", rule.Args[0])
 	}
-	target := time.UnixMilli(targetTime)
-	return compareTimes(actualTime, target, op)
+	return time.UnixMilli(targetTime).In(actualTime.Location()), nil
 }
-func checkDailyTime(actualTime time.Time, wantTimeRule *timeRule, op domain.RuleOperator) (bool, error) {
-	hour, minute, err := parseTimeOfDay(wantTimeRule.Value)
+
+func checkDailyTime(actualTime time.Time, rule *timeRule) (time.Time, error) {
+	if len(rule.Args) != 1 {
+		return time.Time{}, fmt.Errorf("invalid day format: %v", rule.Args)
+	}
+	minutes, err := parseTimeOfDay(rule.Args[0], false)
 	if err != nil {
-		return false, err
+		return time.Time{}, err
+	}
+	return atMinutes(actualTime, actualTime.Day(), minutes), nil
+}
+
+func checkWeeklyTime(actualTime time.Time, rule *timeRule) (time.Time, error) {
+	//@week(1,9:30)
+	if len(rule.Args) != number2 {
+		return time.Time{}, fmt.Errorf("invalid week time format %v", rule.Args)
+	}
+	week, err := strconv.Atoi(rule.Args[0])
+	if err != nil || week < minWeekday || week > maxWeekday {
+		return time.Time{}, fmt.Errorf("invalid week format %s", rule.Args[0])
+	}
+	minutes, err := parseTimeOfDay(rule.Args[1], false)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return atMinutes(actualTime, actualTime.Day()+week-int(actualTime.Weekday()), minutes), nil
+}
+
+func checkMonthlyTime(actualTime time.Time, rule *timeRule) (time.Time, error) {
+	if len(rule.Args) != number2 {
+		return time.Time{}, fmt.Errorf("invalid monthly time format: %v", rule.Args)
+	}
+	day, err := parseMonthDay(rule.Args[0])
+	if err != nil {
+		return time.Time{}, err
+	}
+	minutes, err := parseTimeOfDay(rule.Args[1], false)
+	if err != nil {
+		return time.Time{}, err
+	}
+	// 只处理当前月的时间，31 号在小月按月末处理
+	return atMinutes(actualTime, day.resolve(actualTime), minutes), nil
+}
+
+func checkDate(actualTime time.Time, rule *timeRule) (time.Time, error) {
+	if len(rule.Args) != 1 {
+		return time.Time{}, fmt.Errorf("invalid date format: %v", rule.Args)
+	}
+	date, err := time.Parse(dateLayout, rule.Args[0])
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date %s: %w", rule.Args[0], err)
 	}
-	today := time.Date(actualTime.Year(), actualTime.Month(), actualTime.Day(), hour, minute, 0, 0, actualTime.Location())
-	return compareTimes(actualTime, today, op)
+	return time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, actualTime.Location()), nil
 }
-func parseTimeOfDay(timeStr string) (hour, minute int, err error) {
+
+// atMinutes actualTime 所在月份第 day 天的某个时刻，夏令时跳过的时刻按 time.Date 的规则顺延
+func atMinutes(actualTime time.Time, day, minutes int) time.Time {
+	return time.Date(actualTime.Year(), actualTime.Month(), day,
+		minutes/minutesInHour, minutes%minutesInHour, 0, 0, actualTime.Location())
+}
+
+// parseTimeOfDay 解析 HH:MM，返回当天的分钟数，allowEndOfDay 为 true 时允许 24:00
+func parseTimeOfDay(timeStr string, allowEndOfDay bool) (int, error) {
 	parts := strings.SplitN(timeStr, ":", number2)
 	if len(parts) != number2 {
-		return 0, 0, fmt.Errorf("invalid time format %s", timeStr)
+		return 0, fmt.Errorf("invalid time format %s", timeStr)
 	}
-	hour, err = strconv.Atoi(parts[0])
-	if err != nil || hour < 0 || hour >= hoursInDay {
-		return 0, 0, fmt.Errorf("invalid hour format %s", parts[0])
+	hour, err := strconv.Atoi(parts[0])
+	if err != nil || hour < 0 || hour > hoursInDay {
+		return 0, fmt.Errorf("invalid hour format %s", parts[0])
 	}
-	minute, err = strconv.Atoi(parts[1])
+	minute, err := strconv.Atoi(parts[1])
 	if err != nil || minute < 0 || minute >= minutesInHour {
-		return 0, 0, fmt.Errorf("invalid minute: %s", parts[1])
+		return 0, fmt.Errorf("invalid minute: %s", parts[1])
 	}
-	return hour, minute, nil
+	minutes := hour*minutesInHour + minute
+	if minutes > minutesInDay || (minutes == minutesInDay && !allowEndOfDay) {
+		return 0, fmt.Errorf("invalid time %s", timeStr)
+	}
+	return minutes, nil
 }
 
-func checkWeeklyTime(actualTime time.Time, wantTimeRule *timeRule, op domain.RuleOperator) (bool, error) {
-	//@week(1,9:30)
-	parts := strings.SplitN(wantTimeRule.Value, ",", number2)
-	if len(parts) != number2 {
-		return false, fmt.Errorf("invalid week time format", wantTimeRule.Value)
+// timePeriod =、!= 使用的时间段，为空的条件不限制
+type timePeriod struct {
+	instant   *time.Time
+	weekdays  []bool
+	monthDays []monthDayRange
+	dates     []dateRange
+	clock     *clockRange
+	exclude   []dateRange
+}
+
+func (r *timeRule) period() (timePeriod, error) {
+	res := timePeriod{exclude: r.Exclude}
+	var err error
+	// 第一个参数为日期条件，第二个参数为每天的时间段
+	clockArgs := r.Args
+	switch r.Type {
+	case timeType:
+		if len(r.Exclude) > 0 {
+			return res, fmt.Errorf("exclude is not supported by @time")
+		}
+		instant, err := checkExactTime(time.UnixMilli(0), r)
+		res.instant = &instant
+		return res, err
+	case dayType:
+		if len(r.Args) != 1 {
+			return res, fmt.Errorf("invalid day format: %v", r.Args)
+		}
+	case weekType, monthType, dateType:
+		if len(r.Args) == 0 || len(r.Args) > number2 {
+			return res, fmt.Errorf("invalid %s format: %v", r.Type, r.Args)
+		}
+		switch r.Type {
+		case weekType:
+			res.weekdays, err = parseWeekdays(r.Args[0])
+		case monthType:
+			res.monthDays, err = parseMonthDays(r.Args[0])
+		default:
+			res.dates, err = parseDateRanges(r.Args[0])
+		}
+		if err != nil {
+			return res, err
+		}
+		clockArgs = r.Args[1:]
 	}
-	week, err := strconv.Atoi(parts[0])
-	if err != nil || week < minWeekday || week > maxWeekday {
-		return false, fmt.Errorf("invalid week format", wantTimeRule.Value)
+	if len(clockArgs) > 0 {
+		clock, err := parseClockRange(clockArgs[0])
+		if err != nil {
+			return res, err
+		}
+		res.clock = &clock
+	}
+	return res, nil
+}
+
+// contains actualTime 已经转换到规则的时区，星期、日期以及时间段都按该时区的本地时间判断
+func (p timePeriod) contains(actualTime time.Time) bool {
+	if p.instant != nil {
+		return actualTime.Equal(*p.instant)
+	}
+	if p.weekdays != nil && !p.weekdays[actualTime.Weekday()] {
+		return false
+	}
+	if p.monthDays != nil && !slice.ContainsFunc(p.monthDays, func(src monthDayRange) bool {
+		return src.contains(actualTime)
+	}) {
+		return false
+	}
+	if p.dates != nil && !containsDate(p.dates, actualTime) {
+		return false
+	}
+	if p.clock != nil && !p.clock.contains(actualTime) {
+		return false
+	}
+	return !containsDate(p.exclude, actualTime)
+}
 
+// clockRange 每天的时间段 [start, end)，start 大于 end 时跨过零点
+type clockRange struct {
+	start int
+	end   int
+}
+
+func parseClockRange(str string) (clockRange, error) {
+	startStr, endStr, ok := strings.Cut(str, rangeSeparator)
+	if !ok {
+		return clockRange{}, fmt.Errorf("invalid time range %s, = and != need a range like 09:00-18:00", str)
 	}
-	hour, minute, err := parseTimeOfDay(parts[1])
+	start, err := parseTimeOfDay(startStr, false)
 	if err != nil {
-		return false, err
+		return clockRange{}, err
+	}
+	end, err := parseTimeOfDay(endStr, true)
+	if err != nil {
+		return clockRange{}, err
+	}
+	if start == end {
+		return clockRange{}, fmt.Errorf("empty time range %s", str)
+	}
+	return clockRange{start: start, end: end}, nil
+}
 
+func (c clockRange) contains(actualTime time.Time) bool {
+	minutes := actualTime.Hour()*minutesInHour + actualTime.Minute()
+	if c.start < c.end {
+		return minutes >= c.start && minutes < c.end
 	}
-	target := time.Date(actualTime.Year(), actualTime.Month(), actualTime.Day(), hour, minute, 0, 0, actualTime.Location())
-	target = target.AddDate(0, 0, week-int(actualTime.Weekday()))
-	return compareTimes(actualTime, target, op)
+	return minutes >= c.start || minutes < c.end
 }
-func checkMonthlyTime(actualTime time.Time, rule *timeRule, op domain.RuleOperator) (bool, error) {
-	parts := strings.Split(rule.Value, ",")
-	if len(parts) != number2 {
-		return false, fmt.Errorf("invalid monthly time format: %s", rule.Value)
+
+// parseWeekdays 解析星期集合，例如 1-5、1|3|5、5-1（周五到周一）
+func parseWeekdays(str string) ([]bool, error) {
+	res := make([]bool, daysInWeek)
+	for _, part := range strings.Split(str, setSeparator) {
+		startStr, endStr, isRange := strings.Cut(part, rangeSeparator)
+		if !isRange {
+			endStr = startStr
+		}
+		start, err := strconv.Atoi(startStr)
+		if err != nil || start < minWeekday || start > maxWeekday {
+			return nil, fmt.Errorf("invalid week format %s", part)
+		}
+		end, err := strconv.Atoi(endStr)
+		if err != nil || end < minWeekday || end > maxWeekday {
+			return nil, fmt.Errorf("invalid week format %s", part)
+		}
+		for day := start; ; day = (day + 1) % daysInWeek {
+			res[day] = true
+			if day == end {
+				break
+			}
+		}
 	}
+	return res, nil
+}
 
-	day, err := strconv.Atoi(parts[0])
+// monthDay 每月几号，0 表示月末
+type monthDay int
+
+func parseMonthDay(str string) (monthDay, error) {
+	if str == lastDayOfMonth {
+		return 0, nil
+	}
+	day, err := strconv.Atoi(str)
 	if err != nil || day < minMonthDay || day > maxMonthDay {
-		return false, fmt.Errorf("invalid day of month: %s", parts[0])
+		return 0, fmt.Errorf("invalid day of month: %s", str)
 	}
+	return monthDay(day), nil
+}
 
-	hour, minute, err := parseTimeOfDay(parts[1])
-	if err != nil {
-		return false, err
+// resolve actualTime 所在月份对应的日期，超过当月天数时按月末处理
+func (m monthDay) resolve(actualTime time.Time) int {
+	last := time.Date(actualTime.Year(), actualTime.Month()+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if m == 0 || int(m) > last {
+		return last
 	}
+	return int(m)
+}
 
-	// 只处理当前月的时间
-	targetDate := time.Date(actualTime.Year(), actualTime.Month(), day, hour, minute, 0, 0, actualTime.Location())
-	return compareTimes(actualTime, targetDate, op)
+// monthDayRange 每月的日期范围，start 大于 end 时跨月，例如 25-5
+type monthDayRange struct {
+	start monthDay
+	end   monthDay
+}
+
+func parseMonthDays(str string) ([]monthDayRange, error) {
+	parts := strings.Split(str, setSeparator)
+	res := make([]monthDayRange, 0, len(parts))
+	for _, part := range parts {
+		startStr, endStr, isRange := strings.Cut(part, rangeSeparator)
+		if !isRange {
+			endStr = startStr
+		}
+		start, err := parseMonthDay(startStr)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseMonthDay(endStr)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, monthDayRange{start: start, end: end})
+	}
+	return res, nil
+}
+
+func (m monthDayRange) contains(actualTime time.Time) bool {
+	day := actualTime.Day()
+	start, end := m.start.resolve(actualTime), m.end.resolve(actualTime)
+	if start <= end {
+		return day >= start && day <= end
+	}
+	return day >= start || day <= end
+}
+
+// dateRange 日期范围，两端都包含
+type dateRange struct {
+	start time.Time
+	end   time.Time
+}
+
+// parseDateRanges 解析日期集合，例如 2024-10-01~2024-10-07|2024-12-25
+func parseDateRanges(str string) ([]dateRange, error) {
+	parts := strings.Split(str, setSeparator)
+	res := make([]dateRange, 0, len(parts))
+	for _, part := range parts {
+		startStr, endStr, isRange := strings.Cut(part, dateRangeSeparator)
+		if !isRange {
+			endStr = startStr
+		}
+		start, err := time.Parse(dateLayout, startStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %s: %w", startStr, err)
+		}
+		end, err := time.Parse(dateLayout, endStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid date %s: %w", endStr, err)
+		}
+		if end.Before(start) {
+			return nil, fmt.Errorf("invalid date range %s", part)
+		}
+		res = append(res, dateRange{start: start, end: end})
+	}
+	return res, nil
+}
+
+// containsDate 按 actualTime 所在时区的日期判断
+func containsDate(ranges []dateRange, actualTime time.Time) bool {
+	date := time.Date(actualTime.Year(), actualTime.Month(), actualTime.Day(), 0, 0, 0, 0, time.UTC)
+	for _, r := range ranges {
+		if !date.Before(r.start) && !date.After(r.end) {
+			return true
+		}
+	}
+	return false
 }
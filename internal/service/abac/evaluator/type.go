@@ -1,6 +1,10 @@
 package evaluator
 
 import (
+	"fmt"
+	"maps"
+	"sync"
+
 	"github.com/permission-dev/internal/domain"
 	"github.com/permission-dev/internal/errs"
 )
@@ -9,30 +13,180 @@ type PolicyRuleEvaluator interface {
 	Evaluator(actualVal, wantVal string, op domain.RuleOperator) (bool, error)
 }
 
+// ValueValidator 可选接口，PolicyRuleEvaluator 实现后保存规则时会用它校验比较值
+type ValueValidator interface {
+	Validate(wantVal string, op domain.RuleOperator) error
+}
+
+// OperatorEvaluator 自定义运算符
+type OperatorEvaluator interface {
+	// Evaluate wantVal 为规则中的比较值，actualVal 为属性的值
+	Evaluate(wantVal, actualVal string) (bool, error)
+	// Validate 保存规则时校验比较值
+	Validate(wantVal string) error
+}
+
+// OperatorFunc 不需要校验比较值的自定义运算符
+type OperatorFunc func(wantVal, actualVal string) (bool, error)
+
+func (f OperatorFunc) Evaluate(wantVal, actualVal string) (bool, error) {
+	return f(wantVal, actualVal)
+}
+
+func (f OperatorFunc) Validate(string) error {
+	return nil
+}
+
+// Selector 按数据类型选择 evaluator，同时也是数据类型以及运算符的注册中心，
+// 嵌入本服务时可以注册新的数据类型或者为已有数据类型注册新的运算符。
+// 注册应该在启动时完成，注册之后保存以及执行的规则都能使用新的运算符
 type Selector interface {
 	Select(dataType domain.DataType) (PolicyRuleEvaluator, error)
+	// RegisterDataType 注册数据类型，operators 为 evaluator 支持的运算符，已经注册过的类型会被覆盖
+	RegisterDataType(dataType domain.DataType, evaluator PolicyRuleEvaluator, operators ...domain.RuleOperator)
+	// RegisterOperator 为已经注册的数据类型注册运算符，优先于数据类型自身的 evaluator
+	RegisterOperator(dataType domain.DataType, op domain.RuleOperator, evaluator OperatorEvaluator) error
+	// Supports 判断数据类型是否支持运算符
+	Supports(dataType domain.DataType, op domain.RuleOperator) bool
+	// Validate 保存规则时校验运算符以及比较值
+	Validate(dataType domain.DataType, op domain.RuleOperator, wantVal string) error
+	// Compile 预先解析比较值，编译后的 Matcher 不受之后注册的运算符影响
+	Compile(dataType domain.DataType, op domain.RuleOperator, wantVal string) (Matcher, error)
 }
 
 type selector struct {
-	checkMap map[domain.DataType]PolicyRuleEvaluator
+	mu       sync.RWMutex
+	checkMap map[domain.DataType]*typeEvaluator
 }
 
-func NewSelector() *selector {
-	return &selector{
-		checkMap: map[domain.DataType]PolicyRuleEvaluator{
-			domain.DataTypeString:   NewStringEvaluator(),
-			domain.DataTypeBoolean:  NewBoolEvaluator(),
-			domain.DataTypeArray:    NewArrayEvaluator(),
-			domain.DataTypeDatetime: NewTimeEvaluator(),
-			domain.DataTypeNumber:   NewNumberEvaluator(),
-			domain.DataTypeFloat:    NewNumberEvaluator(),
-		},
+func NewSelector() Selector {
+	numeric := []domain.RuleOperator{domain.Equals, domain.NotEquals, domain.Greater, domain.Less,
+		domain.GreaterOrEqual, domain.LessOrEqual, domain.IN, domain.NotIn}
+	s := &selector{checkMap: make(map[domain.DataType]*typeEvaluator, 8)}
+	s.RegisterDataType(domain.DataTypeString, NewStringEvaluator(), domain.Equals, domain.NotEquals, domain.IN, domain.NotIn)
+	s.RegisterDataType(domain.DataTypeBoolean, NewBoolEvaluator(), domain.Equals, domain.NotEquals)
+	s.RegisterDataType(domain.DataTypeArray, NewArrayEvaluator(), domain.AnyMatch, domain.AllMatch)
+	s.RegisterDataType(domain.DataTypeDatetime, NewTimeEvaluator(), domain.Equals, domain.NotEquals,
+		domain.Greater, domain.Less, domain.GreaterOrEqual, domain.LessOrEqual)
+	s.RegisterDataType(domain.DataTypeNumber, NewNumberEvaluator(), numeric...)
+	s.RegisterDataType(domain.DataTypeFloat, NewNumberEvaluator(), numeric...)
+	s.RegisterDataType(domain.DataTypeIP, NewIPEvaluator(), domain.Equals, domain.NotEquals, domain.InCIDR, domain.NotInCIDR)
+	for op, evaluator := range stringOperators() {
+		_ = s.RegisterOperator(domain.DataTypeString, op, evaluator)
 	}
+	return s
 }
+
 func (s *selector) Select(dataType domain.DataType) (PolicyRuleEvaluator, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
 	evaluator, ok := s.checkMap[dataType]
 	if !ok {
 		return nil, errs.ErrUnkonwDataType
 	}
 	return evaluator, nil
 }
+
+func (s *selector) RegisterDataType(dataType domain.DataType, evaluator PolicyRuleEvaluator, operators ...domain.RuleOperator) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	builtin := make(map[domain.RuleOperator]struct{}, len(operators))
+	for _, op := range operators {
+		builtin[op] = struct{}{}
+	}
+	s.checkMap[dataType] = &typeEvaluator{
+		evaluator: evaluator,
+		builtin:   builtin,
+		custom:    map[domain.RuleOperator]OperatorEvaluator{},
+	}
+}
+
+func (s *selector) RegisterOperator(dataType domain.DataType, op domain.RuleOperator, evaluator OperatorEvaluator) error {
+	if isLogicOperator(op) {
+		return fmt.Errorf("%w: 不能覆盖逻辑运算符 %s", errs.ErrInvalidPolicyRule, op)
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	typ, ok := s.checkMap[dataType]
+	if !ok {
+		return fmt.Errorf("%w: %s", errs.ErrUnkonwDataType, dataType)
+	}
+	// 复制一份再替换，已经 Select 出去的 evaluator 不受影响
+	custom := maps.Clone(typ.custom)
+	custom[op] = evaluator
+	s.checkMap[dataType] = &typeEvaluator{evaluator: typ.evaluator, builtin: typ.builtin, custom: custom}
+	return nil
+}
+
+func (s *selector) Supports(dataType domain.DataType, op domain.RuleOperator) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	typ, ok := s.checkMap[dataType]
+	return ok && typ.supports(op)
+}
+
+func (s *selector) Validate(dataType domain.DataType, op domain.RuleOperator, wantVal string) error {
+	s.mu.RLock()
+	typ, ok := s.checkMap[dataType]
+	s.mu.RUnlock()
+	if !ok {
+		return fmt.Errorf("%w: %w: %s", errs.ErrInvalidPolicyRule, errs.ErrUnkonwDataType, dataType)
+	}
+	if !typ.supports(op) {
+		return fmt.Errorf("%w: 类型 %s 不支持运算符 %s", errs.ErrInvalidPolicyRule, dataType, op)
+	}
+	var err error
+	if custom, ok := typ.custom[op]; ok {
+		err = custom.Validate(wantVal)
+	} else if validator, ok := typ.evaluator.(ValueValidator); ok {
+		err = validator.Validate(wantVal, op)
+	}
+	if err != nil {
+		return fmt.Errorf("%w: 运算符 %s 的比较值 %s 无效: %w", errs.ErrInvalidPolicyRule, op, wantVal, err)
+	}
+	return nil
+}
+
+func (s *selector) Compile(dataType domain.DataType, op domain.RuleOperator, wantVal string) (Matcher, error) {
+	s.mu.RLock()
+	typ, ok := s.checkMap[dataType]
+	s.mu.RUnlock()
+	if !ok {
+		return nil, errs.ErrUnkonwDataType
+	}
+	if custom, ok := typ.custom[op]; ok {
+		return MatcherFunc(func(actualVal string) (bool, error) {
+			return custom.Evaluate(wantVal, actualVal)
+		}), nil
+	}
+	if compiler, ok := typ.evaluator.(Compiler); ok {
+		return compiler.Compile(wantVal, op)
+	}
+	return evaluatorMatcher(typ.evaluator, wantVal, op), nil
+}
+
+// typeEvaluator 一个数据类型的 evaluator，自定义运算符优先
+type typeEvaluator struct {
+	evaluator PolicyRuleEvaluator
+	builtin   map[domain.RuleOperator]struct{}
+	custom    map[domain.RuleOperator]OperatorEvaluator
+}
+
+func (t *typeEvaluator) Evaluator(wantVal, actualVal string, op domain.RuleOperator) (bool, error) {
+	if custom, ok := t.custom[op]; ok {
+		return custom.Evaluate(wantVal, actualVal)
+	}
+	return t.evaluator.Evaluator(wantVal, actualVal, op)
+}
+
+func (t *typeEvaluator) supports(op domain.RuleOperator) bool {
+	if _, ok := t.custom[op]; ok {
+		return true
+	}
+	_, ok := t.builtin[op]
+	return ok
+}
+
+func isLogicOperator(op domain.RuleOperator) bool {
+	return op == domain.AND || op == domain.OR || op == domain.NOT
+}
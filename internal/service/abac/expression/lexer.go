@@ -0,0 +1,145 @@
+package expression
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/permission-dev/internal/errs"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenString
+	tokenNumber
+	// tokenFunc 时间函数，例如 @day(9:00)、@week(1,9:30)，原样作为规则的值
+	tokenFunc
+	// tokenCompare 比较运算符 = == != > < >= <=
+	tokenCompare
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenComma
+	tokenDot
+)
+
+type token struct {
+	kind tokenKind
+	// text 字符串字面量为反转义之后的内容，其余为原文
+	text string
+	pos  int
+}
+
+// is 判断是否为指定的关键字，关键字不区分大小写
+func (t token) is(keyword string) bool {
+	return t.kind == tokenIdent && strings.EqualFold(t.text, keyword)
+}
+
+func (t token) String() string {
+	if t.kind == tokenEOF {
+		return "表达式结尾"
+	}
+	return strconv.Quote(t.text)
+}
+
+func tokenize(expr string) ([]token, error) {
+	var (
+		tokens []token
+		pos    int
+	)
+	for pos < len(expr) {
+		r, size := utf8.DecodeRuneInString(expr[pos:])
+		start := pos
+		switch {
+		case unicode.IsSpace(r):
+			pos += size
+			continue
+		case r == '(':
+			tokens = append(tokens, token{kind: tokenLParen, text: "(", pos: start})
+			pos++
+		case r == ')':
+			tokens = append(tokens, token{kind: tokenRParen, text: ")", pos: start})
+			pos++
+		case r == '[':
+			tokens = append(tokens, token{kind: tokenLBracket, text: "[", pos: start})
+			pos++
+		case r == ']':
+			tokens = append(tokens, token{kind: tokenRBracket, text: "]", pos: start})
+			pos++
+		case r == ',':
+			tokens = append(tokens, token{kind: tokenComma, text: ",", pos: start})
+			pos++
+		case r == '.':
+			tokens = append(tokens, token{kind: tokenDot, text: ".", pos: start})
+			pos++
+		case r == '=' || r == '!' || r == '>' || r == '<':
+			op := expr[pos : pos+1]
+			if pos+1 < len(expr) && expr[pos+1] == '=' {
+				op = expr[pos : pos+2]
+			}
+			if op == "!" {
+				return nil, newSyntaxError(start, "无效的运算符 !")
+			}
+			tokens = append(tokens, token{kind: tokenCompare, text: op, pos: start})
+			pos += len(op)
+		case r == '"' || r == '`':
+			quoted, err := strconv.QuotedPrefix(expr[pos:])
+			if err != nil {
+				return nil, newSyntaxError(start, "字符串没有正确结束")
+			}
+			text, err := strconv.Unquote(quoted)
+			if err != nil {
+				return nil, newSyntaxError(start, "无效的字符串 %s", quoted)
+			}
+			tokens = append(tokens, token{kind: tokenString, text: text, pos: start})
+			pos += len(quoted)
+		case r == '@':
+			end := strings.IndexByte(expr[pos:], ')')
+			if end < 0 {
+				return nil, newSyntaxError(start, "时间函数缺少 )")
+			}
+			tokens = append(tokens, token{kind: tokenFunc, text: expr[pos : pos+end+1], pos: start})
+			pos += end + 1
+		case r == '-' || unicode.IsDigit(r):
+			pos += size
+			for pos < len(expr) && (isDigit(expr[pos]) || expr[pos] == '.') {
+				pos++
+			}
+			text := expr[start:pos]
+			if _, err := strconv.ParseFloat(text, 64); err != nil || strings.HasSuffix(text, ".") {
+				return nil, newSyntaxError(start, "无效的数字 %s", text)
+			}
+			tokens = append(tokens, token{kind: tokenNumber, text: text, pos: start})
+		case isIdentRune(r):
+			for pos < len(expr) {
+				r, size = utf8.DecodeRuneInString(expr[pos:])
+				if !isIdentRune(r) {
+					break
+				}
+				pos += size
+			}
+			tokens = append(tokens, token{kind: tokenIdent, text: expr[start:pos], pos: start})
+		default:
+			return nil, newSyntaxError(start, "无法识别的字符 %q", r)
+		}
+	}
+	return append(tokens, token{kind: tokenEOF, pos: len(expr)}), nil
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func newSyntaxError(pos int, format string, args ...any) error {
+	return fmt.Errorf("%w: 位置 %d: %s", errs.ErrInvalidPolicyExpression, pos, fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,348 @@
+package expression
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/permission-dev/internal/domain"
+)
+
+// 表达式语法：
+//
+//	expr       = and { OR and }
+//	and        = unary { AND unary }
+//	unary      = NOT unary | "(" expr ")" | comparison
+//	comparison = attr operator value
+//	attr       = ( subject | resource | env | environment ) "." 属性名
+//	operator   = = | == | != | > | < | >= | <= | IN | NOT IN | IN CIDR | NOT IN CIDR | ANY MATCH | ALL MATCH | 自定义运算符
+//	value      = attr | 字符串 | 数字 | true | false | 时间函数 | "[" [ 字面量 { "," 字面量 } ] "]"
+//
+// 右侧为属性时和该属性的值比较，例如 resource.owner_id = subject.id，两侧类型需要匹配，见 domain.AttrRefComparable。
+// 关键字不区分大小写，字符串使用双引号或者反引号，时间函数与 TimeEvaluator 一致，例如 @day(9:00)，
+// IP 地址以及网段也使用字符串，例如 env.client_ip IN CIDR ["10.0.0.0/8"]。
+// 例如：subject.level >= 5 AND resource.owner_dept IN ["a","b"] AND NOT env.time < @day(9:00)
+
+// entityPrefixes 属性前缀与实体类型的对应关系
+var entityPrefixes = map[string]domain.EntityType{
+	"subject":     domain.SubjectTypeEntity,
+	"resource":    domain.ResourceTypeEntity,
+	"env":         domain.EnvironmentTypeEntity,
+	"environment": domain.EnvironmentTypeEntity,
+}
+
+// Operators 数据类型支持的运算符以及比较值的校验，evaluator.Selector 实现了该接口，
+// 因此注册的自定义运算符在表达式中同样可以使用
+type Operators interface {
+	Supports(dataType domain.DataType, op domain.RuleOperator) bool
+	Validate(dataType domain.DataType, op domain.RuleOperator, wantVal string) error
+}
+
+// Parse 把表达式编译成规则树，属性名按业务的属性定义解析，运算符以及比较值按 ops 校验
+func Parse(expr string, defs domain.BizAttrDefinition, ops Operators) (domain.PolicyRule, error) {
+	tokens, err := tokenize(expr)
+	if err != nil {
+		return domain.PolicyRule{}, err
+	}
+	p := &parser{tokens: tokens, defs: defs, ops: ops}
+	if p.peek().kind == tokenEOF {
+		return domain.PolicyRule{}, newSyntaxError(0, "表达式不能为空")
+	}
+	rule, err := p.parseOr()
+	if err != nil {
+		return domain.PolicyRule{}, err
+	}
+	if tok := p.peek(); tok.kind != tokenEOF {
+		return domain.PolicyRule{}, newSyntaxError(tok.pos, "多余的 %s", tok)
+	}
+	return rule, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	defs   domain.BizAttrDefinition
+	ops    Operators
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	tok := p.tokens[p.pos]
+	if tok.kind != tokenEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) expect(kind tokenKind, want string) (token, error) {
+	tok := p.next()
+	if tok.kind != kind {
+		return tok, newSyntaxError(tok.pos, "期望 %s，实际为 %s", want, tok)
+	}
+	return tok, nil
+}
+
+func (p *parser) parseOr() (domain.PolicyRule, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return domain.PolicyRule{}, err
+	}
+	for p.peek().is("OR") {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return domain.PolicyRule{}, err
+		}
+		left = logicRule(domain.OR, left, right)
+	}
+	return left, nil
+}
+
+func (p *parser) parseAnd() (domain.PolicyRule, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return domain.PolicyRule{}, err
+	}
+	for p.peek().is("AND") {
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return domain.PolicyRule{}, err
+		}
+		left = logicRule(domain.AND, left, right)
+	}
+	return left, nil
+}
+
+func (p *parser) parseUnary() (domain.PolicyRule, error) {
+	tok := p.peek()
+	switch {
+	case tok.is("NOT"):
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return domain.PolicyRule{}, err
+		}
+		// 与 PolicyExecutor 一致，NOT 只使用右子规则
+		return domain.PolicyRule{Operator: domain.NOT, RightRule: &operand}, nil
+	case tok.kind == tokenLParen:
+		p.next()
+		rule, err := p.parseOr()
+		if err != nil {
+			return domain.PolicyRule{}, err
+		}
+		if _, err = p.expect(tokenRParen, ")"); err != nil {
+			return domain.PolicyRule{}, err
+		}
+		return rule, nil
+	default:
+		return p.parseComparison()
+	}
+}
+
+func (p *parser) parseComparison() (domain.PolicyRule, error) {
+	def, err := p.parseAttr()
+	if err != nil {
+		return domain.PolicyRule{}, err
+	}
+	opTok := p.peek()
+	op, err := p.parseOperator()
+	if err != nil {
+		return domain.PolicyRule{}, err
+	}
+	if !p.ops.Supports(def.DataType, op) {
+		return domain.PolicyRule{}, newSyntaxError(opTok.pos, "属性 %s 的类型 %s 不支持运算符 %s", def.Name, def.DataType, op)
+	}
+	if p.peekAttr() {
+		refTok := p.peek()
+		ref, err := p.parseAttr()
+		if err != nil {
+			return domain.PolicyRule{}, err
+		}
+		if !domain.AttrRefComparable(op, def.DataType, ref.DataType) {
+			return domain.PolicyRule{}, newSyntaxError(refTok.pos, "属性 %s（%s）与 %s（%s）不能使用 %s 比较",
+				def.Name, def.DataType, ref.Name, ref.DataType, op)
+		}
+		return domain.PolicyRule{AttrDef: def, Operator: op, ValueAttrDef: ref}, nil
+	}
+	valueTok := p.peek()
+	value, err := p.parseValue(def, op)
+	if err != nil {
+		return domain.PolicyRule{}, err
+	}
+	if err = p.ops.Validate(def.DataType, op, value); err != nil {
+		return domain.PolicyRule{}, newSyntaxError(valueTok.pos, "%s", err.Error())
+	}
+	return domain.PolicyRule{AttrDef: def, Operator: op, Value: value}, nil
+}
+
+// peekAttr 判断接下来是否为属性，例如 subject.id
+func (p *parser) peekAttr() bool {
+	tok := p.peek()
+	if tok.kind != tokenIdent || p.tokens[p.pos+1].kind != tokenDot {
+		return false
+	}
+	_, ok := entityPrefixes[strings.ToLower(tok.text)]
+	return ok
+}
+
+func (p *parser) parseAttr() (domain.AttributeDefinition, error) {
+	entityTok, err := p.expect(tokenIdent, "属性，例如 subject.level")
+	if err != nil {
+		return domain.AttributeDefinition{}, err
+	}
+	entity, ok := entityPrefixes[strings.ToLower(entityTok.text)]
+	if !ok {
+		return domain.AttributeDefinition{}, newSyntaxError(entityTok.pos, "未知的属性前缀 %s，只支持 subject、resource、env", entityTok)
+	}
+	if _, err = p.expect(tokenDot, "."); err != nil {
+		return domain.AttributeDefinition{}, err
+	}
+	nameTok, err := p.expect(tokenIdent, "属性名")
+	if err != nil {
+		return domain.AttributeDefinition{}, err
+	}
+	defs := p.entityDefs(entity)
+	def, ok := defs.GetByName(nameTok.text)
+	if !ok {
+		return domain.AttributeDefinition{}, newSyntaxError(nameTok.pos, "%s 属性 %s 未定义", entity, nameTok)
+	}
+	// 没有按资源类型筛选属性定义时，不同资源类型的同名属性无法区分
+	for _, other := range defs {
+		if other.Name == def.Name && other.ID != def.ID {
+			return domain.AttributeDefinition{}, newSyntaxError(nameTok.pos, "%s 属性 %s 在多个资源类型中定义，策略需要先关联权限", entity, nameTok)
+		}
+	}
+	return def, nil
+}
+
+func (p *parser) entityDefs(entity domain.EntityType) domain.AttrDefs {
+	switch entity {
+	case domain.SubjectTypeEntity:
+		return p.defs.SubjectAttrDefs
+	case domain.ResourceTypeEntity:
+		return p.defs.ResourceAttrDefs
+	default:
+		return p.defs.EnvironmentAttrDefs
+	}
+}
+
+func (p *parser) parseOperator() (domain.RuleOperator, error) {
+	tok := p.next()
+	switch {
+	case tok.kind == tokenCompare:
+		if tok.text == "==" {
+			return domain.Equals, nil
+		}
+		return domain.RuleOperator(tok.text), nil
+	case tok.is("IN"):
+		if p.peek().is("CIDR") {
+			p.next()
+			return domain.InCIDR, nil
+		}
+		return domain.IN, nil
+	case tok.is("NOT"):
+		if in := p.next(); !in.is("IN") {
+			return "", newSyntaxError(in.pos, "期望 IN，实际为 %s", in)
+		}
+		if p.peek().is("CIDR") {
+			p.next()
+			return domain.NotInCIDR, nil
+		}
+		return domain.NotIn, nil
+	case tok.is("ANY"), tok.is("ALL"):
+		if match := p.next(); !match.is("MATCH") {
+			return "", newSyntaxError(match.pos, "期望 MATCH，实际为 %s", match)
+		}
+		if tok.is("ANY") {
+			return domain.AnyMatch, nil
+		}
+		return domain.AllMatch, nil
+	case tok.kind == tokenIdent:
+		// 自定义运算符，例如 MATCHES、STARTS_WITH
+		return domain.RuleOperator(strings.ToUpper(tok.text)), nil
+	default:
+		return "", newSyntaxError(tok.pos, "期望运算符，实际为 %s", tok)
+	}
+}
+
+// parseValue 解析比较值并编码成规则中存储的格式，列表使用 JSON 数组
+func (p *parser) parseValue(def domain.AttributeDefinition, op domain.RuleOperator) (string, error) {
+	if isListOperator(op) {
+		return p.parseList(def)
+	}
+	tok := p.next()
+	switch def.DataType {
+	case domain.DataTypeString, domain.DataTypeIP:
+		if tok.kind == tokenString {
+			return tok.text, nil
+		}
+	case domain.DataTypeNumber:
+		if tok.kind == tokenNumber && !strings.Contains(tok.text, ".") {
+			return tok.text, nil
+		}
+	case domain.DataTypeFloat:
+		if tok.kind == tokenNumber {
+			return tok.text, nil
+		}
+	case domain.DataTypeBoolean:
+		if tok.is("true") || tok.is("false") {
+			return strings.ToLower(tok.text), nil
+		}
+	case domain.DataTypeDatetime:
+		if tok.kind == tokenFunc || (tok.kind == tokenNumber && !strings.Contains(tok.text, ".")) {
+			return tok.text, nil
+		}
+	default:
+		// 自定义数据类型的比较值使用字符串
+		if tok.kind == tokenString {
+			return tok.text, nil
+		}
+	}
+	return "", newSyntaxError(tok.pos, "%s 不是属性 %s（%s）的合法取值", tok, def.Name, def.DataType)
+}
+
+func (p *parser) parseList(def domain.AttributeDefinition) (string, error) {
+	if _, err := p.expect(tokenLBracket, "["); err != nil {
+		return "", err
+	}
+	wantKind := tokenString
+	if def.DataType == domain.DataTypeNumber || def.DataType == domain.DataTypeFloat {
+		wantKind = tokenNumber
+	}
+	elems := make([]json.RawMessage, 0, 4)
+	for p.peek().kind != tokenRBracket {
+		if len(elems) > 0 {
+			if _, err := p.expect(tokenComma, ", 或者 ]"); err != nil {
+				return "", err
+			}
+		}
+		tok := p.next()
+		if tok.kind != wantKind || (def.DataType == domain.DataTypeNumber && strings.Contains(tok.text, ".")) {
+			return "", newSyntaxError(tok.pos, "%s 不是属性 %s（%s）的合法取值", tok, def.Name, def.DataType)
+		}
+		elem := json.RawMessage(tok.text)
+		if tok.kind == tokenString {
+			elem, _ = json.Marshal(tok.text)
+		}
+		elems = append(elems, elem)
+	}
+	p.next()
+	data, err := json.Marshal(elems)
+	return string(data), err
+}
+
+func isListOperator(op domain.RuleOperator) bool {
+	switch op {
+	case domain.IN, domain.NotIn, domain.AnyMatch, domain.AllMatch, domain.InCIDR, domain.NotInCIDR:
+		return true
+	default:
+		return false
+	}
+}
+
+func logicRule(op domain.RuleOperator, left, right domain.PolicyRule) domain.PolicyRule {
+	return domain.PolicyRule{Operator: op, LeftRule: &left, RightRule: &right}
+}
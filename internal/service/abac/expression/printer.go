@@ -0,0 +1,138 @@
+package expression
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+)
+
+// 优先级越大结合越紧，子规则优先级低于父规则时需要加括号
+const (
+	precedenceOr = iota + 1
+	precedenceAnd
+	precedenceNot
+	precedenceComparison
+)
+
+// entityPrefix 打印时使用的属性前缀
+var entityPrefix = map[domain.EntityType]string{
+	domain.SubjectTypeEntity:     "subject",
+	domain.ResourceTypeEntity:    "resource",
+	domain.EnvironmentTypeEntity: "env",
+}
+
+// Format 把策略的规则渲染成表达式，多条规则之间是 AND 关系。
+// 规则中的属性定义只需要 ID，名称和类型从 defs 中获取
+func Format(rules []domain.PolicyRule, defs domain.BizAttrDefinition) (string, error) {
+	var sb strings.Builder
+	// 只有一条规则时不需要和其他规则 AND，顶层的 OR 不用加括号
+	parent := precedenceOr
+	if len(rules) > 1 {
+		parent = precedenceAnd
+	}
+	for idx := range rules {
+		if idx > 0 {
+			sb.WriteString(" AND ")
+		}
+		if err := formatRule(&sb, rules[idx], defs, parent); err != nil {
+			return "", err
+		}
+	}
+	return sb.String(), nil
+}
+
+func formatRule(sb *strings.Builder, rule domain.PolicyRule, defs domain.BizAttrDefinition, parent int) error {
+	if rule.LeftRule == nil && rule.RightRule == nil {
+		return formatComparison(sb, rule, defs)
+	}
+	var precedence int
+	switch rule.Operator {
+	case domain.AND:
+		precedence = precedenceAnd
+	case domain.OR:
+		precedence = precedenceOr
+	case domain.NOT:
+		precedence = precedenceNot
+	default:
+		return fmt.Errorf("%w: 规则 %d 的逻辑运算符 %s 无效", errs.ErrInvalidPolicyExpression, rule.ID, rule.Operator)
+	}
+	if precedence < parent {
+		sb.WriteString("(")
+		defer sb.WriteString(")")
+	}
+	if rule.Operator == domain.NOT {
+		sb.WriteString("NOT ")
+		return formatRule(sb, rule.SafeRight(), defs, precedenceNot)
+	}
+	if err := formatRule(sb, rule.SafeLeft(), defs, precedence); err != nil {
+		return err
+	}
+	sb.WriteString(" " + rule.Operator.String() + " ")
+	// 右子规则同级时也加括号，保证重新解析后的规则树结构不变
+	return formatRule(sb, rule.SafeRight(), defs, precedence+1)
+}
+
+func formatComparison(sb *strings.Builder, rule domain.PolicyRule, defs domain.BizAttrDefinition) error {
+	attr, def, err := formatAttr(rule, rule.AttrDef.ID, defs)
+	if err != nil {
+		return err
+	}
+	value, err := formatValue(def, rule, defs)
+	if err != nil {
+		return err
+	}
+	sb.WriteString(attr + " " + rule.Operator.String() + " " + value)
+	return nil
+}
+
+func formatAttr(rule domain.PolicyRule, id int64, defs domain.BizAttrDefinition) (string, domain.AttributeDefinition, error) {
+	def, ok := defs.GetByDefId(id)
+	if !ok {
+		return "", def, fmt.Errorf("%w: 规则 %d 的属性 %d 未定义", errs.ErrInvalidPolicyExpression, rule.ID, id)
+	}
+	return entityPrefix[def.EntityType] + "." + def.Name, def, nil
+}
+
+func formatValue(def domain.AttributeDefinition, rule domain.PolicyRule, defs domain.BizAttrDefinition) (string, error) {
+	if rule.ReferencesAttr() {
+		attr, _, err := formatAttr(rule, rule.ValueAttrDef.ID, defs)
+		return attr, err
+	}
+	if isListOperator(rule.Operator) {
+		return formatList(rule)
+	}
+	switch def.DataType {
+	case domain.DataTypeNumber, domain.DataTypeFloat, domain.DataTypeBoolean, domain.DataTypeDatetime:
+		return rule.Value, nil
+	default:
+		// 字符串以及自定义数据类型
+		return strconv.Quote(rule.Value), nil
+	}
+}
+
+// formatList 规则中的列表是 JSON 数组，字符串元素用双引号，数字原样输出
+func formatList(rule domain.PolicyRule) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(rule.Value)))
+	decoder.UseNumber()
+	var elems []any
+	if err := decoder.Decode(&elems); err != nil {
+		return "", fmt.Errorf("%w: 规则 %d 的值 %s 不是列表", errs.ErrInvalidPolicyExpression, rule.ID, rule.Value)
+	}
+	res := make([]string, 0, len(elems))
+	for _, elem := range elems {
+		switch val := elem.(type) {
+		case string:
+			res = append(res, strconv.Quote(val))
+		case json.Number:
+			res = append(res, val.String())
+		default:
+			return "", fmt.Errorf("%w: 规则 %d 的列表元素 %v 无效", errs.ErrInvalidPolicyExpression, rule.ID, elem)
+		}
+	}
+	return "[" + strings.Join(res, ", ") + "]", nil
+}
@@ -2,122 +2,499 @@ package abac
 
 import (
 	"context"
+	"sync"
+
+	"github.com/ecodeclub/ekit/mapx"
 	"github.com/ecodeclub/ekit/slice"
+	"github.com/gotomicro/ego/core/elog"
 	"github.com/permission-dev/internal/domain"
 	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/abac/provider"
 	"golang.org/x/sync/errgroup"
 )
 
 type PermissionSvc interface {
+	// Check 业务定义了的内置环境属性由 provider.BuiltinEnv 自动填充，见 domain.BuiltinEnvAttrs
 	Check(ctx context.Context, bizId, uid int64, resource domain.Resource, action []string, attrs domain.Attributes) (bool, error)
+	BatchCheck(ctx context.Context, bizId, uid int64, items []domain.CheckItem, attrs domain.Attributes) ([]bool, error)
+	// Explain 校验并返回每个策略以及规则节点的执行过程
+	Explain(ctx context.Context, bizId, uid int64, resource domain.Resource, action []string, attrs domain.Attributes) (domain.ABACTrace, error)
+	// Simulate 模拟加入候选策略后每个样本的校验结果，不会写入任何数据。
+	// 候选策略的 ID 不为 0 时视为修改已有策略，会替换掉同 ID 的策略
+	Simulate(ctx context.Context, bizId int64, candidate domain.Policy, samples []domain.SimulationSample) ([]domain.SimulationResult, error)
+	// CheckAsOf 使用 asOf（毫秒）时刻的属性值、策略版本以及策略关联校验，不会从 AttributeProvider 获取属性，
+	// 请求中传入的属性仍然生效
+	CheckAsOf(ctx context.Context, bizId, uid int64, resource domain.Resource, action []string, attrs domain.Attributes, asOf int64) (bool, error)
+	ExplainAsOf(ctx context.Context, bizId, uid int64, resource domain.Resource, action []string, attrs domain.Attributes, asOf int64) (domain.ABACTrace, error)
 }
 
 type permissionSvc struct {
 	permissionRepo repository.PermissionRepository
 	resourceRepo   repository.ResourceRepository
-	policyRepo     repository.AttributePolicyRepository
+	policyCache    PolicyCache
 	valRepo        repository.AttributeValueRepository
 	attrRepo       repository.AttributeDefinitionRepository
+	bizConfigRepo  repository.BusinessConfigRepository
 	parser         PolicyExecutor
+	providers      provider.Registry
+	builtinEnv     provider.BuiltinEnv
+	logger         *elog.Component
 }
 
 func NewPermissionSvc(
 	permissionRepo repository.PermissionRepository,
 	resourceRepo repository.ResourceRepository,
-	policyRepo repository.AttributePolicyRepository,
+	policyCache PolicyCache,
 	valRepo repository.AttributeValueRepository,
 	attrRepo repository.AttributeDefinitionRepository,
+	bizConfigRepo repository.BusinessConfigRepository,
 	parser PolicyExecutor,
+	providers provider.Registry,
+	builtinEnv provider.BuiltinEnv,
 ) PermissionSvc {
 	return &permissionSvc{
 		permissionRepo: permissionRepo,
 		resourceRepo:   resourceRepo,
-		policyRepo:     policyRepo,
+		policyCache:    policyCache,
 		valRepo:        valRepo,
 		attrRepo:       attrRepo,
+		bizConfigRepo:  bizConfigRepo,
 		parser:         parser,
+		providers:      providers,
+		builtinEnv:     builtinEnv,
+		logger:         elog.DefaultLogger.With(elog.FieldName("ABACPermissionSvc")),
 	}
 }
+
+// combining 合并多个策略结果所需的配置
+type combining struct {
+	algorithm domain.CombiningAlgorithm
+	// notApplicable 没有任何适用策略时的结果
+	notApplicable bool
+}
+
+// checkInput 单次校验所需的数据
+type checkInput struct {
+	// 命中的权限ID以及其资源 key 的具体程度
+	permissions map[int64]int
+	combining   combining
+	policies    []*CompiledPolicy
+	subObj      domain.ABACObject
+	resObj      domain.ABACObject
+	envObj      domain.ABACObject
+}
+
 func (p *permissionSvc) Check(ctx context.Context, bizId, uid int64, resource domain.Resource, action []string, attrs domain.Attributes) (bool, error) {
-	permissions, res, bizDefinition, err := p.getPermissionAndRes(ctx, bizId, resource, action)
+	return p.CheckAsOf(ctx, bizId, uid, resource, action, attrs, 0)
+}
+
+func (p *permissionSvc) CheckAsOf(ctx context.Context, bizId, uid int64, resource domain.Resource, action []string, attrs domain.Attributes, asOf int64) (bool, error) {
+	in, err := p.loadCheckInput(ctx, bizId, uid, resource, action, attrs, asOf)
 	if err != nil {
 		return false, err
 	}
-	permissionIds := slice.Map(permissions, func(idx int, src domain.Permission) int64 {
-		return src.ID
+	return p.decide(in.policies, in.permissions, in.combining, func(policy *CompiledPolicy) domain.EvalResult {
+		return p.parser.EvaluateCompiled(policy, in.subObj, in.resObj, in.envObj)
+	}), nil
+}
+
+func (p *permissionSvc) Explain(ctx context.Context, bizId, uid int64, resource domain.Resource, action []string, attrs domain.Attributes) (domain.ABACTrace, error) {
+	return p.ExplainAsOf(ctx, bizId, uid, resource, action, attrs, 0)
+}
+
+func (p *permissionSvc) ExplainAsOf(ctx context.Context, bizId, uid int64, resource domain.Resource, action []string, attrs domain.Attributes, asOf int64) (domain.ABACTrace, error) {
+	in, err := p.loadCheckInput(ctx, bizId, uid, resource, action, attrs, asOf)
+	if err != nil {
+		return domain.ABACTrace{}, err
+	}
+	var res domain.ABACTrace
+	res.Allowed = p.decide(in.policies, in.permissions, in.combining, func(policy *CompiledPolicy) domain.EvalResult {
+		trace := p.parser.ExplainCompiled(policy, in.subObj, in.resObj, in.envObj)
+		res.Policies = append(res.Policies, trace)
+		switch {
+		case trace.Indeterminate:
+			return domain.EvalIndeterminate
+		case trace.Result:
+			return domain.EvalTrue
+		default:
+			return domain.EvalFalse
+		}
 	})
+	return res, nil
+}
+
+// simulateConcurrency 策略模拟时同时校验的样本数量
+const simulateConcurrency = 8
+
+// Simulate 每个样本单独查询校验所需的数据，最多同时校验 simulateConcurrency 个样本
+func (p *permissionSvc) Simulate(ctx context.Context, bizId int64, candidate domain.Policy, samples []domain.SimulationSample) ([]domain.SimulationResult, error) {
+	candidate.Status = domain.PolicyStatusActive
+	candidate.BizID = bizId
+	compiled, err := p.policyCache.Compile(ctx, candidate)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]domain.SimulationResult, len(samples))
+	var eg errgroup.Group
+	eg.SetLimit(simulateConcurrency)
+	for idx := range samples {
+		sample := samples[idx]
+		eg.Go(func() error {
+			in, err := p.loadCheckInput(ctx, bizId, sample.UserID, sample.Resource, sample.Actions, sample.Attrs, 0)
+			if err != nil {
+				return err
+			}
+			hit := func(policy *CompiledPolicy) domain.EvalResult {
+				return p.parser.EvaluateCompiled(policy, in.subObj, in.resObj, in.envObj)
+			}
+			result := domain.SimulationResult{
+				Before: p.decide(in.policies, in.permissions, in.combining, hit),
+			}
+			after := slice.FilterMap(in.policies, func(_ int, src *CompiledPolicy) (*CompiledPolicy, bool) {
+				return src, candidate.ID == 0 || src.ID != candidate.ID
+			})
+			if candidate.ContainsAnyPermissions(mapx.Keys(in.permissions)) {
+				result.Applicable = true
+				result.Matched = hit(compiled) == domain.EvalTrue
+				after = append(after, compiled)
+			}
+			result.After = p.decide(after, in.permissions, in.combining, hit)
+			res[idx] = result
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// loadCheckInput asOf 为 0 时使用当前的数据，否则使用 asOf 时刻的历史数据
+func (p *permissionSvc) loadCheckInput(ctx context.Context, bizId, uid int64, resource domain.Resource, action []string, attrs domain.Attributes, asOf int64) (checkInput, error) {
+	permissions, res, bizDefinition, bizConfig, err := p.getPermissionAndRes(ctx, bizId, resource, action)
+	if err != nil {
+		return checkInput{}, err
+	}
+	perms := permissionSpecificity(permissions)
 	resource.ID = res.ID
+	// 资源属性按资源类型解析，不同资源类型可以有同名的属性
+	bizDefinition = bizDefinition.ForResourceType(resource.Type)
 
 	var (
 		eg       errgroup.Group
 		subObj   domain.ABACObject
 		resObj   domain.ABACObject
 		envObj   domain.ABACObject
-		policies []domain.Policy
+		policies []*CompiledPolicy
 	)
 	eg.Go(func() error {
 		var err error
-		subObj, err = p.valRepo.FindSubjectValue(ctx, bizId, uid)
+		if asOf > 0 {
+			subObj, err = p.valRepo.FindValueAsOf(ctx, bizId, domain.SubjectTypeEntity, uid, asOf)
+		} else {
+			subObj, err = p.valRepo.FindSubjectValue(ctx, bizId, uid)
+		}
 		subObj.FillDefinitions(bizDefinition.SubjectAttrDefs)
 		return err
 	})
 	eg.Go(func() error {
 		var err error
-		resObj, err = p.valRepo.FindResourceValue(ctx, bizId, resource.ID)
+		if asOf > 0 {
+			resObj, err = p.valRepo.FindValueAsOf(ctx, bizId, domain.ResourceTypeEntity, resource.ID, asOf)
+		} else {
+			resObj, err = p.valRepo.FindResourceValue(ctx, bizId, resource.ID)
+		}
 		resObj.FillDefinitions(bizDefinition.ResourceAttrDefs)
 		return err
 	})
 	eg.Go(func() error {
 		var err error
-		envObj, err = p.valRepo.FindEnvironmentValue(ctx, bizId)
+		if asOf > 0 {
+			envObj, err = p.valRepo.FindValueAsOf(ctx, bizId, domain.EnvironmentTypeEntity, 0, asOf)
+		} else {
+			envObj, err = p.valRepo.FindEnvironmentValue(ctx, bizId)
+		}
 		envObj.FillDefinitions(bizDefinition.EnvironmentAttrDefs)
 		return err
 	})
 	eg.Go(func() error {
 		var err error
-		policies, err = p.policyRepo.FindPoliciesByPermissionIDs(ctx, bizId, permissionIds)
+		if asOf > 0 {
+			policies, err = p.policyCache.FindPoliciesAsOf(ctx, bizId, mapx.Keys(perms), asOf)
+		} else {
+			policies, err = p.policyCache.FindPolicies(ctx, bizId, mapx.Keys(perms))
+		}
 		return err
 	})
 	err = eg.Wait()
 	if err != nil {
-		return false, err
+		return checkInput{}, err
 	}
 	subObj.MergeRealTimeAttr(bizDefinition.SubjectAttrDefs, attrs.Subject)
 	resObj.MergeRealTimeAttr(bizDefinition.ResourceAttrDefs, attrs.Resource)
 	envObj.MergeRealTimeAttr(bizDefinition.EnvironmentAttrDefs, attrs.Environment)
-	var hasPermit bool
-	var hasDeny bool
-	if len(policies) == 0 {
-		return false, nil
+	p.builtinEnv.Fill(ctx, bizDefinition.EnvironmentAttrDefs, &envObj, asOf)
+	// 外部来源只能提供当前的属性值
+	if asOf == 0 {
+		p.provideAttrs(ctx, provider.Request{BizID: bizId, UID: uid, Resource: resource}, policies, map[domain.EntityType]*domain.ABACObject{
+			domain.SubjectTypeEntity:     &subObj,
+			domain.ResourceTypeEntity:    &resObj,
+			domain.EnvironmentTypeEntity: &envObj,
+		})
+	}
+	return checkInput{
+		permissions: perms,
+		combining:   combiningFor(bizConfig, permissions),
+		policies:    policies,
+		subObj:      subObj,
+		resObj:      resObj,
+		envObj:      envObj,
+	}, nil
+}
+
+// batchCheckConcurrency 批量校验时同时查询的校验项数量
+const batchCheckConcurrency = 8
+
+// BatchCheck 批量校验，属性定义、主体属性、环境属性以及策略只查询一次，
+// 资源相关的数据按校验项分别查询，最多同时查询 batchCheckConcurrency 项，
+// 查询失败的校验项记录日志并视为拒绝，不影响其他校验项
+func (p *permissionSvc) BatchCheck(ctx context.Context, bizId, uid int64, items []domain.CheckItem, attrs domain.Attributes) ([]bool, error) {
+	var (
+		eg            errgroup.Group
+		bizDefinition domain.BizAttrDefinition
+		bizConfig     domain.BusinessConfig
+		subObj        domain.ABACObject
+		envObj        domain.ABACObject
+		itemPerms     = make([]map[int64]int, len(items))
+		itemPermList  = make([][]domain.Permission, len(items))
+		resObjs       = make([]domain.ABACObject, len(items))
+		itemFailed    = make([]bool, len(items))
+	)
+	eg.SetLimit(batchCheckConcurrency)
+	eg.Go(func() error {
+		var err error
+		bizDefinition, err = p.attrRepo.FindByBizID(ctx, bizId)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		bizConfig, err = p.bizConfigRepo.FindByID(ctx, bizId)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		subObj, err = p.valRepo.FindSubjectValue(ctx, bizId, uid)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		envObj, err = p.valRepo.FindEnvironmentValue(ctx, bizId)
+		return err
+	})
+	for idx := range items {
+		item := items[idx]
+		eg.Go(func() error {
+			permissions, resObj, err := p.loadItem(ctx, bizId, item)
+			if err != nil {
+				itemFailed[idx] = true
+				p.logger.Warn("查询校验项的权限以及资源失败", elog.FieldErr(err), elog.Int64("bizID", bizId),
+					elog.String("resourceType", item.Resource.Type), elog.String("resourceKey", item.Resource.Key))
+				return nil
+			}
+			itemPerms[idx] = permissionSpecificity(permissions)
+			itemPermList[idx] = permissions
+			resObjs[idx] = resObj
+			return nil
+		})
+	}
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+
+	allPermIds := make([]int64, 0, len(items))
+	for idx := range itemPerms {
+		allPermIds = append(allPermIds, mapx.Keys(itemPerms[idx])...)
+	}
+	allPolicies, err := p.policyCache.FindPolicies(ctx, bizId, allPermIds)
+	if err != nil {
+		return nil, err
+	}
+
+	subObj.FillDefinitions(bizDefinition.SubjectAttrDefs)
+	subObj.MergeRealTimeAttr(bizDefinition.SubjectAttrDefs, attrs.Subject)
+	envObj.FillDefinitions(bizDefinition.EnvironmentAttrDefs)
+	envObj.MergeRealTimeAttr(bizDefinition.EnvironmentAttrDefs, attrs.Environment)
+	p.builtinEnv.Fill(ctx, bizDefinition.EnvironmentAttrDefs, &envObj, 0)
+	// 主体和环境属性所有校验项共用，只获取一次
+	p.provideAttrs(ctx, provider.Request{BizID: bizId, UID: uid}, allPolicies, map[domain.EntityType]*domain.ABACObject{
+		domain.SubjectTypeEntity:     &subObj,
+		domain.EnvironmentTypeEntity: &envObj,
+	})
+	res := make([]bool, 0, len(items))
+	for idx := range items {
+		if itemFailed[idx] {
+			res = append(res, false)
+			continue
+		}
+		resObj := resObjs[idx]
+		resDefs := bizDefinition.ForResourceType(items[idx].Resource.Type).ResourceAttrDefs
+		resObj.FillDefinitions(resDefs)
+		resObj.MergeRealTimeAttr(resDefs, attrs.Resource)
+		itemPermIds := mapx.Keys(itemPerms[idx])
+		policies := slice.FilterMap(allPolicies, func(_ int, src *CompiledPolicy) (*CompiledPolicy, bool) {
+			return src, src.ContainsAnyPermissions(itemPermIds)
+		})
+		p.provideAttrs(ctx, provider.Request{BizID: bizId, UID: uid, Resource: items[idx].Resource}, policies, map[domain.EntityType]*domain.ABACObject{
+			domain.ResourceTypeEntity: &resObj,
+		})
+		res = append(res, p.decide(policies, itemPerms[idx], combiningFor(bizConfig, itemPermList[idx]), func(policy *CompiledPolicy) domain.EvalResult {
+			return p.parser.EvaluateCompiled(policy, subObj, resObj, envObj)
+		}))
+	}
+	return res, nil
+}
+
+// loadItem 查询单个校验项命中的权限以及资源的属性值
+func (p *permissionSvc) loadItem(ctx context.Context, bizId int64, item domain.CheckItem) ([]domain.Permission, domain.ABACObject, error) {
+	permissions, err := p.permissionRepo.FindPermissions(ctx, bizId, item.Resource.Type, item.Resource.Key, item.Actions)
+	if err != nil {
+		return nil, domain.ABACObject{}, err
+	}
+	res, err := p.resourceRepo.FindByBizIDAndTypeAndKey(ctx, bizId, item.Resource.Type, item.Resource.Key)
+	if err != nil {
+		return nil, domain.ABACObject{}, err
 	}
+	resObj, err := p.valRepo.FindResourceValue(ctx, bizId, res.ID)
+	return permissions, resObj, err
+}
+
+// provideAttrs 策略引用了但是既没有存储也没有在请求中传入的属性通过 AttributeProvider 获取，
+// 只处理 objs 中有的实体类型，获取失败时记录日志并按属性缺失处理
+func (p *permissionSvc) provideAttrs(ctx context.Context, req provider.Request, policies []*CompiledPolicy, objs map[domain.EntityType]*domain.ABACObject) {
+	type pending struct {
+		def      domain.AttributeDefinition
+		provider provider.AttributeProvider
+		value    string
+		found    bool
+	}
+	var list []*pending
+	seen := make(map[int64]struct{})
+	for _, policy := range policies {
+		if policy.Status == domain.PolicyStatusInActive {
+			continue
+		}
+		for _, def := range policy.Attributes() {
+			obj, ok := objs[def.EntityType]
+			if !ok {
+				continue
+			}
+			if _, ok = seen[def.ID]; ok {
+				continue
+			}
+			seen[def.ID] = struct{}{}
+			if obj.HasAttr(def.ID) {
+				continue
+			}
+			if ap, ok := p.providers.Get(req.BizID, def); ok {
+				list = append(list, &pending{def: def, provider: ap})
+			}
+		}
+	}
+	var wg sync.WaitGroup
+	for _, item := range list {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			itemReq := req
+			itemReq.AttrDef = item.def
+			var err error
+			item.value, item.found, err = item.provider.Provide(ctx, itemReq)
+			if err != nil {
+				item.found = false
+				p.logger.Warn("从属性来源获取属性失败", elog.FieldErr(err), elog.Int64("bizID", req.BizID), elog.String("attribute", item.def.Name))
+			}
+		}()
+	}
+	wg.Wait()
+	for _, item := range list {
+		if item.found {
+			objs[item.def.EntityType].SetAttributeVal(item.value, item.def)
+		}
+	}
+}
+
+// decide 执行策略并按合并算法得出结果，与 RBAC 一致只有资源 key 最具体的权限参与合并，
+// hit 返回策略规则是否满足，规则满足的策略才是适用的，无法判定的策略交给合并算法处理
+func (p *permissionSvc) decide(policies []*CompiledPolicy, permissions map[int64]int, comb combining, hit func(policy *CompiledPolicy) domain.EvalResult) bool {
+	effects := make([]domain.PolicyEffect, 0, len(policies))
 	for index := range policies {
 		policy := policies[index]
-		if p.parser.Check(policy, subObj, resObj, envObj) {
+		// 未启用的策略不参与决策
+		if policy.Status == domain.PolicyStatusInActive {
+			continue
+		}
+		result := hit(policy)
+		if result != domain.EvalFalse {
 			for index := range policy.Permissions {
 				perm := policy.Permissions[index]
-				if perm.Effect == domain.EffectAllow {
-					hasPermit = true
-				}
-				if perm.Effect == domain.EffectDeny {
-					hasDeny = true
+				specificity, ok := permissions[perm.Permission.ID]
+				if !ok {
+					continue
 				}
+				effects = append(effects, domain.PolicyEffect{
+					PolicyID:    policy.ID,
+					Priority:    policy.Priority,
+					Specificity: specificity,
+					Effect:      perm.Effect,
+					// Indeterminate 参考 XACML Indeterminate{P}、Indeterminate{D}，按策略的效果区分
+					Indeterminate: result == domain.EvalIndeterminate,
+				})
 			}
 		}
 	}
-	if hasDeny {
-		return false, nil
+	return comb.algorithm.CombinePolicyEffects(effects, comb.notApplicable)
+}
+
+// combiningFor 资源 key 最具体的权限上配置了合并算法并且配置一致时使用权限上的，否则使用业务配置
+func combiningFor(config domain.BusinessConfig, permissions []domain.Permission) combining {
+	res := combining{
+		algorithm:     config.CombiningAlgorithm.Normalize(),
+		notApplicable: config.NotApplicableAllowed(),
 	}
-	if hasPermit {
-		return true, nil
+	var (
+		override    domain.CombiningAlgorithm
+		specificity = -1
+		conflict    bool
+	)
+	for _, src := range permissions {
+		spec := domain.ResourceKeySpecificity(src.Resource.Key)
+		switch {
+		case spec > specificity:
+			specificity, override, conflict = spec, src.CombiningAlgorithm, false
+		case spec == specificity && src.CombiningAlgorithm != override:
+			conflict = true
+		}
+	}
+	if override != "" && !conflict {
+		res.algorithm = override
 	}
-	return false, nil
+	return res
 }
-func (p *permissionSvc) getPermissionAndRes(ctx context.Context, bizId int64, resource domain.Resource, action []string) ([]domain.Permission, domain.Resource, domain.BizAttrDefinition, error) {
+
+func permissionSpecificity(permissions []domain.Permission) map[int64]int {
+	res := make(map[int64]int, len(permissions))
+	for _, src := range permissions {
+		res[src.ID] = domain.ResourceKeySpecificity(src.Resource.Key)
+	}
+	return res
+}
+func (p *permissionSvc) getPermissionAndRes(ctx context.Context, bizId int64, resource domain.Resource, action []string) ([]domain.Permission, domain.Resource, domain.BizAttrDefinition, domain.BusinessConfig, error) {
 	var (
 		eg          errgroup.Group
 		permissions []domain.Permission
 		res         domain.Resource
 		bizDef      domain.BizAttrDefinition
+		bizConfig   domain.BusinessConfig
 	)
 	eg.Go(func() error {
 		var err error
@@ -134,6 +511,11 @@ func (p *permissionSvc) getPermissionAndRes(ctx context.Context, bizId int64, re
 		bizDef, err = p.attrRepo.FindByBizID(ctx, bizId)
 		return err
 	})
+	eg.Go(func() error {
+		var err error
+		bizConfig, err = p.bizConfigRepo.FindByID(ctx, bizId)
+		return err
+	})
 	err := eg.Wait()
-	return permissions, res, bizDef, err
+	return permissions, res, bizDef, bizConfig, err
 }
@@ -0,0 +1,180 @@
+package abac
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/repository"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
+)
+
+// defaultPolicyCacheTTL 缓存的有效期，本实例修改策略时会立刻失效，
+// 多实例部署时其他实例最多延迟这么久看到修改
+const defaultPolicyCacheTTL = time.Minute
+
+// defaultPolicyLoadTimeout 加载业务策略的超时时间，加载由多个请求共享，不受某一个请求的 ctx 取消影响
+const defaultPolicyLoadTimeout = 3 * time.Second
+
+// PolicyCache 按业务缓存编译后的策略，策略发布、回滚、删除以及属性定义变更后需要调用 Invalidate
+type PolicyCache interface {
+	// FindPolicies 返回与任意一个权限关联的策略，顺序与 FindPoliciesByPermissionIDs 一致
+	FindPolicies(ctx context.Context, bizID int64, permissionIDs []int64) ([]*CompiledPolicy, error)
+	// Compile 使用业务当前的属性定义编译策略，不会写入缓存，用于模拟候选策略
+	Compile(ctx context.Context, policy domain.Policy) (*CompiledPolicy, error)
+	// FindPoliciesAsOf 返回 asOf（毫秒）时刻生效并且与任意一个权限关联的策略，不使用缓存，
+	// 属性定义没有历史，使用当前的属性定义编译
+	FindPoliciesAsOf(ctx context.Context, bizID int64, permissionIDs []int64, asOf int64) ([]*CompiledPolicy, error)
+	Invalidate(bizID int64)
+}
+
+type bizPolicies struct {
+	policies []*CompiledPolicy
+	expireAt time.Time
+}
+
+type policyCache struct {
+	policyRepo repository.AttributePolicyRepository
+	attrRepo   repository.AttributeDefinitionRepository
+	executor   PolicyExecutor
+	ttl        time.Duration
+	timeout    time.Duration
+
+	mu      sync.RWMutex
+	entries map[int64]*bizPolicies
+	// versions 每次 Invalidate 加一，加载期间发生过失效的结果不会写入缓存
+	versions map[int64]uint64
+	group    singleflight.Group
+}
+
+func NewPolicyCache(
+	policyRepo repository.AttributePolicyRepository,
+	attrRepo repository.AttributeDefinitionRepository,
+	executor PolicyExecutor,
+) PolicyCache {
+	return &policyCache{
+		policyRepo: policyRepo,
+		attrRepo:   attrRepo,
+		executor:   executor,
+		ttl:        defaultPolicyCacheTTL,
+		timeout:    defaultPolicyLoadTimeout,
+		entries:    make(map[int64]*bizPolicies),
+		versions:   make(map[int64]uint64),
+	}
+}
+
+func (c *policyCache) FindPolicies(ctx context.Context, bizID int64, permissionIDs []int64) ([]*CompiledPolicy, error) {
+	policies, err := c.bizPolicies(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	return slice.FilterMap(policies, func(_ int, src *CompiledPolicy) (*CompiledPolicy, bool) {
+		return src, src.ContainsAnyPermissions(permissionIDs)
+	}), nil
+}
+
+func (c *policyCache) Compile(ctx context.Context, policy domain.Policy) (*CompiledPolicy, error) {
+	defs, err := c.attrRepo.FindByBizID(ctx, policy.BizID)
+	if err != nil {
+		return nil, err
+	}
+	return c.executor.Compile(policy, defs), nil
+}
+
+func (c *policyCache) FindPoliciesAsOf(ctx context.Context, bizID int64, permissionIDs []int64, asOf int64) ([]*CompiledPolicy, error) {
+	var (
+		eg       errgroup.Group
+		policies []domain.Policy
+		defs     domain.BizAttrDefinition
+	)
+	eg.Go(func() error {
+		var err error
+		policies, err = c.policyRepo.FindBizPoliciesAsOf(ctx, bizID, asOf)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		defs, err = c.attrRepo.FindByBizID(ctx, bizID)
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return slice.FilterMap(policies, func(_ int, src domain.Policy) (*CompiledPolicy, bool) {
+		if !src.ContainsAnyPermissions(permissionIDs) {
+			return nil, false
+		}
+		return c.executor.Compile(src, defs), true
+	}), nil
+}
+
+func (c *policyCache) Invalidate(bizID int64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, bizID)
+	c.versions[bizID]++
+}
+
+func (c *policyCache) bizPolicies(ctx context.Context, bizID int64) ([]*CompiledPolicy, error) {
+	c.mu.RLock()
+	entry, ok := c.entries[bizID]
+	version := c.versions[bizID]
+	c.mu.RUnlock()
+	if ok && time.Now().Before(entry.expireAt) {
+		return entry.policies, nil
+	}
+	// 同一个业务同时只加载一次，key 带上版本，失效之后的请求不会复用失效之前的加载。
+	// 加载使用与请求无关的 ctx，发起加载的请求被取消时不会让等待同一个加载的其他请求失败
+	key := strconv.FormatInt(bizID, 10) + ":" + strconv.FormatUint(version, 10)
+	ch := c.group.DoChan(key, func() (any, error) {
+		loadCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+		defer cancel()
+		policies, err := c.load(loadCtx, bizID)
+		if err != nil {
+			return nil, err
+		}
+		c.mu.Lock()
+		if c.versions[bizID] == version {
+			c.entries[bizID] = &bizPolicies{policies: policies, expireAt: time.Now().Add(c.ttl)}
+		}
+		c.mu.Unlock()
+		return policies, nil
+	})
+	select {
+	case res := <-ch:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+		return res.Val.([]*CompiledPolicy), nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func (c *policyCache) load(ctx context.Context, bizID int64) ([]*CompiledPolicy, error) {
+	var (
+		eg       errgroup.Group
+		policies []domain.Policy
+		defs     domain.BizAttrDefinition
+	)
+	eg.Go(func() error {
+		var err error
+		policies, err = c.policyRepo.FindBizPolicies(ctx, bizID)
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		defs, err = c.attrRepo.FindByBizID(ctx, bizID)
+		return err
+	})
+	if err := eg.Wait(); err != nil {
+		return nil, err
+	}
+	return slice.Map(policies, func(_ int, src domain.Policy) *CompiledPolicy {
+		return c.executor.Compile(src, defs)
+	}), nil
+}
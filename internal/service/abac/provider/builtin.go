@@ -0,0 +1,115 @@
+package provider
+
+import (
+	"context"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/permission-dev/internal/domain"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// Clock 服务端时钟，内置的时间属性使用，测试时替换成固定的时间
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+func NewSystemClock() Clock {
+	return systemClock{}
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// BuiltinEnv 内置的环境属性来源，值来自服务端时钟以及请求的 context，见 domain.BuiltinEnvAttrs
+type BuiltinEnv interface {
+	// Fill 为 defs 中定义了的内置属性填充 env，asOf 不为 0 时时间使用 asOf（毫秒）
+	Fill(ctx context.Context, defs domain.AttrDefs, env *domain.ABACObject, asOf int64)
+}
+
+// builtinSource 获取内置属性的值，override 为 true 时服务端的值优先，覆盖存储以及请求中的值；
+// 否则只在没有值时填充，例如调用方的应用名可以由调用方自己传
+type builtinSource struct {
+	value    func(ctx context.Context, now time.Time) (string, bool)
+	override bool
+}
+
+type builtinEnv struct {
+	clock   Clock
+	sources map[string]builtinSource
+}
+
+// NewBuiltinEnv clientIPConfig 指定用调用方地址填充的环境属性名，为空时不填充
+func NewBuiltinEnv(clock Clock, clientIPConfig domain.ClientIPConfig) BuiltinEnv {
+	sources := map[string]builtinSource{
+		domain.BuiltinEnvCurrentTime: {value: currentTime, override: true},
+		domain.BuiltinEnvWeekday:     {value: weekday, override: true},
+		domain.BuiltinEnvGRPCMethod:  {value: grpcMethod, override: true},
+		domain.BuiltinEnvClientApp:   {value: clientApp},
+	}
+	if clientIPConfig.Attribute != "" {
+		sources[clientIPConfig.Attribute] = builtinSource{value: clientIP, override: true}
+	}
+	return &builtinEnv{clock: clock, sources: sources}
+}
+
+func (b *builtinEnv) Fill(ctx context.Context, defs domain.AttrDefs, env *domain.ABACObject, asOf int64) {
+	now := b.clock.Now()
+	if asOf > 0 {
+		now = time.UnixMilli(asOf).In(now.Location())
+	}
+	for _, def := range defs {
+		source, ok := b.sources[def.Name]
+		if !ok || (!source.override && env.HasAttr(def.ID)) {
+			continue
+		}
+		if val, ok := source.value(ctx, now); ok {
+			env.SetAttributeVal(val, def)
+		}
+	}
+}
+
+func currentTime(_ context.Context, now time.Time) (string, bool) {
+	return strconv.FormatInt(now.UnixMilli(), 10), true
+}
+
+// weekday 使用时钟的时区，与时间规则中的星期一致，0 为周日
+func weekday(_ context.Context, now time.Time) (string, bool) {
+	return strconv.Itoa(int(now.Weekday())), true
+}
+
+func grpcMethod(ctx context.Context, _ time.Time) (string, bool) {
+	return grpc.Method(ctx)
+}
+
+// clientIP 覆盖请求中传的地址，否则调用方可以伪造自己的地址绕过 CIDR 规则
+func clientIP(ctx context.Context, _ time.Time) (string, bool) {
+	pr, ok := peer.FromContext(ctx)
+	if !ok || pr.Addr == nil {
+		return "", false
+	}
+	addrPort, err := netip.ParseAddrPort(pr.Addr.String())
+	if err != nil {
+		return "", false
+	}
+	return addrPort.Addr().Unmap().String(), true
+}
+
+// clientApp ego 的 gRPC 客户端会在 metadata 中带上应用名
+func clientApp(ctx context.Context, _ time.Time) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+	apps := md.Get("app")
+	if len(apps) == 0 || apps[0] == "" {
+		return "", false
+	}
+	return apps[0], true
+}
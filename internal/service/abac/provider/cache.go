@@ -0,0 +1,81 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/permission-dev/internal/domain"
+)
+
+type cachedValue struct {
+	value    string
+	found    bool
+	expireAt time.Time
+}
+
+// cachedProvider 为单个属性的来源加上超时控制以及结果缓存，出错的结果不缓存
+type cachedProvider struct {
+	provider AttributeProvider
+	timeout  time.Duration
+	ttl      time.Duration
+
+	mu      sync.Mutex
+	entries map[string]cachedValue
+	// nextSweep 下次清理过期结果的时间，每个 ttl 最多清理一次，避免一直增长
+	nextSweep time.Time
+}
+
+// NewCachedProvider timeout 为单次获取的超时时间，ttl 为 0 时不缓存
+func NewCachedProvider(provider AttributeProvider, timeout, ttl time.Duration) AttributeProvider {
+	return &cachedProvider{
+		provider: provider,
+		timeout:  timeout,
+		ttl:      ttl,
+		entries:  make(map[string]cachedValue),
+	}
+}
+
+func (c *cachedProvider) Provide(ctx context.Context, req Request) (string, bool, error) {
+	key := cacheKey(req)
+	now := time.Now()
+	if c.ttl > 0 {
+		c.mu.Lock()
+		entry, ok := c.entries[key]
+		c.mu.Unlock()
+		if ok && now.Before(entry.expireAt) {
+			return entry.value, entry.found, nil
+		}
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	value, found, err := c.provider.Provide(ctx, req)
+	if err != nil || c.ttl <= 0 {
+		return value, found, err
+	}
+	c.mu.Lock()
+	c.entries[key] = cachedValue{value: value, found: found, expireAt: now.Add(c.ttl)}
+	if !now.Before(c.nextSweep) {
+		for k, v := range c.entries {
+			if !now.Before(v.expireAt) {
+				delete(c.entries, k)
+			}
+		}
+		c.nextSweep = now.Add(c.ttl)
+	}
+	c.mu.Unlock()
+	return value, found, nil
+}
+
+// cacheKey 主体属性按用户区分，资源属性按资源区分，环境属性只按业务区分
+func cacheKey(req Request) string {
+	switch req.AttrDef.EntityType {
+	case domain.SubjectTypeEntity:
+		return fmt.Sprintf("%d:%d:%d", req.BizID, req.AttrDef.ID, req.UID)
+	case domain.ResourceTypeEntity:
+		return fmt.Sprintf("%d:%d:%s:%s", req.BizID, req.AttrDef.ID, req.Resource.Type, req.Resource.Key)
+	default:
+		return fmt.Sprintf("%d:%d", req.BizID, req.AttrDef.ID)
+	}
+}
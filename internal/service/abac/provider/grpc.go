@@ -0,0 +1,45 @@
+package provider
+
+import (
+	"context"
+
+	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
+	"github.com/permission-dev/internal/domain"
+)
+
+// grpcProvider 调用外部实现的 AttributeProviderService
+type grpcProvider struct {
+	client permissionv1.AttributeProviderServiceClient
+}
+
+func NewGRPCProvider(client permissionv1.AttributeProviderServiceClient) AttributeProvider {
+	return &grpcProvider{client: client}
+}
+
+func (g *grpcProvider) Provide(ctx context.Context, req Request) (string, bool, error) {
+	resp, err := g.client.GetAttribute(ctx, &permissionv1.AttributeProviderServiceGetAttributeRequest{
+		BizId:        req.BizID,
+		EntityType:   toProtoEntityType(req.AttrDef.EntityType),
+		Attribute:    req.AttrDef.Name,
+		Uid:          req.UID,
+		ResourceType: req.Resource.Type,
+		ResourceKey:  req.Resource.Key,
+	})
+	if err != nil {
+		return "", false, err
+	}
+	return resp.GetValue(), resp.GetFound(), nil
+}
+
+func toProtoEntityType(e domain.EntityType) permissionv1.EntityType {
+	switch e {
+	case domain.SubjectTypeEntity:
+		return permissionv1.EntityType_ENTITY_TYPE_SUBJECT
+	case domain.ResourceTypeEntity:
+		return permissionv1.EntityType_ENTITY_TYPE_RESOURCE
+	case domain.EnvironmentTypeEntity:
+		return permissionv1.EntityType_ENTITY_TYPE_ENVIRONMENT
+	default:
+		return permissionv1.EntityType_ENTITY_TYPE_UNKNOWN
+	}
+}
@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/permission-dev/internal/errs"
+)
+
+// HTTPRequest http 来源的请求体
+type HTTPRequest struct {
+	BizID        int64  `json:"bizId"`
+	EntityType   string `json:"entityType"`
+	Attribute    string `json:"attribute"`
+	UID          int64  `json:"uid"`
+	ResourceType string `json:"resourceType"`
+	ResourceKey  string `json:"resourceKey"`
+}
+
+// HTTPResponse http 来源的响应体
+type HTTPResponse struct {
+	Found bool   `json:"found"`
+	Value string `json:"value"`
+}
+
+// httpProvider 以 POST 方式调用接口，返回 404 时视为没有该属性
+type httpProvider struct {
+	endpoint string
+	client   *http.Client
+}
+
+func NewHTTPProvider(endpoint string, client *http.Client) AttributeProvider {
+	return &httpProvider{
+		endpoint: endpoint,
+		client:   client,
+	}
+}
+
+func (h *httpProvider) Provide(ctx context.Context, req Request) (string, bool, error) {
+	body, err := json.Marshal(HTTPRequest{
+		BizID:        req.BizID,
+		EntityType:   req.AttrDef.EntityType.String(),
+		Attribute:    req.AttrDef.Name,
+		UID:          req.UID,
+		ResourceType: req.Resource.Type,
+		ResourceKey:  req.Resource.Key,
+	})
+	if err != nil {
+		return "", false, err
+	}
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, h.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", false, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	resp, err := h.client.Do(httpReq)
+	if err != nil {
+		return "", false, err
+	}
+	defer resp.Body.Close()
+	switch {
+	case resp.StatusCode == http.StatusNotFound:
+		return "", false, nil
+	case resp.StatusCode != http.StatusOK:
+		return "", false, fmt.Errorf("%w: %s 返回 %d", errs.ErrAttrProviderFailed, h.endpoint, resp.StatusCode)
+	}
+	var res HTTPResponse
+	if err = json.NewDecoder(resp.Body).Decode(&res); err != nil {
+		return "", false, fmt.Errorf("%w: %w", errs.ErrAttrProviderFailed, err)
+	}
+	return res.Value, res.Found, nil
+}
@@ -0,0 +1,71 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// DefaultTimeout 没有配置超时时间时单次获取属性的超时时间
+const DefaultTimeout = 100 * time.Millisecond
+
+type registryKey struct {
+	bizID      int64
+	entityType domain.EntityType
+	attribute  string
+}
+
+// registry 按业务、实体类型以及属性名查找，属性定义改名之后需要同步修改配置
+type registry struct {
+	providers map[registryKey]AttributeProvider
+}
+
+// NewRegistry 按配置创建各个属性的来源，同一个 grpc 地址共用一个连接
+func NewRegistry(configs []domain.AttributeProviderConfig) (Registry, error) {
+	res := &registry{providers: make(map[registryKey]AttributeProvider, len(configs))}
+	httpClient := &http.Client{}
+	conns := make(map[string]*grpc.ClientConn)
+	for _, cfg := range configs {
+		var p AttributeProvider
+		switch cfg.Type {
+		case domain.AttributeProviderStatic:
+			p = NewStaticProvider(cfg.Value)
+		case domain.AttributeProviderHTTP:
+			p = NewHTTPProvider(cfg.Endpoint, httpClient)
+		case domain.AttributeProviderGRPC:
+			conn, ok := conns[cfg.Endpoint]
+			if !ok {
+				var err error
+				conn, err = grpc.NewClient(cfg.Endpoint, grpc.WithTransportCredentials(insecure.NewCredentials()))
+				if err != nil {
+					return nil, err
+				}
+				conns[cfg.Endpoint] = conn
+			}
+			p = NewGRPCProvider(permissionv1.NewAttributeProviderServiceClient(conn))
+		default:
+			return nil, fmt.Errorf("%w: %s", errs.ErrUnknownAttrProvider, cfg.Type)
+		}
+		timeout := cfg.Timeout
+		if timeout <= 0 {
+			timeout = DefaultTimeout
+		}
+		res.Register(cfg.BizID, cfg.EntityType, cfg.Attribute, NewCachedProvider(p, timeout, cfg.CacheTTL))
+	}
+	return res, nil
+}
+
+func (r *registry) Register(bizID int64, entityType domain.EntityType, attribute string, p AttributeProvider) {
+	r.providers[registryKey{bizID: bizID, entityType: entityType, attribute: attribute}] = p
+}
+
+func (r *registry) Get(bizID int64, def domain.AttributeDefinition) (AttributeProvider, bool) {
+	p, ok := r.providers[registryKey{bizID: bizID, entityType: def.EntityType, attribute: def.Name}]
+	return p, ok
+}
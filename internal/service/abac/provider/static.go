@@ -0,0 +1,16 @@
+package provider
+
+import "context"
+
+// staticProvider 固定返回配置的值
+type staticProvider struct {
+	value string
+}
+
+func NewStaticProvider(value string) AttributeProvider {
+	return &staticProvider{value: value}
+}
+
+func (s *staticProvider) Provide(_ context.Context, _ Request) (string, bool, error) {
+	return s.value, true, nil
+}
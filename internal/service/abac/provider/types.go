@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/permission-dev/internal/domain"
+)
+
+// AttributeProvider 从外部来源获取属性值，属性既没有存储也没有在请求中传入时使用
+type AttributeProvider interface {
+	// Provide 没有该属性时 found 返回 false
+	Provide(ctx context.Context, req Request) (value string, found bool, err error)
+}
+
+// Request 获取属性所需的信息，环境属性不区分主体和资源
+type Request struct {
+	BizID    int64
+	AttrDef  domain.AttributeDefinition
+	UID      int64
+	Resource domain.Resource
+}
+
+// Registry 按属性定义查找 AttributeProvider
+type Registry interface {
+	// Register 注册自定义的属性来源，已经存在时覆盖，只能在启动时调用
+	Register(bizID int64, entityType domain.EntityType, attribute string, p AttributeProvider)
+	Get(bizID int64, def domain.AttributeDefinition) (AttributeProvider, bool)
+}
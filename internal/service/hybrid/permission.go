@@ -3,33 +3,168 @@ package hybrid
 import (
 	"context"
 	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/repository"
 	"github.com/permission-dev/internal/service/abac"
 	"github.com/permission-dev/internal/service/rbac"
 )
 
+// PermissionService 按业务配置的校验模式组合 RBAC 和 ABAC
 type PermissionService interface {
 	Check(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (bool, error)
+	BatchCheck(ctx context.Context, bizID, userID int64, items []domain.CheckItem, attrs domain.Attributes) ([]bool, error)
+	Explain(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (domain.CheckTrace, error)
+	// CheckAsOf 使用 asOf（毫秒）时刻的授权、属性值和策略校验，校验模式使用当前的业务配置
+	CheckAsOf(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes, asOf int64) (bool, error)
+	ExplainAsOf(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes, asOf int64) (domain.CheckTrace, error)
 }
 
 type permissionService struct {
-	rbacSvc rbac.PermissionService
-	abacSvc abac.PermissionSvc
+	rbacSvc       rbac.PermissionService
+	abacSvc       abac.PermissionSvc
+	bizConfigRepo repository.BusinessConfigRepository
 }
 
 func NewPermissionService(
 	rbacSvc rbac.PermissionService,
 	abacSvc abac.PermissionSvc,
+	bizConfigRepo repository.BusinessConfigRepository,
 ) PermissionService {
 	return &permissionService{
-		rbacSvc: rbacSvc,
-		abacSvc: abacSvc,
+		rbacSvc:       rbacSvc,
+		abacSvc:       abacSvc,
+		bizConfigRepo: bizConfigRepo,
 	}
 }
 
 func (p *permissionService) Check(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (bool, error) {
-	ok, err := p.rbacSvc.Check(ctx, bizID, userID, resource, actions)
-	if err != nil || !ok {
+	return p.CheckAsOf(ctx, bizID, userID, resource, actions, attrs, 0)
+}
+
+func (p *permissionService) CheckAsOf(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes, asOf int64) (bool, error) {
+	mode, err := p.checkMode(ctx, bizID)
+	if err != nil {
 		return false, err
 	}
-	return p.abacSvc.Check(ctx, bizID, userID, resource, actions, attrs)
+	switch mode {
+	case domain.CheckModeABAC:
+		return p.abacSvc.CheckAsOf(ctx, bizID, userID, resource, actions, attrs, asOf)
+	case domain.CheckModeRBACAndABAC:
+		ok, err := p.rbacCheck(ctx, bizID, userID, resource, actions, asOf)
+		if err != nil || !ok {
+			return false, err
+		}
+		return p.abacSvc.CheckAsOf(ctx, bizID, userID, resource, actions, attrs, asOf)
+	case domain.CheckModeRBACOrABAC:
+		ok, err := p.rbacCheck(ctx, bizID, userID, resource, actions, asOf)
+		if err != nil || ok {
+			return ok, err
+		}
+		return p.abacSvc.CheckAsOf(ctx, bizID, userID, resource, actions, attrs, asOf)
+	default:
+		return p.rbacCheck(ctx, bizID, userID, resource, actions, asOf)
+	}
+}
+
+func (p *permissionService) BatchCheck(ctx context.Context, bizID, userID int64, items []domain.CheckItem, attrs domain.Attributes) ([]bool, error) {
+	mode, err := p.checkMode(ctx, bizID)
+	if err != nil {
+		return nil, err
+	}
+	if mode == domain.CheckModeABAC {
+		return p.abacSvc.BatchCheck(ctx, bizID, userID, items, attrs)
+	}
+	res, err := p.rbacSvc.BatchCheck(ctx, bizID, userID, items)
+	if err != nil {
+		return nil, err
+	}
+	switch mode {
+	case domain.CheckModeRBACAndABAC:
+		// 只有 RBAC 通过的校验项才需要走 ABAC
+		return p.abacBatchCheck(ctx, bizID, userID, items, attrs, res, true)
+	case domain.CheckModeRBACOrABAC:
+		// 只有 RBAC 未通过的校验项才需要走 ABAC
+		return p.abacBatchCheck(ctx, bizID, userID, items, attrs, res, false)
+	default:
+		return res, nil
+	}
+}
+
+// abacBatchCheck 对 RBAC 结果等于 rbacRes 的校验项执行 ABAC，并用 ABAC 的结果覆盖
+func (p *permissionService) abacBatchCheck(ctx context.Context, bizID, userID int64, items []domain.CheckItem, attrs domain.Attributes, res []bool, rbacRes bool) ([]bool, error) {
+	idxs := make([]int, 0, len(items))
+	abacItems := make([]domain.CheckItem, 0, len(items))
+	for idx := range res {
+		if res[idx] == rbacRes {
+			idxs = append(idxs, idx)
+			abacItems = append(abacItems, items[idx])
+		}
+	}
+	if len(abacItems) == 0 {
+		return res, nil
+	}
+	abacRes, err := p.abacSvc.BatchCheck(ctx, bizID, userID, abacItems, attrs)
+	if err != nil {
+		return nil, err
+	}
+	for i, idx := range idxs {
+		res[idx] = abacRes[i]
+	}
+	return res, nil
+}
+
+// Explain 不做短路，校验模式涉及的部分都会执行
+func (p *permissionService) Explain(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes) (domain.CheckTrace, error) {
+	return p.ExplainAsOf(ctx, bizID, userID, resource, actions, attrs, 0)
+}
+
+func (p *permissionService) ExplainAsOf(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, attrs domain.Attributes, asOf int64) (domain.CheckTrace, error) {
+	mode, err := p.checkMode(ctx, bizID)
+	if err != nil {
+		return domain.CheckTrace{}, err
+	}
+	res := domain.CheckTrace{Mode: mode}
+	if mode != domain.CheckModeABAC {
+		if asOf > 0 {
+			res.RBAC, err = p.rbacSvc.ExplainAsOf(ctx, bizID, userID, resource, actions, asOf)
+		} else {
+			res.RBAC, err = p.rbacSvc.Explain(ctx, bizID, userID, resource, actions)
+		}
+		if err != nil {
+			return res, err
+		}
+	}
+	if mode != domain.CheckModeRBAC {
+		res.ABAC, err = p.abacSvc.ExplainAsOf(ctx, bizID, userID, resource, actions, attrs, asOf)
+		if err != nil {
+			return res, err
+		}
+	}
+	switch mode {
+	case domain.CheckModeABAC:
+		res.Allowed = res.ABAC.Allowed
+	case domain.CheckModeRBACAndABAC:
+		res.Allowed = res.RBAC.Allowed && res.ABAC.Allowed
+	case domain.CheckModeRBACOrABAC:
+		res.Allowed = res.RBAC.Allowed || res.ABAC.Allowed
+	default:
+		res.Allowed = res.RBAC.Allowed
+	}
+	return res, nil
+}
+
+// rbacCheck asOf 为 0 时使用当前的授权，有效期按当前时间判断
+func (p *permissionService) rbacCheck(ctx context.Context, bizID, userID int64, resource domain.Resource, actions []string, asOf int64) (bool, error) {
+	if asOf > 0 {
+		return p.rbacSvc.CheckAsOf(ctx, bizID, userID, resource, actions, asOf)
+	}
+	return p.rbacSvc.Check(ctx, bizID, userID, resource, actions)
+}
+
+// checkMode 每次校验都会读取业务配置，线上使用 repository.BusinessConfigCachedRepository 读本地缓存
+func (p *permissionService) checkMode(ctx context.Context, bizID int64) (domain.CheckMode, error) {
+	config, err := p.bizConfigRepo.FindByID(ctx, bizID)
+	if err != nil {
+		return "", err
+	}
+	return config.CheckMode.Normalize(), nil
 }
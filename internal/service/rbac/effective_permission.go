@@ -0,0 +1,84 @@
+package rbac
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/permission-dev/internal/domain"
+)
+
+// GetEffectivePermissions 对每个授权过的 (资源类型, 资源 key, 操作) 计算生效的效果，
+// 合并规则和 CheckPermission 一致：覆盖该 key 的权限中最具体的生效，同样具体时 deny 优先。
+// key 为通配符时只考虑不比它更具体的权限，因为更具体的权限只覆盖其中的一部分资源
+func (r *rbacService) GetEffectivePermissions(ctx context.Context, bizID, userID int64, resourceType, resourceKeyPrefix string) ([]domain.EffectivePermission, error) {
+	allUserPermissions, err := r.userPermissionRepo.GetALLUserPermission(ctx, bizID, userID)
+	if err != nil {
+		return nil, err
+	}
+	type key struct {
+		resourceType string
+		resourceKey  string
+		action       string
+	}
+	now := time.Now().Unix()
+	// 按资源类型过滤，前缀只用来过滤返回的条目，前缀之外的通配权限同样会影响结果
+	valid := slice.FilterMap(allUserPermissions, func(_ int, up domain.UserPermission) (domain.UserPermission, bool) {
+		return up, up.IsValidAt(now) && (resourceType == "" || up.Permission.Resource.Type == resourceType)
+	})
+	seen := make(map[key]struct{}, len(valid))
+	res := make([]domain.EffectivePermission, 0, len(valid))
+	for _, up := range valid {
+		p := up.Permission
+		k := key{resourceType: p.Resource.Type, resourceKey: p.Resource.Key, action: p.Action}
+		if _, ok := seen[k]; ok || !strings.HasPrefix(p.Resource.Key, resourceKeyPrefix) {
+			continue
+		}
+		seen[k] = struct{}{}
+		res = append(res, r.effectivePermission(valid, p))
+	}
+	sort.Slice(res, func(i, j int) bool {
+		pi, pj := res[i].Permission, res[j].Permission
+		if pi.Resource.Type != pj.Resource.Type {
+			return pi.Resource.Type < pj.Resource.Type
+		}
+		if pi.Resource.Key != pj.Resource.Key {
+			return pi.Resource.Key < pj.Resource.Key
+		}
+		return pi.Action < pj.Action
+	})
+	return res, nil
+}
+
+// effectivePermission 合并覆盖 permission 的全部权限，Sources 为最具体且效果与结果相同的权限
+func (r *rbacService) effectivePermission(valid []domain.UserPermission, permission domain.Permission) domain.EffectivePermission {
+	specificity := domain.ResourceKeySpecificity(permission.Resource.Key)
+	covering := slice.FilterMap(valid, func(_ int, up domain.UserPermission) (domain.UserPermission, bool) {
+		p := up.Permission
+		return up, p.Resource.Type == permission.Resource.Type && p.Action == permission.Action &&
+			domain.ResourceKeySpecificity(p.Resource.Key) <= specificity &&
+			domain.MatchResourceKey(p.Resource.Key, permission.Resource.Key)
+	})
+	var (
+		decision domain.EffectDecision
+		top      int
+	)
+	for _, up := range covering {
+		s := domain.ResourceKeySpecificity(up.Permission.Resource.Key)
+		decision.Add(s, up.Effect)
+		top = max(top, s)
+	}
+	effect := domain.EffectDeny
+	if decision.Allowed() {
+		effect = domain.EffectAllow
+	}
+	return domain.EffectivePermission{
+		Permission: permission,
+		Effect:     effect,
+		Sources: slice.FilterMap(covering, func(_ int, up domain.UserPermission) (domain.UserPermission, bool) {
+			return up, up.Effect == effect && domain.ResourceKeySpecificity(up.Permission.Resource.Key) == top
+		}),
+	}
+}
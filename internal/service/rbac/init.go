@@ -242,6 +242,7 @@ func (s *InitService) grantRolePermissions(ctx context.Context, role domain.Role
 	return nil
 }
 
+// grantUserRole 有效期和用户权限一样是秒级时间戳
 func (s *InitService) grantUserRole(ctx context.Context, role domain.Role) error {
 	const years = 100
 	_, err := s.userRoleRepo.Create(ctx, domain.UserRole{
@@ -249,7 +250,7 @@ func (s *InitService) grantUserRole(ctx context.Context, role domain.Role) error
 		UserID:    s.userID,
 		Role:      role,
 		StartTime: time.Now().Unix(),
-		EndTime:   time.Now().AddDate(years, 0, 0).UnixMilli(),
+		EndTime:   time.Now().AddDate(years, 0, 0).Unix(),
 	})
 	return err
 }
@@ -5,10 +5,18 @@ import (
 	"github.com/ecodeclub/ekit/slice"
 	"github.com/permission-dev/internal/domain"
 	"github.com/permission-dev/internal/repository"
+	"time"
 )
 
 type PermissionService interface {
 	Check(ctx context.Context, bizId, userId int64, resource domain.Resource, actions []string) (bool, error)
+	// BatchCheck 批量校验，用户权限只查询一次，返回结果与 items 顺序一致
+	BatchCheck(ctx context.Context, bizId, userId int64, items []domain.CheckItem) ([]bool, error)
+	// Explain 校验并返回命中的用户权限以及其来源的角色路径
+	Explain(ctx context.Context, bizId, userId int64, resource domain.Resource, actions []string) (domain.RBACTrace, error)
+	// CheckAsOf 使用 asOf（毫秒）时刻的授权校验，权限的有效期也按该时刻判断
+	CheckAsOf(ctx context.Context, bizId, userId int64, resource domain.Resource, actions []string, asOf int64) (bool, error)
+	ExplainAsOf(ctx context.Context, bizId, userId int64, resource domain.Resource, actions []string, asOf int64) (domain.RBACTrace, error)
 }
 
 type permissionService struct {
@@ -20,18 +28,75 @@ func (p *permissionService) Check(ctx context.Context, bizId, userId int64, reso
 	if err != nil {
 		return false, err
 	}
-	var res bool
-	for _, p := range allUserPermissions {
-		pr := p.Permission.Resource
-		if resource.Type == pr.Type && resource.Key == pr.Key && slice.Contains(actions, p.Permission.Action) {
-			if p.Effect.IsDeny() {
-				return false, nil
-			}
-			res = true
-		}
+	return p.check(allUserPermissions, resource, actions, time.Now().Unix()), nil
+
+}
+
+func (p *permissionService) BatchCheck(ctx context.Context, bizId, userId int64, items []domain.CheckItem) ([]bool, error) {
+	allUserPermissions, err := p.userPermissionRepo.GetALLUserPermission(ctx, bizId, userId)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().Unix()
+	res := make([]bool, 0, len(items))
+	for idx := range items {
+		res = append(res, p.check(allUserPermissions, items[idx].Resource, items[idx].Actions, now))
 	}
 	return res, nil
+}
+
+func (p *permissionService) Explain(ctx context.Context, bizId, userId int64, resource domain.Resource, actions []string) (domain.RBACTrace, error) {
+	allUserPermissions, err := p.userPermissionRepo.GetALLUserPermission(ctx, bizId, userId)
+	if err != nil {
+		return domain.RBACTrace{}, err
+	}
+	return p.explain(allUserPermissions, resource, actions, time.Now().Unix()), nil
+}
+
+func (p *permissionService) CheckAsOf(ctx context.Context, bizId, userId int64, resource domain.Resource, actions []string, asOf int64) (bool, error) {
+	allUserPermissions, err := p.userPermissionRepo.GetALLUserPermissionAsOf(ctx, bizId, userId, asOf)
+	if err != nil {
+		return false, err
+	}
+	return p.check(allUserPermissions, resource, actions, asOf/1000), nil
+}
+
+func (p *permissionService) ExplainAsOf(ctx context.Context, bizId, userId int64, resource domain.Resource, actions []string, asOf int64) (domain.RBACTrace, error) {
+	allUserPermissions, err := p.userPermissionRepo.GetALLUserPermissionAsOf(ctx, bizId, userId, asOf)
+	if err != nil {
+		return domain.RBACTrace{}, err
+	}
+	return p.explain(allUserPermissions, resource, actions, asOf/1000), nil
+}
 
+// explain now 为秒级时间戳
+func (p *permissionService) explain(allUserPermissions []domain.UserPermission, resource domain.Resource, actions []string, now int64) domain.RBACTrace {
+	return domain.RBACTrace{
+		Allowed: p.check(allUserPermissions, resource, actions, now),
+		Matches: slice.FilterMap(allUserPermissions, func(_ int, src domain.UserPermission) (domain.UserPermission, bool) {
+			return src, p.match(src, resource, actions, now)
+		}),
+	}
+}
+
+// match 判断权限是否匹配，不在有效期内的权限视为不匹配
+func (p *permissionService) match(up domain.UserPermission, resource domain.Resource, actions []string, now int64) bool {
+	if !up.IsValidAt(now) {
+		return false
+	}
+	pr := up.Permission.Resource
+	return resource.Type == pr.Type && domain.MatchResourceKey(pr.Key, resource.Key) && slice.Contains(actions, up.Permission.Action)
+}
+
+// check 最具体的权限生效，同样具体时 deny 优先
+func (p *permissionService) check(allUserPermissions []domain.UserPermission, resource domain.Resource, actions []string, now int64) bool {
+	var decision domain.EffectDecision
+	for _, up := range allUserPermissions {
+		if p.match(up, resource, actions, now) {
+			decision.Add(domain.ResourceKeySpecificity(up.Permission.Resource.Key), up.Effect)
+		}
+	}
+	return decision.Allowed()
 }
 
 func NewPermissionService(userPermissionRepo repository.UserPermissionRepository) PermissionService {
@@ -0,0 +1,73 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ecodeclub/ekit/mapx"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/repository"
+)
+
+// checkRoleInclusion 校验新增的包含关系不会形成环，并且加入后最长的包含链不超过最大深度，
+// 已有的包含关系从 repo 中查询
+func (r *rbacService) checkRoleInclusion(ctx context.Context, repo repository.RoleIncludeRepository, inclusion domain.RoleInclusion) error {
+	including, included := inclusion.IncludingRole.ID, inclusion.IncludedRole.ID
+	if including == included {
+		return fmt.Errorf("%w: 角色 %d 不能包含自身", errs.ErrRoleInclusionCycle, including)
+	}
+	maxDepth := r.roleInclusionCfg.MaxDepth
+	// 被包含角色向下展开，如果能到达包含者角色，加入后就会形成环
+	down, err := r.inclusionDepth(ctx, repo, inclusion.BizID, included, maxDepth, true, including)
+	if err != nil {
+		return err
+	}
+	up, err := r.inclusionDepth(ctx, repo, inclusion.BizID, including, maxDepth, false, 0)
+	if err != nil {
+		return err
+	}
+	if depth := up + 1 + down; depth > maxDepth {
+		return fmt.Errorf("%w: 深度 %d，最大深度 %d", errs.ErrRoleInclusionTooDeep, depth, maxDepth)
+	}
+	return nil
+}
+
+// inclusionDepth 从 roleID 出发逐层展开（down 为 true 时展开被包含角色，否则展开包含者角色），
+// 返回最长的包含链长度。每层内去重而不跨层去重，得到的是最长链而不是最短链，
+// 最多展开 limit+1 层，所以已有数据中存在环也不会死循环
+func (r *rbacService) inclusionDepth(ctx context.Context, repo repository.RoleIncludeRepository, bizID, roleID int64, limit int, down bool, target int64) (int, error) {
+	depth := 0
+	current := []int64{roleID}
+	for depth <= limit {
+		var (
+			inclusions []domain.RoleInclusion
+			err        error
+		)
+		if down {
+			inclusions, err = repo.FindByBizIdAndIncludingIds(ctx, bizID, current)
+		} else {
+			inclusions, err = repo.FindByBizIdAndIncludedIds(ctx, bizID, current)
+		}
+		if err != nil {
+			return 0, err
+		}
+		next := make(map[int64]struct{}, len(inclusions))
+		for _, src := range inclusions {
+			id := src.IncludingRole.ID
+			if down {
+				id = src.IncludedRole.ID
+			}
+			if down && id == target {
+				return 0, fmt.Errorf("%w: 角色 %d 已经直接或者间接包含角色 %d", errs.ErrRoleInclusionCycle, roleID, target)
+			}
+			next[id] = struct{}{}
+		}
+		if len(next) == 0 {
+			break
+		}
+		depth++
+		current = mapx.Keys(next)
+	}
+	return depth, nil
+}
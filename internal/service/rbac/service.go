@@ -1,6 +1,7 @@
 package rbac
 
 import (
+	"cmp"
 	"context"
 	"github.com/permission-dev/internal/api/grpc/interceptor/auth"
 	"github.com/permission-dev/internal/domain"
@@ -50,9 +51,14 @@ type Service interface {
 	RevokeUserPermission(ctx context.Context, bizID, id int64) error
 	ListUserPermissionsByUserID(ctx context.Context, bizID, userID int64) ([]domain.UserPermission, error)
 	ListUserPermissions(ctx context.Context, bizID int64, offset, limit int) ([]domain.UserPermission, error)
+	// GetEffectivePermissions 返回用户当前生效的权限以及来源，resourceType、resourceKeyPrefix 为空时不过滤
+	GetEffectivePermissions(ctx context.Context, bizID, userID int64, resourceType, resourceKeyPrefix string) ([]domain.EffectivePermission, error)
+	// WhoCanAccess 返回能对资源执行 action 的用户（按用户ID排序分页）以及总数
+	WhoCanAccess(ctx context.Context, bizID int64, resource domain.Resource, action string, offset, limit int) ([]domain.UserAccess, int, error)
 	//业务接入相关方法
 	CreateBusinessConfig(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error)
 	GetBusinessConfigByID(ctx context.Context, id int64) (domain.BusinessConfig, error)
+	// UpdateBusinessConfig 校验模式、合并算法以及无适用策略时的结果为空时保留原有的值
 	UpdateBusinessConfig(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error)
 	DeleteBusinessConfigByID(ctx context.Context, id int64) error
 	ListBusinessConfigs(ctx context.Context, offset, limit int) ([]domain.BusinessConfig, error)
@@ -68,6 +74,7 @@ func NewService(
 	userPermissionRepository repository.UserPermissionRepository,
 	businessConfigRepository repository.BusinessConfigRepository,
 	jwtToken *jwt.Token,
+	roleInclusionCfg domain.RoleInclusionConfig,
 ) Service {
 	return &rbacService{
 		roleRepo:                 roleRepo,
@@ -79,6 +86,7 @@ func NewService(
 		userPermissionRepo:       userPermissionRepository,
 		businessConfigRepository: businessConfigRepository,
 		jwtToken:                 jwtToken,
+		roleInclusionCfg:         roleInclusionCfg,
 	}
 }
 
@@ -92,7 +100,7 @@ type rbacService struct {
 	userPermissionRepo       repository.UserPermissionRepository
 	businessConfigRepository repository.BusinessConfigRepository
 	jwtToken                 *jwt.Token
-	
+	roleInclusionCfg         domain.RoleInclusionConfig
 }
 
 func (r *rbacService) CreateBusinessConfig(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
@@ -120,7 +128,16 @@ func (r *rbacService) GetBusinessConfigByID(ctx context.Context, id int64) (doma
 }
 
 func (r *rbacService) UpdateBusinessConfig(ctx context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
-	return r.UpdateBusinessConfig(ctx, config)
+	if config.CheckMode == "" || config.CombiningAlgorithm == "" || config.NotApplicableDecision == "" {
+		stored, err := r.businessConfigRepository.FindByID(ctx, config.ID)
+		if err != nil {
+			return domain.BusinessConfig{}, err
+		}
+		config.CheckMode = cmp.Or(config.CheckMode, stored.CheckMode)
+		config.CombiningAlgorithm = cmp.Or(config.CombiningAlgorithm, stored.CombiningAlgorithm)
+		config.NotApplicableDecision = cmp.Or(config.NotApplicableDecision, stored.NotApplicableDecision)
+	}
+	return r.businessConfigRepository.Update(ctx, config)
 }
 
 func (r *rbacService) DeleteBusinessConfigByID(ctx context.Context, id int64) error {
@@ -148,7 +165,10 @@ func (r *rbacService) ListUserPermissions(ctx context.Context, bizID int64, offs
 }
 
 func (r *rbacService) CreateRoleInclusion(ctx context.Context, roleInclusion domain.RoleInclusion) (domain.RoleInclusion, error) {
-	return r.roleIncludeRepo.Create(ctx, roleInclusion)
+	// 校验和创建需要串行执行，否则并发创建 A 包含 B 和 B 包含 A 都能通过校验
+	return r.roleIncludeRepo.CreateWithCheck(ctx, roleInclusion, func(ctx context.Context, repo repository.RoleIncludeRepository) error {
+		return r.checkRoleInclusion(ctx, repo, roleInclusion)
+	})
 }
 
 func (r *rbacService) GetRoleInclusion(ctx context.Context, bizID, id int64) (domain.RoleInclusion, error) {
@@ -0,0 +1,182 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ecodeclub/ekit/mapx"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"golang.org/x/sync/errgroup"
+)
+
+// maxWhoCanAccessGrants 反查时最多加载的直接授权以及用户角色条数，超过时返回 errs.ErrTooManyGrants。
+// 角色继承和 deny 需要在内存中合并之后才能排序分页，没办法在查询中分页，所以限制加载的数量
+const maxWhoCanAccessGrants = 10000
+
+// roleGrantPath 角色通过包含关系获得某个权限，path 从授予权限的角色开始向上，
+// 最后一个元素是包含该权限的角色
+type roleGrantPath struct {
+	permissionID int64
+	path         []int64
+}
+
+func (r *rbacService) WhoCanAccess(ctx context.Context, bizID int64, resource domain.Resource, action string, offset, limit int) ([]domain.UserAccess, int, error) {
+	permissions, err := r.permissionRepo.FindPermissions(ctx, bizID, resource.Type, resource.Key, []string{action})
+	if err != nil || len(permissions) == 0 {
+		return nil, 0, err
+	}
+	permMap := make(map[int64]domain.Permission, len(permissions))
+	for _, src := range permissions {
+		permMap[src.ID] = src
+	}
+	permIDs := mapx.Keys(permMap)
+
+	var (
+		eg              errgroup.Group
+		userPermissions []domain.UserPermission
+		rolePermissions []domain.RolePermission
+	)
+	eg.Go(func() error {
+		var err error
+		userPermissions, err = r.userPermissionRepo.FindByBizIDAndPermissionIDs(ctx, bizID, permIDs, maxWhoCanAccessGrants+1)
+		if err == nil && len(userPermissions) > maxWhoCanAccessGrants {
+			err = fmt.Errorf("%w: 直接授予的用户权限超过 %d 条", errs.ErrTooManyGrants, maxWhoCanAccessGrants)
+		}
+		return err
+	})
+	eg.Go(func() error {
+		var err error
+		rolePermissions, err = r.rolePermissionRepo.FindByBizIDAndPermissionIDs(ctx, bizID, permIDs)
+		return err
+	})
+	if err = eg.Wait(); err != nil {
+		return nil, 0, err
+	}
+
+	now := time.Now().Unix()
+	sources := make(map[int64][]domain.UserPermission)
+	for _, up := range userPermissions {
+		if up.IsValidAt(now) {
+			up.Permission = permMap[up.Permission.ID]
+			sources[up.UserID] = append(sources[up.UserID], up)
+		}
+	}
+
+	grants, err := r.expandIncludingRoles(ctx, bizID, rolePermissions)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(grants) > 0 {
+		userRoles, err := r.userRoleRepo.FindByBizIDAndRoleIDsWithLimit(ctx, bizID, mapx.Keys(grants), maxWhoCanAccessGrants+1)
+		if err != nil {
+			return nil, 0, err
+		}
+		if len(userRoles) > maxWhoCanAccessGrants {
+			return nil, 0, fmt.Errorf("%w: 拥有相关角色的用户角色超过 %d 条", errs.ErrTooManyGrants, maxWhoCanAccessGrants)
+		}
+		for _, ur := range userRoles {
+			if !ur.IsValidAt(now) {
+				continue
+			}
+			for _, grant := range grants[ur.Role.ID] {
+				sources[ur.UserID] = append(sources[ur.UserID], domain.UserPermission{
+					BizID:      bizID,
+					UserID:     ur.UserID,
+					Permission: permMap[grant.permissionID],
+					StartTime:  ur.StartTime,
+					EndTime:    ur.EndTime,
+					Effect:     domain.EffectAllow,
+					RolePath:   reversed(grant.path),
+				})
+			}
+		}
+	}
+
+	// 和 Check 使用同样的规则扣除显式 deny
+	res := make([]domain.UserAccess, 0, len(sources))
+	for userID, ups := range sources {
+		var decision domain.EffectDecision
+		for _, up := range ups {
+			decision.Add(domain.ResourceKeySpecificity(up.Permission.Resource.Key), up.Effect)
+		}
+		if !decision.Allowed() {
+			continue
+		}
+		res = append(res, domain.UserAccess{
+			UserID: userID,
+			Sources: slice.FilterMap(ups, func(_ int, src domain.UserPermission) (domain.UserPermission, bool) {
+				return src, src.Effect.IsAllow()
+			}),
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].UserID < res[j].UserID
+	})
+	total := len(res)
+	offset, limit = max(offset, 0), max(limit, 0)
+	if offset >= total {
+		return []domain.UserAccess{}, total, nil
+	}
+	return res[offset:min(offset+limit, total)], total, nil
+}
+
+// expandIncludingRoles 从授予权限的角色向上展开包含它的角色，返回每个角色获得权限的路径，
+// 同一个授予来源下已经访问过的角色不再展开，并且不超过最大包含深度
+func (r *rbacService) expandIncludingRoles(ctx context.Context, bizID int64, rolePermissions []domain.RolePermission) (map[int64][]roleGrantPath, error) {
+	grants := make(map[int64][]roleGrantPath, len(rolePermissions))
+	type visitKey struct {
+		permissionID int64
+		origin       int64
+		roleID       int64
+	}
+	visited := make(map[visitKey]struct{}, len(rolePermissions))
+	current := make([]roleGrantPath, 0, len(rolePermissions))
+	for _, rp := range rolePermissions {
+		grant := roleGrantPath{permissionID: rp.Permission.ID, path: []int64{rp.Role.ID}}
+		visited[visitKey{rp.Permission.ID, rp.Role.ID, rp.Role.ID}] = struct{}{}
+		grants[rp.Role.ID] = append(grants[rp.Role.ID], grant)
+		current = append(current, grant)
+	}
+	for depth := 0; len(current) > 0 && depth < r.roleInclusionCfg.MaxDepth; depth++ {
+		includedIDs := slice.Map(current, func(_ int, src roleGrantPath) int64 {
+			return src.path[len(src.path)-1]
+		})
+		inclusions, err := r.roleIncludeRepo.FindByBizIdAndIncludedIds(ctx, bizID, includedIDs)
+		if err != nil {
+			return nil, err
+		}
+		includingMap := make(map[int64][]int64, len(inclusions))
+		for _, src := range inclusions {
+			includingMap[src.IncludedRole.ID] = append(includingMap[src.IncludedRole.ID], src.IncludingRole.ID)
+		}
+		next := make([]roleGrantPath, 0, len(inclusions))
+		for _, grant := range current {
+			for _, includingID := range includingMap[grant.path[len(grant.path)-1]] {
+				key := visitKey{grant.permissionID, grant.path[0], includingID}
+				if _, ok := visited[key]; ok {
+					continue
+				}
+				visited[key] = struct{}{}
+				path := make([]int64, 0, len(grant.path)+1)
+				path = append(path, grant.path...)
+				newGrant := roleGrantPath{permissionID: grant.permissionID, path: append(path, includingID)}
+				grants[includingID] = append(grants[includingID], newGrant)
+				next = append(next, newGrant)
+			}
+		}
+		current = next
+	}
+	return grants, nil
+}
+
+func reversed(src []int64) []int64 {
+	res := make([]int64, len(src))
+	for i := range src {
+		res[len(src)-1-i] = src[i]
+	}
+	return res
+}
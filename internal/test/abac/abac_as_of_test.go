@@ -0,0 +1,103 @@
+package abac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/expression"
+	"github.com/permission-dev/internal/service/abac/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// asOfValueRepo 用户的部门在 1000 时刻从 rd 调整为 hr
+type asOfValueRepo struct {
+	providerValueRepo
+}
+
+func (r *asOfValueRepo) FindSubjectValue(_ context.Context, bizID, subjectID int64) (domain.ABACObject, error) {
+	return r.deptObject(bizID, subjectID, "hr"), nil
+}
+
+func (r *asOfValueRepo) FindValueAsOf(_ context.Context, bizID int64, entityType domain.EntityType, entityID, asOf int64) (domain.ABACObject, error) {
+	if entityType != domain.SubjectTypeEntity {
+		return domain.ABACObject{ID: entityID, BizId: bizID}, nil
+	}
+	if asOf < 1000 {
+		return r.deptObject(bizID, entityID, "rd"), nil
+	}
+	return r.deptObject(bizID, entityID, "hr"), nil
+}
+
+func (r *asOfValueRepo) deptObject(bizID, subjectID int64, dept string) domain.ABACObject {
+	return domain.ABACObject{
+		ID:         subjectID,
+		BizId:      bizID,
+		AttrValues: []domain.AttributeValue{{AttrDef: domain.AttributeDefinition{ID: 7}, Value: dept}},
+	}
+}
+
+// asOfPolicyRepo 当前的策略要求 hr，在 2000 时刻之前要求 rd
+type asOfPolicyRepo struct {
+	providerPolicyRepo
+	rd domain.Policy
+}
+
+func (r *asOfPolicyRepo) FindBizPoliciesAsOf(_ context.Context, _ int64, asOf int64) ([]domain.Policy, error) {
+	if asOf < 2000 {
+		return []domain.Policy{r.rd}, nil
+	}
+	return r.policies, nil
+}
+
+func TestPermissionCheckAsOf(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	selector := evaluator.NewSelector()
+	policy := func(expr string) domain.Policy {
+		rule, err := expression.Parse(expr, defs, selector)
+		require.NoError(t, err)
+		return domain.Policy{
+			ID:          1,
+			BizID:       1,
+			Status:      domain.PolicyStatusActive,
+			Permissions: []domain.UserPermission{{Permission: domain.Permission{ID: 1}, Effect: domain.EffectAllow}},
+			Rules:       []domain.PolicyRule{rule},
+		}
+	}
+	policyRepo := &asOfPolicyRepo{
+		providerPolicyRepo: providerPolicyRepo{policies: []domain.Policy{policy(`subject.dept = "hr"`)}},
+		rd:                 policy(`subject.dept = "rd"`),
+	}
+	registry, err := provider.NewRegistry(nil)
+	require.NoError(t, err)
+	attrRepo := &versionAttrRepo{defs: defs}
+	executor := abac.NewPolicyExecutor(selector)
+	svc := abac.NewPermissionSvc(&providerPermissionRepo{}, &providerResourceRepo{}, abac.NewPolicyCache(policyRepo, attrRepo, executor),
+		&asOfValueRepo{}, attrRepo, &providerBizConfigRepo{}, executor, registry, provider.NewBuiltinEnv(provider.NewSystemClock(), defaultClientIPConfig))
+	resource := domain.Resource{Type: "doc", Key: "doc:1"}
+
+	testCases := []struct {
+		name string
+		asOf int64
+		want bool
+	}{
+		{name: "当前状态", asOf: 0, want: true},
+		{name: "属性值和策略都是旧的", asOf: 500, want: true},
+		{name: "属性值已经调整，策略还是旧的", asOf: 1500, want: false},
+		{name: "属性值和策略都已经调整", asOf: 2500, want: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ok, err := svc.CheckAsOf(context.Background(), 1, 1, resource, []string{"read"}, domain.Attributes{}, tc.asOf)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ok)
+			trace, err := svc.ExplainAsOf(context.Background(), 1, 1, resource, []string{"read"}, domain.Attributes{}, tc.asOf)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, trace.Allowed)
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package abac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAttrValue(t *testing.T) {
+	t.Parallel()
+	minLevel, maxLevel := 1.0, 10.0
+	testCases := []struct {
+		name    string
+		def     domain.AttributeDefinition
+		value   string
+		wantErr error
+	}{
+		{name: "整数", def: domain.AttributeDefinition{DataType: domain.DataTypeNumber}, value: "3"},
+		{name: "不是整数", def: domain.AttributeDefinition{DataType: domain.DataTypeNumber}, value: "3.5", wantErr: errs.ErrInvalidAttrValue},
+		{
+			name:  "在取值范围内",
+			def:   domain.AttributeDefinition{DataType: domain.DataTypeNumber, Constraints: domain.AttrConstraints{Min: &minLevel, Max: &maxLevel}},
+			value: "10",
+		},
+		{
+			name:    "超过最大值",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeFloat, Constraints: domain.AttrConstraints{Min: &minLevel, Max: &maxLevel}},
+			value:   "10.5",
+			wantErr: errs.ErrInvalidAttrValue,
+		},
+		{name: "布尔值", def: domain.AttributeDefinition{DataType: domain.DataTypeBoolean}, value: "yes", wantErr: errs.ErrInvalidAttrValue},
+		{name: "IP 地址", def: domain.AttributeDefinition{DataType: domain.DataTypeIP}, value: "10.0.0.256", wantErr: errs.ErrInvalidAttrValue},
+		{
+			name:    "不在枚举中",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeString, Constraints: domain.AttrConstraints{Enum: []string{"rd", "hr"}}},
+			value:   "ops",
+			wantErr: errs.ErrInvalidAttrValue,
+		},
+		{
+			name:  "时间在范围内",
+			def:   domain.AttributeDefinition{DataType: domain.DataTypeDatetime, Constraints: domain.AttrConstraints{NotBefore: 1000, NotAfter: 2000}},
+			value: "1500",
+		},
+		{
+			name:    "时间早于下界",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeDatetime, Constraints: domain.AttrConstraints{NotBefore: 1000}},
+			value:   "999",
+			wantErr: errs.ErrInvalidAttrValue,
+		},
+		{
+			name:  "数组元素",
+			def:   domain.AttributeDefinition{DataType: domain.DataTypeArray, Constraints: domain.AttrConstraints{ElemType: domain.DataTypeNumber, MaxLen: 2, Max: &maxLevel}},
+			value: `["1","10"]`,
+		},
+		{
+			name:    "数组元素类型不对",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeArray, Constraints: domain.AttrConstraints{ElemType: domain.DataTypeNumber}},
+			value:   `["1","a"]`,
+			wantErr: errs.ErrInvalidAttrValue,
+		},
+		{
+			name:    "数组超过最大长度",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeArray, Constraints: domain.AttrConstraints{MaxLen: 1}},
+			value:   `["a","b"]`,
+			wantErr: errs.ErrInvalidAttrValue,
+		},
+		{
+			name:    "数组元素不在枚举中",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeArray, Constraints: domain.AttrConstraints{Enum: []string{"a"}}},
+			value:   `["a","b"]`,
+			wantErr: errs.ErrInvalidAttrValue,
+		},
+		{name: "正则", def: domain.AttributeDefinition{DataType: domain.DataTypeString, ValidationRule: `^[a-z]+$`}, value: "RD", wantErr: errs.ErrInvalidAttrValue},
+		{name: "自定义类型不校验类型", def: domain.AttributeDefinition{DataType: "geo"}, value: "anything"},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := abac.ValidateAttrValue(tc.def, tc.value)
+			assert.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestValidateAttrDefinition(t *testing.T) {
+	t.Parallel()
+	minLevel, maxLevel := 10.0, 1.0
+	testCases := []struct {
+		name    string
+		def     domain.AttributeDefinition
+		wantErr error
+	}{
+		{name: "没有约束", def: domain.AttributeDefinition{DataType: domain.DataTypeString}},
+		{
+			name:    "最小值大于最大值",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeNumber, Constraints: domain.AttrConstraints{Min: &minLevel, Max: &maxLevel}},
+			wantErr: errs.ErrInvalidAttrConstraint,
+		},
+		{
+			name:    "字符串不能限制取值范围",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeString, Constraints: domain.AttrConstraints{Min: &minLevel}},
+			wantErr: errs.ErrInvalidAttrConstraint,
+		},
+		{
+			name:    "只有数组可以限制长度",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeString, Constraints: domain.AttrConstraints{MaxLen: 3}},
+			wantErr: errs.ErrInvalidAttrConstraint,
+		},
+		{
+			name:    "枚举值类型不对",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeNumber, Constraints: domain.AttrConstraints{Enum: []string{"1", "a"}}},
+			wantErr: errs.ErrInvalidAttrConstraint,
+		},
+		{
+			name:    "默认值不满足约束",
+			def:     domain.AttributeDefinition{DataType: domain.DataTypeString, DefaultValue: "ops", Constraints: domain.AttrConstraints{Enum: []string{"rd"}}},
+			wantErr: errs.ErrInvalidAttrValue,
+		},
+		{name: "正则语法错误", def: domain.AttributeDefinition{DataType: domain.DataTypeString, ValidationRule: "("}, wantErr: errs.ErrInvalidAttrConstraint},
+		{
+			name:    "内置环境属性类型不对",
+			def:     domain.AttributeDefinition{Name: domain.BuiltinEnvWeekday, DataType: domain.DataTypeString, EntityType: domain.EnvironmentTypeEntity},
+			wantErr: errs.ErrInvalidAttrConstraint,
+		},
+		{name: "同名的主体属性不受限制", def: domain.AttributeDefinition{Name: domain.BuiltinEnvWeekday, DataType: domain.DataTypeString, EntityType: domain.SubjectTypeEntity}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.ErrorIs(t, abac.ValidateAttrDefinition(tc.def), tc.wantErr)
+		})
+	}
+}
+
+// validationPolicyRepo 记录保存的规则
+type validationPolicyRepo struct {
+	repository.AttributePolicyRepository
+	saved int
+}
+
+func (r *validationPolicyRepo) First(_ context.Context, bizID, id int64) (domain.Policy, error) {
+	return domain.Policy{ID: id, BizID: bizID}, nil
+}
+
+func (r *validationPolicyRepo) SaveRule(_ context.Context, _, _ int64, _ domain.PolicyRule) (int64, error) {
+	r.saved++
+	return int64(r.saved), nil
+}
+
+func TestPolicySaveRuleLiteral(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	maxLevel := 10.0
+	level := defs.SubjectAttrDefs[0]
+	level.Constraints = domain.AttrConstraints{Max: &maxLevel}
+	defs.SubjectAttrDefs[0] = level
+	defs.AllDefs[level.ID] = level
+	dept := defs.SubjectAttrDefs[4]
+	dept.Constraints = domain.AttrConstraints{Enum: []string{"rd", "hr"}}
+	defs.SubjectAttrDefs[4] = dept
+	defs.AllDefs[dept.ID] = dept
+
+	repo := &validationPolicyRepo{}
+	selector := evaluator.NewSelector()
+	svc := abac.NewPolicySvc(repo, &versionAttrRepo{defs: defs}, &providerPermissionRepo{}, selector,
+		abac.NewPolicyCache(repo, &versionAttrRepo{defs: defs}, abac.NewPolicyExecutor(selector)))
+	rule := func(def domain.AttributeDefinition, op domain.RuleOperator, val string) domain.PolicyRule {
+		return domain.PolicyRule{AttrDef: def, Operator: op, Value: val}
+	}
+	testCases := []struct {
+		name    string
+		rule    domain.PolicyRule
+		wantErr error
+	}{
+		{name: "比较值在范围内", rule: rule(level, domain.Equals, "10")},
+		{name: "比较值超过最大值", rule: rule(level, domain.Equals, "11"), wantErr: errs.ErrInvalidAttrValue},
+		{name: "大小比较不限制范围", rule: rule(level, domain.Greater, "11")},
+		{name: "IN 列表中的每一项", rule: rule(dept, domain.IN, `["rd","ops"]`), wantErr: errs.ErrInvalidPolicyRule},
+		{name: "枚举中的值", rule: rule(dept, domain.NotEquals, "hr")},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := svc.SaveRule(context.Background(), 1, 1, tc.rule)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package abac
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bulkValueRepo 在内存中保存批量写入的属性值，ID 按写入顺序递增
+type bulkValueRepo struct {
+	providerValueRepo
+	batches []int
+	values  []domain.EntityAttributeValue
+}
+
+func (r *bulkValueRepo) BatchSaveValues(_ context.Context, _ int64, _ domain.EntityType, values []domain.EntityAttributeValue) error {
+	r.batches = append(r.batches, len(values))
+	for _, val := range values {
+		val.Value.ID = int64(len(r.values) + 1)
+		r.values = append(r.values, val)
+	}
+	return nil
+}
+
+func (r *bulkValueRepo) FindValuesByBizID(_ context.Context, _ int64, _ domain.EntityType, afterID int64, limit int) ([]domain.EntityAttributeValue, error) {
+	start := min(int(afterID), len(r.values))
+	end := min(start+limit, len(r.values))
+	return r.values[start:end], nil
+}
+
+func newBulkValueSvc() (abac.AttributeValueSvc, *bulkValueRepo) {
+	repo := &bulkValueRepo{}
+	return abac.NewAttributeValueSvc(repo, &versionAttrRepo{defs: testBizAttrDefinition()}), repo
+}
+
+func TestImportAttributeValues(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name       string
+		format     domain.AttrValueFormat
+		data       string
+		wantResult domain.AttrValueImportResult
+		wantValues []string
+	}{
+		{
+			name:   "CSV",
+			format: domain.AttrValueFormatCSV,
+			data: "\ufeffattribute,entity_id,value\n" +
+				"level,1,3\n" +
+				"\n" +
+				"level,x,3\n" +
+				"owner_dept,1,rd\n" +
+				"level,2,high\n" +
+				"tags,2,\"[\"\"a\"\",\"\"b\"\"]\"\n" +
+				"dept,3,\"rd\n",
+			wantResult: domain.AttrValueImportResult{
+				Total:     6,
+				Succeeded: 2,
+				Failed:    4,
+				Errors: []domain.AttrValueRowError{
+					{Line: 4, Message: `entity_id "x" 无效`},
+					{Line: 5, Message: "属性 owner_dept 未定义"},
+					{Line: 6},
+					{Line: 8},
+				},
+			},
+			wantValues: []string{"1 level 3", `2 tags ["a","b"]`},
+		},
+		{
+			name:   "NDJSON",
+			format: domain.AttrValueFormatNDJSON,
+			data: `{"entity_id":1,"attribute":"level","value":3}` + "\n" +
+				`{"entity_id":1,"attribute":"tags","value":["a"]}` + "\n" +
+				"\n" +
+				`{"entity_id":2,"attribute":"vip","value":"yes"}` + "\n" +
+				`{"entity_id":2,` + "\n" +
+				`{"entity_id":3,"attribute":"dept","value":"rd"}`,
+			wantResult: domain.AttrValueImportResult{
+				Total:     5,
+				Succeeded: 3,
+				Failed:    2,
+				Errors:    []domain.AttrValueRowError{{Line: 4}, {Line: 5}},
+			},
+			wantValues: []string{"1 level 3", `1 tags ["a"]`, "3 dept rd"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			svc, repo := newBulkValueSvc()
+			res, err := svc.ImportValues(context.Background(), 1, domain.SubjectTypeEntity, tc.format, strings.NewReader(tc.data))
+			require.NoError(t, err)
+			// 类型校验以及格式错误的信息比较长，只比较有没有错误信息
+			for idx := range res.Errors {
+				if tc.wantResult.Errors[idx].Message == "" {
+					assert.NotEmpty(t, res.Errors[idx].Message)
+					res.Errors[idx].Message = ""
+				}
+			}
+			assert.Equal(t, tc.wantResult, res)
+			values := make([]string, 0, len(repo.values))
+			for _, val := range repo.values {
+				values = append(values, fmt.Sprintf("%d %s %s", val.EntityID, val.Value.AttrDef.Name, val.Value.Value))
+			}
+			assert.Equal(t, tc.wantValues, values)
+		})
+	}
+}
+
+func TestImportAttributeValuesInBatches(t *testing.T) {
+	t.Parallel()
+	svc, repo := newBulkValueSvc()
+	var data strings.Builder
+	data.WriteString("entity_id,attribute,value\n")
+	for i := 1; i <= 1200; i++ {
+		fmt.Fprintf(&data, "%d,level,%d\n", i, i%10)
+	}
+	res, err := svc.ImportValues(context.Background(), 1, domain.SubjectTypeEntity, domain.AttrValueFormatCSV, strings.NewReader(data.String()))
+	require.NoError(t, err)
+	assert.Equal(t, int64(1200), res.Succeeded)
+	assert.Equal(t, []int{500, 500, 200}, repo.batches)
+
+	_, err = svc.ImportValues(context.Background(), 1, domain.EnvironmentTypeEntity, domain.AttrValueFormatCSV, strings.NewReader(data.String()))
+	assert.ErrorIs(t, err, errs.ErrUnsupportedBulkEntity)
+	_, err = svc.ImportValues(context.Background(), 1, domain.SubjectTypeEntity, "xml", strings.NewReader(data.String()))
+	assert.ErrorIs(t, err, errs.ErrUnsupportedValueFormat)
+	_, err = svc.ImportValues(context.Background(), 1, domain.SubjectTypeEntity, domain.AttrValueFormatCSV, strings.NewReader("id,attribute,value\n"))
+	assert.ErrorIs(t, err, errs.ErrUnsupportedValueFormat)
+}
+
+func TestExportAttributeValues(t *testing.T) {
+	t.Parallel()
+	for _, format := range []domain.AttrValueFormat{domain.AttrValueFormatCSV, domain.AttrValueFormatNDJSON} {
+		t.Run(string(format), func(t *testing.T) {
+			svc, repo := newBulkValueSvc()
+			var data strings.Builder
+			data.WriteString("entity_id,attribute,value\n")
+			for i := 1; i <= 1500; i++ {
+				fmt.Fprintf(&data, "%d,dept,\"d,%d\"\n", i, i)
+			}
+			_, err := svc.ImportValues(context.Background(), 1, domain.SubjectTypeEntity, domain.AttrValueFormatCSV, strings.NewReader(data.String()))
+			require.NoError(t, err)
+
+			var exported bytes.Buffer
+			err = svc.ExportValues(context.Background(), 1, domain.SubjectTypeEntity, format, &exported)
+			require.NoError(t, err)
+			// 导出的数据可以原样导入
+			target, targetRepo := newBulkValueSvc()
+			res, err := target.ImportValues(context.Background(), 1, domain.SubjectTypeEntity, format, &exported)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1500), res.Succeeded)
+			assert.Equal(t, repo.values, targetRepo.values)
+		})
+	}
+
+	svc, _ := newBulkValueSvc()
+	var exported bytes.Buffer
+	require.NoError(t, svc.ExportValues(context.Background(), 1, domain.ResourceTypeEntity, domain.AttrValueFormatCSV, &exported))
+	assert.Equal(t, "entity_id,attribute,value,resource_type\n", exported.String())
+}
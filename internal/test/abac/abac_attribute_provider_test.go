@@ -0,0 +1,248 @@
+package abac
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/expression"
+	"github.com/permission-dev/internal/service/abac/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// stubAttrServer 本地的 gRPC 属性来源，用户 1 的部门为 rd，其他用户没有该属性
+type stubAttrServer struct {
+	calls atomic.Int64
+}
+
+func (s *stubAttrServer) GetAttribute(_ context.Context, req *permissionv1.AttributeProviderServiceGetAttributeRequest) (*permissionv1.AttributeProviderServiceGetAttributeResponse, error) {
+	s.calls.Add(1)
+	if req.GetUid() != 1 || req.GetAttribute() != "dept" || req.GetEntityType() != permissionv1.EntityType_ENTITY_TYPE_SUBJECT {
+		return &permissionv1.AttributeProviderServiceGetAttributeResponse{}, nil
+	}
+	return &permissionv1.AttributeProviderServiceGetAttributeResponse{Found: true, Value: "rd"}, nil
+}
+
+func startStubGRPCServer(t *testing.T) (*stubAttrServer, string) {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	stub := &stubAttrServer{}
+	permissionv1.RegisterAttributeProviderServiceServer(server, stub)
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+	return stub, lis.Addr().String()
+}
+
+// startStubHTTPServer 本地的 http 属性来源，资源 doc:1 的部门为 hr，slow 接口超时
+func startStubHTTPServer(t *testing.T) (*atomic.Int64, string) {
+	var calls atomic.Int64
+	mux := http.NewServeMux()
+	mux.HandleFunc("/attributes", func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		var req provider.HTTPRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if req.ResourceKey != "doc:1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(provider.HTTPResponse{Found: true, Value: "hr"})
+	})
+	mux.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(200 * time.Millisecond):
+		}
+	})
+	mux.HandleFunc("/broken", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return &calls, server.URL
+}
+
+func TestAttributeProviderRegistry(t *testing.T) {
+	t.Parallel()
+	grpcStub, grpcAddr := startStubGRPCServer(t)
+	httpCalls, httpURL := startStubHTTPServer(t)
+	registry, err := provider.NewRegistry([]domain.AttributeProviderConfig{
+		{BizID: 1, EntityType: domain.SubjectTypeEntity, Attribute: "dept", Type: domain.AttributeProviderGRPC, Endpoint: grpcAddr, Timeout: time.Second, CacheTTL: time.Minute},
+		{BizID: 1, EntityType: domain.ResourceTypeEntity, Attribute: "owner_dept", Type: domain.AttributeProviderHTTP, Endpoint: httpURL + "/attributes", Timeout: time.Second},
+		{BizID: 1, EntityType: domain.ResourceTypeEntity, Attribute: "owner_id", Type: domain.AttributeProviderHTTP, Endpoint: httpURL + "/slow", Timeout: 20 * time.Millisecond},
+		{BizID: 1, EntityType: domain.ResourceTypeEntity, Attribute: "shared_depts", Type: domain.AttributeProviderHTTP, Endpoint: httpURL + "/broken"},
+		{BizID: 1, EntityType: domain.EnvironmentTypeEntity, Attribute: "client_ip", Type: domain.AttributeProviderStatic, Value: "10.0.0.1"},
+	})
+	require.NoError(t, err)
+	defs := testBizAttrDefinition()
+	ctx := context.Background()
+	provide := func(def domain.AttributeDefinition, uid int64, key string) (string, bool, error) {
+		p, ok := registry.Get(1, def)
+		require.True(t, ok)
+		return p.Provide(ctx, provider.Request{BizID: 1, AttrDef: def, UID: uid, Resource: domain.Resource{Type: "doc", Key: key}})
+	}
+
+	_, ok := registry.Get(2, defs.AllDefs[7])
+	assert.False(t, ok)
+	_, ok = registry.Get(1, defs.AllDefs[1])
+	assert.False(t, ok)
+
+	// gRPC，结果按用户缓存
+	for i := 0; i < 2; i++ {
+		val, found, err := provide(defs.AllDefs[7], 1, "")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "rd", val)
+	}
+	_, found, err := provide(defs.AllDefs[7], 2, "")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, int64(2), grpcStub.calls.Load())
+
+	// http，没有配置缓存时每次都调用
+	for i := 0; i < 2; i++ {
+		val, found, err := provide(defs.AllDefs[4], 0, "doc:1")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, "hr", val)
+	}
+	_, found, err = provide(defs.AllDefs[4], 0, "doc:2")
+	require.NoError(t, err)
+	assert.False(t, found)
+	assert.Equal(t, int64(3), httpCalls.Load())
+
+	_, _, err = provide(defs.AllDefs[8], 0, "doc:1")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+	_, _, err = provide(defs.AllDefs[9], 0, "doc:1")
+	assert.ErrorIs(t, err, errs.ErrAttrProviderFailed)
+
+	val, found, err := provide(defs.AllDefs[10], 0, "")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "10.0.0.1", val)
+
+	_, err = provider.NewRegistry([]domain.AttributeProviderConfig{{BizID: 1, Type: "ldap"}})
+	assert.ErrorIs(t, err, errs.ErrUnknownAttrProvider)
+}
+
+type providerPermissionRepo struct {
+	repository.PermissionRepository
+}
+
+func (r *providerPermissionRepo) FindPermissions(_ context.Context, bizId int64, resourceType, resourceKey string, _ []string) ([]domain.Permission, error) {
+	return []domain.Permission{{ID: 1, BizID: bizId, Resource: domain.Resource{Type: resourceType, Key: resourceKey}}}, nil
+}
+
+type providerResourceRepo struct {
+	repository.ResourceRepository
+}
+
+func (r *providerResourceRepo) FindByBizIDAndTypeAndKey(_ context.Context, bizId int64, resourceType, resourceKey string) (domain.Resource, error) {
+	return domain.Resource{ID: 1, BizID: bizId, Type: resourceType, Key: resourceKey}, nil
+}
+
+// providerValueRepo 没有存储任何属性值
+type providerValueRepo struct {
+	repository.AttributeValueRepository
+}
+
+func (r *providerValueRepo) FindSubjectValue(_ context.Context, bizID, subjectID int64) (domain.ABACObject, error) {
+	return domain.ABACObject{ID: subjectID, BizId: bizID}, nil
+}
+
+func (r *providerValueRepo) FindResourceValue(_ context.Context, bizID, resourceID int64) (domain.ABACObject, error) {
+	return domain.ABACObject{ID: resourceID, BizId: bizID}, nil
+}
+
+func (r *providerValueRepo) FindEnvironmentValue(_ context.Context, bizID int64) (domain.ABACObject, error) {
+	return domain.ABACObject{BizId: bizID}, nil
+}
+
+type providerPolicyRepo struct {
+	repository.AttributePolicyRepository
+	policies []domain.Policy
+}
+
+func (r *providerPolicyRepo) FindBizPolicies(_ context.Context, _ int64) ([]domain.Policy, error) {
+	return r.policies, nil
+}
+
+type providerBizConfigRepo struct {
+	repository.BusinessConfigRepository
+}
+
+func (r *providerBizConfigRepo) FindByID(_ context.Context, id int64) (domain.BusinessConfig, error) {
+	return domain.BusinessConfig{ID: id}, nil
+}
+
+func TestPermissionCheckWithAttributeProvider(t *testing.T) {
+	t.Parallel()
+	grpcStub, grpcAddr := startStubGRPCServer(t)
+	_, httpURL := startStubHTTPServer(t)
+	registry, err := provider.NewRegistry([]domain.AttributeProviderConfig{
+		{BizID: 1, EntityType: domain.SubjectTypeEntity, Attribute: "dept", Type: domain.AttributeProviderGRPC, Endpoint: grpcAddr, Timeout: time.Second, CacheTTL: time.Minute},
+		{BizID: 1, EntityType: domain.ResourceTypeEntity, Attribute: "owner_dept", Type: domain.AttributeProviderHTTP, Endpoint: httpURL + "/attributes", Timeout: time.Second},
+	})
+	require.NoError(t, err)
+	defs := testBizAttrDefinition()
+	selector := evaluator.NewSelector()
+	rule, err := expression.Parse(`subject.dept = "rd" AND resource.owner_dept = "hr"`, defs, selector)
+	require.NoError(t, err)
+	policyRepo := &providerPolicyRepo{policies: []domain.Policy{{
+		ID:          1,
+		BizID:       1,
+		Status:      domain.PolicyStatusActive,
+		Permissions: []domain.UserPermission{{Permission: domain.Permission{ID: 1}, Effect: domain.EffectAllow}},
+		Rules:       []domain.PolicyRule{rule},
+	}}}
+	attrRepo := &versionAttrRepo{defs: defs}
+	executor := abac.NewPolicyExecutor(selector)
+	svc := abac.NewPermissionSvc(&providerPermissionRepo{}, &providerResourceRepo{}, abac.NewPolicyCache(policyRepo, attrRepo, executor),
+		&providerValueRepo{}, attrRepo, &providerBizConfigRepo{}, executor, registry, provider.NewBuiltinEnv(provider.NewSystemClock(), defaultClientIPConfig))
+	ctx := context.Background()
+	doc := func(key string) domain.Resource {
+		return domain.Resource{Type: "doc", Key: key}
+	}
+
+	ok, err := svc.Check(ctx, 1, 1, doc("doc:1"), []string{"read"}, domain.Attributes{})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	// 来源中没有该属性
+	ok, err = svc.Check(ctx, 1, 2, doc("doc:1"), []string{"read"}, domain.Attributes{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+	ok, err = svc.Check(ctx, 1, 1, doc("doc:2"), []string{"read"}, domain.Attributes{})
+	require.NoError(t, err)
+	assert.False(t, ok)
+	// 请求中传入的属性优先，不会再调用来源
+	calls := grpcStub.calls.Load()
+	ok, err = svc.Check(ctx, 1, 3, doc("doc:1"), []string{"read"}, domain.Attributes{Subject: domain.SubAttrs{"dept": "rd"}})
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, calls, grpcStub.calls.Load())
+
+	res, err := svc.BatchCheck(ctx, 1, 1, []domain.CheckItem{
+		{Resource: doc("doc:1"), Actions: []string{"read"}},
+		{Resource: doc("doc:2"), Actions: []string{"read"}},
+	}, domain.Attributes{})
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, res)
+}
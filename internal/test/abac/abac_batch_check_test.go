@@ -0,0 +1,138 @@
+package abac
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// batchPermissionRepo doc:1 的权限ID为 1，doc:2 的权限ID为 2，查询 doc:broken 时出错
+type batchPermissionRepo struct {
+	repository.PermissionRepository
+}
+
+var batchPermissionIDs = map[string]int64{"doc:1": 1, "doc:2": 2}
+
+func (r *batchPermissionRepo) FindPermissions(_ context.Context, bizId int64, resourceType, resourceKey string, _ []string) ([]domain.Permission, error) {
+	if resourceKey == "doc:broken" {
+		return nil, errors.New("mock db error")
+	}
+	return []domain.Permission{{ID: batchPermissionIDs[resourceKey], BizID: bizId, Resource: domain.Resource{Type: resourceType, Key: resourceKey}}}, nil
+}
+
+type batchResourceRepo struct {
+	repository.ResourceRepository
+}
+
+func (r *batchResourceRepo) FindByBizIDAndTypeAndKey(_ context.Context, bizId int64, resourceType, resourceKey string) (domain.Resource, error) {
+	return domain.Resource{BizID: bizId, Type: resourceType, Key: resourceKey}, nil
+}
+
+type batchPolicyRepo struct {
+	repository.AttributePolicyRepository
+	policies []domain.Policy
+}
+
+func (r *batchPolicyRepo) FindBizPolicies(_ context.Context, _ int64) ([]domain.Policy, error) {
+	return r.policies, nil
+}
+
+// batchValueRepo 没有存储的属性值，属性值都来自请求
+type batchValueRepo struct {
+	repository.AttributeValueRepository
+}
+
+func (r *batchValueRepo) FindSubjectValue(_ context.Context, _, _ int64) (domain.ABACObject, error) {
+	return domain.ABACObject{}, nil
+}
+
+func (r *batchValueRepo) FindResourceValue(_ context.Context, _, _ int64) (domain.ABACObject, error) {
+	return domain.ABACObject{}, nil
+}
+
+func (r *batchValueRepo) FindEnvironmentValue(_ context.Context, _ int64) (domain.ABACObject, error) {
+	return domain.ABACObject{}, nil
+}
+
+type batchAttrRepo struct {
+	repository.AttributeDefinitionRepository
+	defs domain.BizAttrDefinition
+}
+
+func (r *batchAttrRepo) FindByBizID(_ context.Context, _ int64) (domain.BizAttrDefinition, error) {
+	return r.defs, nil
+}
+
+// batchBizConfigRepo 业务使用默认的策略合并算法
+type batchBizConfigRepo struct {
+	repository.BusinessConfigRepository
+}
+
+func (r *batchBizConfigRepo) FindByID(_ context.Context, id int64) (domain.BusinessConfig, error) {
+	return domain.BusinessConfig{ID: id}, nil
+}
+
+func TestPermissionBatchCheck(t *testing.T) {
+	t.Parallel()
+	level := domain.AttributeDefinition{ID: 1, Name: "level", DataType: domain.DataTypeNumber, EntityType: domain.SubjectTypeEntity}
+	defs := domain.BizAttrDefinition{
+		BizID:           1,
+		SubjectAttrDefs: domain.AttrDefs{level},
+		AllDefs:         map[int64]domain.AttributeDefinition{level.ID: level},
+	}
+	// policy 在 level >= minLevel 时允许 permissionID
+	policy := func(id, permissionID int64, minLevel string) domain.Policy {
+		return domain.Policy{
+			ID:          id,
+			BizID:       1,
+			Status:      domain.PolicyStatusActive,
+			Permissions: []domain.UserPermission{{Permission: domain.Permission{ID: permissionID}, Effect: domain.EffectAllow}},
+			Rules:       []domain.PolicyRule{{AttrDef: level, Operator: domain.GreaterOrEqual, Value: minLevel}},
+		}
+	}
+	policyRepo := &batchPolicyRepo{policies: []domain.Policy{policy(1, 1, "5"), policy(2, 2, "10")}}
+	attrRepo := &batchAttrRepo{defs: defs}
+	executor := abac.NewPolicyExecutor(evaluator.NewSelector())
+	registry, err := provider.NewRegistry(nil)
+	require.NoError(t, err)
+	svc := abac.NewPermissionSvc(&batchPermissionRepo{}, &batchResourceRepo{}, abac.NewPolicyCache(policyRepo, attrRepo, executor),
+		&batchValueRepo{}, attrRepo, &batchBizConfigRepo{}, executor, registry, provider.NewBuiltinEnv(provider.NewSystemClock(), defaultClientIPConfig))
+	item := func(key string) domain.CheckItem {
+		return domain.CheckItem{Resource: domain.Resource{Type: "doc", Key: key}, Actions: []string{"read"}}
+	}
+	attrs := domain.Attributes{Subject: domain.SubAttrs{"level": "5"}}
+
+	// 结果与校验项的顺序一致，查询失败的校验项视为拒绝，不影响其他校验项
+	res, err := svc.BatchCheck(context.Background(), 1, 1, []domain.CheckItem{
+		item("doc:2"), item("doc:broken"), item("doc:1"), item("doc:3"), item("doc:1"),
+	}, attrs)
+	require.NoError(t, err)
+	assert.Equal(t, []bool{false, false, true, false, true}, res)
+
+	// 与单个校验的结果一致
+	for _, key := range []string{"doc:1", "doc:2", "doc:3"} {
+		ok, err := svc.Check(context.Background(), 1, 1, item(key).Resource, item(key).Actions, attrs)
+		require.NoError(t, err)
+		res, err := svc.BatchCheck(context.Background(), 1, 1, []domain.CheckItem{item(key)}, attrs)
+		require.NoError(t, err)
+		assert.Equal(t, []bool{ok}, res, key)
+	}
+
+	// 校验项数量达到上限时，查询并发受限但结果完整
+	items := make([]domain.CheckItem, domain.MaxCheckItems)
+	for idx := range items {
+		items[idx] = item("doc:1")
+	}
+	res, err = svc.BatchCheck(context.Background(), 1, 1, items, attrs)
+	require.NoError(t, err)
+	assert.Len(t, res, domain.MaxCheckItems)
+	assert.NotContains(t, res, false)
+}
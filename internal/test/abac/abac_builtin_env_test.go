@@ -0,0 +1,184 @@
+package abac
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/expression"
+	"github.com/permission-dev/internal/service/abac/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+var defaultClientIPConfig = domain.ClientIPConfig{Attribute: domain.DefaultClientIPAttribute}
+
+type fixedClock struct {
+	now time.Time
+}
+
+func (c *fixedClock) Now() time.Time {
+	return c.now
+}
+
+// methodStream 只提供 gRPC 方法名
+type methodStream struct {
+	grpc.ServerTransportStream
+	method string
+}
+
+func (s *methodStream) Method() string {
+	return s.method
+}
+
+// builtinBizAttrDefinition 在 testBizAttrDefinition 的基础上定义了内置的环境属性
+func builtinBizAttrDefinition() domain.BizAttrDefinition {
+	defs := testBizAttrDefinition()
+	for _, def := range []domain.AttributeDefinition{
+		{ID: 30, Name: domain.BuiltinEnvCurrentTime, DataType: domain.DataTypeDatetime, EntityType: domain.EnvironmentTypeEntity},
+		{ID: 31, Name: domain.BuiltinEnvWeekday, DataType: domain.DataTypeNumber, EntityType: domain.EnvironmentTypeEntity},
+		{ID: 32, Name: domain.BuiltinEnvGRPCMethod, DataType: domain.DataTypeString, EntityType: domain.EnvironmentTypeEntity},
+		{ID: 33, Name: domain.BuiltinEnvClientApp, DataType: domain.DataTypeString, EntityType: domain.EnvironmentTypeEntity},
+	} {
+		defs.EnvironmentAttrDefs = append(defs.EnvironmentAttrDefs, def)
+		defs.AllDefs[def.ID] = def
+	}
+	return defs
+}
+
+func requestContext() context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 5000}})
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("app", "order-svc"))
+	return grpc.NewContextWithServerTransportStream(ctx, &methodStream{method: "/permission.v1.PermissionService/CheckPermission"})
+}
+
+func TestBuiltinEnvFill(t *testing.T) {
+	t.Parallel()
+	defs := builtinBizAttrDefinition()
+	// 2024-10-02 是周三
+	clock := &fixedClock{now: time.Date(2024, 10, 2, 10, 0, 0, 0, time.UTC)}
+	builtin := provider.NewBuiltinEnv(clock, defaultClientIPConfig)
+	values := func(env domain.ABACObject) map[string]string {
+		res := make(map[string]string, len(env.AttrValues))
+		for _, val := range env.AttrValues {
+			res[val.AttrDef.Name] = val.Value
+		}
+		return res
+	}
+
+	// 时间以及调用方地址以服务端为准，请求中伪造的地址不生效
+	env := domain.ABACObject{}
+	env.MergeRealTimeAttr(defs.EnvironmentAttrDefs, map[string]string{"current_time": "1", "client_ip": "192.168.1.1"})
+	builtin.Fill(requestContext(), defs.EnvironmentAttrDefs, &env, 0)
+	assert.Equal(t, map[string]string{
+		"current_time": "1727863200000",
+		"weekday":      "3",
+		"client_ip":    "10.0.0.1",
+		"grpc_method":  "/permission.v1.PermissionService/CheckPermission",
+		"client_app":   "order-svc",
+	}, values(env))
+
+	// 没有 gRPC 请求信息时只填充时间，asOf 不为 0 时使用 asOf
+	env = domain.ABACObject{}
+	builtin.Fill(context.Background(), defs.EnvironmentAttrDefs, &env, time.Date(2024, 10, 6, 10, 0, 0, 0, time.UTC).UnixMilli())
+	assert.Equal(t, map[string]string{"current_time": "1728208800000", "weekday": "0"}, values(env))
+
+	// 业务没有定义内置属性时不填充
+	env = domain.ABACObject{}
+	builtin.Fill(requestContext(), testBizAttrDefinition().EnvironmentAttrDefs, &env, 0)
+	assert.Equal(t, map[string]string{"client_ip": "10.0.0.1"}, values(env))
+
+	// 配置指定调用方地址填充到其他属性，配置为空时不填充
+	ipDefs := domain.AttrDefs{
+		{ID: 40, Name: "client_ip", DataType: domain.DataTypeIP, EntityType: domain.EnvironmentTypeEntity},
+		{ID: 41, Name: "caller_ip", DataType: domain.DataTypeIP, EntityType: domain.EnvironmentTypeEntity},
+	}
+	env = domain.ABACObject{}
+	provider.NewBuiltinEnv(clock, domain.ClientIPConfig{Attribute: "caller_ip"}).Fill(requestContext(), ipDefs, &env, 0)
+	assert.Equal(t, map[string]string{"caller_ip": "10.0.0.1"}, values(env))
+	env = domain.ABACObject{}
+	provider.NewBuiltinEnv(clock, domain.ClientIPConfig{}).Fill(requestContext(), ipDefs, &env, 0)
+	assert.Empty(t, values(env))
+}
+
+func TestPermissionCheckWithBuiltinEnv(t *testing.T) {
+	t.Parallel()
+	defs := builtinBizAttrDefinition()
+	selector := evaluator.NewSelector()
+	rule, err := expression.Parse(`env.current_time = @day(09:00-18:00,UTC) AND env.weekday IN [1, 2, 3, 4, 5] AND env.client_app = "order-svc"`, defs, selector)
+	require.NoError(t, err)
+	policyRepo := &providerPolicyRepo{policies: []domain.Policy{{
+		ID:          1,
+		BizID:       1,
+		Status:      domain.PolicyStatusActive,
+		Permissions: []domain.UserPermission{{Permission: domain.Permission{ID: 1}, Effect: domain.EffectAllow}},
+		Rules:       []domain.PolicyRule{rule},
+	}}}
+	registry, err := provider.NewRegistry(nil)
+	require.NoError(t, err)
+	attrRepo := &versionAttrRepo{defs: defs}
+	executor := abac.NewPolicyExecutor(selector)
+	clock := &fixedClock{}
+	svc := abac.NewPermissionSvc(&providerPermissionRepo{}, &providerResourceRepo{}, abac.NewPolicyCache(policyRepo, attrRepo, executor),
+		&providerValueRepo{}, attrRepo, &providerBizConfigRepo{}, executor, registry, provider.NewBuiltinEnv(clock, defaultClientIPConfig))
+	resource := domain.Resource{Type: "doc", Key: "doc:1"}
+
+	testCases := []struct {
+		name  string
+		now   time.Time
+		attrs domain.Attributes
+		want  bool
+	}{
+		{name: "工作日工作时间", now: time.Date(2024, 10, 2, 10, 0, 0, 0, time.UTC), want: true},
+		{name: "工作日下班后", now: time.Date(2024, 10, 2, 20, 0, 0, 0, time.UTC), want: false},
+		{name: "周末", now: time.Date(2024, 10, 6, 10, 0, 0, 0, time.UTC), want: false},
+		{
+			name:  "请求中传入的时间不生效",
+			now:   time.Date(2024, 10, 6, 10, 0, 0, 0, time.UTC),
+			attrs: domain.Attributes{Environment: domain.SubAttrs{"current_time": "1727863200000", "weekday": "3"}},
+			want:  false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			clock.now = tc.now
+			ok, err := svc.Check(requestContext(), 1, 1, resource, []string{"read"}, tc.attrs)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, ok)
+		})
+	}
+}
+
+func TestPermissionCheckClientIPSpoof(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	selector := evaluator.NewSelector()
+	rule, err := expression.Parse(`env.client_ip IN CIDR ["192.168.0.0/16"]`, defs, selector)
+	require.NoError(t, err)
+	policyRepo := &providerPolicyRepo{policies: []domain.Policy{{
+		ID:          1,
+		BizID:       1,
+		Status:      domain.PolicyStatusActive,
+		Permissions: []domain.UserPermission{{Permission: domain.Permission{ID: 1}, Effect: domain.EffectAllow}},
+		Rules:       []domain.PolicyRule{rule},
+	}}}
+	registry, err := provider.NewRegistry(nil)
+	require.NoError(t, err)
+	attrRepo := &versionAttrRepo{defs: defs}
+	executor := abac.NewPolicyExecutor(selector)
+	svc := abac.NewPermissionSvc(&providerPermissionRepo{}, &providerResourceRepo{}, abac.NewPolicyCache(policyRepo, attrRepo, executor),
+		&providerValueRepo{}, attrRepo, &providerBizConfigRepo{}, executor, registry, provider.NewBuiltinEnv(provider.NewSystemClock(), defaultClientIPConfig))
+
+	// 调用方地址为 10.0.0.1，请求中传了网段内的地址也不能通过
+	ok, err := svc.Check(requestContext(), 1, 1, domain.Resource{Type: "doc", Key: "doc:1"}, []string{"read"},
+		domain.Attributes{Environment: domain.SubAttrs{"client_ip": "192.168.1.1"}})
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
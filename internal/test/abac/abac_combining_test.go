@@ -0,0 +1,113 @@
+package abac
+
+import (
+	"cmp"
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCombinePolicyEffects(t *testing.T) {
+	t.Parallel()
+	allow := domain.PolicyEffect{PolicyID: 1, Effect: domain.EffectAllow}
+	deny := domain.PolicyEffect{PolicyID: 2, Effect: domain.EffectDeny}
+	indeterminateAllow := domain.PolicyEffect{PolicyID: 3, Effect: domain.EffectAllow, Indeterminate: true}
+	indeterminateDeny := domain.PolicyEffect{PolicyID: 4, Effect: domain.EffectDeny, Indeterminate: true}
+	// 资源 key 更具体的权限上的 deny，只有它参与合并
+	specificDeny := domain.PolicyEffect{PolicyID: 5, Effect: domain.EffectDeny, Specificity: 1}
+	// 优先级更高的 deny
+	highDeny := domain.PolicyEffect{PolicyID: 6, Effect: domain.EffectDeny, Priority: 10}
+
+	type testCase struct {
+		name          string
+		effects       []domain.PolicyEffect
+		notApplicable bool
+		want          bool
+	}
+	// 每个算法都覆盖的情况：没有适用策略时返回 notApplicable，只有最具体的权限参与合并
+	common := func(cases ...testCase) []testCase {
+		return append([]testCase{
+			{name: "没有适用策略 默认拒绝"},
+			{name: "没有适用策略 配置为允许", notApplicable: true, want: true},
+			{name: "只有 allow", effects: []domain.PolicyEffect{allow}, want: true},
+			{name: "只有 deny", effects: []domain.PolicyEffect{deny}, notApplicable: true},
+			{name: "更具体的 deny 覆盖 allow", effects: []domain.PolicyEffect{allow, specificDeny}},
+		}, cases...)
+	}
+	algorithms := []struct {
+		algorithm domain.CombiningAlgorithm
+		cases     []testCase
+	}{
+		{
+			algorithm: domain.CombiningDenyOverrides,
+			cases: common(
+				testCase{name: "allow 和 deny", effects: []domain.PolicyEffect{allow, deny}},
+				testCase{name: "无法判定的 deny", effects: []domain.PolicyEffect{allow, indeterminateDeny}},
+				testCase{name: "只有无法判定的 allow", effects: []domain.PolicyEffect{indeterminateAllow}, notApplicable: true},
+				testCase{name: "allow 和无法判定的 allow", effects: []domain.PolicyEffect{allow, indeterminateAllow}, want: true},
+			),
+		},
+		{
+			// 未配置时按 deny-overrides 处理
+			algorithm: "",
+			cases: common(
+				testCase{name: "allow 和 deny", effects: []domain.PolicyEffect{allow, deny}},
+			),
+		},
+		{
+			algorithm: domain.CombiningPermitOverrides,
+			cases: common(
+				testCase{name: "allow 和 deny", effects: []domain.PolicyEffect{allow, deny}, want: true},
+				testCase{name: "allow 和无法判定的 deny", effects: []domain.PolicyEffect{allow, indeterminateDeny}, want: true},
+				testCase{name: "只有无法判定的 allow", effects: []domain.PolicyEffect{indeterminateAllow}, notApplicable: true},
+				testCase{name: "只有无法判定的 deny", effects: []domain.PolicyEffect{indeterminateDeny}, notApplicable: true},
+			),
+		},
+		{
+			algorithm: domain.CombiningFirstApplicable,
+			cases: common(
+				testCase{name: "按顺序第一个 allow", effects: []domain.PolicyEffect{allow, deny}, want: true},
+				testCase{name: "按顺序第一个 deny", effects: []domain.PolicyEffect{deny, allow}},
+				testCase{name: "优先级高的先生效", effects: []domain.PolicyEffect{allow, highDeny}},
+				testCase{name: "第一个无法判定", effects: []domain.PolicyEffect{indeterminateAllow, allow}, notApplicable: true},
+			),
+		},
+		{
+			algorithm: domain.CombiningOnlyOneApplicable,
+			cases: common(
+				testCase{name: "多个适用策略", effects: []domain.PolicyEffect{allow, {PolicyID: 7, Effect: domain.EffectAllow}}},
+				testCase{name: "同一个策略的多个权限", effects: []domain.PolicyEffect{allow, {PolicyID: 1, Effect: domain.EffectAllow}}, want: true},
+				testCase{name: "同一个策略 allow 和 deny", effects: []domain.PolicyEffect{allow, {PolicyID: 1, Effect: domain.EffectDeny}}},
+				testCase{name: "无法判定", effects: []domain.PolicyEffect{{PolicyID: 1, Effect: domain.EffectAllow, Indeterminate: true}}, notApplicable: true},
+			),
+		},
+		{
+			algorithm: domain.CombiningDenyUnlessPermit,
+			cases: []testCase{
+				{name: "没有适用策略 忽略配置", notApplicable: true},
+				{name: "allow 和 deny", effects: []domain.PolicyEffect{allow, deny}, want: true},
+				{name: "只有 deny", effects: []domain.PolicyEffect{deny}, notApplicable: true},
+				{name: "忽略无法判定的 allow", effects: []domain.PolicyEffect{indeterminateAllow}, notApplicable: true},
+				{name: "更具体的 deny 覆盖 allow", effects: []domain.PolicyEffect{allow, specificDeny}},
+			},
+		},
+		{
+			algorithm: domain.CombiningPermitUnlessDeny,
+			cases: []testCase{
+				{name: "没有适用策略 忽略配置", want: true},
+				{name: "allow 和 deny", effects: []domain.PolicyEffect{allow, deny}},
+				{name: "只有 allow", effects: []domain.PolicyEffect{allow}, want: true},
+				{name: "忽略无法判定的 deny", effects: []domain.PolicyEffect{indeterminateDeny}, want: true},
+				{name: "更具体的 deny 覆盖 allow", effects: []domain.PolicyEffect{allow, specificDeny}},
+			},
+		},
+	}
+	for _, alg := range algorithms {
+		for _, tc := range alg.cases {
+			t.Run(cmp.Or(alg.algorithm.String(), "未配置")+" "+tc.name, func(t *testing.T) {
+				assert.Equal(t, tc.want, alg.algorithm.CombinePolicyEffects(tc.effects, tc.notApplicable))
+			})
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package abac
+
+import (
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIPEvaluator(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name      string
+		wantVal   string
+		actualVal string
+		op        domain.RuleOperator
+		want      bool
+		wantErr   bool
+	}{
+		{name: "IPv4 相等", wantVal: "10.0.0.1", actualVal: "10.0.0.1", op: domain.Equals, want: true},
+		{name: "IPv4 映射的 IPv6 地址相等", wantVal: "10.0.0.1", actualVal: "::ffff:10.0.0.1", op: domain.Equals, want: true},
+		{name: "IPv6 不相等", wantVal: "fd00::1", actualVal: "fd00::2", op: domain.NotEquals, want: true},
+		{name: "IPv4 属于网段", wantVal: `["192.168.0.0/16","10.0.0.0/8"]`, actualVal: "10.1.2.3", op: domain.InCIDR, want: true},
+		{name: "IPv4 不属于网段", wantVal: `["192.168.0.0/16"]`, actualVal: "10.1.2.3", op: domain.InCIDR},
+		{name: "IPv6 属于网段", wantVal: `["fd00::/8"]`, actualVal: "fd12:3456::1", op: domain.InCIDR, want: true},
+		{name: "IPv4 不属于 IPv6 网段", wantVal: `["::/0"]`, actualVal: "10.0.0.1", op: domain.InCIDR},
+		{name: "单个网段", wantVal: "10.0.0.0/8", actualVal: "10.0.0.1", op: domain.InCIDR, want: true},
+		{name: "单个地址", wantVal: `["10.0.0.1"]`, actualVal: "10.0.0.1", op: domain.InCIDR, want: true},
+		{name: "NOT IN CIDR", wantVal: `["10.0.0.0/8"]`, actualVal: "172.16.0.1", op: domain.NotInCIDR, want: true},
+		{name: "NOT IN CIDR 属于网段", wantVal: `["10.0.0.0/8"]`, actualVal: "10.0.0.1", op: domain.NotInCIDR},
+		{name: "属性值不是 IP", wantVal: `["10.0.0.0/8"]`, actualVal: "localhost", op: domain.InCIDR, wantErr: true},
+		{name: "网段无效", wantVal: `["10.0.0.0/33"]`, actualVal: "10.0.0.1", op: domain.InCIDR, wantErr: true},
+		{name: "不支持的运算符", wantVal: "10.0.0.1", actualVal: "10.0.0.1", op: domain.Greater, wantErr: true},
+	}
+	ipEvaluator := evaluator.NewIPEvaluator()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ipEvaluator.Evaluator(tc.wantVal, tc.actualVal, tc.op)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
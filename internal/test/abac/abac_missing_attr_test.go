@@ -0,0 +1,89 @@
+package abac
+
+import (
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/expression"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyExecutorMissingAttr(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	// 部门的默认值
+	dept := defs.AllDefs[7]
+	dept.DefaultValue = "rd"
+	defs.AllDefs[7] = dept
+	selector := evaluator.NewSelector()
+	executor := abac.NewPolicyExecutor(selector)
+	withDept := domain.ABACObject{AttrValues: []domain.AttributeValue{{AttrDef: defs.AllDefs[7], Value: "hr"}}}
+	badLevel := domain.ABACObject{AttrValues: []domain.AttributeValue{{AttrDef: defs.AllDefs[1], Value: "abc"}}}
+	tests := []struct {
+		name    string
+		expr    string
+		mode    domain.MissingAttrMode
+		subject domain.ABACObject
+		want    domain.EvalResult
+	}{
+		{name: "未配置按 false 处理", expr: `subject.level > 1`, want: domain.EvalFalse},
+		{name: "false 取反", expr: `NOT subject.level > 1`, mode: domain.MissingAttrFalse, want: domain.EvalTrue},
+		{name: "无法判定", expr: `subject.level > 1`, mode: domain.MissingAttrIndeterminate, want: domain.EvalIndeterminate},
+		{name: "无法判定取反", expr: `NOT subject.level > 1`, mode: domain.MissingAttrIndeterminate, want: domain.EvalIndeterminate},
+		{name: "无法判定 OR 满足", expr: `subject.level > 1 OR subject.dept = "hr"`, mode: domain.MissingAttrIndeterminate, subject: withDept, want: domain.EvalTrue},
+		{name: "无法判定 AND 不满足", expr: `subject.level > 1 AND subject.dept = "rd"`, mode: domain.MissingAttrIndeterminate, subject: withDept, want: domain.EvalFalse},
+		{name: "无法判定 AND 满足", expr: `subject.level > 1 AND subject.dept = "hr"`, mode: domain.MissingAttrIndeterminate, subject: withDept, want: domain.EvalIndeterminate},
+		{name: "使用默认值", expr: `subject.dept = "rd"`, mode: domain.MissingAttrDefault, want: domain.EvalTrue},
+		{name: "有值时不使用默认值", expr: `subject.dept = "rd"`, mode: domain.MissingAttrDefault, subject: withDept, want: domain.EvalFalse},
+		{name: "没有默认值", expr: `subject.level > 1`, mode: domain.MissingAttrDefault, want: domain.EvalIndeterminate},
+		{name: "执行出错", expr: `subject.level > 1`, subject: badLevel, want: domain.EvalIndeterminate},
+		{name: "执行出错取反", expr: `NOT subject.level > 1`, subject: badLevel, want: domain.EvalIndeterminate},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rule, err := expression.Parse(tc.expr, defs, selector)
+			require.NoError(t, err)
+			policy := executor.Compile(domain.Policy{MissingAttr: tc.mode, Rules: []domain.PolicyRule{rule}}, defs)
+			assert.Equal(t, tc.want, executor.EvaluateCompiled(policy, tc.subject, domain.ABACObject{}, domain.ABACObject{}))
+			trace := executor.ExplainCompiled(policy, tc.subject, domain.ABACObject{}, domain.ABACObject{})
+			assert.Equal(t, tc.want == domain.EvalTrue, trace.Result)
+			assert.Equal(t, tc.want == domain.EvalIndeterminate, trace.Indeterminate)
+		})
+	}
+}
+
+func TestCombineIndeterminate(t *testing.T) {
+	t.Parallel()
+	allow := domain.PolicyEffect{PolicyID: 1, Effect: domain.EffectAllow}
+	deny := domain.PolicyEffect{PolicyID: 2, Effect: domain.EffectDeny}
+	indeterminateAllow := domain.PolicyEffect{PolicyID: 3, Effect: domain.EffectAllow, Indeterminate: true}
+	indeterminateDeny := domain.PolicyEffect{PolicyID: 4, Effect: domain.EffectDeny, Indeterminate: true}
+	tests := []struct {
+		name          string
+		algorithm     domain.CombiningAlgorithm
+		effects       []domain.PolicyEffect
+		notApplicable bool
+		want          bool
+	}{
+		{name: "deny-overrides 无法判定的 deny", algorithm: domain.CombiningDenyOverrides, effects: []domain.PolicyEffect{allow, indeterminateDeny}},
+		{name: "deny-overrides 无法判定的 allow", algorithm: domain.CombiningDenyOverrides, effects: []domain.PolicyEffect{indeterminateAllow}, notApplicable: true},
+		{name: "deny-overrides allow 优先于无法判定的 allow", algorithm: domain.CombiningDenyOverrides, effects: []domain.PolicyEffect{allow, indeterminateAllow}, want: true},
+		{name: "permit-overrides allow", algorithm: domain.CombiningPermitOverrides, effects: []domain.PolicyEffect{allow, indeterminateDeny}, want: true},
+		{name: "permit-overrides 无法判定", algorithm: domain.CombiningPermitOverrides, effects: []domain.PolicyEffect{indeterminateAllow}, notApplicable: true},
+		{name: "first-applicable 第一个无法判定", algorithm: domain.CombiningFirstApplicable, effects: []domain.PolicyEffect{indeterminateAllow, allow}},
+		{name: "only-one-applicable 无法判定", algorithm: domain.CombiningOnlyOneApplicable, effects: []domain.PolicyEffect{allow, {PolicyID: 1, Effect: domain.EffectDeny, Indeterminate: true}}},
+		{name: "deny-unless-permit 忽略无法判定", algorithm: domain.CombiningDenyUnlessPermit, effects: []domain.PolicyEffect{indeterminateAllow}},
+		{name: "permit-unless-deny 忽略无法判定", algorithm: domain.CombiningPermitUnlessDeny, effects: []domain.PolicyEffect{indeterminateDeny}, want: true},
+		{name: "deny-overrides 只有 deny", algorithm: domain.CombiningDenyOverrides, effects: []domain.PolicyEffect{deny}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tc.want, tc.algorithm.CombinePolicyEffects(tc.effects, tc.notApplicable))
+		})
+	}
+}
@@ -0,0 +1,74 @@
+package abac
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/expression"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperatorRegistry(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	selector := evaluator.NewSelector()
+	// 注册一个数字类型的自定义运算符：能被比较值整除
+	err := selector.RegisterOperator(domain.DataTypeNumber, "DIVISIBLE_BY", evaluator.OperatorFunc(func(wantVal, actualVal string) (bool, error) {
+		divisor, err := strconv.Atoi(wantVal)
+		if err != nil {
+			return false, err
+		}
+		val, err := strconv.Atoi(actualVal)
+		return err == nil && divisor != 0 && val%divisor == 0, err
+	}))
+	require.NoError(t, err)
+	assert.ErrorIs(t, selector.RegisterOperator(domain.DataTypeNumber, domain.AND, evaluator.OperatorFunc(nil)), errs.ErrInvalidPolicyRule)
+	assert.ErrorIs(t, selector.RegisterOperator("geo", "NEAR", evaluator.OperatorFunc(nil)), errs.ErrUnkonwDataType)
+
+	assert.True(t, selector.Supports(domain.DataTypeNumber, "DIVISIBLE_BY"))
+	assert.False(t, selector.Supports(domain.DataTypeString, "DIVISIBLE_BY"))
+	assert.True(t, selector.Supports(domain.DataTypeString, evaluator.OperatorMatches))
+	assert.NoError(t, selector.Validate(domain.DataTypeString, evaluator.OperatorMatches, `^a.*`))
+	assert.ErrorIs(t, selector.Validate(domain.DataTypeString, evaluator.OperatorMatches, `(`), errs.ErrInvalidPolicyRule)
+	assert.ErrorIs(t, selector.Validate(domain.DataTypeBoolean, domain.Greater, "true"), errs.ErrInvalidPolicyRule)
+
+	level := func(val string) domain.ABACObject {
+		return domain.ABACObject{AttrValues: []domain.AttributeValue{{AttrDef: defs.AllDefs[1], Value: val}}}
+	}
+	dept := func(val string) domain.ABACObject {
+		return domain.ABACObject{AttrValues: []domain.AttributeValue{{AttrDef: defs.AllDefs[7], Value: val}}}
+	}
+	tests := []struct {
+		name    string
+		expr    string
+		subject domain.ABACObject
+		want    bool
+	}{
+		{name: "自定义运算符", expr: `subject.level divisible_by 10`, subject: level("20"), want: true},
+		{name: "自定义运算符不满足", expr: `subject.level DIVISIBLE_BY 10`, subject: level("21")},
+		{name: "正则匹配", expr: `subject.dept MATCHES "^rd-[0-9]+$"`, subject: dept("rd-12"), want: true},
+		{name: "正则不匹配", expr: `subject.dept MATCHES "^rd-[0-9]+$"`, subject: dept("hr-12")},
+		{name: "前缀", expr: `subject.dept STARTS_WITH "rd"`, subject: dept("rd-12"), want: true},
+		{name: "包含", expr: `subject.dept CONTAINS "-1"`, subject: dept("rd-12"), want: true},
+	}
+	executor := abac.NewPolicyExecutor(selector)
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rule, err := expression.Parse(tc.expr, defs, selector)
+			require.NoError(t, err)
+			policy := domain.Policy{Rules: []domain.PolicyRule{rule}}
+			assert.Equal(t, tc.want, executor.Check(policy, tc.subject, domain.ABACObject{}, domain.ABACObject{}))
+		})
+	}
+
+	_, err = expression.Parse(`subject.dept MATCHES "("`, defs, selector)
+	assert.ErrorIs(t, err, errs.ErrInvalidPolicyExpression)
+	_, err = expression.Parse(`subject.vip DIVISIBLE_BY 10`, defs, selector)
+	assert.ErrorIs(t, err, errs.ErrInvalidPolicyExpression)
+}
@@ -0,0 +1,104 @@
+package abac
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/repository/dao"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// bindingPolicyDAO 一个策略，草稿中的权限关联保存在 draft，已经发布的版本保存在 versions，
+// published 为生效的版本号
+type bindingPolicyDAO struct {
+	dao.PolicyDAO
+	draft     []dao.PermissionPolicy
+	versions  map[int64]dao.PolicyVersion
+	published int64
+}
+
+func (d *bindingPolicyDAO) FindPoliciesByBizId(_ context.Context, bizId int64) ([]dao.Policy, error) {
+	return []dao.Policy{{ID: 1, BizID: bizId, Name: "binding", Version: d.published}}, nil
+}
+
+func (d *bindingPolicyDAO) FindPublishedPolicyVersions(_ context.Context, _ int64) (map[int64]dao.PolicyVersion, error) {
+	if d.published == 0 {
+		return map[int64]dao.PolicyVersion{}, nil
+	}
+	return map[int64]dao.PolicyVersion{1: d.versions[d.published]}, nil
+}
+
+func (d *bindingPolicyDAO) FindPolicyById(ctx context.Context, bizId, _ int64) (dao.Policy, error) {
+	policies, err := d.FindPoliciesByBizId(ctx, bizId)
+	return policies[0], err
+}
+
+func (d *bindingPolicyDAO) FindPolicyVersion(_ context.Context, _, _, version int64) (dao.PolicyVersion, error) {
+	return d.versions[version], nil
+}
+
+// FindPermissionPolicy 返回草稿中的关联，鉴权时不应该使用
+func (d *bindingPolicyDAO) FindPermissionPolicy(_ context.Context, _ int64) (map[int64][]dao.PermissionPolicy, error) {
+	return map[int64][]dao.PermissionPolicy{1: d.draft}, nil
+}
+
+func bindingVersion(t *testing.T, version int64, permissionIDs ...int64) dao.PolicyVersion {
+	snapshot := dao.PolicySnapshot{Status: string(domain.PolicyStatusActive)}
+	for _, id := range permissionIDs {
+		snapshot.Permissions = append(snapshot.Permissions, dao.PermissionPolicy{
+			BizID: 1, PolicyID: 1, PermissionID: id, Effect: domain.EffectAllow.String(),
+		})
+	}
+	val, err := json.Marshal(snapshot)
+	require.NoError(t, err)
+	return dao.PolicyVersion{BizID: 1, PolicyID: 1, Version: version, Snapshot: string(val)}
+}
+
+func TestPolicyPermissionBindingVersions(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	policyDAO := &bindingPolicyDAO{
+		// 草稿中新关联了权限 2，还没有发布
+		draft: []dao.PermissionPolicy{
+			{BizID: 1, PolicyID: 1, PermissionID: 1, Effect: domain.EffectAllow.String()},
+			{BizID: 1, PolicyID: 1, PermissionID: 2, Effect: domain.EffectAllow.String()},
+		},
+		versions:  map[int64]dao.PolicyVersion{1: bindingVersion(t, 1, 1)},
+		published: 1,
+	}
+	repo := repository.NewAttributePolicyRepository(policyDAO)
+	policyIDs := func(permissionID int64) []int64 {
+		policies, err := repo.FindPoliciesByPermissionIDs(ctx, 1, []int64{permissionID})
+		require.NoError(t, err)
+		res := make([]int64, 0, len(policies))
+		for _, policy := range policies {
+			res = append(res, policy.ID)
+		}
+		return res
+	}
+
+	// 发布前只有已经发布的关联生效
+	assert.Equal(t, []int64{1}, policyIDs(1))
+	assert.Empty(t, policyIDs(2))
+
+	// 发布后新的关联生效
+	policyDAO.versions[2] = bindingVersion(t, 2, 1, 2)
+	policyDAO.published = 2
+	assert.Equal(t, []int64{1}, policyIDs(2))
+
+	// 回滚到版本 1 之后新的关联不再生效
+	policyDAO.versions[3] = bindingVersion(t, 3, 1)
+	policyDAO.published = 3
+	assert.Equal(t, []int64{1}, policyIDs(1))
+	assert.Empty(t, policyIDs(2))
+
+	// 版本的快照中包含关联的权限
+	version, err := repo.FindVersion(ctx, 1, 1, 2)
+	require.NoError(t, err)
+	require.Len(t, version.Policy.Permissions, 2)
+	assert.Equal(t, int64(2), version.Policy.Permissions[1].Permission.ID)
+}
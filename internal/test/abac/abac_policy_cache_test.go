@@ -0,0 +1,83 @@
+package abac
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// blockingPolicyRepo 查询业务策略时等待 release 关闭，返回时 ctx 已经取消则返回错误
+type blockingPolicyRepo struct {
+	repository.AttributePolicyRepository
+	policies []domain.Policy
+	started  chan struct{}
+	release  chan struct{}
+	once     sync.Once
+	calls    atomic.Int32
+}
+
+func (r *blockingPolicyRepo) FindBizPolicies(ctx context.Context, _ int64) ([]domain.Policy, error) {
+	r.calls.Add(1)
+	r.once.Do(func() { close(r.started) })
+	<-r.release
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return r.policies, nil
+}
+
+func TestPolicyCacheLoadIgnoresCallerCancel(t *testing.T) {
+	t.Parallel()
+	repo := &blockingPolicyRepo{
+		policies: []domain.Policy{{ID: 1, BizID: 1, Status: domain.PolicyStatusActive,
+			Permissions: []domain.UserPermission{{Permission: domain.Permission{ID: 1}, Effect: domain.EffectAllow}}}},
+		started: make(chan struct{}),
+		release: make(chan struct{}),
+	}
+	cache := abac.NewPolicyCache(repo, &batchAttrRepo{}, abac.NewPolicyExecutor(evaluator.NewSelector()))
+
+	type result struct {
+		policies []*abac.CompiledPolicy
+		err      error
+	}
+	find := func(ctx context.Context) <-chan result {
+		ch := make(chan result, 1)
+		go func() {
+			policies, err := cache.FindPolicies(ctx, 1, []int64{1})
+			ch <- result{policies: policies, err: err}
+		}()
+		return ch
+	}
+
+	// 第一个请求发起加载，第二个请求等待同一个加载
+	ctx, cancel := context.WithCancel(context.Background())
+	first := find(ctx)
+	<-repo.started
+	second := find(context.Background())
+	time.Sleep(10 * time.Millisecond)
+
+	// 第一个请求取消后立刻返回，不等待加载完成
+	cancel()
+	select {
+	case res := <-first:
+		assert.ErrorIs(t, res.err, context.Canceled)
+	case <-time.After(time.Second):
+		t.Fatal("取消的请求没有返回")
+	}
+
+	// 加载不受第一个请求取消的影响，第二个请求拿到结果
+	close(repo.release)
+	res := <-second
+	require.NoError(t, res.err)
+	assert.Len(t, res.policies, 1)
+	assert.Equal(t, int32(1), repo.calls.Load())
+}
@@ -0,0 +1,147 @@
+package abac
+
+import (
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/expression"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyExecutorAttrRef(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	subject := func(id, dept string) domain.ABACObject {
+		return domain.ABACObject{AttrValues: []domain.AttributeValue{
+			{AttrDef: defs.AllDefs[6], Value: id},
+			{AttrDef: defs.AllDefs[7], Value: dept},
+		}}
+	}
+	resource := func(ownerID, ownerDept, shared string) domain.ABACObject {
+		return domain.ABACObject{AttrValues: []domain.AttributeValue{
+			{AttrDef: defs.AllDefs[8], Value: ownerID},
+			{AttrDef: defs.AllDefs[4], Value: ownerDept},
+			{AttrDef: defs.AllDefs[9], Value: shared},
+		}}
+	}
+	tests := []struct {
+		name     string
+		expr     string
+		subject  domain.ABACObject
+		resource domain.ABACObject
+		want     bool
+	}{
+		{
+			name:     "数字相等",
+			expr:     `resource.owner_id = subject.id`,
+			subject:  subject("1", "a"),
+			resource: resource("1", "b", `[]`),
+			want:     true,
+		},
+		{
+			name:     "数字不相等",
+			expr:     `resource.owner_id = subject.id`,
+			subject:  subject("2", "a"),
+			resource: resource("1", "b", `[]`),
+		},
+		{
+			name:     "字符串相等",
+			expr:     `subject.dept = resource.owner_dept`,
+			subject:  subject("2", "b"),
+			resource: resource("1", "b", `[]`),
+			want:     true,
+		},
+		{
+			name:     "字符串不相等",
+			expr:     `subject.dept = resource.owner_dept`,
+			subject:  subject("2", "a"),
+			resource: resource("1", "b", `[]`),
+		},
+		{
+			name:     "IN 数组属性",
+			expr:     `subject.dept IN resource.shared_depts`,
+			subject:  subject("2", "a"),
+			resource: resource("1", "b", `["a","c"]`),
+			want:     true,
+		},
+		{
+			name:     "引用的属性没有值",
+			expr:     `resource.owner_id = subject.id`,
+			subject:  domain.ABACObject{},
+			resource: resource("1", "b", `[]`),
+		},
+	}
+	executor := abac.NewPolicyExecutor(evaluator.NewSelector())
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rule, err := expression.Parse(tc.expr, defs, evaluator.NewSelector())
+			require.NoError(t, err)
+			policy := domain.Policy{Rules: []domain.PolicyRule{rule}}
+			assert.Equal(t, tc.want, executor.Check(policy, tc.subject, tc.resource, domain.ABACObject{}))
+		})
+	}
+}
+
+func TestPolicyExecutorAttrRefTypeMismatch(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	// 绕过表达式的类型检查，直接构造两侧类型不同的规则
+	rule := domain.PolicyRule{ID: 1, AttrDef: defs.AllDefs[8], Operator: domain.Equals, ValueAttrDef: defs.AllDefs[7]}
+	subject := domain.ABACObject{AttrValues: []domain.AttributeValue{{AttrDef: defs.AllDefs[7], Value: "1"}}}
+	resource := domain.ABACObject{AttrValues: []domain.AttributeValue{{AttrDef: defs.AllDefs[8], Value: "1"}}}
+	executor := abac.NewPolicyExecutor(evaluator.NewSelector())
+	trace := executor.Explain(domain.Policy{Rules: []domain.PolicyRule{rule}}, subject, resource, domain.ABACObject{})
+	assert.False(t, trace.Result)
+	require.Len(t, trace.Rules, 1)
+	assert.Equal(t, "dept", trace.Rules[0].ValueAttrDef.Name)
+	assert.Contains(t, trace.Rules[0].Err, errs.ErrRuleAttrRefInvalid.Error())
+}
+
+func TestPolicyExecutorCompiled(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	selector := evaluator.NewSelector()
+	executor := abac.NewPolicyExecutor(selector)
+	subject := func(level, dept string) domain.ABACObject {
+		return domain.ABACObject{AttrValues: []domain.AttributeValue{
+			{AttrDef: defs.AllDefs[1], Value: level},
+			{AttrDef: defs.AllDefs[7], Value: dept},
+		}}
+	}
+	rule, err := expression.Parse(`subject.level IN [1, 3, 5] AND NOT subject.dept IN ["hr", "fin"]`, defs, selector)
+	require.NoError(t, err)
+	policy := executor.Compile(domain.Policy{ID: 1, Rules: []domain.PolicyRule{rule}}, defs)
+	tests := []struct {
+		name    string
+		subject domain.ABACObject
+		want    bool
+	}{
+		{name: "满足", subject: subject("3", "rd"), want: true},
+		{name: "等级不在列表中", subject: subject("2", "rd")},
+		{name: "部门被排除", subject: subject("5", "hr")},
+		{name: "等级不是数字", subject: subject("abc", "rd")},
+		{name: "没有属性值", subject: domain.ABACObject{}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// 编译后的结果与直接执行一致
+			assert.Equal(t, tc.want, executor.CheckCompiled(policy, tc.subject, domain.ABACObject{}, domain.ABACObject{}))
+			assert.Equal(t, tc.want, executor.Check(policy.Policy, tc.subject, domain.ABACObject{}, domain.ABACObject{}))
+		})
+	}
+
+	// 比较值在编译时就无法解析，执行结果为 false 并记录原因
+	invalid := domain.PolicyRule{ID: 2, AttrDef: domain.AttributeDefinition{ID: 1}, Operator: domain.IN, Value: `[1, `}
+	trace := executor.ExplainCompiled(executor.Compile(domain.Policy{Rules: []domain.PolicyRule{invalid}}, defs),
+		subject("1", "rd"), domain.ABACObject{}, domain.ABACObject{})
+	assert.False(t, trace.Result)
+	require.Len(t, trace.Rules, 1)
+	assert.Equal(t, "level", trace.Rules[0].AttrDef.Name)
+	assert.NotEmpty(t, trace.Rules[0].Err)
+}
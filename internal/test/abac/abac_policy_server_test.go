@@ -0,0 +1,79 @@
+package abac
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
+	grpcabac "github.com/permission-dev/internal/api/grpc/abac"
+	"github.com/permission-dev/internal/api/grpc/interceptor/auth"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// failingPolicyRepo 查询策略时返回 err，否则返回一个没有规则的策略
+type failingPolicyRepo struct {
+	repository.AttributePolicyRepository
+	err error
+}
+
+func (r *failingPolicyRepo) First(_ context.Context, bizID, id int64) (domain.Policy, error) {
+	if r.err != nil {
+		return domain.Policy{}, r.err
+	}
+	return domain.Policy{ID: id, BizID: bizID}, nil
+}
+
+func (r *failingPolicyRepo) ReplaceRules(_ context.Context, _, _ int64, _ domain.PolicyRule) (int64, error) {
+	return 1, nil
+}
+
+func statusBizAttrDefinition() domain.BizAttrDefinition {
+	statusAttr := domain.AttributeDefinition{ID: 1, Name: "status", DataType: domain.DataTypeString, EntityType: domain.ResourceTypeEntity}
+	return domain.BizAttrDefinition{
+		BizID:            1,
+		ResourceAttrDefs: domain.AttrDefs{statusAttr},
+		AllDefs:          map[int64]domain.AttributeDefinition{statusAttr.ID: statusAttr},
+	}
+}
+
+func TestPolicyExpressionStatusCode(t *testing.T) {
+	t.Parallel()
+	selector := evaluator.NewSelector()
+	ctx := context.WithValue(context.Background(), auth.BizIDName, int64(1))
+	testCases := []struct {
+		name       string
+		err        error
+		expression string
+		want       codes.Code
+	}{
+		{name: "成功", expression: `resource.status = "draft"`, want: codes.OK},
+		{name: "策略不存在", err: fmt.Errorf("%w: %d", errs.ErrPolicyNotFound, 1), expression: `resource.status = "draft"`, want: codes.NotFound},
+		{name: "表达式无效", expression: `resource.status =`, want: codes.InvalidArgument},
+		{name: "数据库错误", err: errors.New("mock db error"), expression: `resource.status = "draft"`, want: codes.Internal},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &failingPolicyRepo{err: tc.err}
+			attrRepo := &batchAttrRepo{defs: statusBizAttrDefinition()}
+			svc := abac.NewPolicySvc(repo, attrRepo, &batchPermissionRepo{}, selector, abac.NewPolicyCache(repo, attrRepo, abac.NewPolicyExecutor(selector)))
+			server := grpcabac.NewABACPolicyServer(svc, nil)
+
+			_, err := server.SaveExpression(ctx, &permissionv1.PolicyServiceSaveExpressionRequest{PolicyId: 1, Expression: tc.expression})
+			assert.Equal(t, tc.want, status.Code(err), err)
+			if tc.want == codes.InvalidArgument {
+				return
+			}
+			_, err = server.GetExpression(ctx, &permissionv1.PolicyServiceGetExpressionRequest{PolicyId: 1})
+			assert.Equal(t, tc.want, status.Code(err), err)
+		})
+	}
+}
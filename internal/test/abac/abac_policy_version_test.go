@@ -0,0 +1,92 @@
+package abac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/expression"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// versionPolicyRepo 只实现比较版本用到的方法
+type versionPolicyRepo struct {
+	repository.AttributePolicyRepository
+	draft    domain.Policy
+	versions map[int64]domain.Policy
+}
+
+func (r *versionPolicyRepo) First(_ context.Context, _, _ int64) (domain.Policy, error) {
+	return r.draft, nil
+}
+
+func (r *versionPolicyRepo) FindVersion(_ context.Context, _, _, version int64) (domain.PolicyVersion, error) {
+	return domain.PolicyVersion{Version: version, Policy: r.versions[version]}, nil
+}
+
+type versionAttrRepo struct {
+	repository.AttributeDefinitionRepository
+	defs domain.BizAttrDefinition
+}
+
+func (r *versionAttrRepo) FindByBizID(_ context.Context, _ int64) (domain.BizAttrDefinition, error) {
+	return r.defs, nil
+}
+
+func TestPolicyDiffVersions(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	selector := evaluator.NewSelector()
+	rules := func(exprs ...string) []domain.PolicyRule {
+		res := make([]domain.PolicyRule, 0, len(exprs))
+		for _, expr := range exprs {
+			rule, err := expression.Parse(expr, defs, selector)
+			require.NoError(t, err)
+			res = append(res, rule)
+		}
+		return res
+	}
+	repo := &versionPolicyRepo{
+		draft: domain.Policy{
+			Status:   domain.PolicyStatusActive,
+			Priority: 10,
+			Rules:    rules(`subject.level >= 5`, `subject.dept IN ["rd", "qa"] OR subject.vip = true`),
+		},
+		versions: map[int64]domain.Policy{
+			1: {
+				Status: domain.PolicyStatusActive,
+				Rules:  rules(`subject.level >= 5`, `subject.dept = "rd"`),
+			},
+			2: {
+				Status: domain.PolicyStatusInActive,
+				Rules:  rules(`subject.level >= 5`, `subject.dept = "rd"`),
+			},
+		},
+	}
+	attrRepo := &versionAttrRepo{defs: defs}
+	svc := abac.NewPolicySvc(repo, attrRepo, &providerPermissionRepo{}, selector,
+		abac.NewPolicyCache(repo, attrRepo, abac.NewPolicyExecutor(selector)))
+
+	diff, err := svc.DiffVersions(context.Background(), 1, 1, 1, domain.PolicyDraftVersion)
+	require.NoError(t, err)
+	assert.Equal(t, domain.PolicyVersionDiff{
+		From:           1,
+		To:             domain.PolicyDraftVersion,
+		FromExpression: `subject.level >= 5 AND subject.dept = "rd"`,
+		ToExpression:   `subject.level >= 5 AND (subject.dept IN ["rd", "qa"] OR subject.vip = true)`,
+		AddedRules:     []string{`subject.dept IN ["rd", "qa"] OR subject.vip = true`},
+		RemovedRules:   []string{`subject.dept = "rd"`},
+		Changes:        []domain.PolicyFieldChange{{Field: "priority", From: "0", To: "10"}},
+	}, diff)
+
+	// 规则相同，只有状态不同
+	diff, err = svc.DiffVersions(context.Background(), 1, 1, 1, 2)
+	require.NoError(t, err)
+	assert.Empty(t, diff.AddedRules)
+	assert.Empty(t, diff.RemovedRules)
+	assert.Equal(t, []domain.PolicyFieldChange{{Field: "status", From: "active", To: "inactive"}}, diff.Changes)
+}
@@ -0,0 +1,217 @@
+package abac
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/expression"
+	"github.com/permission-dev/internal/service/abac/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// scopedBizAttrDefinition 在 testBizAttrDefinition 的基础上，doc 和 order 各自定义了 status
+func scopedBizAttrDefinition() domain.BizAttrDefinition {
+	defs := testBizAttrDefinition()
+	for _, def := range []domain.AttributeDefinition{
+		{ID: 20, Name: "status", DataType: domain.DataTypeString, EntityType: domain.ResourceTypeEntity, ResourceType: "doc"},
+		{ID: 21, Name: "status", DataType: domain.DataTypeNumber, EntityType: domain.ResourceTypeEntity, ResourceType: "order"},
+	} {
+		defs.ResourceAttrDefs = append(defs.ResourceAttrDefs, def)
+		defs.AllDefs[def.ID] = def
+	}
+	return defs
+}
+
+// scopedPermissionRepo doc 的权限ID为 1，order 的权限ID为 2
+type scopedPermissionRepo struct {
+	repository.PermissionRepository
+}
+
+var scopedPermissionIDs = map[string]int64{"doc": 1, "order": 2}
+
+func (r *scopedPermissionRepo) FindPermissions(_ context.Context, bizId int64, resourceType, resourceKey string, _ []string) ([]domain.Permission, error) {
+	return []domain.Permission{{ID: scopedPermissionIDs[resourceType], BizID: bizId, Resource: domain.Resource{Type: resourceType, Key: resourceKey}}}, nil
+}
+
+func (r *scopedPermissionRepo) FindByBizIDANdID(_ context.Context, bizId, id int64) (domain.Permission, error) {
+	for resourceType, permissionID := range scopedPermissionIDs {
+		if permissionID == id {
+			return domain.Permission{ID: id, BizID: bizId, Resource: domain.Resource{Type: resourceType}}, nil
+		}
+	}
+	return domain.Permission{}, errs.ErrBizIDNotFound
+}
+
+func TestBizAttrDefinitionForResourceType(t *testing.T) {
+	t.Parallel()
+	defs := scopedBizAttrDefinition()
+	names := func(defs domain.BizAttrDefinition) []string {
+		res := make([]string, 0, len(defs.ResourceAttrDefs))
+		for _, def := range defs.ResourceAttrDefs {
+			res = append(res, def.Name+"@"+def.ResourceType)
+		}
+		return res
+	}
+	assert.Equal(t, []string{"status@doc", "owner_dept@", "owner_id@", "shared_depts@"}, names(defs.ForResourceType("doc")))
+	assert.Equal(t, []string{"owner_dept@", "owner_id@", "shared_depts@"}, names(defs.ForResourceType("user")))
+	assert.Equal(t, names(defs), names(defs.ForResourceTypes(nil)))
+	assert.Equal(t, names(defs.ForResourceType("")), names(defs.ForResourceTypes([]string{"doc", "order"})))
+	assert.Len(t, defs.ForResourceType("doc").AllDefs, len(defs.AllDefs))
+
+	// 没有按资源类型筛选时，同名的属性无法区分
+	_, err := expression.Parse(`resource.status = "draft"`, defs, evaluator.NewSelector())
+	assert.ErrorIs(t, err, errs.ErrInvalidPolicyExpression)
+	rule, err := expression.Parse(`resource.status > 100`, defs.ForResourceType("order"), evaluator.NewSelector())
+	require.NoError(t, err)
+	assert.Equal(t, int64(21), rule.AttrDef.ID)
+}
+
+func TestPermissionCheckResourceTypeAttrs(t *testing.T) {
+	t.Parallel()
+	defs := scopedBizAttrDefinition()
+	selector := evaluator.NewSelector()
+	policy := func(id int64, resourceType, expr string) domain.Policy {
+		rule, err := expression.Parse(expr, defs.ForResourceType(resourceType), selector)
+		require.NoError(t, err)
+		return domain.Policy{
+			ID:          id,
+			BizID:       1,
+			Status:      domain.PolicyStatusActive,
+			Permissions: []domain.UserPermission{{Permission: domain.Permission{ID: scopedPermissionIDs[resourceType]}, Effect: domain.EffectAllow}},
+			Rules:       []domain.PolicyRule{rule},
+		}
+	}
+	policyRepo := &providerPolicyRepo{policies: []domain.Policy{
+		policy(1, "doc", `resource.status = "draft"`),
+		policy(2, "order", `resource.status > 100`),
+	}}
+	registry, err := provider.NewRegistry(nil)
+	require.NoError(t, err)
+	attrRepo := &versionAttrRepo{defs: defs}
+	executor := abac.NewPolicyExecutor(selector)
+	svc := abac.NewPermissionSvc(&scopedPermissionRepo{}, &providerResourceRepo{}, abac.NewPolicyCache(policyRepo, attrRepo, executor),
+		&providerValueRepo{}, attrRepo, &providerBizConfigRepo{}, executor, registry, provider.NewBuiltinEnv(provider.NewSystemClock(), defaultClientIPConfig))
+	ctx := context.Background()
+	status := func(val string) domain.Attributes {
+		return domain.Attributes{Resource: domain.SubAttrs{"status": val}}
+	}
+
+	// 请求中的同名属性按资源类型解析
+	ok, err := svc.Check(ctx, 1, 1, domain.Resource{Type: "doc", Key: "doc:1"}, []string{"read"}, status("draft"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = svc.Check(ctx, 1, 1, domain.Resource{Type: "order", Key: "order:1"}, []string{"read"}, status("200"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	ok, err = svc.Check(ctx, 1, 1, domain.Resource{Type: "order", Key: "order:1"}, []string{"read"}, status("50"))
+	require.NoError(t, err)
+	assert.False(t, ok)
+
+	res, err := svc.BatchCheck(ctx, 1, 1, []domain.CheckItem{
+		{Resource: domain.Resource{Type: "order", Key: "order:1"}, Actions: []string{"read"}},
+		{Resource: domain.Resource{Type: "doc", Key: "doc:1"}, Actions: []string{"read"}},
+	}, status("200"))
+	require.NoError(t, err)
+	assert.Equal(t, []bool{true, false}, res)
+}
+
+// scopedPolicyRepo 草稿关联了 permissions 中的权限
+type scopedPolicyRepo struct {
+	repository.AttributePolicyRepository
+	permissions []int64
+	rules       []domain.PolicyRule
+}
+
+func (r *scopedPolicyRepo) First(_ context.Context, bizID, id int64) (domain.Policy, error) {
+	policy := domain.Policy{ID: id, BizID: bizID, Rules: r.rules}
+	for _, permissionID := range r.permissions {
+		policy.Permissions = append(policy.Permissions, domain.UserPermission{Permission: domain.Permission{ID: permissionID}})
+	}
+	return policy, nil
+}
+
+func (r *scopedPolicyRepo) SaveRule(_ context.Context, _, _ int64, rule domain.PolicyRule) (int64, error) {
+	r.rules = append(r.rules, rule)
+	return int64(len(r.rules)), nil
+}
+
+func (r *scopedPolicyRepo) ReplaceRules(_ context.Context, _, _ int64, rule domain.PolicyRule) (int64, error) {
+	r.rules = []domain.PolicyRule{rule}
+	return 1, nil
+}
+
+func (r *scopedPolicyRepo) SavePermissionPolicy(_ context.Context, _, _, permissionID int64, _ domain.Effect) error {
+	r.permissions = append(r.permissions, permissionID)
+	return nil
+}
+
+func TestPolicyRuleResourceTypes(t *testing.T) {
+	t.Parallel()
+	defs := scopedBizAttrDefinition()
+	selector := evaluator.NewSelector()
+	newSvc := func(repo *scopedPolicyRepo) abac.PolicySvc {
+		attrRepo := &versionAttrRepo{defs: defs}
+		return abac.NewPolicySvc(repo, attrRepo, &scopedPermissionRepo{}, selector,
+			abac.NewPolicyCache(repo, attrRepo, abac.NewPolicyExecutor(selector)))
+	}
+	ctx := context.Background()
+
+	repo := &scopedPolicyRepo{permissions: []int64{1}}
+	svc := newSvc(repo)
+	_, err := svc.SaveExpression(ctx, 1, 1, `resource.status = "draft" AND resource.owner_dept = "rd"`)
+	require.NoError(t, err)
+	assert.Equal(t, int64(20), repo.rules[0].LeftRule.AttrDef.ID)
+	// 关联了 doc 的策略不能使用 order 的属性
+	_, err = svc.SaveRule(ctx, 1, 1, domain.PolicyRule{AttrDef: defs.AllDefs[21], Operator: domain.Greater, Value: "100"})
+	assert.ErrorIs(t, err, errs.ErrInvalidPolicyRule)
+	_, err = svc.SaveRule(ctx, 1, 1, domain.PolicyRule{AttrDef: defs.AllDefs[4], Operator: domain.Equals, Value: "rd"})
+	require.NoError(t, err)
+	// 规则引用了 doc 的属性，不能再关联 order 的权限
+	err = svc.SavePermissionPolicy(ctx, 1, 1, 2, domain.EffectAllow)
+	assert.ErrorIs(t, err, errs.ErrInvalidPolicyRule)
+	assert.Equal(t, []int64{1}, repo.permissions)
+
+	// 同时关联了 doc 和 order 的策略只能使用共用的属性
+	repo = &scopedPolicyRepo{permissions: []int64{1, 2}}
+	svc = newSvc(repo)
+	_, err = svc.SaveExpression(ctx, 1, 1, `resource.status = "draft"`)
+	assert.ErrorIs(t, err, errs.ErrInvalidPolicyExpression)
+	_, err = svc.SaveExpression(ctx, 1, 1, `resource.owner_dept = "rd"`)
+	require.NoError(t, err)
+	require.NoError(t, svc.SavePermissionPolicy(ctx, 1, 1, 2, domain.EffectAllow))
+}
+
+func TestImportResourceTypeAttrValues(t *testing.T) {
+	t.Parallel()
+	repo := &bulkValueRepo{}
+	svc := abac.NewAttributeValueSvc(repo, &versionAttrRepo{defs: scopedBizAttrDefinition()})
+	data := "entity_id,attribute,value,resource_type\n" +
+		"1,status,draft,doc\n" +
+		"2,status,200,order\n" +
+		"3,status,draft,\n" +
+		"4,owner_dept,rd,doc\n" +
+		"5,status,draft,user\n"
+	res, err := svc.ImportValues(context.Background(), 1, domain.ResourceTypeEntity, domain.AttrValueFormatCSV, strings.NewReader(data))
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), res.Succeeded)
+	require.Len(t, res.Errors, 2)
+	assert.Equal(t, int64(4), res.Errors[0].Line)
+	assert.Equal(t, int64(6), res.Errors[1].Line)
+	ids := make([]int64, 0, len(repo.values))
+	for _, val := range repo.values {
+		ids = append(ids, val.Value.AttrDef.ID)
+	}
+	assert.Equal(t, []int64{20, 21, 4}, ids)
+
+	// 导出时带上资源类型，可以原样导入
+	var exported strings.Builder
+	require.NoError(t, svc.ExportValues(context.Background(), 1, domain.ResourceTypeEntity, domain.AttrValueFormatCSV, &exported))
+	assert.Equal(t, "entity_id,attribute,value,resource_type\n1,status,draft,doc\n2,status,200,order\n4,owner_dept,rd,\n", exported.String())
+}
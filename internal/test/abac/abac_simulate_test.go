@@ -0,0 +1,171 @@
+package abac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ecodeclub/ekit/slice"
+	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
+	grpcabac "github.com/permission-dev/internal/api/grpc/abac"
+	"github.com/permission-dev/internal/api/grpc/interceptor/auth"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/provider"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestPolicySimulate(t *testing.T) {
+	t.Parallel()
+	level := domain.AttributeDefinition{ID: 1, Name: "level", DataType: domain.DataTypeNumber, EntityType: domain.SubjectTypeEntity}
+	defs := domain.BizAttrDefinition{
+		BizID:           1,
+		SubjectAttrDefs: domain.AttrDefs{level},
+		AllDefs:         map[int64]domain.AttributeDefinition{level.ID: level},
+	}
+	// policy 在 level >= minLevel 时对 doc:1 的权限生效
+	policy := func(id int64, effect domain.Effect, minLevel string) domain.Policy {
+		return domain.Policy{
+			ID:          id,
+			BizID:       1,
+			Status:      domain.PolicyStatusActive,
+			Permissions: []domain.UserPermission{{Permission: domain.Permission{ID: 1}, Effect: effect}},
+			Rules:       []domain.PolicyRule{{AttrDef: level, Operator: domain.GreaterOrEqual, Value: minLevel}},
+		}
+	}
+	// 已有的策略：level >= 5 时允许
+	policyRepo := &batchPolicyRepo{policies: []domain.Policy{policy(1, domain.EffectAllow, "5")}}
+	attrRepo := &batchAttrRepo{defs: defs}
+	selector := evaluator.NewSelector()
+	executor := abac.NewPolicyExecutor(selector)
+	cache := abac.NewPolicyCache(policyRepo, attrRepo, executor)
+	registry, err := provider.NewRegistry(nil)
+	require.NoError(t, err)
+	svc := abac.NewPermissionSvc(&batchPermissionRepo{}, &batchResourceRepo{}, cache, &batchValueRepo{},
+		attrRepo, &batchBizConfigRepo{}, executor, registry, provider.NewBuiltinEnv(provider.NewSystemClock(), defaultClientIPConfig))
+	samples := slice.Map([]string{"3", "6", "9"}, func(_ int, level string) domain.SimulationSample {
+		return domain.SimulationSample{
+			UserID:   1,
+			Resource: domain.Resource{Type: "doc", Key: "doc:1"},
+			Actions:  []string{"read"},
+			Attrs:    domain.Attributes{Subject: domain.SubAttrs{"level": level}},
+		}
+	})
+	type result struct {
+		before, after, applicable, matched bool
+	}
+	toResults := func(src []domain.SimulationResult) []result {
+		return slice.Map(src, func(_ int, r domain.SimulationResult) result {
+			return result{before: r.Before, after: r.After, applicable: r.Applicable, matched: r.Matched}
+		})
+	}
+
+	testCases := []struct {
+		name      string
+		candidate domain.Policy
+		want      []result
+	}{
+		{
+			name:      "新增 deny 策略",
+			candidate: policy(0, domain.EffectDeny, "8"),
+			want: []result{
+				{before: false, after: false, applicable: true},
+				{before: true, after: true, applicable: true},
+				{before: true, after: false, applicable: true, matched: true},
+			},
+		},
+		{
+			name:      "替换已有的策略",
+			candidate: policy(1, domain.EffectAllow, "7"),
+			want: []result{
+				{before: false, after: false, applicable: true},
+				{before: true, after: false, applicable: true},
+				{before: true, after: true, applicable: true, matched: true},
+			},
+		},
+		{
+			name: "候选策略不适用",
+			candidate: func() domain.Policy {
+				p := policy(0, domain.EffectDeny, "0")
+				p.Permissions[0].Permission.ID = 2
+				return p
+			}(),
+			want: []result{
+				{before: false, after: false},
+				{before: true, after: true},
+				{before: true, after: true},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := svc.Simulate(context.Background(), 1, tc.candidate, samples)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, toResults(res))
+		})
+	}
+
+	// 模拟不改变已有策略的校验结果
+	ok, err := svc.Check(context.Background(), 1, 1, samples[1].Resource, samples[1].Actions, samples[1].Attrs)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	server := grpcabac.NewABACPolicyServer(abac.NewPolicySvc(policyRepo, attrRepo, &batchPermissionRepo{}, selector, cache), svc)
+	ctx := context.WithValue(context.Background(), auth.BizIDName, int64(1))
+	request := func(attrID int64) *permissionv1.PolicyServiceSimulateRequest {
+		return &permissionv1.PolicyServiceSimulateRequest{
+			Policy: &permissionv1.Policy{Rules: []*permissionv1.PolicyRule{{
+				AttributeDefinition: &permissionv1.AttributeDefinition{Id: attrID},
+				Operator:            permissionv1.RuleOperator_RULE_OPERATOR_GREATER_OR_EQUAL,
+				Value:               "8",
+			}}},
+			Permissions: []*permissionv1.PolicyPermissionBinding{{PermissionId: 1, Effect: permissionv1.Effect_EFFECT_DENY}},
+			Samples: []*permissionv1.SimulationSample{{
+				Uid: 1, ResourceType: "doc", ResourceKey: "doc:1", Actions: []string{"read"},
+				SubjectAttributes: map[string]string{"level": "9"},
+			}},
+		}
+	}
+	resp, err := server.Simulate(ctx, request(1))
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	assert.True(t, resp.Results[0].Before)
+	assert.False(t, resp.Results[0].After)
+	assert.True(t, resp.Results[0].Changed)
+
+	// 候选策略引用了未定义的属性时返回 InvalidArgument
+	_, err = server.Simulate(ctx, request(99))
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), err)
+
+	// 比较值不是合法的属性值时返回 InvalidArgument
+	invalid := request(1)
+	invalid.Policy.Rules[0].Operator = permissionv1.RuleOperator_RULE_OPERATOR_EQUALS
+	invalid.Policy.Rules[0].Value = "abc"
+	_, err = server.Simulate(ctx, invalid)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), err)
+
+	// 样本数量达到上限时，同时校验的样本数量受限但结果完整
+	many := make([]domain.SimulationSample, domain.MaxSimulationSamples)
+	for idx := range many {
+		many[idx] = samples[idx%len(samples)]
+	}
+	res, err := svc.Simulate(context.Background(), 1, policy(0, domain.EffectDeny, "8"), many)
+	require.NoError(t, err)
+	require.Len(t, res, domain.MaxSimulationSamples)
+	for idx := range res {
+		assert.Equal(t, testCases[0].want[idx%len(samples)], toResults(res[idx : idx+1])[0])
+	}
+
+	// 样本数量超过上限时请求校验失败，服务端直接返回 InvalidArgument
+	req := request(1)
+	req.Samples = make([]*permissionv1.SimulationSample, domain.MaxSimulationSamples+1)
+	for idx := range req.Samples {
+		req.Samples[idx] = request(1).Samples[0]
+	}
+	assert.Error(t, req.Validate())
+	_, err = server.Simulate(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), err)
+}
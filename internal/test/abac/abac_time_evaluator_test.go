@@ -0,0 +1,139 @@
+package abac
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeEvaluator(t *testing.T) {
+	t.Parallel()
+	// utc 属性值，参数为 UTC 时间
+	utc := func(value string) string {
+		tm, err := time.Parse("2006-01-02 15:04", value)
+		require.NoError(t, err)
+		return strconv.FormatInt(tm.UnixMilli(), 10)
+	}
+	tests := []struct {
+		name      string
+		wantVal   string
+		op        domain.RuleOperator
+		actualVal string
+		want      bool
+		wantErr   bool
+	}{
+		// 时间点
+		{name: "时间戳", wantVal: "@time(" + utc("2024-05-06 09:00") + ")", op: domain.GreaterOrEqual, actualVal: utc("2024-05-06 09:00"), want: true},
+		{name: "不带函数的时间戳", wantVal: utc("2024-05-06 09:00"), op: domain.Greater, actualVal: utc("2024-05-06 09:00")},
+		{name: "时间戳相等", wantVal: "@time(" + utc("2024-05-06 09:00") + ")", op: domain.Equals, actualVal: utc("2024-05-06 09:00"), want: true},
+		{name: "时间戳不相等", wantVal: "@time(" + utc("2024-05-06 09:00") + ")", op: domain.NotEquals, actualVal: utc("2024-05-06 09:01"), want: true},
+		{name: "每天时间点", wantVal: "@day(09:00,UTC)", op: domain.GreaterOrEqual, actualVal: utc("2024-05-06 09:00"), want: true},
+		{name: "每天时间点之前", wantVal: "@day(9:00,UTC)", op: domain.Less, actualVal: utc("2024-05-06 08:59"), want: true},
+		{name: "时区", wantVal: "@day(09:00,Asia/Shanghai)", op: domain.GreaterOrEqual, actualVal: utc("2024-05-06 01:30"), want: true},
+		{name: "时区换算后在时间点之前", wantVal: "@day(09:00,Asia/Shanghai)", op: domain.GreaterOrEqual, actualVal: utc("2024-05-06 00:30")},
+		{name: "本周时间点", wantVal: "@week(1,09:30,UTC)", op: domain.GreaterOrEqual, actualVal: utc("2024-05-07 08:00"), want: true},
+		{name: "本周时间点之前", wantVal: "@week(3,09:30,UTC)", op: domain.Less, actualVal: utc("2024-05-07 10:00"), want: true},
+		{name: "日期零点", wantVal: "@date(2024-10-01,UTC)", op: domain.GreaterOrEqual, actualVal: utc("2024-10-01 00:00"), want: true},
+		{name: "日期零点之前", wantVal: "@date(2024-10-01,Asia/Shanghai)", op: domain.Less, actualVal: utc("2024-09-30 15:59"), want: true},
+
+		// 每天的时间段
+		{name: "时间段内", wantVal: "@day(09:00-18:00,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-05-06 01:30"), want: true},
+		{name: "时间段不包含结束时间", wantVal: "@day(09:00-18:00,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-05-06 10:00")},
+		{name: "不在时间段内", wantVal: "@day(09:00-18:00,Asia/Shanghai)", op: domain.NotEquals, actualVal: utc("2024-05-06 12:00"), want: true},
+		{name: "跨零点的时间段", wantVal: "@day(22:00-06:00,UTC)", op: domain.Equals, actualVal: utc("2024-05-06 23:00"), want: true},
+		{name: "跨零点的时间段次日", wantVal: "@day(22:00-06:00,UTC)", op: domain.Equals, actualVal: utc("2024-05-07 05:59"), want: true},
+		{name: "跨零点的时间段结束", wantVal: "@day(22:00-06:00,UTC)", op: domain.Equals, actualVal: utc("2024-05-07 06:00")},
+		{name: "到 24 点", wantVal: "@day(18:00-24:00,UTC)", op: domain.Equals, actualVal: utc("2024-05-06 23:59"), want: true},
+
+		// 星期集合
+		{name: "工作日工作时间", wantVal: "@week(1-5,09:00-18:00,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-05-06 02:00"), want: true},
+		{name: "周六", wantVal: "@week(1-5,09:00-18:00,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-05-11 02:00")},
+		{name: "UTC 周日是上海周一", wantVal: "@week(1-5,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-05-05 23:30"), want: true},
+		{name: "UTC 周五是上海周六", wantVal: "@week(1-5,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-05-10 16:30")},
+		{name: "跨周的星期范围", wantVal: "@week(5-1,UTC)", op: domain.Equals, actualVal: utc("2024-05-05 12:00"), want: true},
+		{name: "跨周的星期范围不包含周三", wantVal: "@week(5-1,UTC)", op: domain.Equals, actualVal: utc("2024-05-08 12:00")},
+		{name: "星期列表", wantVal: "@week(1|3,UTC)", op: domain.Equals, actualVal: utc("2024-05-08 12:00"), want: true},
+
+		// 夏令时，纽约 2024-03-10 02:00 跳到 03:00，2024-11-03 02:00 回到 01:00
+		{name: "夏令时开始前一天", wantVal: "@day(09:00-17:00,America/New_York)", op: domain.Equals, actualVal: utc("2024-03-09 14:30"), want: true},
+		{name: "夏令时开始当天", wantVal: "@day(09:00-17:00,America/New_York)", op: domain.Equals, actualVal: utc("2024-03-10 13:30"), want: true},
+		{name: "夏令时开始当天的 UTC 时间不再适用", wantVal: "@day(09:00-17:00,America/New_York)", op: domain.Equals, actualVal: utc("2024-03-10 21:30")},
+		{name: "跳过的一小时不匹配", wantVal: "@day(02:00-03:00,America/New_York)", op: domain.Equals, actualVal: utc("2024-03-10 07:00")},
+		{name: "跳过之前的时刻", wantVal: "@day(01:00-02:00,America/New_York)", op: domain.Equals, actualVal: utc("2024-03-10 06:59"), want: true},
+		{name: "夏令时结束当天", wantVal: "@day(09:00-17:00,America/New_York)", op: domain.Equals, actualVal: utc("2024-11-03 13:30")},
+		{name: "夏令时结束前一天", wantVal: "@day(09:00-17:00,America/New_York)", op: domain.Equals, actualVal: utc("2024-11-02 13:30"), want: true},
+		{name: "重复的一小时第一次", wantVal: "@day(01:00-02:00,America/New_York)", op: domain.Equals, actualVal: utc("2024-11-03 05:30"), want: true},
+		{name: "重复的一小时第二次", wantVal: "@day(01:00-02:00,America/New_York)", op: domain.Equals, actualVal: utc("2024-11-03 06:30"), want: true},
+		{name: "夏令时的时间点", wantVal: "@day(09:00,America/New_York)", op: domain.GreaterOrEqual, actualVal: utc("2024-07-01 13:00"), want: true},
+		{name: "冬令时的时间点", wantVal: "@day(09:00,America/New_York)", op: domain.GreaterOrEqual, actualVal: utc("2024-12-02 13:00")},
+
+		// 月末
+		{name: "31 号在二月按月末处理", wantVal: "@month(31,09:00,UTC)", op: domain.GreaterOrEqual, actualVal: utc("2024-02-29 10:00"), want: true},
+		{name: "31 号在二月之前", wantVal: "@month(31,09:00,UTC)", op: domain.Less, actualVal: utc("2024-02-28 10:00"), want: true},
+		{name: "31 号在小月", wantVal: "@month(31,UTC)", op: domain.Equals, actualVal: utc("2024-04-30 10:00"), want: true},
+		{name: "月末闰年", wantVal: "@month(L,UTC)", op: domain.Equals, actualVal: utc("2024-02-29 10:00"), want: true},
+		{name: "月末平年", wantVal: "@month(L,UTC)", op: domain.Equals, actualVal: utc("2023-02-28 10:00"), want: true},
+		{name: "闰年二月 28 号不是月末", wantVal: "@month(L,UTC)", op: domain.Equals, actualVal: utc("2024-02-28 10:00")},
+		{name: "时区换算后不是月末", wantVal: "@month(L,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-04-30 16:30")},
+		{name: "时区换算后是月末", wantVal: "@month(L,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-04-30 15:30"), want: true},
+		{name: "到月末的范围", wantVal: "@month(25-L,09:00-18:00,UTC)", op: domain.Equals, actualVal: utc("2024-04-30 10:00"), want: true},
+		{name: "到月末的范围之前", wantVal: "@month(25-L,UTC)", op: domain.Equals, actualVal: utc("2024-04-24 10:00")},
+		{name: "跨月的范围", wantVal: "@month(28-2,UTC)", op: domain.Equals, actualVal: utc("2024-03-01 10:00"), want: true},
+		{name: "跨月的范围之外", wantVal: "@month(28-2,UTC)", op: domain.Equals, actualVal: utc("2024-03-15 10:00")},
+
+		// 日期范围以及排除日期
+		{name: "日期范围", wantVal: "@date(2024-10-01~2024-10-07,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-09-30 16:30"), want: true},
+		{name: "日期范围之后", wantVal: "@date(2024-10-01~2024-10-07,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-10-07 16:00")},
+		{name: "日期列表", wantVal: "@date(2024-10-01~2024-10-07|2024-12-25,UTC)", op: domain.Equals, actualVal: utc("2024-12-25 10:00"), want: true},
+		{name: "日期范围内的时间段", wantVal: "@date(2024-12-24~2024-12-31,09:00-12:00,UTC)", op: domain.Equals, actualVal: utc("2024-12-31 13:00")},
+		{name: "节假日排除", wantVal: "@week(1-5,09:00-18:00,exclude=2024-10-01~2024-10-07|2024-12-25,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-10-01 02:00")},
+		{name: "节假日之后", wantVal: "@week(1-5,09:00-18:00,exclude=2024-10-01~2024-10-07|2024-12-25,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-10-08 02:00"), want: true},
+		{name: "单个节假日", wantVal: "@week(1-5,09:00-18:00,exclude=2024-10-01~2024-10-07|2024-12-25,Asia/Shanghai)", op: domain.NotEquals, actualVal: utc("2024-12-25 02:00"), want: true},
+		{name: "排除日期按规则时区判断", wantVal: "@day(00:00-24:00,exclude=2024-12-25,Asia/Shanghai)", op: domain.Equals, actualVal: utc("2024-12-24 16:30")},
+
+		// 无效的规则
+		{name: "未知的类型", wantVal: "@hour(1)", op: domain.Equals, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "= 需要时间段", wantVal: "@day(09:00,UTC)", op: domain.Equals, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "空的时间段", wantVal: "@day(09:00-09:00,UTC)", op: domain.Equals, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "比较运算不支持 24 点", wantVal: "@day(24:00,UTC)", op: domain.Greater, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "无效的小时", wantVal: "@day(25:00-26:00,UTC)", op: domain.Equals, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "无效的星期", wantVal: "@week(1-7,UTC)", op: domain.Equals, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "比较运算不支持星期集合", wantVal: "@week(1-5,09:00,UTC)", op: domain.Greater, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "无效的日期", wantVal: "@month(32,UTC)", op: domain.Equals, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "不存在的日期", wantVal: "@date(2024-02-30)", op: domain.Equals, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "日期范围颠倒", wantVal: "@date(2024-10-07~2024-10-01)", op: domain.Equals, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "未知的时区", wantVal: "@day(09:00,Mars/Olympus)", op: domain.Greater, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+		{name: "比较运算不支持排除日期", wantVal: "@day(09:00,exclude=2024-12-25)", op: domain.Greater, actualVal: utc("2024-05-06 10:00"), wantErr: true},
+	}
+	timeEvaluator := evaluator.NewTimeEvaluator()
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := timeEvaluator.Evaluator(tc.wantVal, tc.actualVal, tc.op)
+			// 保存规则时的校验与执行时一致
+			validateErr := timeEvaluator.Validate(tc.wantVal, tc.op)
+			if tc.wantErr {
+				assert.Error(t, err)
+				assert.Error(t, validateErr)
+				return
+			}
+			require.NoError(t, err)
+			require.NoError(t, validateErr)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestTimeEvaluatorSelectorValidate(t *testing.T) {
+	t.Parallel()
+	selector := evaluator.NewSelector()
+	assert.NoError(t, selector.Validate(domain.DataTypeDatetime, domain.Equals, "@week(1-5,09:00-18:00,Asia/Shanghai)"))
+	assert.ErrorIs(t, selector.Validate(domain.DataTypeDatetime, domain.Equals, "@week(1-5,9:00,Asia/Shanghai)"), errs.ErrInvalidPolicyRule)
+	assert.ErrorIs(t, selector.Validate(domain.DataTypeDatetime, domain.IN, "@day(09:00-18:00)"), errs.ErrInvalidPolicyRule)
+}
@@ -0,0 +1,154 @@
+package abac
+
+import (
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/expression"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testBizAttrDefinition() domain.BizAttrDefinition {
+	defs := domain.BizAttrDefinition{
+		SubjectAttrDefs: domain.AttrDefs{
+			{ID: 1, Name: "level", DataType: domain.DataTypeNumber, EntityType: domain.SubjectTypeEntity},
+			{ID: 2, Name: "tags", DataType: domain.DataTypeArray, EntityType: domain.SubjectTypeEntity},
+			{ID: 3, Name: "vip", DataType: domain.DataTypeBoolean, EntityType: domain.SubjectTypeEntity},
+			{ID: 6, Name: "id", DataType: domain.DataTypeNumber, EntityType: domain.SubjectTypeEntity},
+			{ID: 7, Name: "dept", DataType: domain.DataTypeString, EntityType: domain.SubjectTypeEntity},
+		},
+		ResourceAttrDefs: domain.AttrDefs{
+			{ID: 4, Name: "owner_dept", DataType: domain.DataTypeString, EntityType: domain.ResourceTypeEntity},
+			{ID: 8, Name: "owner_id", DataType: domain.DataTypeNumber, EntityType: domain.ResourceTypeEntity},
+			{ID: 9, Name: "shared_depts", DataType: domain.DataTypeArray, EntityType: domain.ResourceTypeEntity},
+		},
+		EnvironmentAttrDefs: domain.AttrDefs{
+			{ID: 5, Name: "time", DataType: domain.DataTypeDatetime, EntityType: domain.EnvironmentTypeEntity},
+			{ID: 10, Name: "client_ip", DataType: domain.DataTypeIP, EntityType: domain.EnvironmentTypeEntity},
+		},
+		AllDefs: map[int64]domain.AttributeDefinition{},
+	}
+	for _, attrs := range []domain.AttrDefs{defs.SubjectAttrDefs, defs.ResourceAttrDefs, defs.EnvironmentAttrDefs} {
+		for key, val := range attrs.Map() {
+			defs.AllDefs[key] = val
+		}
+	}
+	return defs
+}
+
+func TestPolicyExpression(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	tests := []struct {
+		name string
+		expr string
+		// want 为空表示渲染结果与 expr 相同
+		want string
+	}{
+		{
+			name: "比较",
+			expr: `subject.level >= 5`,
+		},
+		{
+			name: "AND 以及 NOT",
+			expr: `subject.level >= 5 AND resource.owner_dept IN ["a", "b"] AND NOT env.time < @day(9:00)`,
+		},
+		{
+			name: "OR 优先级低于 AND",
+			expr: `subject.vip = true OR subject.level > 3 AND subject.tags ANY MATCH ["x"]`,
+		},
+		{
+			name: "括号",
+			expr: `(subject.vip = true OR subject.level > 3) AND resource.owner_dept != "a\"b"`,
+		},
+		{
+			name: "关键字不区分大小写",
+			expr: `subject.level not in [1,2] and environment.time >= @week(1,9:30)`,
+			want: `subject.level NOT IN [1, 2] AND env.time >= @week(1,9:30)`,
+		},
+		{
+			name: "右侧引用属性",
+			expr: `resource.owner_id = subject.id OR subject.dept IN resource.shared_depts`,
+		},
+		{
+			name: "时间段",
+			expr: `env.time = @week(1-5,09:00-18:00,exclude=2024-10-01~2024-10-07,Asia/Shanghai)`,
+		},
+		{
+			name: "IP 网段",
+			expr: `env.client_ip IN CIDR ["10.0.0.0/8", "fd00::/8"] OR env.client_ip not in cidr ["192.168.0.0/16"]`,
+			want: `env.client_ip IN CIDR ["10.0.0.0/8", "fd00::/8"] OR env.client_ip NOT IN CIDR ["192.168.0.0/16"]`,
+		},
+		{
+			name: "右侧同级规则保留括号",
+			expr: `subject.level = 1 AND (subject.level = 2 AND subject.level == 3)`,
+			want: `subject.level = 1 AND (subject.level = 2 AND subject.level = 3)`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			rule, err := expression.Parse(tc.expr, defs, evaluator.NewSelector())
+			require.NoError(t, err)
+			got, err := expression.Format([]domain.PolicyRule{rule}, defs)
+			require.NoError(t, err)
+			want := tc.want
+			if want == "" {
+				want = tc.expr
+			}
+			assert.Equal(t, want, got)
+
+			// 渲染结果重新解析得到同样的规则树
+			again, err := expression.Parse(got, defs, evaluator.NewSelector())
+			require.NoError(t, err)
+			assert.Equal(t, rule, again)
+		})
+	}
+}
+
+func TestPolicyExpressionTree(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	rule, err := expression.Parse(`subject.level >= 5 AND NOT resource.owner_dept IN ["a","b"]`, defs, evaluator.NewSelector())
+	require.NoError(t, err)
+	assert.Equal(t, domain.AND, rule.Operator)
+	assert.Equal(t, domain.PolicyRule{AttrDef: defs.SubjectAttrDefs[0], Operator: domain.GreaterOrEqual, Value: "5"}, *rule.LeftRule)
+	not := *rule.RightRule
+	assert.Equal(t, domain.NOT, not.Operator)
+	assert.Nil(t, not.LeftRule)
+	assert.Equal(t, domain.PolicyRule{AttrDef: defs.ResourceAttrDefs[0], Operator: domain.IN, Value: `["a","b"]`}, *not.RightRule)
+}
+
+func TestPolicyExpressionInvalid(t *testing.T) {
+	t.Parallel()
+	defs := testBizAttrDefinition()
+	tests := []struct {
+		name string
+		expr string
+	}{
+		{name: "空表达式", expr: "  "},
+		{name: "未知前缀", expr: `user.level > 1`},
+		{name: "未定义的属性", expr: `subject.age > 1`},
+		{name: "类型不支持的运算符", expr: `subject.vip > true`},
+		{name: "取值类型不匹配", expr: `subject.level = "5"`},
+		{name: "列表元素类型不匹配", expr: `resource.owner_dept IN [1]`},
+		{name: "缺少右括号", expr: `(subject.level > 1`},
+		{name: "多余的内容", expr: `subject.level > 1 subject.level < 3`},
+		{name: "字符串没有结束", expr: `resource.owner_dept = "a`},
+		{name: "引用属性类型不匹配", expr: `resource.owner_id = subject.dept`},
+		{name: "引用属性 IN 要求数组", expr: `subject.dept IN resource.owner_dept`},
+		{name: "无效的时间段", expr: `env.time = @day(09:00)`},
+		{name: "无效的网段", expr: `env.client_ip IN CIDR ["10.0.0.0/33"]`},
+		{name: "IP 不支持大小比较", expr: `env.client_ip > "10.0.0.1"`},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := expression.Parse(tc.expr, defs, evaluator.NewSelector())
+			assert.ErrorIs(t, err, errs.ErrInvalidPolicyExpression)
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package abac
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
+	"github.com/permission-dev/internal/api/grpc/interceptor/auth"
+	iocAbac "github.com/permission-dev/internal/test/integration/ioc/abac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// 测试用的业务ID，避免和预设数据冲突
+const testBizID = int64(10086)
+
+type ABACGRPCSuite struct {
+	suite.Suite
+	server *grpc.Server
+	conn   *grpc.ClientConn
+	token  string
+
+	policyClient    permissionv1.PolicyServiceClient
+	attrValClient   permissionv1.AttributeValueServiceClient
+	attrDefinClient permissionv1.AttributeDefinitionServiceClient
+}
+
+func TestABACGRPC(t *testing.T) {
+	suite.Run(t, new(ABACGRPCSuite))
+}
+
+func (s *ABACGRPCSuite) SetupSuite() {
+	svc := iocAbac.Init()
+	// 与 ioc.InitGRPC 使用同一个鉴权拦截器
+	s.server = grpc.NewServer(grpc.UnaryInterceptor(auth.New(svc.Token).Build()))
+	permissionv1.RegisterPolicyServiceServer(s.server, svc.PolicyServer)
+	permissionv1.RegisterAttributeValueServiceServer(s.server, svc.AttrValServer)
+	permissionv1.RegisterAttributeDefinitionServiceServer(s.server, svc.AttrDefinitionServer)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	s.Require().NoError(err)
+	go func() {
+		_ = s.server.Serve(lis)
+	}()
+
+	s.conn, err = grpc.NewClient(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	s.Require().NoError(err)
+	s.policyClient = permissionv1.NewPolicyServiceClient(s.conn)
+	s.attrValClient = permissionv1.NewAttributeValueServiceClient(s.conn)
+	s.attrDefinClient = permissionv1.NewAttributeDefinitionServiceClient(s.conn)
+
+	s.token, err = svc.Token.Encode(map[string]any{
+		auth.BizIDName: testBizID,
+	})
+	s.Require().NoError(err)
+}
+
+func (s *ABACGRPCSuite) TearDownSuite() {
+	_ = s.conn.Close()
+	s.server.Stop()
+}
+
+func (s *ABACGRPCSuite) authCtx() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	return metadata.AppendToOutgoingContext(ctx, "Authorization", s.token), cancel
+}
+
+func (s *ABACGRPCSuite) TestUnauthenticated() {
+	t := s.T()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := s.attrDefinClient.Find(ctx, &permissionv1.AttributeDefinitionServiceFindRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.policyClient.FindPolicies(ctx, &permissionv1.PolicyServiceFindPoliciesRequest{Limit: 10})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	_, err = s.attrValClient.FindEnvironmentValueWithDefinition(ctx, &permissionv1.AttributeValueServiceFindEnvironmentValueWithDefinitionRequest{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func (s *ABACGRPCSuite) TestEndToEnd() {
+	t := s.T()
+	ctx, cancel := s.authCtx()
+	defer cancel()
+
+	// 属性定义
+	defResp, err := s.attrDefinClient.Save(ctx, &permissionv1.AttributeDefinitionServiceSaveRequest{
+		Definition: &permissionv1.AttributeDefinition{
+			Name:        "integration_level",
+			Description: "用户等级",
+			DataType:    permissionv1.DataType_DATA_TYPE_NUMBER,
+			EntityType:  permissionv1.EntityType_ENTITY_TYPE_SUBJECT,
+		},
+	})
+	require.NoError(t, err)
+	require.Greater(t, defResp.Id, int64(0))
+	defer func() {
+		_, err := s.attrDefinClient.Delete(ctx, &permissionv1.AttributeDefinitionServiceDeleteRequest{Id: defResp.Id})
+		assert.NoError(t, err)
+	}()
+
+	firstDef, err := s.attrDefinClient.First(ctx, &permissionv1.AttributeDefinitionServiceFirstRequest{Id: defResp.Id})
+	require.NoError(t, err)
+	assert.Equal(t, "integration_level", firstDef.Definition.Name)
+	assert.Equal(t, permissionv1.DataType_DATA_TYPE_NUMBER, firstDef.Definition.DataType)
+
+	bizDef, err := s.attrDefinClient.Find(ctx, &permissionv1.AttributeDefinitionServiceFindRequest{})
+	require.NoError(t, err)
+	assert.True(t, containsDefinition(bizDef.BizDefinition.SubjectAttrs, defResp.Id))
+
+	// 属性值
+	const subjectID = int64(1)
+	valResp, err := s.attrValClient.SaveSubjectValue(ctx, &permissionv1.AttributeValueServiceSaveSubjectValueRequest{
+		SubjectId: subjectID,
+		Value: &permissionv1.SubjectAttributeValue{
+			Definition: &permissionv1.AttributeDefinition{Id: defResp.Id},
+			Value:      "3",
+		},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_, err := s.attrValClient.DeleteSubjectValue(ctx, &permissionv1.AttributeValueServiceDeleteSubjectValueRequest{Id: valResp.Id})
+		assert.NoError(t, err)
+	}()
+
+	subject, err := s.attrValClient.FindSubjectValueWithDefinition(ctx, &permissionv1.AttributeValueServiceFindSubjectValueWithDefinitionRequest{
+		SubjectId: subjectID,
+	})
+	require.NoError(t, err)
+	require.Len(t, subject.Subject.AttributeValues, 1)
+	assert.Equal(t, "3", subject.Subject.AttributeValues[0].Value)
+	assert.Equal(t, "integration_level", subject.Subject.AttributeValues[0].Definition.Name)
+
+	// 策略以及规则
+	policyResp, err := s.policyClient.Save(ctx, &permissionv1.PolicyServiceSaveRequest{
+		Policy: &permissionv1.Policy{
+			Name:        "integration_policy",
+			Description: "等级大于2",
+			Status:      permissionv1.PolicyStatus_POLICY_STATUS_ACTIVE,
+		},
+	})
+	require.NoError(t, err)
+	require.Greater(t, policyResp.Id, int64(0))
+	defer func() {
+		_, err := s.policyClient.Delete(ctx, &permissionv1.PolicyServiceDeleteRequest{Id: policyResp.Id})
+		assert.NoError(t, err)
+	}()
+
+	ruleResp, err := s.policyClient.SaveRule(ctx, &permissionv1.PolicyServiceSaveRuleRequest{
+		PolicyId: policyResp.Id,
+		Rule: &permissionv1.PolicyRule{
+			AttributeDefinition: &permissionv1.AttributeDefinition{Id: defResp.Id},
+			Value:               "2",
+			Operator:            permissionv1.RuleOperator_RULE_OPERATOR_GREATER,
+		},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_, err := s.policyClient.DeleteRule(ctx, &permissionv1.PolicyServiceDeleteRuleRequest{RuleId: ruleResp.Id})
+		assert.NoError(t, err)
+	}()
+
+	_, err = s.policyClient.SavePermissionPolicy(ctx, &permissionv1.PolicyServiceSavePermissionPolicyRequest{
+		PolicyId:     policyResp.Id,
+		PermissionId: 1,
+		Effect:       permissionv1.Effect_EFFECT_ALLOW,
+	})
+	require.NoError(t, err)
+
+	policy, err := s.policyClient.First(ctx, &permissionv1.PolicyServiceFirstRequest{Id: policyResp.Id})
+	require.NoError(t, err)
+	assert.Equal(t, "integration_policy", policy.Policy.Name)
+	require.Len(t, policy.Policy.Rules, 1)
+	assert.Equal(t, "2", policy.Policy.Rules[0].Value)
+	assert.Equal(t, permissionv1.RuleOperator_RULE_OPERATOR_GREATER, policy.Policy.Rules[0].Operator)
+	assert.Equal(t, int64(0), policy.Policy.Version)
+
+	// 规则保存在草稿中，发布后才生效
+	publishResp, err := s.policyClient.Publish(ctx, &permissionv1.PolicyServicePublishRequest{
+		PolicyId: policyResp.Id,
+		Comment:  "等级大于2",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), publishResp.Version)
+
+	versions, err := s.policyClient.ListVersions(ctx, &permissionv1.PolicyServiceListVersionsRequest{PolicyId: policyResp.Id})
+	require.NoError(t, err)
+	require.Len(t, versions.Versions, 1)
+	assert.True(t, versions.Versions[0].Published)
+	assert.Equal(t, "等级大于2", versions.Versions[0].Comment)
+
+	diff, err := s.policyClient.DiffVersions(ctx, &permissionv1.PolicyServiceDiffVersionsRequest{
+		PolicyId: policyResp.Id,
+		From:     1,
+		To:       0,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "subject.integration_level > 2", diff.FromExpression)
+	assert.Empty(t, diff.AddedRules)
+	assert.Empty(t, diff.RemovedRules)
+
+	// 策略与权限的关联同样保存在版本中，版本的 effect 来自关联的权限
+	version, err := s.policyClient.GetVersion(ctx, &permissionv1.PolicyServiceGetVersionRequest{PolicyId: policyResp.Id, Version: 1})
+	require.NoError(t, err)
+	assert.Equal(t, permissionv1.Effect_EFFECT_ALLOW, version.Version.Policy.Effect)
+
+	policies, err := s.policyClient.FindPolicies(ctx, &permissionv1.PolicyServiceFindPoliciesRequest{Limit: 100})
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, policies.Total, int64(1))
+}
+
+func (s *ABACGRPCSuite) TestPermissionBindingVersions() {
+	t := s.T()
+	ctx, cancel := s.authCtx()
+	defer cancel()
+
+	policyResp, err := s.policyClient.Save(ctx, &permissionv1.PolicyServiceSaveRequest{
+		Policy: &permissionv1.Policy{
+			Name:   "integration_binding_policy",
+			Status: permissionv1.PolicyStatus_POLICY_STATUS_ACTIVE,
+		},
+	})
+	require.NoError(t, err)
+	defer func() {
+		_, err := s.policyClient.Delete(ctx, &permissionv1.PolicyServiceDeleteRequest{Id: policyResp.Id})
+		assert.NoError(t, err)
+	}()
+	// effect 来自版本中关联的权限，没有关联时为 UNKNOWN
+	effect := func(version int64) permissionv1.Effect {
+		resp, err := s.policyClient.GetVersion(ctx, &permissionv1.PolicyServiceGetVersionRequest{PolicyId: policyResp.Id, Version: version})
+		require.NoError(t, err)
+		return resp.Version.Policy.Effect
+	}
+
+	_, err = s.policyClient.Publish(ctx, &permissionv1.PolicyServicePublishRequest{PolicyId: policyResp.Id})
+	require.NoError(t, err)
+
+	// 关联权限只修改草稿，已经发布的版本不变
+	_, err = s.policyClient.SavePermissionPolicy(ctx, &permissionv1.PolicyServiceSavePermissionPolicyRequest{
+		PolicyId:     policyResp.Id,
+		PermissionId: 1,
+		Effect:       permissionv1.Effect_EFFECT_DENY,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, permissionv1.Effect_EFFECT_UNKNOWN, effect(1))
+
+	publishResp, err := s.policyClient.Publish(ctx, &permissionv1.PolicyServicePublishRequest{PolicyId: policyResp.Id})
+	require.NoError(t, err)
+	assert.Equal(t, permissionv1.Effect_EFFECT_DENY, effect(publishResp.Version))
+
+	// 回滚到版本 1，关联也恢复成版本 1 的，再次发布时不会带上回滚掉的关联
+	rollbackResp, err := s.policyClient.Rollback(ctx, &permissionv1.PolicyServiceRollbackRequest{PolicyId: policyResp.Id, Version: 1})
+	require.NoError(t, err)
+	assert.Equal(t, permissionv1.Effect_EFFECT_UNKNOWN, effect(rollbackResp.Version))
+	publishResp, err = s.policyClient.Publish(ctx, &permissionv1.PolicyServicePublishRequest{PolicyId: policyResp.Id})
+	require.NoError(t, err)
+	assert.Equal(t, permissionv1.Effect_EFFECT_UNKNOWN, effect(publishResp.Version))
+}
+
+func containsDefinition(defs []*permissionv1.AttributeDefinition, id int64) bool {
+	for _, def := range defs {
+		if def.Id == id {
+			return true
+		}
+	}
+	return false
+}
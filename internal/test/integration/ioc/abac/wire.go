@@ -0,0 +1,61 @@
+//go:build wireinject
+
+package abac
+
+import (
+	"github.com/google/wire"
+	abacGrpc "github.com/permission-dev/internal/api/grpc/abac"
+	"github.com/permission-dev/internal/pkg/jwt"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/repository/dao"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/provider"
+	"github.com/permission-dev/internal/test/ioc"
+)
+
+type Server struct {
+	PolicyServer         *abacGrpc.ABACPolicyServer
+	AttrValServer        *abacGrpc.ABACAttributeValServer
+	AttrDefinitionServer *abacGrpc.ABACAttributeDefinitionServer
+	Token                *jwt.Token
+}
+
+func Init() *Server {
+	wire.Build(
+		ioc.BaseSet,
+		dao.NewAttributeDefinitionDAO,
+		dao.NewResourceAttributeValueDAO,
+		dao.NewEnvironmentAttributeValueDAO,
+		dao.NewSubjectAttributeValueDAO,
+		dao.NewPolicyDAO,
+		dao.NewAttributeValueHistoryDAO,
+		dao.NewPermissionDAO,
+		dao.NewResourceDao,
+		dao.NewBusinessConfigDAO,
+
+		repository.NewAttributeDefinitionRepository,
+		repository.NewAttributeValueRepository,
+		repository.NewAttributePolicyRepository,
+		repository.NewPermissionRepository,
+		repository.NewResourceRepository,
+		repository.NewBusinessConfigRepository,
+
+		abac.NewAttributeDefinitionSvc,
+		abac.NewAttributeValueSvc,
+		abac.NewPolicySvc,
+		abac.NewPermissionSvc,
+		abac.NewPolicyExecutor,
+		abac.NewPolicyCache,
+		ioc.InitAttributeProviderRegistry,
+		evaluator.NewSelector,
+		provider.NewSystemClock,
+		provider.NewBuiltinEnv,
+
+		abacGrpc.NewABACPolicyServer,
+		abacGrpc.NewABACAttributeValServer,
+		abacGrpc.NewABACAttributeDefinitionServer,
+		wire.Struct(new(Server), "*"),
+	)
+	return nil
+}
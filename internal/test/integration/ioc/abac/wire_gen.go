@@ -0,0 +1,75 @@
+// Code generated by Wire. DO NOT EDIT.
+
+//go:generate go run -mod=mod github.com/google/wire/cmd/wire
+//go:build !wireinject
+// +build !wireinject
+
+package abac
+
+import (
+	abac2 "github.com/permission-dev/internal/api/grpc/abac"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/pkg/jwt"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/repository/dao"
+	"github.com/permission-dev/internal/service/abac"
+	"github.com/permission-dev/internal/service/abac/evaluator"
+	"github.com/permission-dev/internal/service/abac/provider"
+	"github.com/permission-dev/internal/test/ioc"
+)
+
+// Injectors from wire.go:
+
+func Init() *Server {
+	v := ioc.InitDBAndTables()
+	policyDAO := dao.NewPolicyDAO(v)
+	attributePolicyRepository := repository.NewAttributePolicyRepository(policyDAO)
+	attributeDefinitionDAO := dao.NewAttributeDefinitionDAO(v)
+	attributeDefinitionRepository := repository.NewAttributeDefinitionRepository(attributeDefinitionDAO)
+	permissionDAO := dao.NewPermissionDAO(v)
+	permissionRepository := repository.NewPermissionRepository(permissionDAO)
+	selector := evaluator.NewSelector()
+	policyExecutor := abac.NewPolicyExecutor(selector)
+	policyCache := abac.NewPolicyCache(attributePolicyRepository, attributeDefinitionRepository, policyExecutor)
+	policySvc := abac.NewPolicySvc(attributePolicyRepository, attributeDefinitionRepository, permissionRepository, selector, policyCache)
+	resourceDao := dao.NewResourceDao(v)
+	resourceRepository := repository.NewResourceRepository(resourceDao)
+	resourceAttributeValueDAO := dao.NewResourceAttributeValueDAO(v)
+	environmentAttributeValueDAO := dao.NewEnvironmentAttributeValueDAO(v)
+	subjectAttributeValueDAO := dao.NewSubjectAttributeValueDAO(v)
+	attributeValueHistoryDAO := dao.NewAttributeValueHistoryDAO(v)
+	attributeValueRepository := repository.NewAttributeValueRepository(resourceAttributeValueDAO, environmentAttributeValueDAO, subjectAttributeValueDAO, attributeDefinitionDAO, attributeValueHistoryDAO)
+	businessConfigDAO := dao.NewBusinessConfigDAO(v)
+	businessConfigRepository := repository.NewBusinessConfigRepository(businessConfigDAO)
+	registry := ioc.InitAttributeProviderRegistry()
+	clock := provider.NewSystemClock()
+	clientIPConfig := _wireClientIPConfigValue
+	builtinEnv := provider.NewBuiltinEnv(clock, clientIPConfig)
+	permissionSvc := abac.NewPermissionSvc(permissionRepository, resourceRepository, policyCache, attributeValueRepository, attributeDefinitionRepository, businessConfigRepository, policyExecutor, registry, builtinEnv)
+	abacPolicyServer := abac2.NewABACPolicyServer(policySvc, permissionSvc)
+	attributeValueSvc := abac.NewAttributeValueSvc(attributeValueRepository, attributeDefinitionRepository)
+	abacAttributeValServer := abac2.NewABACAttributeValServer(attributeValueSvc)
+	attributeDefinitionSvc := abac.NewAttributeDefinitionSvc(attributeDefinitionRepository, policyCache)
+	abacAttributeDefinitionServer := abac2.NewABACAttributeDefinitionServer(attributeDefinitionSvc)
+	token := ioc.InitJWTToken()
+	server := &Server{
+		PolicyServer:         abacPolicyServer,
+		AttrValServer:        abacAttributeValServer,
+		AttrDefinitionServer: abacAttributeDefinitionServer,
+		Token:                token,
+	}
+	return server
+}
+
+var (
+	_wireClientIPConfigValue = domain.ClientIPConfig{Attribute: domain.DefaultClientIPAttribute}
+)
+
+// wire.go:
+
+type Server struct {
+	PolicyServer         *abac2.ABACPolicyServer
+	AttrValServer        *abac2.ABACAttributeValServer
+	AttrDefinitionServer *abac2.ABACAttributeDefinitionServer
+	Token                *jwt.Token
+}
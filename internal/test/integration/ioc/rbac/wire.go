@@ -33,6 +33,7 @@ func Init() *Service {
 		dao.NewUserPermissionDAO,
 		dao.NewRoleInclusionDAO,
 		dao.NewBusinessConfigDAO,
+		dao.NewGrantHistoryDAO,
 		repository.NewRoleRepository,
 		repository.NewResourceRepository,
 		repository.NewPermissionRepository,
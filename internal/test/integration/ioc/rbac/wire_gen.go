@@ -7,6 +7,7 @@
 package rbac
 
 import (
+	"github.com/permission-dev/internal/domain"
 	"github.com/permission-dev/internal/repository"
 	"github.com/permission-dev/internal/repository/dao"
 	"github.com/permission-dev/internal/service/rbac"
@@ -16,25 +17,27 @@ import (
 // Injectors from wire.go:
 
 func Init() *Service {
-	db := ioc.InitDBAndTables()
-	roleDAO := dao.NewRoleDao(db)
+	v := ioc.InitDBAndTables()
+	roleDAO := dao.NewRoleDao(v)
 	roleRepository := repository.NewRoleRepository(roleDAO)
-	resourceDao := dao.NewResourceDao(db)
+	resourceDao := dao.NewResourceDao(v)
 	resourceRepository := repository.NewResourceRepository(resourceDao)
-	permissionDAO := dao.NewPermissionDAO(db)
+	permissionDAO := dao.NewPermissionDAO(v)
 	permissionRepository := repository.NewPermissionRepository(permissionDAO)
-	userRoleDAO := dao.NewUserDaoDAO(db)
+	userRoleDAO := dao.NewUserDaoDAO(v)
 	userRoleRepository := repository.NewUserRoleRepository(userRoleDAO)
-	rolePermissionDAO := dao.NewRolePermissionDAO(db)
+	rolePermissionDAO := dao.NewRolePermissionDAO(v)
 	rolePermissionRepository := repository.NewRolePermissionRepository(rolePermissionDAO)
-	roleInclusionDAO := dao.NewRoleInclusionDAO(db)
+	roleInclusionDAO := dao.NewRoleInclusionDAO(v)
 	roleIncludeRepository := repository.NewRoleIncludeRepository(roleInclusionDAO)
-	businessConfigDAO := dao.NewBusinessConfigDAO(db)
+	businessConfigDAO := dao.NewBusinessConfigDAO(v)
 	businessConfigRepository := repository.NewBusinessConfigRepository(businessConfigDAO)
-	userPermissionDAO := dao.NewUserPermissionDAO(db)
-	userPermissionRepository := repository.NewUserPermissionRepository(userPermissionDAO)
+	userPermissionDAO := dao.NewUserPermissionDAO(v)
+	grantHistoryDAO := dao.NewGrantHistoryDAO(v)
+	roleInclusionConfig := _wireRoleInclusionConfigValue
+	userPermissionRepository := repository.NewUserPermissionRepository(userRoleDAO, roleInclusionDAO, rolePermissionDAO, userPermissionDAO, grantHistoryDAO, roleInclusionConfig)
 	token := ioc.InitJWTToken()
-	service := rbac.NewService(roleRepository, resourceRepository, permissionRepository, userRoleRepository, rolePermissionRepository, roleIncludeRepository, userPermissionRepository, businessConfigRepository, token)
+	service := rbac.NewService(roleRepository, resourceRepository, permissionRepository, userRoleRepository, rolePermissionRepository, roleIncludeRepository, userPermissionRepository, businessConfigRepository, token, roleInclusionConfig)
 	rbacService := &Service{
 		RoleRepo:           roleRepository,
 		ResourceRepo:       resourceRepository,
@@ -49,6 +52,10 @@ func Init() *Service {
 	return rbacService
 }
 
+var (
+	_wireRoleInclusionConfigValue = domain.RoleInclusionConfig{MaxDepth: domain.DefaultMaxRoleInclusionDepth}
+)
+
 // wire.go:
 
 type Service struct {
@@ -0,0 +1,12 @@
+package ioc
+
+import "github.com/permission-dev/internal/service/abac/provider"
+
+// InitAttributeProviderRegistry 测试环境不配置属性来源
+func InitAttributeProviderRegistry() provider.Registry {
+	registry, err := provider.NewRegistry(nil)
+	if err != nil {
+		panic(err)
+	}
+	return registry
+}
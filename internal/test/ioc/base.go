@@ -1,5 +1,13 @@
 package ioc
 
-import "github.com/google/wire"
+import (
+	"github.com/google/wire"
+	"github.com/permission-dev/internal/domain"
+)
 
-var BaseSet = wire.NewSet(InitDBAndTables, InitJWTToken)
+var BaseSet = wire.NewSet(
+	InitDBAndTables,
+	InitJWTToken,
+	wire.Value(domain.RoleInclusionConfig{MaxDepth: domain.DefaultMaxRoleInclusionDepth}),
+	wire.Value(domain.ClientIPConfig{Attribute: domain.DefaultClientIPAttribute}),
+)
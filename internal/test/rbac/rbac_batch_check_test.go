@@ -0,0 +1,28 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	permissionv1 "github.com/permission-dev/api/proto/gen/permission/v1"
+	"github.com/permission-dev/internal/api/grpc/interceptor/auth"
+	grpcrbac "github.com/permission-dev/internal/api/grpc/rbac"
+	"github.com/permission-dev/internal/domain"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestBatchCheckPermissionTooManyItems(t *testing.T) {
+	t.Parallel()
+	ctx := context.WithValue(context.Background(), auth.BizIDName, int64(1))
+	req := &permissionv1.BatchCheckPermissionRequest{Uid: 1}
+	for i := 0; i <= domain.MaxCheckItems; i++ {
+		req.Permissions = append(req.Permissions, &permissionv1.Permission{ResourceType: "doc", ResourceKey: "doc:1", Actions: []string{"read"}})
+	}
+	assert.Error(t, req.Validate())
+
+	// 超过上限时不查询权限，直接返回 InvalidArgument
+	_, err := grpcrbac.NewPermissionServer(nil).BatchCheckPermission(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -0,0 +1,63 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ecodeclub/ecache/memory/lru"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/repository"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// countingBizConfigRepo 记录 FindByID 的调用次数
+type countingBizConfigRepo struct {
+	repository.BusinessConfigRepository
+	config domain.BusinessConfig
+	finds  int
+}
+
+func (r *countingBizConfigRepo) FindByID(_ context.Context, id int64) (domain.BusinessConfig, error) {
+	r.finds++
+	config := r.config
+	config.ID = id
+	return config, nil
+}
+
+func (r *countingBizConfigRepo) Update(_ context.Context, config domain.BusinessConfig) (domain.BusinessConfig, error) {
+	r.config = config
+	return config, nil
+}
+
+func TestBusinessConfigCachedRepository(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	db := &countingBizConfigRepo{config: domain.BusinessConfig{CheckMode: domain.CheckModeABAC}}
+	repo := repository.NewBusinessConfigCachedRepository(db, lru.NewCache(100), time.Minute)
+
+	for i := 0; i < 3; i++ {
+		config, err := repo.FindByID(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, domain.CheckModeABAC, config.CheckMode)
+	}
+	assert.Equal(t, 1, db.finds)
+
+	// 修改后重新读取
+	_, err := repo.Update(ctx, domain.BusinessConfig{ID: 1, CheckMode: domain.CheckModeRBACOrABAC})
+	require.NoError(t, err)
+	config, err := repo.FindByID(ctx, 1)
+	require.NoError(t, err)
+	assert.Equal(t, domain.CheckModeRBACOrABAC, config.CheckMode)
+	assert.Equal(t, 2, db.finds)
+
+	// 过期后重新读取
+	repo = repository.NewBusinessConfigCachedRepository(db, lru.NewCache(100), time.Millisecond)
+	_, err = repo.FindByID(ctx, 2)
+	require.NoError(t, err)
+	time.Sleep(5 * time.Millisecond)
+	_, err = repo.FindByID(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, 4, db.finds)
+}
@@ -0,0 +1,45 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/service/rbac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpdateBusinessConfigKeepsOmittedFields(t *testing.T) {
+	t.Parallel()
+	stored := domain.BusinessConfig{
+		ID:                    1,
+		Name:                  "old",
+		CheckMode:             domain.CheckModeABAC,
+		CombiningAlgorithm:    domain.CombiningPermitOverrides,
+		NotApplicableDecision: domain.EffectAllow,
+	}
+	repo := &countingBizConfigRepo{config: stored}
+	svc := rbac.NewService(nil, nil, nil, nil, nil, nil, nil, repo, nil, domain.RoleInclusionConfig{})
+
+	// 未指定的字段保留原有的值
+	_, err := svc.UpdateBusinessConfig(context.Background(), domain.BusinessConfig{ID: 1, Name: "new"})
+	require.NoError(t, err)
+	want := stored
+	want.Name = "new"
+	assert.Equal(t, want, repo.config)
+
+	// 指定的字段覆盖原有的值
+	_, err = svc.UpdateBusinessConfig(context.Background(), domain.BusinessConfig{
+		ID:                    1,
+		Name:                  "new",
+		CheckMode:             domain.CheckModeRBAC,
+		CombiningAlgorithm:    domain.CombiningFirstApplicable,
+		NotApplicableDecision: domain.EffectDeny,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, domain.CheckModeRBAC, repo.config.CheckMode)
+	assert.Equal(t, domain.CombiningFirstApplicable, repo.config.CombiningAlgorithm)
+	assert.Equal(t, domain.EffectDeny, repo.config.NotApplicableDecision)
+	assert.Equal(t, 1, repo.finds)
+}
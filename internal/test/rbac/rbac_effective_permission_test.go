@@ -0,0 +1,106 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/rbac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// staticUserPermissionRepo 固定返回同一组用户权限
+type staticUserPermissionRepo struct {
+	repository.UserPermissionRepository
+	permissions []domain.UserPermission
+}
+
+func (r *staticUserPermissionRepo) GetALLUserPermission(_ context.Context, _, _ int64) ([]domain.UserPermission, error) {
+	return r.permissions, nil
+}
+
+func TestGetEffectivePermissions(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Unix()
+	grant := func(id int64, key, action string, effect domain.Effect) domain.UserPermission {
+		return domain.UserPermission{
+			ID:         id,
+			Permission: domain.Permission{ID: id, Resource: domain.Resource{Type: "order", Key: key}, Action: action},
+			StartTime:  now - 10,
+			EndTime:    now + 3600,
+			Effect:     effect,
+		}
+	}
+	repo := &staticUserPermissionRepo{permissions: []domain.UserPermission{
+		grant(1, "/order/*", "read", domain.EffectDeny),
+		grant(2, "/order/123", "read", domain.EffectAllow),
+		grant(3, "/order/**", "write", domain.EffectAllow),
+		grant(4, "/order/*", "write", domain.EffectDeny),
+		grant(5, "/order/456", "read", domain.EffectAllow),
+		grant(6, "/order/456", "read", domain.EffectDeny),
+		grant(7, "/order/789", "write", domain.EffectAllow),
+	}}
+	// 其他资源类型的权限不返回
+	other := grant(8, "/user/1", "read", domain.EffectAllow)
+	other.Permission.Resource.Type = "user"
+	repo.permissions = append(repo.permissions, other)
+	// 已经过期的权限不返回
+	repo.permissions = append(repo.permissions, domain.UserPermission{
+		ID:         9,
+		Permission: domain.Permission{ID: 9, Resource: domain.Resource{Type: "order", Key: "/order/999"}, Action: "read"},
+		StartTime:  now - 3600,
+		EndTime:    now - 10,
+		Effect:     domain.EffectAllow,
+	})
+	svc := rbac.NewService(nil, nil, nil, nil, nil, nil, repo, nil, nil, domain.RoleInclusionConfig{})
+	checker := rbac.NewPermissionService(repo)
+
+	res, err := svc.GetEffectivePermissions(context.Background(), 1, 1, "order", "")
+	require.NoError(t, err)
+	type entry struct {
+		key     string
+		action  string
+		effect  domain.Effect
+		sources []int64
+	}
+	assert.Equal(t, []entry{
+		// /order/123 的 allow 只覆盖 /order/* 中的一个资源，不影响 /order/* 本身
+		{key: "/order/*", action: "read", effect: domain.EffectDeny, sources: []int64{1}},
+		// 更具体的 /order/* deny 覆盖 /order/**，但是不影响 /order/** 本身
+		{key: "/order/*", action: "write", effect: domain.EffectDeny, sources: []int64{4}},
+		{key: "/order/**", action: "write", effect: domain.EffectAllow, sources: []int64{3}},
+		{key: "/order/123", action: "read", effect: domain.EffectAllow, sources: []int64{2}},
+		// 同样具体时 deny 优先
+		{key: "/order/456", action: "read", effect: domain.EffectDeny, sources: []int64{6}},
+		{key: "/order/789", action: "write", effect: domain.EffectAllow, sources: []int64{7}},
+	}, slice.Map(res, func(_ int, src domain.EffectivePermission) entry {
+		return entry{
+			key:    src.Permission.Resource.Key,
+			action: src.Permission.Action,
+			effect: src.Effect,
+			sources: slice.Map(src.Sources, func(_ int, up domain.UserPermission) int64 {
+				return up.ID
+			}),
+		}
+	}))
+
+	// 具体 key 上的结果和权限校验一致
+	for _, ep := range res {
+		if domain.IsResourceKeyPattern(ep.Permission.Resource.Key) {
+			continue
+		}
+		ok, err := checker.Check(context.Background(), 1, 1, ep.Permission.Resource, []string{ep.Permission.Action})
+		require.NoError(t, err)
+		assert.Equal(t, ok, ep.Effect.IsAllow(), ep.Permission.Resource.Key)
+	}
+
+	// 前缀只过滤返回的条目，前缀之外的通配 deny 仍然生效
+	res, err = svc.GetEffectivePermissions(context.Background(), 1, 1, "order", "/order/4")
+	require.NoError(t, err)
+	require.Len(t, res, 1)
+	assert.Equal(t, domain.EffectDeny, res[0].Effect)
+}
@@ -0,0 +1,120 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/repository/dao"
+	"github.com/permission-dev/internal/service/rbac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// grantDAOs 在内存中保存用户角色、角色包含关系、角色权限以及个人权限
+type grantDAOs struct {
+	userRoles       []dao.UserRole
+	inclusions      []dao.RoleInclusion
+	rolePermissions []dao.RolePermission
+	userPermissions []dao.UserPermission
+}
+
+type grantUserRoleDAO struct {
+	dao.UserRoleDAO
+	*grantDAOs
+}
+
+func (d grantUserRoleDAO) FindByBizIDAndUserID(_ context.Context, bizId, userId int64) ([]dao.UserRole, error) {
+	return slice.FilterMap(d.userRoles, func(_ int, src dao.UserRole) (dao.UserRole, bool) {
+		return src, src.BizID == bizId && src.UserID == userId
+	}), nil
+}
+
+type grantRoleInclusionDAO struct {
+	dao.RoleInclusionDAO
+	*grantDAOs
+}
+
+func (d grantRoleInclusionDAO) FindByBizIdAndIncludingIds(_ context.Context, bizId int64, includingIds []int64) ([]dao.RoleInclusion, error) {
+	return slice.FilterMap(d.inclusions, func(_ int, src dao.RoleInclusion) (dao.RoleInclusion, bool) {
+		return src, src.BizID == bizId && slice.Contains(includingIds, src.IncludingRoleID)
+	}), nil
+}
+
+type grantRolePermissionDAO struct {
+	dao.RolePermissionDAO
+	*grantDAOs
+}
+
+func (d grantRolePermissionDAO) FindByBizIDAndRoleIds(_ context.Context, bizId int64, roleIds []int64) ([]dao.RolePermission, error) {
+	return slice.FilterMap(d.rolePermissions, func(_ int, src dao.RolePermission) (dao.RolePermission, bool) {
+		return src, src.BizID == bizId && slice.Contains(roleIds, src.RoleID)
+	}), nil
+}
+
+type grantUserPermissionDAO struct {
+	dao.UserPermissionDAO
+	*grantDAOs
+}
+
+func (d grantUserPermissionDAO) FindUnexpiredByBizIdAndUserId(_ context.Context, bizId, userId, now int64) ([]dao.UserPermission, error) {
+	return slice.FilterMap(d.userPermissions, func(_ int, src dao.UserPermission) (dao.UserPermission, bool) {
+		return src, src.BizID == bizId && src.UserID == userId && src.EndTime >= now
+	}), nil
+}
+
+func (d *grantDAOs) userPermissionRepo(maxDepth int) repository.UserPermissionRepository {
+	return repository.NewUserPermissionRepository(grantUserRoleDAO{grantDAOs: d}, grantRoleInclusionDAO{grantDAOs: d},
+		grantRolePermissionDAO{grantDAOs: d}, grantUserPermissionDAO{grantDAOs: d}, nil, domain.RoleInclusionConfig{MaxDepth: maxDepth})
+}
+
+func TestExplainInheritedGrant(t *testing.T) {
+	t.Parallel()
+	now := time.Now().Unix()
+	// 用户 1 拥有角色 10，10 包含 20，20 包含 30，角色 30 授予了 doc:1 的 read 权限，
+	// 同时直接授予了用户 doc:1 的 read 权限
+	daos := &grantDAOs{
+		userRoles: []dao.UserRole{{BizID: 1, UserID: 1, RoleID: 10, StartTime: now - 10, EndTime: now + 3600}},
+		inclusions: []dao.RoleInclusion{
+			{BizID: 1, IncludingRoleID: 10, IncludedRoleID: 20},
+			{BizID: 1, IncludingRoleID: 20, IncludedRoleID: 30},
+		},
+		rolePermissions: []dao.RolePermission{
+			{BizID: 1, RoleID: 30, PermissionID: 100, ResourceType: "doc", ResourceKey: "doc:1", PermissionAction: "read"},
+			{BizID: 1, RoleID: 20, PermissionID: 101, ResourceType: "doc", ResourceKey: "doc:1", PermissionAction: "write"},
+		},
+		userPermissions: []dao.UserPermission{
+			{ID: 5, BizID: 1, UserID: 1, PermissionID: 100, ResourceType: "doc", ResourceKey: "doc:1", PermissionAction: "read",
+				StartTime: now - 10, EndTime: now + 3600, Effect: "allow"},
+		},
+	}
+	svc := rbac.NewPermissionService(daos.userPermissionRepo(domain.DefaultMaxRoleInclusionDepth))
+
+	trace, err := svc.Explain(context.Background(), 1, 1, domain.Resource{Type: "doc", Key: "doc:1"}, []string{"read"})
+	require.NoError(t, err)
+	assert.True(t, trace.Allowed)
+	require.Len(t, trace.Matches, 2)
+	// 直接授予的权限没有角色路径，继承的权限带上从直接拥有的角色到授予角色的路径
+	assert.Equal(t, int64(5), trace.Matches[0].ID)
+	assert.Empty(t, trace.Matches[0].RolePath)
+	assert.Equal(t, int64(100), trace.Matches[1].Permission.ID)
+	assert.Equal(t, []int64{10, 20, 30}, trace.Matches[1].RolePath)
+	assert.Equal(t, now+3600, trace.Matches[1].EndTime)
+
+	// 没有命中的动作不返回匹配项
+	trace, err = svc.Explain(context.Background(), 1, 1, domain.Resource{Type: "doc", Key: "doc:1"}, []string{"delete"})
+	require.NoError(t, err)
+	assert.False(t, trace.Allowed)
+	assert.Empty(t, trace.Matches)
+
+	// 超过最大包含深度的角色不再展开
+	svc = rbac.NewPermissionService(daos.userPermissionRepo(1))
+	trace, err = svc.Explain(context.Background(), 1, 1, domain.Resource{Type: "doc", Key: "doc:1"}, []string{"read", "write"})
+	require.NoError(t, err)
+	assert.Equal(t, [][]int64{nil, {10, 20}}, slice.Map(trace.Matches, func(_ int, src domain.UserPermission) []int64 {
+		return src.RolePath
+	}))
+}
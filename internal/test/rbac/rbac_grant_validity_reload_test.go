@@ -0,0 +1,112 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/ecodeclub/ecache/memory/lru"
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/event/permission"
+	"github.com/permission-dev/internal/job"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/repository/cache"
+	"github.com/permission-dev/internal/repository/dao"
+	pkgcache "github.com/permission-dev/pkg/cache"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func (d grantUserPermissionDAO) FindByValidityBoundary(_ context.Context, from, to int64) ([]dao.UserPermission, error) {
+	return slice.FilterMap(d.userPermissions, func(_ int, src dao.UserPermission) (dao.UserPermission, bool) {
+		return src, (src.StartTime > from && src.StartTime <= to) || (src.EndTime >= from && src.EndTime < to)
+	}), nil
+}
+
+func (d grantUserRoleDAO) FindByValidityBoundary(_ context.Context, from, to int64) ([]dao.UserRole, error) {
+	return slice.FilterMap(d.userRoles, func(_ int, src dao.UserRole) (dao.UserRole, bool) {
+		return src, (src.StartTime > from && src.StartTime <= to) || (src.EndTime >= from && src.EndTime < to)
+	}), nil
+}
+
+// expirationRecorder 记录每个 key 最近一次设置的过期时间
+type expirationRecorder struct {
+	pkgcache.Cache
+	expirations map[string]time.Duration
+}
+
+func (r *expirationRecorder) Set(ctx context.Context, key string, val any, expiration time.Duration) error {
+	r.expirations[key] = expiration
+	return r.Cache.Set(ctx, key, val, expiration)
+}
+
+type recordingEventProducer struct {
+	events []permission.UserPermissionEvent
+}
+
+func (p *recordingEventProducer) Produce(_ context.Context, event permission.UserPermissionEvent) error {
+	p.events = append(p.events, event)
+	return nil
+}
+
+func validityCacheKey(bizID, userID int64) string {
+	return fmt.Sprintf("permission:bizID:%d:userID:%d", bizID, userID)
+}
+
+func TestUserPermissionCacheExpiresAtValidityBoundary(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().Unix()
+	recorder := &expirationRecorder{Cache: lru.NewCache(100), expirations: map[string]time.Duration{}}
+	c := cache.NewUserPermissionCache(recorder, validityCacheKey)
+
+	// 最近的变化是 120 秒后生效的权限
+	err := c.Set(ctx, []domain.UserPermission{
+		{BizID: 1, UserID: 1, StartTime: now - 10, EndTime: now + 3600},
+		{BizID: 1, UserID: 1, StartTime: now + 120, EndTime: now + 3600},
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 120*time.Second, recorder.expirations[validityCacheKey(1, 1)], float64(time.Second))
+
+	// 最近的变化是 60 秒后失效的权限，失效时刻为 EndTime 的下一秒
+	err = c.Set(ctx, []domain.UserPermission{
+		{BizID: 1, UserID: 2, StartTime: now - 10, EndTime: now + 60},
+	})
+	require.NoError(t, err)
+	assert.InDelta(t, 61*time.Second, recorder.expirations[validityCacheKey(1, 2)], float64(time.Second))
+}
+
+func TestGrantValidityReloadTask(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	now := time.Now().Unix()
+	// 用户 1 的权限在 30 秒前生效，90 秒后失效；用户 2 的权限有效期在本周期内没有变化
+	daos := &grantDAOs{
+		userPermissions: []dao.UserPermission{
+			{ID: 1, BizID: 1, UserID: 1, PermissionID: 100, ResourceType: "doc", ResourceKey: "doc:1", PermissionAction: "read",
+				StartTime: now - 30, EndTime: now + 90, Effect: "allow"},
+			{ID: 2, BizID: 1, UserID: 2, PermissionID: 100, ResourceType: "doc", ResourceKey: "doc:1", PermissionAction: "read",
+				StartTime: now - 3600, EndTime: now + 3600, Effect: "allow"},
+		},
+	}
+	repo := daos.userPermissionRepo(domain.DefaultMaxRoleInclusionDepth)
+	recorder := &expirationRecorder{Cache: lru.NewCache(100), expirations: map[string]time.Duration{}}
+	producer := &recordingEventProducer{}
+	reloader := repository.NewUserPermissionCachedRepository(repo, cache.NewUserPermissionCache(recorder, validityCacheKey), producer)
+	task := job.NewGrantValidityReloadTask(repo, reloader, time.Minute)
+
+	require.NoError(t, task.Run(ctx, now-60, now))
+
+	// 只重新加载并通知有效期发生变化的用户
+	require.Len(t, producer.events, 1)
+	evt := producer.events[0]
+	require.Len(t, evt.Permissions, 1)
+	require.Len(t, evt.Permissions[1].Permissions, 1)
+	assert.Equal(t, now-30, evt.Permissions[1].Permissions[0].StartTime)
+	assert.Equal(t, now+90, evt.Permissions[1].Permissions[0].EndTime)
+	// 缓存在下一次失效时过期
+	assert.InDelta(t, 91*time.Second, recorder.expirations[validityCacheKey(1, 1)], float64(time.Second))
+	assert.NotContains(t, recorder.expirations, validityCacheKey(1, 2))
+}
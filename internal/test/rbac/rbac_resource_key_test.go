@@ -0,0 +1,115 @@
+package rbac
+
+import (
+	"testing"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchResourceKey(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		pattern string
+		key     string
+		want    bool
+	}{
+		{pattern: "/order/123", key: "/order/123", want: true},
+		{pattern: "/order/123", key: "/order/1234", want: false},
+		{pattern: "/order/*", key: "/order/123", want: true},
+		{pattern: "/order/*", key: "/order", want: false},
+		// * 只匹配一段
+		{pattern: "/order/*", key: "/order/123/items", want: false},
+		{pattern: "/order/*/items", key: "/order/123/items", want: true},
+		{pattern: "/order/12?", key: "/order/123", want: true},
+		{pattern: "/order/12?", key: "/order/12", want: false},
+		{pattern: "/order/[0-9]*", key: "/order/123", want: true},
+		{pattern: "/order/[0-9]*", key: "/order/abc", want: false},
+		{pattern: "/order/[^a]*", key: "/order/abc", want: false},
+		{pattern: "/order/[^a]*", key: "/order/bc", want: true},
+		// ** 匹配零个或者多个段
+		{pattern: "/order/**", key: "/order", want: true},
+		{pattern: "/order/**", key: "/order/123/items", want: true},
+		{pattern: "/order/**/items", key: "/order/items", want: true},
+		{pattern: "/order/**/items", key: "/order/1/2/items", want: true},
+		{pattern: "/order/**/items", key: "/order/1/2/item", want: false},
+		{pattern: "/**", key: "/user/1", want: true},
+		// 语法错误的 pattern 不匹配任何 key
+		{pattern: "/order/[", key: "/order/[", want: false},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.pattern+" "+tc.key, func(t *testing.T) {
+			assert.Equal(t, tc.want, domain.MatchResourceKey(tc.pattern, tc.key))
+		})
+	}
+}
+
+func TestResourceKeySpecificity(t *testing.T) {
+	t.Parallel()
+	// 从最具体到最不具体
+	patterns := []string{"/order/123/items", "/order/123", "/order/*/*", "/order/12?", "/order/**", "/**"}
+	for i := 1; i < len(patterns); i++ {
+		assert.Greater(t, domain.ResourceKeySpecificity(patterns[i-1]), domain.ResourceKeySpecificity(patterns[i]),
+			"%s 应该比 %s 具体", patterns[i-1], patterns[i])
+	}
+	// 同一段内的 *、?、[...] 一样具体
+	assert.Equal(t, domain.ResourceKeySpecificity("/order/*"), domain.ResourceKeySpecificity("/order/[0-9]*"))
+	assert.Equal(t, domain.ResourceKeySpecificity("/order/*"), domain.ResourceKeySpecificity("/order/1?3"))
+}
+
+func TestEffectDecision(t *testing.T) {
+	t.Parallel()
+	type grant struct {
+		pattern string
+		effect  domain.Effect
+	}
+	testCases := []struct {
+		name   string
+		grants []grant
+		want   bool
+	}{
+		{name: "没有匹配的权限", want: false},
+		{name: "只有 allow", grants: []grant{{"/order/*", domain.EffectAllow}}, want: true},
+		{name: "只有 deny", grants: []grant{{"/order/*", domain.EffectDeny}}, want: false},
+		{
+			name:   "同样具体时 deny 优先",
+			grants: []grant{{"/order/*", domain.EffectAllow}, {"/order/[0-9]*", domain.EffectDeny}},
+			want:   false,
+		},
+		{
+			name:   "同样具体时 deny 优先，与顺序无关",
+			grants: []grant{{"/order/123", domain.EffectDeny}, {"/order/123", domain.EffectAllow}},
+			want:   false,
+		},
+		{
+			name:   "具体的 allow 覆盖通配的 deny",
+			grants: []grant{{"/order/*", domain.EffectDeny}, {"/order/123", domain.EffectAllow}},
+			want:   true,
+		},
+		{
+			name:   "具体的 deny 覆盖通配的 allow",
+			grants: []grant{{"/order/123", domain.EffectDeny}, {"/order/**", domain.EffectAllow}},
+			want:   false,
+		},
+		{
+			name:   "单段通配比多段通配具体",
+			grants: []grant{{"/order/**", domain.EffectDeny}, {"/order/?23", domain.EffectAllow}},
+			want:   true,
+		},
+		{
+			name:   "不具体的权限在后面也不生效",
+			grants: []grant{{"/order/123", domain.EffectAllow}, {"/**", domain.EffectDeny}},
+			want:   true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var decision domain.EffectDecision
+			for _, g := range tc.grants {
+				assert.True(t, domain.MatchResourceKey(g.pattern, "/order/123"))
+				decision.Add(domain.ResourceKeySpecificity(g.pattern), g.effect)
+			}
+			assert.Equal(t, tc.want, decision.Allowed())
+		})
+	}
+}
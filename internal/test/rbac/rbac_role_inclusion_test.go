@@ -0,0 +1,127 @@
+package rbac
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/ecodeclub/ekit/slice"
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/rbac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// memRoleIncludeRepo 在内存中保存包含关系，CreateWithCheck 和数据库实现一样串行执行
+type memRoleIncludeRepo struct {
+	repository.RoleIncludeRepository
+	mu         sync.Mutex
+	inclusions []domain.RoleInclusion
+}
+
+func (r *memRoleIncludeRepo) CreateWithCheck(ctx context.Context, inclusion domain.RoleInclusion,
+	check func(ctx context.Context, repo repository.RoleIncludeRepository) error) (domain.RoleInclusion, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := check(ctx, r); err != nil {
+		return domain.RoleInclusion{}, err
+	}
+	inclusion.ID = int64(len(r.inclusions) + 1)
+	r.inclusions = append(r.inclusions, inclusion)
+	return inclusion, nil
+}
+
+func (r *memRoleIncludeRepo) FindByBizIdAndIncludingIds(_ context.Context, bizId int64, includingIds []int64) ([]domain.RoleInclusion, error) {
+	return slice.FilterMap(r.inclusions, func(_ int, src domain.RoleInclusion) (domain.RoleInclusion, bool) {
+		return src, src.BizID == bizId && slice.Contains(includingIds, src.IncludingRole.ID)
+	}), nil
+}
+
+func (r *memRoleIncludeRepo) FindByBizIdAndIncludedIds(_ context.Context, bizId int64, includedIds []int64) ([]domain.RoleInclusion, error) {
+	return slice.FilterMap(r.inclusions, func(_ int, src domain.RoleInclusion) (domain.RoleInclusion, bool) {
+		return src, src.BizID == bizId && slice.Contains(includedIds, src.IncludedRole.ID)
+	}), nil
+}
+
+func roleInclusion(including, included int64) domain.RoleInclusion {
+	return domain.RoleInclusion{BizID: 1, IncludingRole: domain.Role{ID: including}, IncludedRole: domain.Role{ID: included}}
+}
+
+func newInclusionService(repo repository.RoleIncludeRepository, maxDepth int) rbac.Service {
+	return rbac.NewService(nil, nil, nil, nil, nil, repo, nil, nil, nil, domain.RoleInclusionConfig{MaxDepth: maxDepth})
+}
+
+func TestCreateRoleInclusion(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name     string
+		existing [][2]int64
+		maxDepth int
+		create   [2]int64
+		wantErr  error
+	}{
+		{name: "包含自身", maxDepth: 3, create: [2]int64{1, 1}, wantErr: errs.ErrRoleInclusionCycle},
+		{name: "直接形成环", existing: [][2]int64{{1, 2}}, maxDepth: 3, create: [2]int64{2, 1}, wantErr: errs.ErrRoleInclusionCycle},
+		{name: "间接形成环", existing: [][2]int64{{1, 2}, {2, 3}}, maxDepth: 5, create: [2]int64{3, 1}, wantErr: errs.ErrRoleInclusionCycle},
+		{name: "其他业务的包含关系不影响", existing: nil, maxDepth: 3, create: [2]int64{2, 1}},
+		{name: "不形成环的菱形", existing: [][2]int64{{1, 2}, {1, 3}, {2, 4}}, maxDepth: 3, create: [2]int64{3, 4}},
+		// 1 -> 2 -> 3 加上 3 -> 4 后深度为 3
+		{name: "刚好达到最大深度", existing: [][2]int64{{1, 2}, {2, 3}}, maxDepth: 3, create: [2]int64{3, 4}},
+		{name: "向下超过最大深度", existing: [][2]int64{{2, 3}, {3, 4}}, maxDepth: 2, create: [2]int64{1, 2}, wantErr: errs.ErrRoleInclusionTooDeep},
+		{name: "两端连起来超过最大深度", existing: [][2]int64{{1, 2}, {3, 4}}, maxDepth: 2, create: [2]int64{2, 3}, wantErr: errs.ErrRoleInclusionTooDeep},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			repo := &memRoleIncludeRepo{}
+			for _, e := range tc.existing {
+				repo.inclusions = append(repo.inclusions, roleInclusion(e[0], e[1]))
+			}
+			// 其他业务中存在反向的包含关系
+			repo.inclusions = append(repo.inclusions, domain.RoleInclusion{BizID: 2, IncludingRole: domain.Role{ID: 1}, IncludedRole: domain.Role{ID: 2}})
+			svc := newInclusionService(repo, tc.maxDepth)
+
+			_, err := svc.CreateRoleInclusion(context.Background(), roleInclusion(tc.create[0], tc.create[1]))
+			if tc.wantErr != nil {
+				assert.ErrorIs(t, err, tc.wantErr)
+				assert.Len(t, repo.inclusions, len(tc.existing)+1)
+				return
+			}
+			require.NoError(t, err)
+			assert.Len(t, repo.inclusions, len(tc.existing)+2)
+		})
+	}
+}
+
+func TestCreateRoleInclusionConcurrently(t *testing.T) {
+	t.Parallel()
+	repo := &memRoleIncludeRepo{}
+	svc := newInclusionService(repo, domain.DefaultMaxRoleInclusionDepth)
+
+	// 并发创建 1 包含 2 和 2 包含 1，只能有一个成功
+	for i := 0; i < 50; i++ {
+		repo.inclusions = nil
+		var wg sync.WaitGroup
+		errCh := make(chan error, 2)
+		for _, inclusion := range []domain.RoleInclusion{roleInclusion(1, 2), roleInclusion(2, 1)} {
+			wg.Add(1)
+			go func(inclusion domain.RoleInclusion) {
+				defer wg.Done()
+				_, err := svc.CreateRoleInclusion(context.Background(), inclusion)
+				errCh <- err
+			}(inclusion)
+		}
+		wg.Wait()
+		close(errCh)
+		var failed int
+		for err := range errCh {
+			if err != nil {
+				assert.ErrorIs(t, err, errs.ErrRoleInclusionCycle)
+				failed++
+			}
+		}
+		assert.Equal(t, 1, failed)
+		assert.Len(t, repo.inclusions, 1)
+	}
+}
@@ -0,0 +1,82 @@
+package rbac
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/permission-dev/internal/domain"
+	"github.com/permission-dev/internal/errs"
+	"github.com/permission-dev/internal/repository"
+	"github.com/permission-dev/internal/service/rbac"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type singlePermissionRepo struct {
+	repository.PermissionRepository
+}
+
+func (r *singlePermissionRepo) FindPermissions(_ context.Context, bizId int64, resourceType, resourceKey string, actions []string) ([]domain.Permission, error) {
+	return []domain.Permission{{ID: 1, BizID: bizId, Resource: domain.Resource{Type: resourceType, Key: resourceKey}, Action: actions[0]}}, nil
+}
+
+// directGrantRepo 把权限 1 直接授予了 users 个用户，并且记录查询时的 limit
+type directGrantRepo struct {
+	repository.UserPermissionRepository
+	users int
+	limit int
+}
+
+func (r *directGrantRepo) FindByBizIDAndPermissionIDs(_ context.Context, bizId int64, _ []int64, limit int) ([]domain.UserPermission, error) {
+	r.limit = limit
+	now := time.Now().Unix()
+	res := make([]domain.UserPermission, 0, min(r.users, limit))
+	for i := 1; i <= r.users && len(res) < limit; i++ {
+		res = append(res, domain.UserPermission{
+			BizID:      bizId,
+			UserID:     int64(i),
+			Permission: domain.Permission{ID: 1},
+			StartTime:  now - 10,
+			EndTime:    now + 3600,
+			Effect:     domain.EffectAllow,
+		})
+	}
+	return res, nil
+}
+
+type emptyRolePermissionRepo struct {
+	repository.RolePermissionRepository
+}
+
+func (r *emptyRolePermissionRepo) FindByBizIDAndPermissionIDs(_ context.Context, _ int64, _ []int64) ([]domain.RolePermission, error) {
+	return nil, nil
+}
+
+func TestWhoCanAccessBounds(t *testing.T) {
+	t.Parallel()
+	resource := domain.Resource{Type: "doc", Key: "doc:1"}
+	newSvc := func(repo *directGrantRepo) rbac.Service {
+		return rbac.NewService(nil, nil, &singlePermissionRepo{}, nil, &emptyRolePermissionRepo{}, nil, repo, nil, nil,
+			domain.RoleInclusionConfig{MaxDepth: domain.DefaultMaxRoleInclusionDepth})
+	}
+
+	repo := &directGrantRepo{users: 25}
+	users, total, err := newSvc(repo).WhoCanAccess(context.Background(), 1, resource, "read", 20, 10)
+	require.NoError(t, err)
+	assert.Equal(t, 25, total)
+	require.Len(t, users, 5)
+	assert.Equal(t, int64(21), users[0].UserID)
+
+	// 负数 offset 不会越界
+	users, _, err = newSvc(repo).WhoCanAccess(context.Background(), 1, resource, "read", -1, 2)
+	require.NoError(t, err)
+	require.Len(t, users, 2)
+	assert.Equal(t, int64(1), users[0].UserID)
+
+	// 查询时限制了加载的条数，超过上限时返回错误而不是截断
+	repo = &directGrantRepo{users: 20000}
+	_, _, err = newSvc(repo).WhoCanAccess(context.Background(), 1, resource, "read", 0, 10)
+	assert.ErrorIs(t, err, errs.ErrTooManyGrants)
+	assert.Less(t, repo.limit, repo.users)
+}